@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
 )
 
 type TodoServiceClient interface {
@@ -19,48 +20,121 @@ type TodoServiceClient interface {
 	PatchTodoMethod
 }
 type DeleteTodosMethod interface {
-	DeleteTodos(request *DeleteTodosRequest) (DeleteTodosResponse, error)
+	DeleteTodos(ctx context.Context, request *DeleteTodosRequest) (DeleteTodosResponse, error)
 }
 type ListTodosMethod interface {
-	ListTodos(request *ListTodosRequest) (ListTodosResponse, error)
+	ListTodos(ctx context.Context, request *ListTodosRequest) (ListTodosResponse, error)
 }
 type PostTodoMethod interface {
-	PostTodo(request *PostTodoRequest) (PostTodoResponse, error)
+	PostTodo(ctx context.Context, request *PostTodoRequest) (PostTodoResponse, error)
 }
 type DeleteTodoMethod interface {
-	DeleteTodo(request *DeleteTodoRequest) (DeleteTodoResponse, error)
+	DeleteTodo(ctx context.Context, request *DeleteTodoRequest) (DeleteTodoResponse, error)
 }
 type GetTodoMethod interface {
-	GetTodo(request *GetTodoRequest) (GetTodoResponse, error)
+	GetTodo(ctx context.Context, request *GetTodoRequest) (GetTodoResponse, error)
 }
 type PatchTodoMethod interface {
-	PatchTodo(request *PatchTodoRequest) (PatchTodoResponse, error)
+	PatchTodo(ctx context.Context, request *PatchTodoRequest) (PatchTodoResponse, error)
 }
 
 func NewTodoServiceClient(httpClient *http.Client, baseUrl string, options Opts) TodoServiceClient {
-	return &todoServiceClient{httpClient: newHttpClientWrapper(httpClient, baseUrl), baseURL: baseUrl, hooks: options.Hooks, ctx: options.Ctx, xmlMatcher: regexp.MustCompile("^application\\/(.+)xml$")}
+	ctx := options.Ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	client := &todoServiceClient{httpClient: newHttpClientWrapper(httpClient, baseUrl), baseURL: baseUrl, hooks: options.Hooks, ctx: ctx, credentials: options.Credentials, responseErrors: options.ResponseErrors, streamItems: options.StreamItems, xmlMatcher: regexp.MustCompile("^(application|text)\\/(.+\\+)?xml$")}
+	if options.Validate {
+		client.validator = NewValidation()
+		client.registerValidators()
+	}
+	return client
+}
+
+// TodoServiceClientWithoutContext is the client interface of former versions without context parameters, use TodoServiceClient instead
+type TodoServiceClientWithoutContext interface {
+	DeleteTodos(request *DeleteTodosRequest) (DeleteTodosResponse, error)
+	ListTodos(request *ListTodosRequest) (ListTodosResponse, error)
+	PostTodo(request *PostTodoRequest) (PostTodoResponse, error)
+	DeleteTodo(request *DeleteTodoRequest) (DeleteTodoResponse, error)
+	GetTodo(request *GetTodoRequest) (GetTodoResponse, error)
+	PatchTodo(request *PatchTodoRequest) (PatchTodoResponse, error)
+}
+
+// NewTodoServiceClientWithoutContext creates a client for existing callers of the methods without context parameter, the calls use the context of the options
+func NewTodoServiceClientWithoutContext(httpClient *http.Client, baseUrl string, options Opts) TodoServiceClientWithoutContext {
+	return &todoServiceClientWithoutContext{client: NewTodoServiceClient(httpClient, baseUrl, options), ctx: options.Ctx}
+}
+
+type todoServiceClientWithoutContext struct {
+	client TodoServiceClient
+	ctx    context.Context
+}
+
+func (client *todoServiceClientWithoutContext) DeleteTodos(request *DeleteTodosRequest) (DeleteTodosResponse, error) {
+	return client.client.DeleteTodos(client.ctx, request)
+}
+
+func (client *todoServiceClientWithoutContext) ListTodos(request *ListTodosRequest) (ListTodosResponse, error) {
+	return client.client.ListTodos(client.ctx, request)
+}
+
+func (client *todoServiceClientWithoutContext) PostTodo(request *PostTodoRequest) (PostTodoResponse, error) {
+	return client.client.PostTodo(client.ctx, request)
+}
+
+func (client *todoServiceClientWithoutContext) DeleteTodo(request *DeleteTodoRequest) (DeleteTodoResponse, error) {
+	return client.client.DeleteTodo(client.ctx, request)
+}
+
+func (client *todoServiceClientWithoutContext) GetTodo(request *GetTodoRequest) (GetTodoResponse, error) {
+	return client.client.GetTodo(client.ctx, request)
+}
+
+func (client *todoServiceClientWithoutContext) PatchTodo(request *PatchTodoRequest) (PatchTodoResponse, error) {
+	return client.client.PatchTodo(client.ctx, request)
 }
 
 type todoServiceClient struct {
-	baseURL    string
-	hooks      HooksClient
-	ctx        context.Context
-	httpClient *httpClientWrapper
-	xmlMatcher *regexp.Regexp
+	baseURL        string
+	hooks          HooksClient
+	ctx            context.Context
+	httpClient     *httpClientWrapper
+	xmlMatcher     *regexp.Regexp
+	validator      *Validator
+	credentials    map[string]CredentialProvider
+	responseErrors bool
+	streamItems    bool
 }
 
-func (client *todoServiceClient) DeleteTodos(request *DeleteTodosRequest) (DeleteTodosResponse, error) {
+func (client *todoServiceClient) DeleteTodos(ctx context.Context, request *DeleteTodosRequest) (DeleteTodosResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/todos"
 	method := "DELETE"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
+	if request.IfMatch != "" {
+		httpRequest.Header[ifMatchHeader] = []string{request.IfMatch}
+	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfUnmodifiedSince.IsZero() {
+		httpRequest.Header[ifUnmodifiedSinceHeader] = []string{formatHTTPTime(request.IfUnmodifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -69,15 +143,21 @@ func (client *todoServiceClient) DeleteTodos(request *DeleteTodosRequest) (Delet
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "DeleteTodos", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusNoContent {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(DeleteTodos204Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -85,23 +165,35 @@ func (client *todoServiceClient) DeleteTodos(request *DeleteTodosRequest) (Delet
 
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *todoServiceClient) ListTodos(request *ListTodosRequest) (ListTodosResponse, error) {
+func (client *todoServiceClient) ListTodos(ctx context.Context, request *ListTodosRequest) (ListTodosResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/todos"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -109,49 +201,80 @@ func (client *todoServiceClient) ListTodos(request *ListTodosRequest) (ListTodos
 	}
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
+		if client.streamItems {
+			httpRequest.Header["Accept"] = []string{"application/x-ndjson, application/json;q=0.9"}
+		}
 	}
+	operation := ClientOperation{ID: "ListTodos", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
-	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeApplicationNdjson {
+			response := new(ListTodos200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+			response.Items = readListTodosItems(httpResponse.Body)
+			return response, nil
+		}
+		defer httpResponse.Body.Close()
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(ListTodos200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(ListTodos200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	defer httpResponse.Body.Close()
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(ListTodos304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *todoServiceClient) PostTodo(request *PostTodoRequest) (PostTodoResponse, error) {
+func (client *todoServiceClient) PostTodo(ctx context.Context, request *PostTodoRequest) (PostTodoResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/todos"
 	method := "POST"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	jsonData := new(bytes.Buffer)
 	encodeErr := json.NewEncoder(jsonData).Encode(&request.TodoPost)
 	if encodeErr != nil {
 		return nil, encodeErr
 	}
-	httpRequest, reqErr := http.NewRequest(method, endpoint, jsonData)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, jsonData)
 	if reqErr != nil {
 		return nil, reqErr
 	}
@@ -164,18 +287,22 @@ func (client *todoServiceClient) PostTodo(request *PostTodoRequest) (PostTodoRes
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "PostTodo", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusCreated {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(PostTodo201Response)
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
@@ -187,24 +314,39 @@ func (client *todoServiceClient) PostTodo(request *PostTodoRequest) (PostTodoRes
 
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *todoServiceClient) DeleteTodo(request *DeleteTodoRequest) (DeleteTodoResponse, error) {
+func (client *todoServiceClient) DeleteTodo(ctx context.Context, request *DeleteTodoRequest) (DeleteTodoResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/todos/{todoId}"
 	method := "DELETE"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	endpoint = strings.Replace(endpoint, "{todoId}", url.QueryEscape(toString(request.TodoId)), 1)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
+	if request.IfMatch != "" {
+		httpRequest.Header[ifMatchHeader] = []string{request.IfMatch}
+	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfUnmodifiedSince.IsZero() {
+		httpRequest.Header[ifUnmodifiedSinceHeader] = []string{formatHTTPTime(request.IfUnmodifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -213,15 +355,21 @@ func (client *todoServiceClient) DeleteTodo(request *DeleteTodoRequest) (DeleteT
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "DeleteTodo", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusNoContent {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(DeleteTodo204Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -231,6 +379,9 @@ func (client *todoServiceClient) DeleteTodo(request *DeleteTodoRequest) (DeleteT
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(DeleteTodo404Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -238,24 +389,36 @@ func (client *todoServiceClient) DeleteTodo(request *DeleteTodoRequest) (DeleteT
 
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *todoServiceClient) GetTodo(request *GetTodoRequest) (GetTodoResponse, error) {
+func (client *todoServiceClient) GetTodo(ctx context.Context, request *GetTodoRequest) (GetTodoResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/todos/{todoId}"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	endpoint = strings.Replace(endpoint, "{todoId}", url.QueryEscape(toString(request.TodoId)), 1)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -264,22 +427,30 @@ func (client *todoServiceClient) GetTodo(request *GetTodoRequest) (GetTodoRespon
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "GetTodo", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(GetTodo200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(GetTodo200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -289,37 +460,61 @@ func (client *todoServiceClient) GetTodo(request *GetTodoRequest) (GetTodoRespon
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(GetTodo404Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(GetTodo304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *todoServiceClient) PatchTodo(request *PatchTodoRequest) (PatchTodoResponse, error) {
+func (client *todoServiceClient) PatchTodo(ctx context.Context, request *PatchTodoRequest) (PatchTodoResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/todos/{todoId}"
 	method := "PATCH"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	endpoint = strings.Replace(endpoint, "{todoId}", url.QueryEscape(toString(request.TodoId)), 1)
 	jsonData := new(bytes.Buffer)
 	encodeErr := json.NewEncoder(jsonData).Encode(&request.TodoPatch)
 	if encodeErr != nil {
 		return nil, encodeErr
 	}
-	httpRequest, reqErr := http.NewRequest(method, endpoint, jsonData)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, jsonData)
 	if reqErr != nil {
 		return nil, reqErr
 	}
 	httpRequest.Header[contentTypeHeader] = []string{contentTypeApplicationJson}
+	if request.IfMatch != "" {
+		httpRequest.Header[ifMatchHeader] = []string{request.IfMatch}
+	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfUnmodifiedSince.IsZero() {
+		httpRequest.Header[ifUnmodifiedSinceHeader] = []string{formatHTTPTime(request.IfUnmodifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -328,22 +523,30 @@ func (client *todoServiceClient) PatchTodo(request *PatchTodoRequest) (PatchTodo
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "PatchTodo", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(PatchTodo200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(PatchTodo200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -353,6 +556,9 @@ func (client *todoServiceClient) PatchTodo(request *PatchTodoRequest) (PatchTodo
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(PatchTodo404Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -360,7 +566,9 @@ func (client *todoServiceClient) PatchTodo(request *PatchTodoRequest) (PatchTodo
 
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
+
+func (client *todoServiceClient) registerValidators() {}
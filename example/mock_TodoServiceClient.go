@@ -2,20 +2,24 @@
 
 package todo
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockTodoServiceClient is an autogenerated mock type for the TodoServiceClient type
 type MockTodoServiceClient struct {
 	mock.Mock
 }
 
-// DeleteTodo provides a mock function with given fields: request
-func (_m *MockTodoServiceClient) DeleteTodo(request *DeleteTodoRequest) (DeleteTodoResponse, error) {
-	ret := _m.Called(request)
+// DeleteTodo provides a mock function with given fields: ctx, request
+func (_m *MockTodoServiceClient) DeleteTodo(ctx context.Context, request *DeleteTodoRequest) (DeleteTodoResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 DeleteTodoResponse
-	if rf, ok := ret.Get(0).(func(*DeleteTodoRequest) DeleteTodoResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *DeleteTodoRequest) DeleteTodoResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(DeleteTodoResponse)
@@ -23,8 +27,8 @@ func (_m *MockTodoServiceClient) DeleteTodo(request *DeleteTodoRequest) (DeleteT
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*DeleteTodoRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *DeleteTodoRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -32,13 +36,13 @@ func (_m *MockTodoServiceClient) DeleteTodo(request *DeleteTodoRequest) (DeleteT
 	return r0, r1
 }
 
-// DeleteTodos provides a mock function with given fields: request
-func (_m *MockTodoServiceClient) DeleteTodos(request *DeleteTodosRequest) (DeleteTodosResponse, error) {
-	ret := _m.Called(request)
+// DeleteTodos provides a mock function with given fields: ctx, request
+func (_m *MockTodoServiceClient) DeleteTodos(ctx context.Context, request *DeleteTodosRequest) (DeleteTodosResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 DeleteTodosResponse
-	if rf, ok := ret.Get(0).(func(*DeleteTodosRequest) DeleteTodosResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *DeleteTodosRequest) DeleteTodosResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(DeleteTodosResponse)
@@ -46,8 +50,8 @@ func (_m *MockTodoServiceClient) DeleteTodos(request *DeleteTodosRequest) (Delet
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*DeleteTodosRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *DeleteTodosRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -55,13 +59,13 @@ func (_m *MockTodoServiceClient) DeleteTodos(request *DeleteTodosRequest) (Delet
 	return r0, r1
 }
 
-// GetTodo provides a mock function with given fields: request
-func (_m *MockTodoServiceClient) GetTodo(request *GetTodoRequest) (GetTodoResponse, error) {
-	ret := _m.Called(request)
+// GetTodo provides a mock function with given fields: ctx, request
+func (_m *MockTodoServiceClient) GetTodo(ctx context.Context, request *GetTodoRequest) (GetTodoResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 GetTodoResponse
-	if rf, ok := ret.Get(0).(func(*GetTodoRequest) GetTodoResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *GetTodoRequest) GetTodoResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(GetTodoResponse)
@@ -69,8 +73,8 @@ func (_m *MockTodoServiceClient) GetTodo(request *GetTodoRequest) (GetTodoRespon
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*GetTodoRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *GetTodoRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -78,13 +82,13 @@ func (_m *MockTodoServiceClient) GetTodo(request *GetTodoRequest) (GetTodoRespon
 	return r0, r1
 }
 
-// ListTodos provides a mock function with given fields: request
-func (_m *MockTodoServiceClient) ListTodos(request *ListTodosRequest) (ListTodosResponse, error) {
-	ret := _m.Called(request)
+// ListTodos provides a mock function with given fields: ctx, request
+func (_m *MockTodoServiceClient) ListTodos(ctx context.Context, request *ListTodosRequest) (ListTodosResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 ListTodosResponse
-	if rf, ok := ret.Get(0).(func(*ListTodosRequest) ListTodosResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *ListTodosRequest) ListTodosResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(ListTodosResponse)
@@ -92,8 +96,8 @@ func (_m *MockTodoServiceClient) ListTodos(request *ListTodosRequest) (ListTodos
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*ListTodosRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *ListTodosRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -101,13 +105,13 @@ func (_m *MockTodoServiceClient) ListTodos(request *ListTodosRequest) (ListTodos
 	return r0, r1
 }
 
-// PatchTodo provides a mock function with given fields: request
-func (_m *MockTodoServiceClient) PatchTodo(request *PatchTodoRequest) (PatchTodoResponse, error) {
-	ret := _m.Called(request)
+// PatchTodo provides a mock function with given fields: ctx, request
+func (_m *MockTodoServiceClient) PatchTodo(ctx context.Context, request *PatchTodoRequest) (PatchTodoResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 PatchTodoResponse
-	if rf, ok := ret.Get(0).(func(*PatchTodoRequest) PatchTodoResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *PatchTodoRequest) PatchTodoResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(PatchTodoResponse)
@@ -115,8 +119,8 @@ func (_m *MockTodoServiceClient) PatchTodo(request *PatchTodoRequest) (PatchTodo
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*PatchTodoRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *PatchTodoRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -124,13 +128,13 @@ func (_m *MockTodoServiceClient) PatchTodo(request *PatchTodoRequest) (PatchTodo
 	return r0, r1
 }
 
-// PostTodo provides a mock function with given fields: request
-func (_m *MockTodoServiceClient) PostTodo(request *PostTodoRequest) (PostTodoResponse, error) {
-	ret := _m.Called(request)
+// PostTodo provides a mock function with given fields: ctx, request
+func (_m *MockTodoServiceClient) PostTodo(ctx context.Context, request *PostTodoRequest) (PostTodoResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 PostTodoResponse
-	if rf, ok := ret.Get(0).(func(*PostTodoRequest) PostTodoResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *PostTodoRequest) PostTodoResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(PostTodoResponse)
@@ -138,8 +142,8 @@ func (_m *MockTodoServiceClient) PostTodo(request *PostTodoRequest) (PostTodoRes
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*PostTodoRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *PostTodoRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,8 +3,8 @@ package todo
 import (
 	"context"
 	"fmt"
-	routing "github.com/go-ozzo/ozzo-routing"
 	"net/http"
+	"time"
 )
 
 func NewTodoServiceServer(options *ServerOpts) *TodoServiceServer {
@@ -23,13 +23,16 @@ func NewTodoServiceServer(options *ServerOpts) *TodoServiceServer {
 
 type TodoServiceServer struct {
 	*Server
-	Validator          *Validator
-	deleteTodosHandler *deleteTodosHandlerRoute
-	listTodosHandler   *listTodosHandlerRoute
-	postTodoHandler    *postTodoHandlerRoute
-	deleteTodoHandler  *deleteTodoHandlerRoute
-	getTodoHandler     *getTodoHandlerRoute
-	patchTodoHandler   *patchTodoHandlerRoute
+	Validator               *Validator
+	deleteTodosHandler      *deleteTodosHandlerRoute
+	deleteTodosPrecondition DeleteTodosPrecondition
+	listTodosHandler        *listTodosHandlerRoute
+	postTodoHandler         *postTodoHandlerRoute
+	deleteTodoHandler       *deleteTodoHandlerRoute
+	deleteTodoPrecondition  DeleteTodoPrecondition
+	getTodoHandler          *getTodoHandlerRoute
+	patchTodoHandler        *patchTodoHandlerRoute
+	patchTodoPrecondition   PatchTodoPrecondition
 }
 type DeleteTodosHandler func(ctx context.Context, request *DeleteTodosRequest) DeleteTodosResponse
 
@@ -42,26 +45,55 @@ func (server *TodoServiceServer) SetDeleteTodosHandler(handler DeleteTodosHandle
 	server.deleteTodosHandler = &deleteTodosHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "DELETE", Path: "/todos", Handler: server.DeleteTodosHandler, Middleware: middleware}}
 }
 
-func (server *TodoServiceServer) DeleteTodosHandler(c *routing.Context) error {
+// DeleteTodosPrecondition returns the ETag and Last-Modified of the current representation of the resource, which are compared
+// with the conditional headers of a request before it is handled. An empty ETag and a zero time stand for a missing resource.
+type DeleteTodosPrecondition func(ctx context.Context, request *DeleteTodosRequest) (etag string, lastModified time.Time, err error)
+
+// SetDeleteTodosPrecondition sets the precondition of DeleteTodos requests, a failed precondition is answered with 412 (Precondition Failed)
+func (server *TodoServiceServer) SetDeleteTodosPrecondition(precondition DeleteTodosPrecondition) {
+	server.deleteTodosPrecondition = precondition
+}
+
+func (server *TodoServiceServer) DeleteTodosHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.deleteTodosHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: DeleteTodos (DELETE) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteTodos (DELETE) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(DeleteTodosRequest)
+		request.IfMatch = r.Header.Get(ifMatchHeader)
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfUnmodifiedSince = parseHTTPTime(r.Header.Get(ifUnmodifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteTodos (DELETE) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
+		}
+		if server.deleteTodosPrecondition != nil && hasPreconditions(r.Header) {
+			etag, lastModified, err := server.deleteTodosPrecondition(r.Context(), request)
+			if err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteTodos (DELETE) could not evaluate preconditions (error: %v)", err))
+				return NewHTTPStatusCodeError(http.StatusInternalServerError)
+			}
+			if evaluatePreconditions(r, etag, lastModified) != 0 {
+				return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+			}
 		}
-		response := server.deleteTodosHandler.customHandler(c.Request.Context(), request)
+		response := server.deleteTodosHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: DeleteTodos (DELETE) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteTodos (DELETE) could not send response (error: %v)", err))
 			return err
 		}
@@ -80,26 +112,46 @@ func (server *TodoServiceServer) SetListTodosHandler(handler ListTodosHandler, m
 	server.listTodosHandler = &listTodosHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/todos", Handler: server.ListTodosHandler, Middleware: middleware}}
 }
 
-func (server *TodoServiceServer) ListTodosHandler(c *routing.Context) error {
+func (server *TodoServiceServer) ListTodosHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.listTodosHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: ListTodos (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json", "application/x-ndjson"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ListTodos (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(ListTodosRequest)
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: ListTodos (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.listTodosHandler.customHandler(c.Request.Context(), request)
+		response := server.listTodosHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: ListTodos (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *ListTodos200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &ListTodos304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: ListTodos (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -118,18 +170,25 @@ func (server *TodoServiceServer) SetPostTodoHandler(handler PostTodoHandler, mid
 	server.postTodoHandler = &postTodoHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "POST", Path: "/todos", Handler: server.PostTodoHandler, Middleware: middleware}}
 }
 
-func (server *TodoServiceServer) PostTodoHandler(c *routing.Context) error {
+func (server *TodoServiceServer) PostTodoHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.postTodoHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: PostTodo (POST) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: PostTodo (POST) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(PostTodoRequest)
-		contentTypeOfResponse := extractContentType(c.Request.Header.Get(contentTypeHeader))
+		contentTypeOfResponse := extractContentType(r.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson {
-			err := JSON(c.Request.Body, &request.TodoPost, false)
+			err := JSON(r.Body, &request.TodoPost, false)
 			if err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: PostTodo (POST) could not decode request body of incoming request (%v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewBodyError("todoPost", err))
 			}
 		} else {
 			if contentTypeOfResponse != "" {
@@ -143,14 +202,14 @@ func (server *TodoServiceServer) PostTodoHandler(c *routing.Context) error {
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.postTodoHandler.customHandler(c.Request.Context(), request)
+		response := server.postTodoHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: PostTodo (POST) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: PostTodo (POST) could not send response (error: %v)", err))
 			return err
 		}
@@ -166,33 +225,62 @@ type deleteTodoHandlerRoute struct {
 }
 
 func (server *TodoServiceServer) SetDeleteTodoHandler(handler DeleteTodoHandler, middleware ...Middleware) {
-	server.deleteTodoHandler = &deleteTodoHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "DELETE", Path: "/todos/<todoId>", Handler: server.DeleteTodoHandler, Middleware: middleware}}
+	server.deleteTodoHandler = &deleteTodoHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "DELETE", Path: "/todos/{todoId}", Handler: server.DeleteTodoHandler, Middleware: middleware}}
 }
 
-func (server *TodoServiceServer) DeleteTodoHandler(c *routing.Context) error {
+// DeleteTodoPrecondition returns the ETag and Last-Modified of the current representation of the resource, which are compared
+// with the conditional headers of a request before it is handled. An empty ETag and a zero time stand for a missing resource.
+type DeleteTodoPrecondition func(ctx context.Context, request *DeleteTodoRequest) (etag string, lastModified time.Time, err error)
+
+// SetDeleteTodoPrecondition sets the precondition of DeleteTodo requests, a failed precondition is answered with 412 (Precondition Failed)
+func (server *TodoServiceServer) SetDeleteTodoPrecondition(precondition DeleteTodoPrecondition) {
+	server.deleteTodoPrecondition = precondition
+}
+
+func (server *TodoServiceServer) DeleteTodoHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.deleteTodoHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: DeleteTodo (DELETE) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteTodo (DELETE) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(DeleteTodoRequest)
-		if err := fromString(c.Param("todoId"), &request.TodoId); err != nil {
+		if err := fromString(server.PathParam(r, "todoId"), &request.TodoId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteTodo (DELETE) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("todoId", "path", CodeInvalidType, err.Error()))
 		}
+		request.IfMatch = r.Header.Get(ifMatchHeader)
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfUnmodifiedSince = parseHTTPTime(r.Header.Get(ifUnmodifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteTodo (DELETE) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.deleteTodoHandler.customHandler(c.Request.Context(), request)
+		if server.deleteTodoPrecondition != nil && hasPreconditions(r.Header) {
+			etag, lastModified, err := server.deleteTodoPrecondition(r.Context(), request)
+			if err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteTodo (DELETE) could not evaluate preconditions (error: %v)", err))
+				return NewHTTPStatusCodeError(http.StatusInternalServerError)
+			}
+			if evaluatePreconditions(r, etag, lastModified) != 0 {
+				return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+			}
+		}
+		response := server.deleteTodoHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: DeleteTodo (DELETE) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteTodo (DELETE) could not send response (error: %v)", err))
 			return err
 		}
@@ -208,33 +296,53 @@ type getTodoHandlerRoute struct {
 }
 
 func (server *TodoServiceServer) SetGetTodoHandler(handler GetTodoHandler, middleware ...Middleware) {
-	server.getTodoHandler = &getTodoHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/todos/<todoId>", Handler: server.GetTodoHandler, Middleware: middleware}}
+	server.getTodoHandler = &getTodoHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/todos/{todoId}", Handler: server.GetTodoHandler, Middleware: middleware}}
 }
 
-func (server *TodoServiceServer) GetTodoHandler(c *routing.Context) error {
+func (server *TodoServiceServer) GetTodoHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.getTodoHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: GetTodo (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetTodo (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(GetTodoRequest)
-		if err := fromString(c.Param("todoId"), &request.TodoId); err != nil {
+		if err := fromString(server.PathParam(r, "todoId"), &request.TodoId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetTodo (GET) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("todoId", "path", CodeInvalidType, err.Error()))
 		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetTodo (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.getTodoHandler.customHandler(c.Request.Context(), request)
+		response := server.getTodoHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: GetTodo (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *GetTodo200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &GetTodo304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetTodo (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -250,21 +358,37 @@ type patchTodoHandlerRoute struct {
 }
 
 func (server *TodoServiceServer) SetPatchTodoHandler(handler PatchTodoHandler, middleware ...Middleware) {
-	server.patchTodoHandler = &patchTodoHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "PATCH", Path: "/todos/<todoId>", Handler: server.PatchTodoHandler, Middleware: middleware}}
+	server.patchTodoHandler = &patchTodoHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "PATCH", Path: "/todos/{todoId}", Handler: server.PatchTodoHandler, Middleware: middleware}}
 }
 
-func (server *TodoServiceServer) PatchTodoHandler(c *routing.Context) error {
+// PatchTodoPrecondition returns the ETag and Last-Modified of the current representation of the resource, which are compared
+// with the conditional headers of a request before it is handled. An empty ETag and a zero time stand for a missing resource.
+type PatchTodoPrecondition func(ctx context.Context, request *PatchTodoRequest) (etag string, lastModified time.Time, err error)
+
+// SetPatchTodoPrecondition sets the precondition of PatchTodo requests, a failed precondition is answered with 412 (Precondition Failed)
+func (server *TodoServiceServer) SetPatchTodoPrecondition(precondition PatchTodoPrecondition) {
+	server.patchTodoPrecondition = precondition
+}
+
+func (server *TodoServiceServer) PatchTodoHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.patchTodoHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: PatchTodo (PATCH) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: PatchTodo (PATCH) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(PatchTodoRequest)
-		contentTypeOfResponse := extractContentType(c.Request.Header.Get(contentTypeHeader))
+		contentTypeOfResponse := extractContentType(r.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson {
-			err := JSON(c.Request.Body, &request.TodoPatch, false)
+			err := JSON(r.Body, &request.TodoPatch, false)
 			if err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: PatchTodo (PATCH) could not decode request body of incoming request (%v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewBodyError("TodoPatch", err))
 			}
 		} else {
 			if contentTypeOfResponse != "" {
@@ -272,24 +396,37 @@ func (server *TodoServiceServer) PatchTodoHandler(c *routing.Context) error {
 				return newNotSupportedContentType(415, contentTypeOfResponse)
 			}
 		}
-		if err := fromString(c.Param("todoId"), &request.TodoId); err != nil {
+		if err := fromString(server.PathParam(r, "todoId"), &request.TodoId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: PatchTodo (PATCH) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("todoId", "path", CodeInvalidType, err.Error()))
 		}
+		request.IfMatch = r.Header.Get(ifMatchHeader)
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfUnmodifiedSince = parseHTTPTime(r.Header.Get(ifUnmodifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: PatchTodo (PATCH) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
+		}
+		if server.patchTodoPrecondition != nil && hasPreconditions(r.Header) {
+			etag, lastModified, err := server.patchTodoPrecondition(r.Context(), request)
+			if err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: PatchTodo (PATCH) could not evaluate preconditions (error: %v)", err))
+				return NewHTTPStatusCodeError(http.StatusInternalServerError)
+			}
+			if evaluatePreconditions(r, etag, lastModified) != 0 {
+				return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+			}
 		}
-		response := server.patchTodoHandler.customHandler(c.Request.Context(), request)
+		response := server.patchTodoHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: PatchTodo (PATCH) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: PatchTodo (PATCH) could not send response (error: %v)", err))
 			return err
 		}
@@ -322,4 +459,4 @@ func (server *TodoServiceServer) Start(port int) error {
 	return server.Server.Start(port, routes)
 }
 
-const swagger = "{\"consumes\":[\"application/json\"],\"produces\":[\"application/json\"],\"schemes\":[\"http\"],\"swagger\":\"2.0\",\"info\":{\"title\":\"Todo Service\",\"version\":\"1.0.0\"},\"host\":\"localhost:9001\",\"paths\":{\"/todos\":{\"get\":{\"operationId\":\"ListTodos\",\"responses\":{\"200\":{\"description\":\"List of todos\",\"schema\":{\"$ref\":\"#/definitions/TodoList\"}}}},\"post\":{\"operationId\":\"PostTodo\",\"parameters\":[{\"name\":\"todoPost\",\"in\":\"body\",\"schema\":{\"type\":\"object\",\"required\":[\"title\"],\"properties\":{\"title\":{\"type\":\"string\"}}}}],\"responses\":{\"201\":{\"description\":\"Created\",\"schema\":{\"$ref\":\"#/definitions/Todo\"}}}},\"delete\":{\"operationId\":\"DeleteTodos\",\"responses\":{\"204\":{\"description\":\"Ok\"}}}},\"/todos/{todoId}\":{\"get\":{\"operationId\":\"GetTodo\",\"responses\":{\"200\":{\"description\":\"Successful\",\"schema\":{\"$ref\":\"#/definitions/Todo\"}},\"404\":{\"description\":\"Not found\"}}},\"delete\":{\"operationId\":\"DeleteTodo\",\"responses\":{\"204\":{\"description\":\"Ok\"},\"404\":{\"description\":\"Not found\"}}},\"patch\":{\"operationId\":\"PatchTodo\",\"parameters\":[{\"name\":\"TodoPatch\",\"in\":\"body\",\"schema\":{\"type\":\"object\",\"properties\":{\"completed\":{\"type\":\"boolean\"},\"order\":{\"type\":\"integer\"},\"title\":{\"type\":\"string\"}}}}],\"responses\":{\"200\":{\"description\":\"Successful\",\"schema\":{\"$ref\":\"#/definitions/Todo\"}},\"404\":{\"description\":\"Not found\"}}},\"parameters\":[{\"type\":\"integer\",\"name\":\"todoId\",\"in\":\"path\",\"required\":true}]}},\"definitions\":{\"Todo\":{\"type\":\"object\",\"required\":[\"id\",\"title\",\"order\",\"completed\",\"url\"],\"properties\":{\"completed\":{\"type\":\"boolean\"},\"id\":{\"type\":\"integer\"},\"order\":{\"type\":\"integer\"},\"title\":{\"type\":\"string\"},\"url\":{\"type\":\"string\"}}},\"TodoList\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/Todo\"}}},\"parameters\":{\"TodoId\":{\"type\":\"integer\",\"name\":\"todoId\",\"in\":\"path\",\"required\":true},\"TodoPatch\":{\"name\":\"TodoPatch\",\"in\":\"body\",\"schema\":{\"type\":\"object\",\"properties\":{\"completed\":{\"type\":\"boolean\"},\"order\":{\"type\":\"integer\"},\"title\":{\"type\":\"string\"}}}},\"TodoPost\":{\"name\":\"todoPost\",\"in\":\"body\",\"schema\":{\"type\":\"object\",\"required\":[\"title\"],\"properties\":{\"title\":{\"type\":\"string\"}}}}}}"
+const swagger = "{\"consumes\":[\"application/json\"],\"produces\":[\"application/json\"],\"schemes\":[\"http\"],\"swagger\":\"2.0\",\"info\":{\"title\":\"Todo Service\",\"version\":\"1.0.0\"},\"host\":\"localhost:9001\",\"paths\":{\"/todos\":{\"get\":{\"produces\":[\"application/json\",\"application/x-ndjson\"],\"operationId\":\"ListTodos\",\"responses\":{\"200\":{\"description\":\"List of todos\",\"schema\":{\"$ref\":\"#/definitions/TodoList\"}}}},\"post\":{\"operationId\":\"PostTodo\",\"parameters\":[{\"name\":\"todoPost\",\"in\":\"body\",\"schema\":{\"type\":\"object\",\"required\":[\"title\"],\"properties\":{\"title\":{\"type\":\"string\"}}}}],\"responses\":{\"201\":{\"description\":\"Created\",\"schema\":{\"$ref\":\"#/definitions/Todo\"}}}},\"delete\":{\"operationId\":\"DeleteTodos\",\"responses\":{\"204\":{\"description\":\"Ok\"}}}},\"/todos/{todoId}\":{\"get\":{\"operationId\":\"GetTodo\",\"responses\":{\"200\":{\"description\":\"Successful\",\"schema\":{\"$ref\":\"#/definitions/Todo\"}},\"404\":{\"description\":\"Not found\"}}},\"delete\":{\"operationId\":\"DeleteTodo\",\"responses\":{\"204\":{\"description\":\"Ok\"},\"404\":{\"description\":\"Not found\"}}},\"patch\":{\"operationId\":\"PatchTodo\",\"parameters\":[{\"name\":\"TodoPatch\",\"in\":\"body\",\"schema\":{\"type\":\"object\",\"properties\":{\"completed\":{\"type\":\"boolean\"},\"order\":{\"type\":\"integer\"},\"title\":{\"type\":\"string\"}}}}],\"responses\":{\"200\":{\"description\":\"Successful\",\"schema\":{\"$ref\":\"#/definitions/Todo\"}},\"404\":{\"description\":\"Not found\"}}},\"parameters\":[{\"type\":\"integer\",\"name\":\"todoId\",\"in\":\"path\",\"required\":true}]}},\"definitions\":{\"Todo\":{\"type\":\"object\",\"required\":[\"id\",\"title\",\"order\",\"completed\",\"url\"],\"properties\":{\"completed\":{\"type\":\"boolean\"},\"id\":{\"type\":\"integer\"},\"order\":{\"type\":\"integer\"},\"title\":{\"type\":\"string\"},\"url\":{\"type\":\"string\"}}},\"TodoList\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/Todo\"}}},\"parameters\":{\"TodoId\":{\"type\":\"integer\",\"name\":\"todoId\",\"in\":\"path\",\"required\":true},\"TodoPatch\":{\"name\":\"TodoPatch\",\"in\":\"body\",\"schema\":{\"type\":\"object\",\"properties\":{\"completed\":{\"type\":\"boolean\"},\"order\":{\"type\":\"integer\"},\"title\":{\"type\":\"string\"}}}},\"TodoPost\":{\"name\":\"todoPost\",\"in\":\"body\",\"schema\":{\"type\":\"object\",\"required\":[\"title\"],\"properties\":{\"title\":{\"type\":\"string\"}}}}}}"
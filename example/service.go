@@ -3,6 +3,7 @@ package todo
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/sirupsen/logrus"
 )
@@ -50,7 +51,17 @@ func (s *Service) Stop() error {
 }
 
 func (s *Service) ListTodos(ctx context.Context, request *ListTodosRequest) ListTodosResponse {
-	return &ListTodos200Response{Body: s.todos}
+
+	// the todos are streamed one by one instead of being serialized as a whole
+	todos := s.todos
+	return &ListTodos200Response{Items: NewListTodosItems(func() (*Todo, error) {
+		if len(todos) == 0 {
+			return nil, io.EOF
+		}
+		todo := todos[0]
+		todos = todos[1:]
+		return &todo, nil
+	}, nil)}
 }
 
 func (s *Service) PostTodo(ctx context.Context, request *PostTodoRequest) PostTodoResponse {
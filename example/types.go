@@ -1,6 +1,10 @@
 package todo
 
-import "net/http"
+import (
+	"io"
+	"net/http"
+	"time"
+)
 
 var contentTypesForFiles = []string{"application/json", "image/png", "image/jpeg", "image/tiff", "image/webp", "image/svg+xml", "image/gif", "image/tiff", "image/x-icon", "application/pdf", "application/octet-stream"}
 
@@ -23,16 +27,23 @@ type Object2 struct {
 	Title string `bson:"title,required" json:"title,required" xml:"title,required"`
 }
 
-type DeleteTodosRequest struct{}
+type DeleteTodosRequest struct {
+	IfMatch           string
+	IfNoneMatch       string
+	IfUnmodifiedSince time.Time
+}
 
 type DeleteTodosResponse interface {
 	isDeleteTodosResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Ok
-type DeleteTodos204Response struct{}
+type DeleteTodos204Response struct {
+	ETag         string
+	LastModified time.Time
+}
 
 func (r *DeleteTodos204Response) isDeleteTodosResponse() {}
 
@@ -40,23 +51,63 @@ func (r *DeleteTodos204Response) StatusCode() int {
 	return 204
 }
 
-func (r *DeleteTodos204Response) write(response http.ResponseWriter) error {
+func (r *DeleteTodos204Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(204)
 	return nil
 }
 
-type ListTodosRequest struct{}
+type ListTodosRequest struct {
+	IfNoneMatch     string
+	IfModifiedSince time.Time
+}
 
 type ListTodosResponse interface {
 	isListTodosResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
+}
+
+// ListTodosItems are the items of a streamed ListTodos response, Next returns io.EOF after the last item
+type ListTodosItems struct {
+	next   func() (*Todo, error)
+	closer func() error
+}
+
+// NewListTodosItems creates the items of a ListTodos response which are produced by next, close is called with the end of the response and may be nil
+func NewListTodosItems(next func() (*Todo, error), close func() error) *ListTodosItems {
+	return &ListTodosItems{next: next, closer: close}
+}
+
+func (i *ListTodosItems) Next() (*Todo, error) {
+	return i.next()
+}
+
+func (i *ListTodosItems) Close() error {
+	if i.closer == nil {
+		return nil
+	}
+	return i.closer()
+}
+
+func readListTodosItems(body io.ReadCloser) *ListTodosItems {
+	reader := newItemReader(body)
+	return NewListTodosItems(func() (*Todo, error) {
+		item := new(Todo)
+		if err := reader.Next(item); err != nil {
+			return nil, err
+		}
+		return item, nil
+	}, reader.Close)
 }
 
 // List of todos
 type ListTodos200Response struct {
-	Body TodoList
+	Body         TodoList
+	Items        *ListTodosItems
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *ListTodos200Response) isListTodosResponse() {}
@@ -65,10 +116,47 @@ func (r *ListTodos200Response) StatusCode() int {
 	return 200
 }
 
-func (r *ListTodos200Response) write(response http.ResponseWriter) error {
-	if err := serveJson(response, 200, r.Body); err != nil {
-		return NewHTTPStatusCodeError(http.StatusInternalServerError)
+func (r *ListTodos200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	if r.Items != nil {
+		defer r.Items.Close()
+		next := func() (interface{}, error) {
+			return r.Items.Next()
+		}
+		if contentType != contentTypeApplicationNdjson {
+			return serveJsonArray(response, 200, next)
+		}
+		return serveNdjson(response, 200, next)
 	}
+	switch contentType {
+	case "application/x-ndjson":
+		if err := serveNdjson(response, 200, sliceItems(r.Body)); err != nil {
+			return err
+		}
+	default:
+		if err := serveJson(response, 200, r.Body); err != nil {
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+	}
+	return nil
+}
+
+// ListTodos304Response is the response of a conditional request if the resource was not modified
+type ListTodos304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *ListTodos304Response) isListTodosResponse() {}
+
+func (r *ListTodos304Response) StatusCode() int {
+	return 304
+}
+
+func (r *ListTodos304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
 	return nil
 }
 
@@ -77,13 +165,13 @@ type Object3 struct {
 }
 
 type PostTodoRequest struct {
-	TodoPost Object3
+	TodoPost Object3 `param:"todoPost,body"`
 }
 
 type PostTodoResponse interface {
 	isPostTodoResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Created
@@ -97,7 +185,7 @@ func (r *PostTodo201Response) StatusCode() int {
 	return 201
 }
 
-func (r *PostTodo201Response) write(response http.ResponseWriter) error {
+func (r *PostTodo201Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	if err := serveJson(response, 201, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -105,17 +193,23 @@ func (r *PostTodo201Response) write(response http.ResponseWriter) error {
 }
 
 type DeleteTodoRequest struct {
-	TodoId int64
+	TodoId            int64 `param:"todoId,path"`
+	IfMatch           string
+	IfNoneMatch       string
+	IfUnmodifiedSince time.Time
 }
 
 type DeleteTodoResponse interface {
 	isDeleteTodoResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Ok
-type DeleteTodo204Response struct{}
+type DeleteTodo204Response struct {
+	ETag         string
+	LastModified time.Time
+}
 
 func (r *DeleteTodo204Response) isDeleteTodoResponse() {}
 
@@ -123,7 +217,8 @@ func (r *DeleteTodo204Response) StatusCode() int {
 	return 204
 }
 
-func (r *DeleteTodo204Response) write(response http.ResponseWriter) error {
+func (r *DeleteTodo204Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(204)
 	return nil
@@ -138,25 +233,37 @@ func (r *DeleteTodo404Response) StatusCode() int {
 	return 404
 }
 
-func (r *DeleteTodo404Response) write(response http.ResponseWriter) error {
+func (r *DeleteTodo404Response) OperationID() string {
+	return "DeleteTodo"
+}
+
+func (r *DeleteTodo404Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *DeleteTodo404Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(404)
 	return nil
 }
 
 type GetTodoRequest struct {
-	TodoId int64
+	TodoId          int64 `param:"todoId,path"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
 }
 
 type GetTodoResponse interface {
 	isGetTodoResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Successful
 type GetTodo200Response struct {
-	Body Todo
+	Body         Todo
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *GetTodo200Response) isGetTodoResponse() {}
@@ -165,7 +272,8 @@ func (r *GetTodo200Response) StatusCode() int {
 	return 200
 }
 
-func (r *GetTodo200Response) write(response http.ResponseWriter) error {
+func (r *GetTodo200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	if err := serveJson(response, 200, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -181,12 +289,39 @@ func (r *GetTodo404Response) StatusCode() int {
 	return 404
 }
 
-func (r *GetTodo404Response) write(response http.ResponseWriter) error {
+func (r *GetTodo404Response) OperationID() string {
+	return "GetTodo"
+}
+
+func (r *GetTodo404Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetTodo404Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(404)
 	return nil
 }
 
+// GetTodo304Response is the response of a conditional request if the resource was not modified
+type GetTodo304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetTodo304Response) isGetTodoResponse() {}
+
+func (r *GetTodo304Response) StatusCode() int {
+	return 304
+}
+
+func (r *GetTodo304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
 type Object4 struct {
 	Completed *bool   `bson:"completed,omitempty" json:"completed,omitempty" xml:"completed,omitempty"`
 	Order     *int64  `bson:"order,omitempty" json:"order,omitempty" xml:"order,omitempty"`
@@ -194,19 +329,24 @@ type Object4 struct {
 }
 
 type PatchTodoRequest struct {
-	TodoId    int64
-	TodoPatch Object4
+	TodoId            int64   `param:"todoId,path"`
+	TodoPatch         Object4 `param:"TodoPatch,body"`
+	IfMatch           string
+	IfNoneMatch       string
+	IfUnmodifiedSince time.Time
 }
 
 type PatchTodoResponse interface {
 	isPatchTodoResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Successful
 type PatchTodo200Response struct {
-	Body Todo
+	Body         Todo
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *PatchTodo200Response) isPatchTodoResponse() {}
@@ -215,7 +355,8 @@ func (r *PatchTodo200Response) StatusCode() int {
 	return 200
 }
 
-func (r *PatchTodo200Response) write(response http.ResponseWriter) error {
+func (r *PatchTodo200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	if err := serveJson(response, 200, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -231,7 +372,15 @@ func (r *PatchTodo404Response) StatusCode() int {
 	return 404
 }
 
-func (r *PatchTodo404Response) write(response http.ResponseWriter) error {
+func (r *PatchTodo404Response) OperationID() string {
+	return "PatchTodo"
+}
+
+func (r *PatchTodo404Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *PatchTodo404Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(404)
 	return nil
@@ -1,4 +1,4 @@
 
 	package framework
 
-	var ClientCode []byte = []byte{ 0x70,0x61,0x63,0x6b,0x61,0x67,0x65,0x20,0x66,0x72,0x61,0x6d,0x65,0x77,0x6f,0x72,0x6b,0x0a,0x0a,0x69,0x6d,0x70,0x6f,0x72,0x74,0x20,0x28,0x0a,0x09,0x22,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x22,0x0a,0x09,0x22,0x65,0x6e,0x63,0x6f,0x64,0x69,0x6e,0x67,0x2f,0x6a,0x73,0x6f,0x6e,0x22,0x0a,0x09,0x22,0x66,0x6d,0x74,0x22,0x0a,0x09,0x22,0x67,0x69,0x74,0x68,0x75,0x62,0x2e,0x63,0x6f,0x6d,0x2f,0x70,0x6b,0x67,0x2f,0x65,0x72,0x72,0x6f,0x72,0x73,0x22,0x0a,0x09,0x22,0x67,0x69,0x74,0x68,0x75,0x62,0x2e,0x63,0x6f,0x6d,0x2f,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2f,0x63,0x6c,0x69,0x65,0x6e,0x74,0x5f,0x67,0x6f,0x6c,0x61,0x6e,0x67,0x2f,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x22,0x0a,0x09,0x22,0x67,0x69,0x74,0x68,0x75,0x62,0x2e,0x63,0x6f,0x6d,0x2f,0x73,0x69,0x72,0x75,0x70,0x73,0x65,0x6e,0x2f,0x6c,0x6f,0x67,0x72,0x75,0x73,0x22,0x0a,0x09,0x22,0x69,0x6f,0x22,0x0a,0x09,0x22,0x6d,0x69,0x6d,0x65,0x2f,0x6d,0x75,0x6c,0x74,0x69,0x70,0x61,0x72,0x74,0x22,0x0a,0x09,0x22,0x6e,0x65,0x74,0x2f,0x68,0x74,0x74,0x70,0x22,0x0a,0x09,0x22,0x6e,0x65,0x74,0x2f,0x68,0x74,0x74,0x70,0x2f,0x68,0x74,0x74,0x70,0x75,0x74,0x69,0x6c,0x22,0x0a,0x09,0x22,0x6f,0x73,0x22,0x0a,0x09,0x22,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x22,0x0a,0x09,0x22,0x72,0x75,0x6e,0x74,0x69,0x6d,0x65,0x22,0x0a,0x09,0x22,0x72,0x75,0x6e,0x74,0x69,0x6d,0x65,0x2f,0x64,0x65,0x62,0x75,0x67,0x22,0x0a,0x09,0x22,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x22,0x0a,0x09,0x22,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x22,0x0a,0x09,0x22,0x74,0x65,0x78,0x74,0x2f,0x74,0x61,0x62,0x77,0x72,0x69,0x74,0x65,0x72,0x22,0x0a,0x09,0x22,0x74,0x69,0x6d,0x65,0x22,0x0a,0x29,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x48,0x6f,0x6f,0x6b,0x73,0x43,0x6c,0x69,0x65,0x6e,0x74,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x4f,0x6e,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,0x66,0x75,0x6e,0x63,0x28,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2c,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x44,0x65,0x76,0x48,0x6f,0x6f,0x6b,0x28,0x29,0x20,0x48,0x6f,0x6f,0x6b,0x73,0x43,0x6c,0x69,0x65,0x6e,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x48,0x6f,0x6f,0x6b,0x73,0x43,0x6c,0x69,0x65,0x6e,0x74,0x7b,0x0a,0x09,0x09,0x4f,0x6e,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x3a,0x20,0x66,0x75,0x6e,0x63,0x28,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2c,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x09,0x09,0x76,0x61,0x72,0x20,0x68,0x74,0x74,0x70,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x44,0x75,0x6d,0x70,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x09,0x09,0x68,0x74,0x74,0x70,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x44,0x75,0x6d,0x70,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x68,0x74,0x74,0x70,0x75,0x74,0x69,0x6c,0x2e,0x44,0x75,0x6d,0x70,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x28,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x74,0x72,0x75,0x65,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x68,0x74,0x74,0x70,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x44,0x75,0x6d,0x70,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x63,0x6f,0x75,0x6c,0x64,0x20,0x6e,0x6f,0x74,0x20,0x64,0x75,0x6d,0x70,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x28,0x25,0x76,0x29,0x22,0x2c,0x20,0x65,0x72,0x72,0x2e,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x29,0x0a,0x09,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x68,0x74,0x74,0x70,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x44,0x75,0x6d,0x70,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x28,0x68,0x74,0x74,0x70,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x44,0x75,0x6d,0x70,0x29,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x09,0x76,0x61,0x72,0x20,0x68,0x74,0x74,0x70,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x44,0x75,0x6d,0x70,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x09,0x09,0x68,0x74,0x74,0x70,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x44,0x75,0x6d,0x70,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x68,0x74,0x74,0x70,0x75,0x74,0x69,0x6c,0x2e,0x44,0x75,0x6d,0x70,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x28,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2c,0x20,0x74,0x72,0x75,0x65,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x68,0x74,0x74,0x70,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x44,0x75,0x6d,0x70,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x63,0x6f,0x75,0x6c,0x64,0x20,0x6e,0x6f,0x74,0x20,0x64,0x75,0x6d,0x70,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x28,0x25,0x76,0x29,0x22,0x2c,0x20,0x65,0x72,0x72,0x2e,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x29,0x0a,0x09,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x68,0x74,0x74,0x70,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x44,0x75,0x6d,0x70,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x28,0x68,0x74,0x74,0x70,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x44,0x75,0x6d,0x70,0x29,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x09,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x3a,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x75,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x63,0x6f,0x64,0x65,0x20,0x25,0x64,0x22,0x2c,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x6c,0x65,0x6e,0x28,0x68,0x74,0x74,0x70,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x44,0x75,0x6d,0x70,0x29,0x20,0x21,0x3d,0x20,0x30,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x3d,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x2b,0x20,0x22,0x5c,0x6e,0x20,0x48,0x54,0x54,0x50,0x20,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x3a,0x20,0x5c,0x6e,0x20,0x27,0x22,0x20,0x2b,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x28,0x68,0x74,0x74,0x70,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x44,0x75,0x6d,0x70,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x29,0x20,0x2b,0x20,0x22,0x27,0x20,0x5c,0x6e,0x22,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x6c,0x65,0x6e,0x28,0x68,0x74,0x74,0x70,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x44,0x75,0x6d,0x70,0x29,0x20,0x21,0x3d,0x20,0x30,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x3d,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x2b,0x20,0x22,0x48,0x54,0x54,0x50,0x20,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x3a,0x20,0x5c,0x6e,0x20,0x27,0x22,0x20,0x2b,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x28,0x68,0x74,0x74,0x70,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x44,0x75,0x6d,0x70,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x29,0x20,0x2b,0x20,0x22,0x27,0x22,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x0a,0x09,0x09,0x7d,0x2c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x66,0x75,0x6e,0x63,0x20,0x70,0x72,0x69,0x6d,0x69,0x74,0x69,0x76,0x65,0x54,0x6f,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x70,0x61,0x72,0x61,0x6d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x0a,0x09,0x76,0x61,0x72,0x20,0x76,0x61,0x6c,0x75,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x0a,0x09,0x73,0x77,0x69,0x74,0x63,0x68,0x20,0x70,0x61,0x72,0x61,0x6d,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x7b,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x38,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x31,0x36,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x33,0x32,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x36,0x34,0x3a,0x0a,0x09,0x09,0x66,0x61,0x6c,0x6c,0x74,0x68,0x72,0x6f,0x75,0x67,0x68,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x38,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x31,0x36,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x33,0x32,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x36,0x34,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x64,0x22,0x2c,0x20,0x70,0x61,0x72,0x61,0x6d,0x2e,0x49,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x28,0x29,0x29,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x46,0x6c,0x6f,0x61,0x74,0x36,0x34,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x3d,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x46,0x6f,0x72,0x6d,0x61,0x74,0x46,0x6c,0x6f,0x61,0x74,0x28,0x70,0x61,0x72,0x61,0x6d,0x2e,0x49,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x28,0x29,0x2e,0x28,0x66,0x6c,0x6f,0x61,0x74,0x36,0x34,0x29,0x2c,0x20,0x27,0x66,0x27,0x2c,0x20,0x2d,0x31,0x2c,0x20,0x36,0x34,0x29,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x46,0x6c,0x6f,0x61,0x74,0x33,0x32,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x3d,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x46,0x6f,0x72,0x6d,0x61,0x74,0x46,0x6c,0x6f,0x61,0x74,0x28,0x66,0x6c,0x6f,0x61,0x74,0x36,0x34,0x28,0x70,0x61,0x72,0x61,0x6d,0x2e,0x49,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x28,0x29,0x2e,0x28,0x66,0x6c,0x6f,0x61,0x74,0x33,0x32,0x29,0x29,0x2c,0x20,0x27,0x66,0x27,0x2c,0x20,0x2d,0x31,0x2c,0x20,0x33,0x32,0x29,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x73,0x22,0x2c,0x20,0x70,0x61,0x72,0x61,0x6d,0x2e,0x49,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x28,0x29,0x29,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x42,0x6f,0x6f,0x6c,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x74,0x22,0x2c,0x20,0x70,0x61,0x72,0x61,0x6d,0x2e,0x49,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x28,0x29,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x73,0x6c,0x69,0x63,0x65,0x54,0x6f,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x70,0x61,0x72,0x61,0x6d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x0a,0x09,0x73,0x6c,0x69,0x63,0x65,0x20,0x3a,0x3d,0x20,0x6d,0x61,0x6b,0x65,0x28,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x70,0x61,0x72,0x61,0x6d,0x2e,0x4c,0x65,0x6e,0x28,0x29,0x29,0x0a,0x09,0x66,0x6f,0x72,0x20,0x69,0x20,0x3a,0x3d,0x20,0x30,0x3b,0x20,0x69,0x20,0x3c,0x20,0x70,0x61,0x72,0x61,0x6d,0x2e,0x4c,0x65,0x6e,0x28,0x29,0x3b,0x20,0x69,0x2b,0x2b,0x20,0x7b,0x0a,0x09,0x09,0x73,0x6c,0x69,0x63,0x65,0x5b,0x69,0x5d,0x20,0x3d,0x20,0x54,0x6f,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x70,0x61,0x72,0x61,0x6d,0x2e,0x49,0x6e,0x64,0x65,0x78,0x28,0x69,0x29,0x2e,0x49,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x28,0x29,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x4a,0x6f,0x69,0x6e,0x28,0x73,0x6c,0x69,0x63,0x65,0x2c,0x20,0x22,0x2c,0x22,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x54,0x6f,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x70,0x61,0x72,0x61,0x6d,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x0a,0x09,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x20,0x3a,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x4f,0x66,0x28,0x70,0x61,0x72,0x61,0x6d,0x29,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x50,0x74,0x72,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x2e,0x49,0x73,0x4e,0x69,0x6c,0x28,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x22,0x22,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x20,0x3d,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x76,0x61,0x72,0x20,0x76,0x61,0x6c,0x75,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x69,0x66,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x6c,0x69,0x63,0x65,0x20,0x7c,0x7c,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x41,0x72,0x72,0x61,0x79,0x20,0x7b,0x0a,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x3d,0x20,0x73,0x6c,0x69,0x63,0x65,0x54,0x6f,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x29,0x0a,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x7b,0x0a,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x3d,0x20,0x70,0x72,0x69,0x6d,0x69,0x74,0x69,0x76,0x65,0x54,0x6f,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x54,0x6f,0x50,0x72,0x69,0x6d,0x69,0x74,0x69,0x76,0x65,0x28,0x73,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x70,0x61,0x72,0x61,0x6d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x70,0x61,0x72,0x61,0x6d,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x21,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x50,0x74,0x72,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x45,0x72,0x72,0x56,0x61,0x6c,0x75,0x65,0x49,0x73,0x4e,0x6f,0x74,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x7b,0x76,0x61,0x6c,0x75,0x65,0x3a,0x20,0x70,0x61,0x72,0x61,0x6d,0x7d,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x76,0x61,0x72,0x20,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x0a,0x09,0x65,0x6c,0x6d,0x20,0x3a,0x3d,0x20,0x70,0x61,0x72,0x61,0x6d,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x0a,0x0a,0x09,0x73,0x77,0x69,0x74,0x63,0x68,0x20,0x65,0x6c,0x6d,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x7b,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x38,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x31,0x36,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x33,0x32,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x36,0x34,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x20,0x3a,0x3d,0x20,0x69,0x6e,0x74,0x36,0x34,0x28,0x30,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x73,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x76,0x61,0x6c,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x50,0x61,0x72,0x73,0x65,0x49,0x6e,0x74,0x28,0x73,0x2c,0x20,0x30,0x2c,0x20,0x36,0x34,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x6c,0x6d,0x2e,0x4f,0x76,0x65,0x72,0x66,0x6c,0x6f,0x77,0x49,0x6e,0x74,0x28,0x76,0x61,0x6c,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x45,0x72,0x72,0x54,0x79,0x70,0x65,0x56,0x61,0x6c,0x75,0x65,0x4f,0x76,0x65,0x72,0x66,0x6c,0x6f,0x77,0x7b,0x76,0x61,0x6c,0x75,0x65,0x3a,0x20,0x73,0x7d,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x65,0x6c,0x6d,0x2e,0x53,0x65,0x74,0x49,0x6e,0x74,0x28,0x76,0x61,0x6c,0x29,0x0a,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x38,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x31,0x36,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x33,0x32,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x36,0x34,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x20,0x3a,0x3d,0x20,0x75,0x69,0x6e,0x74,0x36,0x34,0x28,0x30,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x73,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x76,0x61,0x6c,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x50,0x61,0x72,0x73,0x65,0x55,0x69,0x6e,0x74,0x28,0x73,0x2c,0x20,0x30,0x2c,0x20,0x36,0x34,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x6c,0x6d,0x2e,0x4f,0x76,0x65,0x72,0x66,0x6c,0x6f,0x77,0x55,0x69,0x6e,0x74,0x28,0x76,0x61,0x6c,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x45,0x72,0x72,0x54,0x79,0x70,0x65,0x56,0x61,0x6c,0x75,0x65,0x4f,0x76,0x65,0x72,0x66,0x6c,0x6f,0x77,0x7b,0x76,0x61,0x6c,0x75,0x65,0x3a,0x20,0x73,0x7d,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x65,0x6c,0x6d,0x2e,0x53,0x65,0x74,0x55,0x69,0x6e,0x74,0x28,0x76,0x61,0x6c,0x29,0x0a,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x46,0x6c,0x6f,0x61,0x74,0x33,0x32,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x20,0x3a,0x3d,0x20,0x66,0x6c,0x6f,0x61,0x74,0x36,0x34,0x28,0x30,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x73,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x76,0x61,0x6c,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x50,0x61,0x72,0x73,0x65,0x46,0x6c,0x6f,0x61,0x74,0x28,0x73,0x2c,0x20,0x33,0x32,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x65,0x6c,0x6d,0x2e,0x53,0x65,0x74,0x46,0x6c,0x6f,0x61,0x74,0x28,0x76,0x61,0x6c,0x29,0x0a,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x46,0x6c,0x6f,0x61,0x74,0x36,0x34,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x20,0x3a,0x3d,0x20,0x66,0x6c,0x6f,0x61,0x74,0x36,0x34,0x28,0x30,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x73,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x76,0x61,0x6c,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x50,0x61,0x72,0x73,0x65,0x46,0x6c,0x6f,0x61,0x74,0x28,0x73,0x2c,0x20,0x36,0x34,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x65,0x6c,0x6d,0x2e,0x53,0x65,0x74,0x46,0x6c,0x6f,0x61,0x74,0x28,0x76,0x61,0x6c,0x29,0x0a,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x3a,0x0a,0x09,0x09,0x65,0x6c,0x6d,0x2e,0x53,0x65,0x74,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x73,0x29,0x0a,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x42,0x6f,0x6f,0x6c,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x20,0x3a,0x3d,0x20,0x66,0x61,0x6c,0x73,0x65,0x0a,0x09,0x09,0x69,0x66,0x20,0x73,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x76,0x61,0x6c,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x50,0x61,0x72,0x73,0x65,0x42,0x6f,0x6f,0x6c,0x28,0x73,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x65,0x6c,0x6d,0x2e,0x53,0x65,0x74,0x42,0x6f,0x6f,0x6c,0x28,0x76,0x61,0x6c,0x29,0x0a,0x0a,0x09,0x64,0x65,0x66,0x61,0x75,0x6c,0x74,0x3a,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x45,0x72,0x72,0x55,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x50,0x72,0x69,0x6d,0x69,0x74,0x69,0x76,0x65,0x54,0x79,0x70,0x65,0x7b,0x76,0x61,0x6c,0x75,0x65,0x3a,0x20,0x70,0x61,0x72,0x61,0x6d,0x7d,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x54,0x6f,0x53,0x6c,0x69,0x63,0x65,0x28,0x73,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x70,0x61,0x72,0x61,0x6d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x76,0x61,0x6c,0x75,0x65,0x73,0x20,0x3a,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x53,0x70,0x6c,0x69,0x74,0x28,0x73,0x2c,0x20,0x22,0x2c,0x22,0x29,0x0a,0x09,0x65,0x6c,0x65,0x6d,0x46,0x6f,0x72,0x49,0x6e,0x6a,0x65,0x63,0x74,0x69,0x6f,0x6e,0x20,0x3a,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x4e,0x65,0x77,0x28,0x70,0x61,0x72,0x61,0x6d,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x2e,0x54,0x79,0x70,0x65,0x28,0x29,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x29,0x0a,0x09,0x66,0x6f,0x72,0x20,0x5f,0x2c,0x20,0x76,0x61,0x6c,0x75,0x65,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x76,0x61,0x6c,0x75,0x65,0x73,0x20,0x7b,0x0a,0x09,0x09,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x46,0x72,0x6f,0x6d,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x65,0x6c,0x65,0x6d,0x46,0x6f,0x72,0x49,0x6e,0x6a,0x65,0x63,0x74,0x69,0x6f,0x6e,0x2e,0x49,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x28,0x29,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x73,0x6c,0x69,0x63,0x65,0x20,0x3a,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x41,0x70,0x70,0x65,0x6e,0x64,0x28,0x70,0x61,0x72,0x61,0x6d,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x2c,0x20,0x65,0x6c,0x65,0x6d,0x46,0x6f,0x72,0x49,0x6e,0x6a,0x65,0x63,0x74,0x69,0x6f,0x6e,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x29,0x0a,0x09,0x09,0x70,0x61,0x72,0x61,0x6d,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x2e,0x53,0x65,0x74,0x28,0x73,0x6c,0x69,0x63,0x65,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x46,0x72,0x6f,0x6d,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x73,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x70,0x61,0x72,0x61,0x6d,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x28,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x64,0x65,0x66,0x65,0x72,0x20,0x66,0x75,0x6e,0x63,0x28,0x29,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x76,0x20,0x3a,0x3d,0x20,0x72,0x65,0x63,0x6f,0x76,0x65,0x72,0x28,0x29,0x3b,0x20,0x76,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x73,0x74,0x61,0x63,0x6b,0x20,0x3a,0x3d,0x20,0x64,0x65,0x62,0x75,0x67,0x2e,0x53,0x74,0x61,0x63,0x6b,0x28,0x29,0x0a,0x09,0x09,0x09,0x65,0x72,0x72,0x20,0x3d,0x20,0x26,0x52,0x65,0x63,0x6f,0x76,0x65,0x72,0x45,0x72,0x72,0x6f,0x72,0x7b,0x45,0x72,0x72,0x3a,0x20,0x76,0x2c,0x20,0x53,0x74,0x61,0x63,0x6b,0x3a,0x20,0x73,0x74,0x61,0x63,0x6b,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x28,0x29,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x09,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x20,0x3a,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x4f,0x66,0x28,0x70,0x61,0x72,0x61,0x6d,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x21,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x50,0x74,0x72,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x45,0x72,0x72,0x50,0x61,0x72,0x61,0x6d,0x49,0x73,0x4e,0x6f,0x74,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x69,0x66,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x2e,0x49,0x73,0x4e,0x69,0x6c,0x28,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x45,0x72,0x72,0x50,0x61,0x72,0x61,0x6d,0x49,0x73,0x4e,0x69,0x6c,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x6b,0x69,0x6e,0x64,0x4f,0x66,0x45,0x6c,0x65,0x6d,0x65,0x6e,0x74,0x20,0x3a,0x3d,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x6b,0x69,0x6e,0x64,0x4f,0x66,0x45,0x6c,0x65,0x6d,0x65,0x6e,0x74,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x50,0x74,0x72,0x20,0x7b,0x0a,0x09,0x09,0x09,0x70,0x74,0x72,0x20,0x3a,0x3d,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x70,0x74,0x72,0x2e,0x49,0x73,0x4e,0x69,0x6c,0x28,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x70,0x74,0x72,0x2e,0x53,0x65,0x74,0x28,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x4e,0x65,0x77,0x28,0x70,0x74,0x72,0x2e,0x54,0x79,0x70,0x65,0x28,0x29,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x29,0x29,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x09,0x70,0x61,0x72,0x61,0x6d,0x20,0x3d,0x20,0x70,0x74,0x72,0x2e,0x49,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x28,0x29,0x0a,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x7b,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x6b,0x69,0x6e,0x64,0x4f,0x66,0x45,0x6c,0x65,0x6d,0x65,0x6e,0x74,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x6c,0x69,0x63,0x65,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x65,0x72,0x72,0x20,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x54,0x6f,0x53,0x6c,0x69,0x63,0x65,0x28,0x73,0x2c,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x29,0x0a,0x09,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x69,0x66,0x20,0x6b,0x69,0x6e,0x64,0x4f,0x66,0x45,0x6c,0x65,0x6d,0x65,0x6e,0x74,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x41,0x72,0x72,0x61,0x79,0x20,0x7c,0x7c,0x20,0x6b,0x69,0x6e,0x64,0x4f,0x66,0x45,0x6c,0x65,0x6d,0x65,0x6e,0x74,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x4d,0x61,0x70,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x65,0x72,0x72,0x20,0x3d,0x20,0x26,0x45,0x72,0x72,0x55,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x4b,0x69,0x6e,0x64,0x7b,0x6b,0x69,0x6e,0x64,0x3a,0x20,0x6b,0x69,0x6e,0x64,0x4f,0x66,0x45,0x6c,0x65,0x6d,0x65,0x6e,0x74,0x7d,0x0a,0x09,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x65,0x72,0x72,0x20,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x54,0x6f,0x50,0x72,0x69,0x6d,0x69,0x74,0x69,0x76,0x65,0x28,0x73,0x2c,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x29,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x09,0x62,0x72,0x65,0x61,0x6b,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x0a,0x7d,0x0a,0x0a,0x76,0x61,0x72,0x20,0x45,0x72,0x72,0x50,0x61,0x72,0x61,0x6d,0x49,0x73,0x4e,0x6f,0x74,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x3d,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4e,0x65,0x77,0x28,0x22,0x70,0x61,0x72,0x61,0x6d,0x20,0x69,0x73,0x6e,0x27,0x74,0x20,0x61,0x20,0x70,0x6f,0x69,0x6e,0x74,0x65,0x72,0x22,0x29,0x0a,0x76,0x61,0x72,0x20,0x45,0x72,0x72,0x50,0x61,0x72,0x61,0x6d,0x49,0x73,0x4e,0x69,0x6c,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x3d,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4e,0x65,0x77,0x28,0x22,0x70,0x61,0x72,0x61,0x6d,0x20,0x69,0x73,0x20,0x6e,0x69,0x6c,0x22,0x29,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x45,0x72,0x72,0x55,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x4b,0x69,0x6e,0x64,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x6b,0x69,0x6e,0x64,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x4b,0x69,0x6e,0x64,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x72,0x72,0x20,0x2a,0x45,0x72,0x72,0x55,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x4b,0x69,0x6e,0x64,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x75,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x20,0x6b,0x69,0x6e,0x64,0x3a,0x20,0x27,0x25,0x73,0x27,0x22,0x2c,0x20,0x65,0x72,0x72,0x2e,0x6b,0x69,0x6e,0x64,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x29,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x45,0x72,0x72,0x56,0x61,0x6c,0x75,0x65,0x49,0x73,0x4e,0x6f,0x74,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x72,0x72,0x20,0x2a,0x45,0x72,0x72,0x56,0x61,0x6c,0x75,0x65,0x49,0x73,0x4e,0x6f,0x74,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x76,0x61,0x6c,0x75,0x65,0x20,0x69,0x73,0x20,0x6e,0x6f,0x74,0x20,0x61,0x20,0x70,0x6f,0x69,0x6e,0x74,0x65,0x72,0x3a,0x20,0x27,0x25,0x73,0x27,0x22,0x2c,0x20,0x65,0x72,0x72,0x2e,0x76,0x61,0x6c,0x75,0x65,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x29,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x45,0x72,0x72,0x54,0x79,0x70,0x65,0x56,0x61,0x6c,0x75,0x65,0x4f,0x76,0x65,0x72,0x66,0x6c,0x6f,0x77,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x72,0x72,0x20,0x2a,0x45,0x72,0x72,0x54,0x79,0x70,0x65,0x56,0x61,0x6c,0x75,0x65,0x4f,0x76,0x65,0x72,0x66,0x6c,0x6f,0x77,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x74,0x79,0x70,0x65,0x20,0x6f,0x76,0x65,0x72,0x66,0x6c,0x6f,0x77,0x3a,0x20,0x27,0x25,0x73,0x27,0x22,0x2c,0x20,0x65,0x72,0x72,0x2e,0x76,0x61,0x6c,0x75,0x65,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x45,0x72,0x72,0x55,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x50,0x72,0x69,0x6d,0x69,0x74,0x69,0x76,0x65,0x54,0x79,0x70,0x65,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x72,0x72,0x20,0x2a,0x45,0x72,0x72,0x55,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x50,0x72,0x69,0x6d,0x69,0x74,0x69,0x76,0x65,0x54,0x79,0x70,0x65,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x75,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x20,0x70,0x72,0x69,0x6d,0x69,0x74,0x69,0x76,0x65,0x20,0x74,0x79,0x70,0x65,0x3a,0x20,0x27,0x25,0x73,0x27,0x22,0x2c,0x20,0x65,0x72,0x72,0x2e,0x76,0x61,0x6c,0x75,0x65,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x29,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x52,0x65,0x63,0x6f,0x76,0x65,0x72,0x45,0x72,0x72,0x6f,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x45,0x72,0x72,0x20,0x20,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x0a,0x09,0x53,0x74,0x61,0x63,0x6b,0x20,0x5b,0x5d,0x62,0x79,0x74,0x65,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x52,0x65,0x63,0x6f,0x76,0x65,0x72,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x20,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x66,0x72,0x6f,0x6d,0x53,0x74,0x72,0x69,0x6e,0x67,0x20,0x70,0x61,0x6e,0x69,0x63,0x6b,0x65,0x64,0x3a,0x20,0x25,0x76,0x22,0x2c,0x20,0x65,0x2e,0x45,0x72,0x72,0x29,0x20,0x7d,0x0a,0x0a,0x76,0x61,0x72,0x20,0x28,0x0a,0x09,0x47,0x69,0x74,0x43,0x6f,0x6d,0x6d,0x69,0x74,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x61,0x38,0x37,0x31,0x39,0x65,0x37,0x64,0x37,0x36,0x32,0x32,0x37,0x63,0x66,0x32,0x38,0x64,0x33,0x35,0x35,0x66,0x65,0x66,0x32,0x35,0x66,0x38,0x66,0x32,0x37,0x35,0x65,0x36,0x64,0x35,0x31,0x36,0x32,0x31,0x22,0x0a,0x09,0x47,0x69,0x74,0x42,0x72,0x61,0x6e,0x63,0x68,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x6d,0x61,0x73,0x74,0x65,0x72,0x22,0x0a,0x09,0x47,0x69,0x74,0x54,0x61,0x67,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x76,0x31,0x2e,0x30,0x2e,0x30,0x22,0x0a,0x09,0x42,0x75,0x69,0x6c,0x64,0x54,0x69,0x6d,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x57,0x65,0x64,0x20,0x32,0x37,0x20,0x4f,0x63,0x74,0x20,0x32,0x30,0x32,0x31,0x20,0x32,0x32,0x3a,0x31,0x37,0x3a,0x32,0x38,0x20,0x42,0x53,0x54,0x22,0x0a,0x29,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x49,0x6e,0x66,0x6f,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x47,0x6f,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x67,0x6f,0x5f,0x76,0x65,0x72,0x73,0x69,0x6f,0x6e,0x22,0x60,0x0a,0x09,0x47,0x69,0x74,0x54,0x61,0x67,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x67,0x69,0x74,0x5f,0x74,0x61,0x67,0x22,0x60,0x0a,0x09,0x47,0x69,0x74,0x43,0x6f,0x6d,0x6d,0x69,0x74,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x67,0x69,0x74,0x5f,0x63,0x6f,0x6d,0x6d,0x69,0x74,0x22,0x60,0x0a,0x09,0x47,0x69,0x74,0x42,0x72,0x61,0x6e,0x63,0x68,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x67,0x69,0x74,0x5f,0x62,0x72,0x61,0x6e,0x63,0x68,0x22,0x60,0x0a,0x09,0x42,0x75,0x69,0x6c,0x64,0x54,0x69,0x6d,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x62,0x75,0x69,0x6c,0x64,0x5f,0x74,0x69,0x6d,0x65,0x22,0x60,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x41,0x70,0x69,0x6b,0x69,0x74,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x28,0x29,0x20,0x2a,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x49,0x6e,0x66,0x6f,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x49,0x6e,0x66,0x6f,0x7b,0x0a,0x09,0x09,0x47,0x6f,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x3a,0x20,0x72,0x75,0x6e,0x74,0x69,0x6d,0x65,0x2e,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x28,0x29,0x2c,0x0a,0x09,0x09,0x47,0x69,0x74,0x54,0x61,0x67,0x3a,0x20,0x20,0x20,0x20,0x47,0x69,0x74,0x54,0x61,0x67,0x2c,0x0a,0x09,0x09,0x47,0x69,0x74,0x43,0x6f,0x6d,0x6d,0x69,0x74,0x3a,0x20,0x47,0x69,0x74,0x43,0x6f,0x6d,0x6d,0x69,0x74,0x2c,0x0a,0x09,0x09,0x47,0x69,0x74,0x42,0x72,0x61,0x6e,0x63,0x68,0x3a,0x20,0x47,0x69,0x74,0x42,0x72,0x61,0x6e,0x63,0x68,0x2c,0x0a,0x09,0x09,0x42,0x75,0x69,0x6c,0x64,0x54,0x69,0x6d,0x65,0x3a,0x20,0x42,0x75,0x69,0x6c,0x64,0x54,0x69,0x6d,0x65,0x2c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x76,0x69,0x20,0x2a,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x49,0x6e,0x66,0x6f,0x29,0x20,0x50,0x72,0x69,0x6e,0x74,0x54,0x61,0x62,0x6c,0x65,0x28,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x77,0x20,0x3a,0x3d,0x20,0x74,0x61,0x62,0x77,0x72,0x69,0x74,0x65,0x72,0x2e,0x4e,0x65,0x77,0x57,0x72,0x69,0x74,0x65,0x72,0x28,0x6f,0x73,0x2e,0x53,0x74,0x64,0x6f,0x75,0x74,0x2c,0x20,0x30,0x2c,0x20,0x30,0x2c,0x20,0x31,0x2c,0x20,0x27,0x2e,0x27,0x2c,0x20,0x74,0x61,0x62,0x77,0x72,0x69,0x74,0x65,0x72,0x2e,0x41,0x6c,0x69,0x67,0x6e,0x52,0x69,0x67,0x68,0x74,0x7c,0x74,0x61,0x62,0x77,0x72,0x69,0x74,0x65,0x72,0x2e,0x44,0x65,0x62,0x75,0x67,0x29,0x0a,0x0a,0x09,0x5f,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x46,0x70,0x72,0x69,0x6e,0x74,0x6c,0x6e,0x28,0x77,0x2c,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x47,0x6f,0x20,0x76,0x65,0x72,0x73,0x69,0x6f,0x6e,0x3a,0x20,0x25,0x73,0x22,0x2c,0x20,0x76,0x69,0x2e,0x47,0x6f,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x29,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x5f,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x46,0x70,0x72,0x69,0x6e,0x74,0x6c,0x6e,0x28,0x77,0x2c,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x47,0x69,0x74,0x20,0x74,0x61,0x67,0x3a,0x20,0x25,0x73,0x22,0x2c,0x20,0x76,0x69,0x2e,0x47,0x69,0x74,0x54,0x61,0x67,0x29,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x5f,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x46,0x70,0x72,0x69,0x6e,0x74,0x6c,0x6e,0x28,0x77,0x2c,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x47,0x69,0x74,0x20,0x63,0x6f,0x6d,0x6d,0x69,0x74,0x3a,0x20,0x25,0x73,0x22,0x2c,0x20,0x76,0x69,0x2e,0x47,0x69,0x74,0x43,0x6f,0x6d,0x6d,0x69,0x74,0x29,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x5f,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x46,0x70,0x72,0x69,0x6e,0x74,0x6c,0x6e,0x28,0x77,0x2c,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x47,0x69,0x74,0x20,0x62,0x72,0x61,0x6e,0x63,0x68,0x3a,0x20,0x25,0x73,0x22,0x2c,0x20,0x76,0x69,0x2e,0x47,0x69,0x74,0x42,0x72,0x61,0x6e,0x63,0x68,0x29,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x5f,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x46,0x70,0x72,0x69,0x6e,0x74,0x6c,0x6e,0x28,0x77,0x2c,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x42,0x75,0x69,0x6c,0x64,0x74,0x69,0x6d,0x65,0x3a,0x20,0x25,0x73,0x22,0x2c,0x20,0x76,0x69,0x2e,0x42,0x75,0x69,0x6c,0x64,0x54,0x69,0x6d,0x65,0x29,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x77,0x2e,0x46,0x6c,0x75,0x73,0x68,0x28,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x4f,0x70,0x74,0x73,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x48,0x6f,0x6f,0x6b,0x73,0x20,0x68,0x6f,0x6f,0x6b,0x73,0x2e,0x48,0x6f,0x6f,0x6b,0x73,0x43,0x6c,0x69,0x65,0x6e,0x74,0x0a,0x09,0x43,0x74,0x78,0x20,0x20,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x48,0x74,0x74,0x70,0x43,0x6c,0x69,0x65,0x6e,0x74,0x57,0x72,0x61,0x70,0x70,0x65,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x42,0x61,0x73,0x65,0x55,0x52,0x4c,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x0a,0x09,0x2a,0x68,0x74,0x74,0x70,0x2e,0x43,0x6c,0x69,0x65,0x6e,0x74,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x48,0x74,0x74,0x70,0x43,0x6c,0x69,0x65,0x6e,0x74,0x57,0x72,0x61,0x70,0x70,0x65,0x72,0x28,0x63,0x6c,0x69,0x65,0x6e,0x74,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x43,0x6c,0x69,0x65,0x6e,0x74,0x2c,0x20,0x62,0x61,0x73,0x65,0x55,0x72,0x6c,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x2a,0x48,0x74,0x74,0x70,0x43,0x6c,0x69,0x65,0x6e,0x74,0x57,0x72,0x61,0x70,0x70,0x65,0x72,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x48,0x74,0x74,0x70,0x43,0x6c,0x69,0x65,0x6e,0x74,0x57,0x72,0x61,0x70,0x70,0x65,0x72,0x7b,0x0a,0x09,0x09,0x43,0x6c,0x69,0x65,0x6e,0x74,0x3a,0x20,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x2c,0x0a,0x09,0x09,0x42,0x61,0x73,0x65,0x55,0x52,0x4c,0x3a,0x20,0x62,0x61,0x73,0x65,0x55,0x72,0x6c,0x2c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x4e,0x65,0x77,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x66,0x75,0x6e,0x63,0x28,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x69,0x6f,0x2e,0x52,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x28,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x63,0x20,0x2a,0x48,0x74,0x74,0x70,0x43,0x6c,0x69,0x65,0x6e,0x74,0x57,0x72,0x61,0x70,0x70,0x65,0x72,0x29,0x20,0x56,0x65,0x72,0x62,0x28,0x76,0x65,0x72,0x62,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x4e,0x65,0x77,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x7b,0x0a,0x09,0x62,0x61,0x73,0x65,0x55,0x52,0x4c,0x20,0x3a,0x3d,0x20,0x63,0x2e,0x42,0x61,0x73,0x65,0x55,0x52,0x4c,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x75,0x6e,0x63,0x28,0x65,0x6e,0x64,0x70,0x6f,0x69,0x6e,0x74,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x62,0x6f,0x64,0x79,0x20,0x69,0x6f,0x2e,0x52,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x28,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x71,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x68,0x74,0x74,0x70,0x2e,0x4e,0x65,0x77,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x28,0x76,0x65,0x72,0x62,0x2c,0x20,0x62,0x61,0x73,0x65,0x55,0x52,0x4c,0x2b,0x65,0x6e,0x64,0x70,0x6f,0x69,0x6e,0x74,0x2c,0x20,0x62,0x6f,0x64,0x79,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x72,0x65,0x71,0x2c,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x63,0x20,0x2a,0x48,0x74,0x74,0x70,0x43,0x6c,0x69,0x65,0x6e,0x74,0x57,0x72,0x61,0x70,0x70,0x65,0x72,0x29,0x20,0x47,0x65,0x74,0x28,0x29,0x20,0x4e,0x65,0x77,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x63,0x2e,0x56,0x65,0x72,0x62,0x28,0x68,0x74,0x74,0x70,0x2e,0x4d,0x65,0x74,0x68,0x6f,0x64,0x47,0x65,0x74,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x63,0x20,0x2a,0x48,0x74,0x74,0x70,0x43,0x6c,0x69,0x65,0x6e,0x74,0x57,0x72,0x61,0x70,0x70,0x65,0x72,0x29,0x20,0x49,0x6e,0x74,0x6f,0x28,0x62,0x6f,0x64,0x79,0x20,0x69,0x6f,0x2e,0x52,0x65,0x61,0x64,0x43,0x6c,0x6f,0x73,0x65,0x72,0x2c,0x20,0x72,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x6a,0x73,0x6f,0x6e,0x2e,0x4e,0x65,0x77,0x44,0x65,0x63,0x6f,0x64,0x65,0x72,0x28,0x62,0x6f,0x64,0x79,0x29,0x2e,0x44,0x65,0x63,0x6f,0x64,0x65,0x28,0x26,0x72,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x09,0x64,0x65,0x66,0x65,0x72,0x20,0x62,0x6f,0x64,0x79,0x2e,0x43,0x6c,0x6f,0x73,0x65,0x28,0x29,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x63,0x6f,0x6e,0x73,0x74,0x20,0x28,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x2d,0x54,0x79,0x70,0x65,0x22,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x41,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x4a,0x73,0x6f,0x6e,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2f,0x6a,0x73,0x6f,0x6e,0x22,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x41,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x48,0x61,0x6c,0x4a,0x73,0x6f,0x6e,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2f,0x68,0x61,0x6c,0x2b,0x6a,0x73,0x6f,0x6e,0x22,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x54,0x65,0x78,0x74,0x50,0x6c,0x61,0x69,0x6e,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x74,0x65,0x78,0x74,0x2f,0x70,0x6c,0x61,0x69,0x6e,0x22,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x4d,0x75,0x6c,0x74,0x69,0x70,0x61,0x72,0x74,0x46,0x6f,0x72,0x6d,0x44,0x61,0x74,0x61,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x6d,0x75,0x6c,0x74,0x69,0x70,0x61,0x72,0x74,0x2f,0x66,0x6f,0x72,0x6d,0x2d,0x64,0x61,0x74,0x61,0x22,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x41,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x46,0x6f,0x72,0x6d,0x55,0x72,0x6c,0x65,0x6e,0x63,0x6f,0x64,0x65,0x64,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2f,0x78,0x2d,0x77,0x77,0x77,0x2d,0x66,0x6f,0x72,0x6d,0x2d,0x75,0x72,0x6c,0x65,0x6e,0x63,0x6f,0x64,0x65,0x64,0x22,0x0a,0x29,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x45,0x78,0x74,0x72,0x61,0x63,0x74,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x28,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x69,0x66,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x3d,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x22,0x22,0x0a,0x09,0x7d,0x0a,0x09,0x69,0x20,0x3a,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x49,0x6e,0x64,0x65,0x78,0x28,0x68,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x22,0x3b,0x22,0x29,0x0a,0x09,0x69,0x66,0x20,0x69,0x20,0x3d,0x3d,0x20,0x2d,0x31,0x20,0x7b,0x0a,0x09,0x09,0x69,0x20,0x3d,0x20,0x6c,0x65,0x6e,0x28,0x68,0x65,0x61,0x64,0x65,0x72,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x54,0x72,0x69,0x6d,0x53,0x70,0x61,0x63,0x65,0x28,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x54,0x6f,0x4c,0x6f,0x77,0x65,0x72,0x28,0x68,0x65,0x61,0x64,0x65,0x72,0x5b,0x3a,0x69,0x5d,0x29,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x49,0x6e,0x4c,0x69,0x73,0x74,0x28,0x74,0x79,0x70,0x65,0x73,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x74,0x79,0x70,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x20,0x7b,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x5f,0x2c,0x20,0x74,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x74,0x79,0x70,0x65,0x73,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x74,0x20,0x3d,0x3d,0x20,0x74,0x79,0x70,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x74,0x72,0x75,0x65,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x61,0x6c,0x73,0x65,0x0a,0x7d,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x4e,0x6f,0x74,0x53,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x28,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x20,0x69,0x6e,0x74,0x2c,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x4e,0x6f,0x74,0x53,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x7b,0x0a,0x09,0x09,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x3a,0x20,0x20,0x20,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x2c,0x0a,0x09,0x09,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x3a,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x2c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x4e,0x6f,0x74,0x53,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x20,0x69,0x6e,0x74,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x4e,0x6f,0x74,0x53,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x65,0x72,0x72,0x6f,0x72,0x20,0x75,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x20,0x6d,0x65,0x64,0x69,0x61,0x20,0x74,0x79,0x70,0x65,0x20,0x28,0x25,0x73,0x29,0x22,0x2c,0x20,0x65,0x2e,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x4e,0x6f,0x74,0x53,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x29,0x20,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x29,0x20,0x69,0x6e,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x2e,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x0a,0x7d,0x0a,0x0a,0x76,0x61,0x72,0x20,0x4e,0x65,0x77,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x4f,0x62,0x6a,0x65,0x63,0x74,0x49,0x73,0x4e,0x69,0x6c,0x45,0x72,0x72,0x6f,0x72,0x20,0x3d,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4e,0x65,0x77,0x28,0x22,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x6f,0x62,0x6a,0x65,0x63,0x74,0x20,0x69,0x73,0x20,0x6e,0x69,0x6c,0x22,0x29,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x45,0x72,0x72,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x28,0x63,0x6f,0x64,0x65,0x20,0x69,0x6e,0x74,0x29,0x20,0x2a,0x45,0x72,0x72,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x45,0x72,0x72,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x7b,0x0a,0x09,0x09,0x63,0x6f,0x64,0x65,0x3a,0x20,0x63,0x6f,0x64,0x65,0x2c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x45,0x72,0x72,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x63,0x6f,0x64,0x65,0x20,0x69,0x6e,0x74,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x72,0x72,0x20,0x2a,0x45,0x72,0x72,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x75,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x63,0x6f,0x64,0x65,0x20,0x27,0x25,0x64,0x27,0x22,0x2c,0x20,0x65,0x72,0x72,0x2e,0x63,0x6f,0x64,0x65,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x45,0x72,0x72,0x4f,0x6e,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x28,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x2a,0x45,0x72,0x72,0x4f,0x6e,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x45,0x72,0x72,0x4f,0x6e,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x7b,0x0a,0x09,0x09,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x3a,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x2c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x45,0x72,0x72,0x4f,0x6e,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x72,0x72,0x20,0x2a,0x45,0x72,0x72,0x4f,0x6e,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x65,0x72,0x72,0x2e,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x29,0x0a,0x7d,0x0a,0x66,0x75,0x6e,0x63,0x20,0x53,0x65,0x72,0x76,0x65,0x4a,0x73,0x6f,0x6e,0x28,0x77,0x20,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x57,0x72,0x69,0x74,0x65,0x72,0x2c,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x69,0x6e,0x74,0x2c,0x20,0x76,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x77,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x28,0x29,0x5b,0x22,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x2d,0x54,0x79,0x70,0x65,0x22,0x5d,0x20,0x3d,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x7b,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2f,0x6a,0x73,0x6f,0x6e,0x22,0x7d,0x0a,0x09,0x77,0x2e,0x57,0x72,0x69,0x74,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x28,0x73,0x74,0x61,0x74,0x75,0x73,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x6a,0x73,0x6f,0x6e,0x2e,0x4e,0x65,0x77,0x45,0x6e,0x63,0x6f,0x64,0x65,0x72,0x28,0x77,0x29,0x2e,0x45,0x6e,0x63,0x6f,0x64,0x65,0x28,0x76,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x53,0x65,0x72,0x76,0x65,0x48,0x61,0x6c,0x4a,0x73,0x6f,0x6e,0x28,0x77,0x20,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x57,0x72,0x69,0x74,0x65,0x72,0x2c,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x69,0x6e,0x74,0x2c,0x20,0x76,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x77,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x28,0x29,0x5b,0x22,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x2d,0x54,0x79,0x70,0x65,0x22,0x5d,0x20,0x3d,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x7b,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2f,0x68,0x61,0x6c,0x2b,0x6a,0x73,0x6f,0x6e,0x22,0x7d,0x0a,0x09,0x77,0x2e,0x57,0x72,0x69,0x74,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x28,0x73,0x74,0x61,0x74,0x75,0x73,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x6a,0x73,0x6f,0x6e,0x2e,0x4e,0x65,0x77,0x45,0x6e,0x63,0x6f,0x64,0x65,0x72,0x28,0x77,0x29,0x2e,0x45,0x6e,0x63,0x6f,0x64,0x65,0x28,0x76,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x4d,0x69,0x6d,0x65,0x46,0x69,0x6c,0x65,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x48,0x65,0x61,0x64,0x65,0x72,0x20,0x20,0x2a,0x6d,0x75,0x6c,0x74,0x69,0x70,0x61,0x72,0x74,0x2e,0x46,0x69,0x6c,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x20,0x69,0x6f,0x2e,0x52,0x65,0x61,0x64,0x43,0x6c,0x6f,0x73,0x65,0x72,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x45,0x78,0x74,0x72,0x61,0x63,0x74,0x55,0x70,0x6c,0x6f,0x61,0x64,0x28,0x66,0x69,0x6c,0x65,0x49,0x44,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x72,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x29,0x20,0x28,0x2a,0x4d,0x69,0x6d,0x65,0x46,0x69,0x6c,0x65,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x72,0x2e,0x50,0x61,0x72,0x73,0x65,0x4d,0x75,0x6c,0x74,0x69,0x70,0x61,0x72,0x74,0x46,0x6f,0x72,0x6d,0x28,0x31,0x30,0x32,0x34,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x66,0x69,0x6c,0x65,0x2c,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x72,0x2e,0x46,0x6f,0x72,0x6d,0x46,0x69,0x6c,0x65,0x28,0x66,0x69,0x6c,0x65,0x49,0x44,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x6d,0x69,0x6d,0x65,0x46,0x69,0x6c,0x65,0x20,0x3a,0x3d,0x20,0x4d,0x69,0x6d,0x65,0x46,0x69,0x6c,0x65,0x7b,0x0a,0x09,0x09,0x48,0x65,0x61,0x64,0x65,0x72,0x3a,0x20,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x2c,0x0a,0x09,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x3a,0x20,0x66,0x69,0x6c,0x65,0x2c,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x6d,0x69,0x6d,0x65,0x46,0x69,0x6c,0x65,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x4b,0x65,0x79,0x20,0x69,0x6e,0x74,0x0a,0x0a,0x63,0x6f,0x6e,0x73,0x74,0x20,0x28,0x0a,0x09,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x48,0x65,0x61,0x64,0x65,0x72,0x4b,0x65,0x79,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x4b,0x65,0x79,0x20,0x3d,0x20,0x31,0x20,0x2b,0x20,0x69,0x6f,0x74,0x61,0x0a,0x29,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x48,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x20,0x7b,0x0a,0x09,0x47,0x65,0x74,0x48,0x54,0x54,0x50,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x48,0x65,0x61,0x64,0x65,0x72,0x73,0x28,0x29,0x20,0x28,0x68,0x74,0x74,0x70,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x62,0x6f,0x6f,0x6c,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x43,0x72,0x65,0x61,0x74,0x65,0x48,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x28,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x68,0x74,0x74,0x70,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x7b,0x0a,0x09,0x63,0x74,0x78,0x20,0x3a,0x3d,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x42,0x61,0x63,0x6b,0x67,0x72,0x6f,0x75,0x6e,0x64,0x28,0x29,0x0a,0x09,0x63,0x74,0x78,0x20,0x3d,0x20,0x68,0x54,0x54,0x50,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x48,0x65,0x61,0x64,0x65,0x72,0x73,0x28,0x63,0x74,0x78,0x2c,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x29,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x63,0x74,0x78,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x68,0x54,0x54,0x50,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x48,0x65,0x61,0x64,0x65,0x72,0x73,0x28,0x63,0x74,0x78,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2c,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x68,0x74,0x74,0x70,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x57,0x69,0x74,0x68,0x56,0x61,0x6c,0x75,0x65,0x28,0x63,0x74,0x78,0x2c,0x20,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x48,0x65,0x61,0x64,0x65,0x72,0x4b,0x65,0x79,0x2c,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x48,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x57,0x72,0x61,0x70,0x70,0x65,0x72,0x28,0x63,0x74,0x78,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x29,0x20,0x48,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x7b,0x0a,0x09,0x69,0x66,0x20,0x63,0x74,0x78,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x63,0x74,0x78,0x20,0x3d,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x42,0x61,0x63,0x6b,0x67,0x72,0x6f,0x75,0x6e,0x64,0x28,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x68,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x7b,0x0a,0x09,0x09,0x63,0x74,0x78,0x2c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x68,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x63,0x20,0x2a,0x68,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x29,0x20,0x47,0x65,0x74,0x48,0x54,0x54,0x50,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x48,0x65,0x61,0x64,0x65,0x72,0x73,0x28,0x29,0x20,0x28,0x68,0x74,0x74,0x70,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x62,0x6f,0x6f,0x6c,0x29,0x20,0x7b,0x0a,0x09,0x68,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x6f,0x6b,0x20,0x3a,0x3d,0x20,0x63,0x2e,0x56,0x61,0x6c,0x75,0x65,0x28,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x48,0x65,0x61,0x64,0x65,0x72,0x4b,0x65,0x79,0x29,0x2e,0x28,0x68,0x74,0x74,0x70,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x6f,0x6b,0x0a,0x7d,0x0a,0x0a,0x76,0x61,0x72,0x20,0x45,0x72,0x72,0x43,0x6f,0x6c,0x6c,0x69,0x73,0x69,0x6f,0x6e,0x4d,0x61,0x70,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x3d,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4e,0x65,0x77,0x28,0x22,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x66,0x72,0x6f,0x6d,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x6f,0x76,0x65,0x72,0x77,0x72,0x69,0x74,0x65,0x73,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x69,0x6e,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x6f,0x62,0x6a,0x65,0x63,0x74,0x22,0x29,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x53,0x65,0x74,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x48,0x65,0x61,0x64,0x65,0x72,0x73,0x46,0x72,0x6f,0x6d,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x28,0x68,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x48,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2c,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x68,0x74,0x74,0x70,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x68,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x68,0x65,0x61,0x64,0x65,0x72,0x73,0x46,0x72,0x6f,0x6d,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2c,0x20,0x6f,0x6b,0x20,0x3a,0x3d,0x20,0x68,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x47,0x65,0x74,0x48,0x54,0x54,0x50,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x48,0x65,0x61,0x64,0x65,0x72,0x73,0x28,0x29,0x0a,0x09,0x69,0x66,0x20,0x21,0x6f,0x6b,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x6b,0x65,0x79,0x2c,0x20,0x76,0x61,0x6c,0x75,0x65,0x73,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x73,0x46,0x72,0x6f,0x6d,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x5f,0x2c,0x20,0x65,0x78,0x69,0x73,0x74,0x73,0x20,0x3a,0x3d,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x5b,0x6b,0x65,0x79,0x5d,0x3b,0x20,0x65,0x78,0x69,0x73,0x74,0x73,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x45,0x72,0x72,0x43,0x6f,0x6c,0x6c,0x69,0x73,0x69,0x6f,0x6e,0x4d,0x61,0x70,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x68,0x65,0x61,0x64,0x65,0x72,0x5b,0x6b,0x65,0x79,0x5d,0x20,0x3d,0x20,0x76,0x61,0x6c,0x75,0x65,0x73,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x58,0x48,0x54,0x54,0x50,0x45,0x72,0x72,0x6f,0x72,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x20,0x7b,0x0a,0x09,0x65,0x72,0x72,0x6f,0x72,0x0a,0x0a,0x09,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x29,0x20,0x69,0x6e,0x74,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x48,0x74,0x74,0x70,0x43,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x20,0x69,0x6e,0x74,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x48,0x54,0x54,0x50,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x28,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x69,0x6e,0x74,0x29,0x20,0x58,0x48,0x54,0x54,0x50,0x45,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x48,0x74,0x74,0x70,0x43,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x7b,0x73,0x74,0x61,0x74,0x75,0x73,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x48,0x74,0x74,0x70,0x43,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x54,0x65,0x78,0x74,0x28,0x65,0x2e,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x48,0x74,0x74,0x70,0x43,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x29,0x20,0x69,0x6e,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x2e,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x48,0x74,0x74,0x70,0x4a,0x73,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x20,0x69,0x6e,0x74,0x0a,0x09,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x20,0x20,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x4a,0x73,0x6f,0x6e,0x48,0x54,0x54,0x50,0x45,0x72,0x72,0x6f,0x72,0x28,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x69,0x6e,0x74,0x2c,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x58,0x48,0x54,0x54,0x50,0x45,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x48,0x74,0x74,0x70,0x4a,0x73,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x7b,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x3a,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x2c,0x20,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x3a,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x48,0x74,0x74,0x70,0x4a,0x73,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x29,0x20,0x69,0x6e,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x2e,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x48,0x74,0x74,0x70,0x4a,0x73,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x73,0x3a,0x20,0x25,0x76,0x22,0x2c,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x54,0x65,0x78,0x74,0x28,0x65,0x2e,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x29,0x2c,0x20,0x65,0x2e,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x50,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x48,0x61,0x6e,0x64,0x6c,0x65,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x20,0x20,0x20,0x2a,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x56,0x65,0x63,0x0a,0x09,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x20,0x2a,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x48,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x56,0x65,0x63,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x50,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x48,0x61,0x6e,0x64,0x6c,0x65,0x72,0x28,0x6e,0x61,0x6d,0x65,0x73,0x70,0x61,0x63,0x65,0x20,0x2a,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x2a,0x50,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x48,0x61,0x6e,0x64,0x6c,0x65,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x4e,0x61,0x6d,0x65,0x20,0x3a,0x3d,0x20,0x22,0x61,0x70,0x69,0x5f,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x5f,0x6e,0x75,0x6d,0x62,0x65,0x72,0x5f,0x74,0x6f,0x74,0x61,0x6c,0x22,0x0a,0x09,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x4e,0x61,0x6d,0x65,0x20,0x3a,0x3d,0x20,0x22,0x61,0x70,0x69,0x5f,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x5f,0x64,0x75,0x72,0x61,0x74,0x69,0x6f,0x6e,0x5f,0x73,0x65,0x63,0x6f,0x6e,0x64,0x73,0x22,0x0a,0x0a,0x09,0x69,0x66,0x20,0x6e,0x61,0x6d,0x65,0x73,0x70,0x61,0x63,0x65,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x4e,0x61,0x6d,0x65,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x73,0x5f,0x25,0x73,0x22,0x2c,0x20,0x2a,0x6e,0x61,0x6d,0x65,0x73,0x70,0x61,0x63,0x65,0x2c,0x20,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x4e,0x61,0x6d,0x65,0x29,0x0a,0x09,0x09,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x4e,0x61,0x6d,0x65,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x73,0x5f,0x25,0x73,0x22,0x2c,0x20,0x2a,0x6e,0x61,0x6d,0x65,0x73,0x70,0x61,0x63,0x65,0x2c,0x20,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x4e,0x61,0x6d,0x65,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x20,0x3a,0x3d,0x20,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x4e,0x65,0x77,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x56,0x65,0x63,0x28,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x4f,0x70,0x74,0x73,0x7b,0x0a,0x09,0x09,0x4e,0x61,0x6d,0x65,0x3a,0x20,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x4e,0x61,0x6d,0x65,0x2c,0x0a,0x09,0x09,0x48,0x65,0x6c,0x70,0x3a,0x20,0x22,0x54,0x6f,0x74,0x61,0x6c,0x20,0x6e,0x75,0x6d,0x62,0x65,0x72,0x20,0x41,0x50,0x49,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x73,0x20,0x73,0x65,0x6e,0x74,0x20,0x62,0x79,0x20,0x74,0x68,0x65,0x20,0x73,0x65,0x72,0x76,0x69,0x63,0x65,0x2e,0x22,0x2c,0x0a,0x09,0x7d,0x2c,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x7b,0x22,0x68,0x61,0x6e,0x64,0x6c,0x65,0x72,0x22,0x2c,0x20,0x22,0x6d,0x65,0x74,0x68,0x6f,0x64,0x22,0x2c,0x20,0x22,0x73,0x74,0x61,0x74,0x75,0x73,0x22,0x7d,0x29,0x0a,0x0a,0x09,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x20,0x3a,0x3d,0x20,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x4e,0x65,0x77,0x48,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x56,0x65,0x63,0x28,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x48,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x4f,0x70,0x74,0x73,0x7b,0x0a,0x09,0x09,0x4e,0x61,0x6d,0x65,0x3a,0x20,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x4e,0x61,0x6d,0x65,0x2c,0x0a,0x09,0x09,0x48,0x65,0x6c,0x70,0x3a,0x20,0x22,0x44,0x75,0x72,0x61,0x74,0x69,0x6f,0x6e,0x20,0x6f,0x66,0x20,0x74,0x68,0x65,0x20,0x41,0x50,0x49,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x73,0x20,0x62,0x65,0x69,0x6e,0x67,0x20,0x68,0x61,0x6e,0x64,0x6c,0x65,0x64,0x22,0x2c,0x0a,0x09,0x7d,0x2c,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x7b,0x22,0x68,0x61,0x6e,0x64,0x6c,0x65,0x72,0x22,0x7d,0x29,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x52,0x65,0x67,0x69,0x73,0x74,0x65,0x72,0x28,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x6c,0x6f,0x67,0x72,0x75,0x73,0x2e,0x57,0x69,0x74,0x68,0x45,0x72,0x72,0x6f,0x72,0x28,0x65,0x72,0x72,0x29,0x2e,0x57,0x61,0x72,0x6e,0x28,0x22,0x66,0x61,0x69,0x6c,0x65,0x64,0x20,0x74,0x6f,0x20,0x72,0x65,0x67,0x69,0x73,0x74,0x65,0x72,0x20,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x20,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x22,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x52,0x65,0x67,0x69,0x73,0x74,0x65,0x72,0x28,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x6c,0x6f,0x67,0x72,0x75,0x73,0x2e,0x57,0x69,0x74,0x68,0x45,0x72,0x72,0x6f,0x72,0x28,0x65,0x72,0x72,0x29,0x2e,0x57,0x61,0x72,0x6e,0x28,0x22,0x66,0x61,0x69,0x6c,0x65,0x64,0x20,0x74,0x6f,0x20,0x72,0x65,0x67,0x69,0x73,0x74,0x65,0x72,0x20,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x20,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x22,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x68,0x20,0x3a,0x3d,0x20,0x26,0x50,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x48,0x61,0x6e,0x64,0x6c,0x65,0x72,0x7b,0x0a,0x09,0x09,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x3a,0x20,0x20,0x20,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x2c,0x0a,0x09,0x09,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x3a,0x20,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x2c,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x68,0x20,0x2a,0x50,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x48,0x61,0x6e,0x64,0x6c,0x65,0x72,0x29,0x20,0x49,0x6e,0x69,0x74,0x4d,0x65,0x74,0x72,0x69,0x63,0x28,0x70,0x61,0x74,0x68,0x2c,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x68,0x2e,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x2e,0x57,0x69,0x74,0x68,0x4c,0x61,0x62,0x65,0x6c,0x56,0x61,0x6c,0x75,0x65,0x73,0x28,0x70,0x61,0x74,0x68,0x2c,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x2c,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x49,0x74,0x6f,0x61,0x28,0x32,0x30,0x30,0x29,0x29,0x0a,0x09,0x68,0x2e,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x2e,0x57,0x69,0x74,0x68,0x4c,0x61,0x62,0x65,0x6c,0x56,0x61,0x6c,0x75,0x65,0x73,0x28,0x70,0x61,0x74,0x68,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x68,0x20,0x2a,0x50,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x48,0x61,0x6e,0x64,0x6c,0x65,0x72,0x29,0x20,0x48,0x61,0x6e,0x64,0x6c,0x65,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x28,0x70,0x61,0x74,0x68,0x2c,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x69,0x6e,0x74,0x2c,0x20,0x64,0x75,0x72,0x61,0x74,0x69,0x6f,0x6e,0x20,0x74,0x69,0x6d,0x65,0x2e,0x44,0x75,0x72,0x61,0x74,0x69,0x6f,0x6e,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x68,0x2e,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x2e,0x57,0x69,0x74,0x68,0x4c,0x61,0x62,0x65,0x6c,0x56,0x61,0x6c,0x75,0x65,0x73,0x28,0x70,0x61,0x74,0x68,0x2c,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x2c,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x49,0x74,0x6f,0x61,0x28,0x73,0x74,0x61,0x74,0x75,0x73,0x29,0x29,0x2e,0x49,0x6e,0x63,0x28,0x29,0x0a,0x09,0x68,0x2e,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x2e,0x57,0x69,0x74,0x68,0x4c,0x61,0x62,0x65,0x6c,0x56,0x61,0x6c,0x75,0x65,0x73,0x28,0x70,0x61,0x74,0x68,0x29,0x2e,0x4f,0x62,0x73,0x65,0x72,0x76,0x65,0x28,0x64,0x75,0x72,0x61,0x74,0x69,0x6f,0x6e,0x2e,0x53,0x65,0x63,0x6f,0x6e,0x64,0x73,0x28,0x29,0x29,0x0a,0x7d,0x0a, }
\ No newline at end of file
+	var ClientCode []byte = []byte{ 0x70,0x61,0x63,0x6b,0x61,0x67,0x65,0x20,0x66,0x72,0x61,0x6d,0x65,0x77,0x6f,0x72,0x6b,0x0a,0x0a,0x69,0x6d,0x70,0x6f,0x72,0x74,0x20,0x28,0x0a,0x09,0x22,0x62,0x75,0x66,0x69,0x6f,0x22,0x0a,0x09,0x22,0x62,0x79,0x74,0x65,0x73,0x22,0x0a,0x09,0x22,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x22,0x0a,0x09,0x22,0x65,0x6e,0x63,0x6f,0x64,0x69,0x6e,0x67,0x2f,0x62,0x61,0x73,0x65,0x36,0x34,0x22,0x0a,0x09,0x22,0x65,0x6e,0x63,0x6f,0x64,0x69,0x6e,0x67,0x2f,0x6a,0x73,0x6f,0x6e,0x22,0x0a,0x09,0x22,0x65,0x6e,0x63,0x6f,0x64,0x69,0x6e,0x67,0x2f,0x78,0x6d,0x6c,0x22,0x0a,0x09,0x22,0x66,0x6d,0x74,0x22,0x0a,0x09,0x22,0x67,0x69,0x74,0x68,0x75,0x62,0x2e,0x63,0x6f,0x6d,0x2f,0x67,0x6f,0x2d,0x70,0x6c,0x61,0x79,0x67,0x72,0x6f,0x75,0x6e,0x64,0x2f,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x22,0x0a,0x09,0x22,0x67,0x69,0x74,0x68,0x75,0x62,0x2e,0x63,0x6f,0x6d,0x2f,0x67,0x6f,0x72,0x69,0x6c,0x6c,0x61,0x2f,0x77,0x65,0x62,0x73,0x6f,0x63,0x6b,0x65,0x74,0x22,0x0a,0x09,0x22,0x67,0x69,0x74,0x68,0x75,0x62,0x2e,0x63,0x6f,0x6d,0x2f,0x70,0x6b,0x67,0x2f,0x65,0x72,0x72,0x6f,0x72,0x73,0x22,0x0a,0x09,0x22,0x67,0x69,0x74,0x68,0x75,0x62,0x2e,0x63,0x6f,0x6d,0x2f,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2f,0x63,0x6c,0x69,0x65,0x6e,0x74,0x5f,0x67,0x6f,0x6c,0x61,0x6e,0x67,0x2f,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x22,0x0a,0x09,0x22,0x67,0x69,0x74,0x68,0x75,0x62,0x2e,0x63,0x6f,0x6d,0x2f,0x73,0x69,0x72,0x75,0x70,0x73,0x65,0x6e,0x2f,0x6c,0x6f,0x67,0x72,0x75,0x73,0x22,0x0a,0x09,0x22,0x69,0x6f,0x22,0x0a,0x09,0x22,0x69,0x6f,0x2f,0x69,0x6f,0x75,0x74,0x69,0x6c,0x22,0x0a,0x09,0x22,0x6d,0x61,0x74,0x68,0x22,0x0a,0x09,0x22,0x6d,0x69,0x6d,0x65,0x22,0x0a,0x09,0x22,0x6d,0x69,0x6d,0x65,0x2f,0x6d,0x75,0x6c,0x74,0x69,0x70,0x61,0x72,0x74,0x22,0x0a,0x09,0x22,0x6e,0x65,0x74,0x2f,0x68,0x74,0x74,0x70,0x22,0x0a,0x09,0x22,0x6e,0x65,0x74,0x2f,0x68,0x74,0x74,0x70,0x2f,0x68,0x74,0x74,0x70,0x75,0x74,0x69,0x6c,0x22,0x0a,0x09,0x22,0x6e,0x65,0x74,0x2f,0x74,0x65,0x78,0x74,0x70,0x72,0x6f,0x74,0x6f,0x22,0x0a,0x09,0x22,0x6e,0x65,0x74,0x2f,0x75,0x72,0x6c,0x22,0x0a,0x09,0x22,0x6f,0x73,0x22,0x0a,0x09,0x22,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x22,0x0a,0x09,0x22,0x72,0x65,0x67,0x65,0x78,0x70,0x22,0x0a,0x09,0x22,0x72,0x75,0x6e,0x74,0x69,0x6d,0x65,0x22,0x0a,0x09,0x22,0x72,0x75,0x6e,0x74,0x69,0x6d,0x65,0x2f,0x64,0x65,0x62,0x75,0x67,0x22,0x0a,0x09,0x22,0x73,0x6f,0x72,0x74,0x22,0x0a,0x09,0x22,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x22,0x0a,0x09,0x22,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x22,0x0a,0x09,0x22,0x73,0x79,0x6e,0x63,0x22,0x0a,0x09,0x22,0x74,0x65,0x78,0x74,0x2f,0x74,0x61,0x62,0x77,0x72,0x69,0x74,0x65,0x72,0x22,0x0a,0x09,0x22,0x74,0x69,0x6d,0x65,0x22,0x0a,0x29,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x43,0x6c,0x69,0x65,0x6e,0x74,0x4f,0x70,0x65,0x72,0x61,0x74,0x69,0x6f,0x6e,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x49,0x44,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x0a,0x09,0x52,0x6f,0x75,0x74,0x65,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x4d,0x65,0x74,0x68,0x6f,0x64,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x48,0x6f,0x6f,0x6b,0x73,0x43,0x6c,0x69,0x65,0x6e,0x74,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x4f,0x6e,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,0x66,0x75,0x6e,0x63,0x28,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2c,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x0a,0x09,0x4f,0x6e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x66,0x75,0x6e,0x63,0x28,0x6f,0x70,0x65,0x72,0x61,0x74,0x69,0x6f,0x6e,0x20,0x43,0x6c,0x69,0x65,0x6e,0x74,0x4f,0x70,0x65,0x72,0x61,0x74,0x69,0x6f,0x6e,0x2c,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x29,0x0a,0x0a,0x09,0x4f,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x66,0x75,0x6e,0x63,0x28,0x6f,0x70,0x65,0x72,0x61,0x74,0x69,0x6f,0x6e,0x20,0x43,0x6c,0x69,0x65,0x6e,0x74,0x4f,0x70,0x65,0x72,0x61,0x74,0x69,0x6f,0x6e,0x2c,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2c,0x20,0x64,0x75,0x72,0x61,0x74,0x69,0x6f,0x6e,0x20,0x74,0x69,0x6d,0x65,0x2e,0x44,0x75,0x72,0x61,0x74,0x69,0x6f,0x6e,0x29,0x0a,0x0a,0x09,0x4f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x20,0x66,0x75,0x6e,0x63,0x28,0x6f,0x70,0x65,0x72,0x61,0x74,0x69,0x6f,0x6e,0x20,0x43,0x6c,0x69,0x65,0x6e,0x74,0x4f,0x70,0x65,0x72,0x61,0x74,0x69,0x6f,0x6e,0x2c,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2c,0x20,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x68,0x20,0x2a,0x48,0x6f,0x6f,0x6b,0x73,0x43,0x6c,0x69,0x65,0x6e,0x74,0x29,0x20,0x43,0x61,0x6c,0x6c,0x4f,0x6e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x28,0x6f,0x70,0x65,0x72,0x61,0x74,0x69,0x6f,0x6e,0x20,0x43,0x6c,0x69,0x65,0x6e,0x74,0x4f,0x70,0x65,0x72,0x61,0x74,0x69,0x6f,0x6e,0x2c,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x68,0x2e,0x4f,0x6e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x68,0x2e,0x4f,0x6e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x28,0x6f,0x70,0x65,0x72,0x61,0x74,0x69,0x6f,0x6e,0x2c,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x29,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x68,0x20,0x2a,0x48,0x6f,0x6f,0x6b,0x73,0x43,0x6c,0x69,0x65,0x6e,0x74,0x29,0x20,0x43,0x61,0x6c,0x6c,0x4f,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x28,0x6f,0x70,0x65,0x72,0x61,0x74,0x69,0x6f,0x6e,0x20,0x43,0x6c,0x69,0x65,0x6e,0x74,0x4f,0x70,0x65,0x72,0x61,0x74,0x69,0x6f,0x6e,0x2c,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2c,0x20,0x73,0x74,0x61,0x72,0x74,0x20,0x74,0x69,0x6d,0x65,0x2e,0x54,0x69,0x6d,0x65,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x68,0x2e,0x4f,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x68,0x2e,0x4f,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x28,0x6f,0x70,0x65,0x72,0x61,0x74,0x69,0x6f,0x6e,0x2c,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2c,0x20,0x74,0x69,0x6d,0x65,0x2e,0x53,0x69,0x6e,0x63,0x65,0x28,0x73,0x74,0x61,0x72,0x74,0x29,0x29,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x68,0x20,0x2a,0x48,0x6f,0x6f,0x6b,0x73,0x43,0x6c,0x69,0x65,0x6e,0x74,0x29,0x20,0x43,0x61,0x6c,0x6c,0x4f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x28,0x6f,0x70,0x65,0x72,0x61,0x74,0x69,0x6f,0x6e,0x20,0x43,0x6c,0x69,0x65,0x6e,0x74,0x4f,0x70,0x65,0x72,0x61,0x74,0x69,0x6f,0x6e,0x2c,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2c,0x20,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x68,0x2e,0x4f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x68,0x2e,0x4f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x28,0x6f,0x70,0x65,0x72,0x61,0x74,0x69,0x6f,0x6e,0x2c,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2c,0x20,0x65,0x72,0x72,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x44,0x65,0x76,0x48,0x6f,0x6f,0x6b,0x28,0x29,0x20,0x48,0x6f,0x6f,0x6b,0x73,0x43,0x6c,0x69,0x65,0x6e,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x48,0x6f,0x6f,0x6b,0x73,0x43,0x6c,0x69,0x65,0x6e,0x74,0x7b,0x0a,0x09,0x09,0x4f,0x6e,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x3a,0x20,0x66,0x75,0x6e,0x63,0x28,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2c,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x09,0x09,0x76,0x61,0x72,0x20,0x68,0x74,0x74,0x70,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x44,0x75,0x6d,0x70,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x09,0x09,0x68,0x74,0x74,0x70,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x44,0x75,0x6d,0x70,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x68,0x74,0x74,0x70,0x75,0x74,0x69,0x6c,0x2e,0x44,0x75,0x6d,0x70,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x28,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x74,0x72,0x75,0x65,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x68,0x74,0x74,0x70,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x44,0x75,0x6d,0x70,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x63,0x6f,0x75,0x6c,0x64,0x20,0x6e,0x6f,0x74,0x20,0x64,0x75,0x6d,0x70,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x28,0x25,0x76,0x29,0x22,0x2c,0x20,0x65,0x72,0x72,0x2e,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x29,0x0a,0x09,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x68,0x74,0x74,0x70,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x44,0x75,0x6d,0x70,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x28,0x68,0x74,0x74,0x70,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x44,0x75,0x6d,0x70,0x29,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x09,0x76,0x61,0x72,0x20,0x68,0x74,0x74,0x70,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x44,0x75,0x6d,0x70,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x09,0x09,0x68,0x74,0x74,0x70,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x44,0x75,0x6d,0x70,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x68,0x74,0x74,0x70,0x75,0x74,0x69,0x6c,0x2e,0x44,0x75,0x6d,0x70,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x28,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2c,0x20,0x74,0x72,0x75,0x65,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x68,0x74,0x74,0x70,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x44,0x75,0x6d,0x70,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x63,0x6f,0x75,0x6c,0x64,0x20,0x6e,0x6f,0x74,0x20,0x64,0x75,0x6d,0x70,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x28,0x25,0x76,0x29,0x22,0x2c,0x20,0x65,0x72,0x72,0x2e,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x29,0x0a,0x09,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x68,0x74,0x74,0x70,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x44,0x75,0x6d,0x70,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x28,0x68,0x74,0x74,0x70,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x44,0x75,0x6d,0x70,0x29,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x09,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x3a,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x75,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x63,0x6f,0x64,0x65,0x20,0x25,0x64,0x22,0x2c,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x6c,0x65,0x6e,0x28,0x68,0x74,0x74,0x70,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x44,0x75,0x6d,0x70,0x29,0x20,0x21,0x3d,0x20,0x30,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x3d,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x2b,0x20,0x22,0x5c,0x6e,0x20,0x48,0x54,0x54,0x50,0x20,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x3a,0x20,0x5c,0x6e,0x20,0x27,0x22,0x20,0x2b,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x28,0x68,0x74,0x74,0x70,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x44,0x75,0x6d,0x70,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x29,0x20,0x2b,0x20,0x22,0x27,0x20,0x5c,0x6e,0x22,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x6c,0x65,0x6e,0x28,0x68,0x74,0x74,0x70,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x44,0x75,0x6d,0x70,0x29,0x20,0x21,0x3d,0x20,0x30,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x3d,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x2b,0x20,0x22,0x48,0x54,0x54,0x50,0x20,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x3a,0x20,0x5c,0x6e,0x20,0x27,0x22,0x20,0x2b,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x28,0x68,0x74,0x74,0x70,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x44,0x75,0x6d,0x70,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x29,0x20,0x2b,0x20,0x22,0x27,0x22,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x0a,0x09,0x09,0x7d,0x2c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x66,0x75,0x6e,0x63,0x20,0x70,0x72,0x69,0x6d,0x69,0x74,0x69,0x76,0x65,0x54,0x6f,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x70,0x61,0x72,0x61,0x6d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x0a,0x09,0x76,0x61,0x72,0x20,0x76,0x61,0x6c,0x75,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x0a,0x09,0x73,0x77,0x69,0x74,0x63,0x68,0x20,0x70,0x61,0x72,0x61,0x6d,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x7b,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x38,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x31,0x36,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x33,0x32,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x36,0x34,0x3a,0x0a,0x09,0x09,0x66,0x61,0x6c,0x6c,0x74,0x68,0x72,0x6f,0x75,0x67,0x68,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x38,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x31,0x36,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x33,0x32,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x36,0x34,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x64,0x22,0x2c,0x20,0x70,0x61,0x72,0x61,0x6d,0x2e,0x49,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x28,0x29,0x29,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x46,0x6c,0x6f,0x61,0x74,0x36,0x34,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x3d,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x46,0x6f,0x72,0x6d,0x61,0x74,0x46,0x6c,0x6f,0x61,0x74,0x28,0x70,0x61,0x72,0x61,0x6d,0x2e,0x49,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x28,0x29,0x2e,0x28,0x66,0x6c,0x6f,0x61,0x74,0x36,0x34,0x29,0x2c,0x20,0x27,0x66,0x27,0x2c,0x20,0x2d,0x31,0x2c,0x20,0x36,0x34,0x29,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x46,0x6c,0x6f,0x61,0x74,0x33,0x32,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x3d,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x46,0x6f,0x72,0x6d,0x61,0x74,0x46,0x6c,0x6f,0x61,0x74,0x28,0x66,0x6c,0x6f,0x61,0x74,0x36,0x34,0x28,0x70,0x61,0x72,0x61,0x6d,0x2e,0x49,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x28,0x29,0x2e,0x28,0x66,0x6c,0x6f,0x61,0x74,0x33,0x32,0x29,0x29,0x2c,0x20,0x27,0x66,0x27,0x2c,0x20,0x2d,0x31,0x2c,0x20,0x33,0x32,0x29,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x73,0x22,0x2c,0x20,0x70,0x61,0x72,0x61,0x6d,0x2e,0x49,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x28,0x29,0x29,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x42,0x6f,0x6f,0x6c,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x74,0x22,0x2c,0x20,0x70,0x61,0x72,0x61,0x6d,0x2e,0x49,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x28,0x29,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x73,0x6c,0x69,0x63,0x65,0x54,0x6f,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x70,0x61,0x72,0x61,0x6d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x0a,0x09,0x73,0x6c,0x69,0x63,0x65,0x20,0x3a,0x3d,0x20,0x6d,0x61,0x6b,0x65,0x28,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x70,0x61,0x72,0x61,0x6d,0x2e,0x4c,0x65,0x6e,0x28,0x29,0x29,0x0a,0x09,0x66,0x6f,0x72,0x20,0x69,0x20,0x3a,0x3d,0x20,0x30,0x3b,0x20,0x69,0x20,0x3c,0x20,0x70,0x61,0x72,0x61,0x6d,0x2e,0x4c,0x65,0x6e,0x28,0x29,0x3b,0x20,0x69,0x2b,0x2b,0x20,0x7b,0x0a,0x09,0x09,0x73,0x6c,0x69,0x63,0x65,0x5b,0x69,0x5d,0x20,0x3d,0x20,0x54,0x6f,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x70,0x61,0x72,0x61,0x6d,0x2e,0x49,0x6e,0x64,0x65,0x78,0x28,0x69,0x29,0x2e,0x49,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x28,0x29,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x4a,0x6f,0x69,0x6e,0x28,0x73,0x6c,0x69,0x63,0x65,0x2c,0x20,0x22,0x2c,0x22,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x54,0x6f,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x70,0x61,0x72,0x61,0x6d,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x0a,0x09,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x20,0x3a,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x4f,0x66,0x28,0x70,0x61,0x72,0x61,0x6d,0x29,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x50,0x74,0x72,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x2e,0x49,0x73,0x4e,0x69,0x6c,0x28,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x22,0x22,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x20,0x3d,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x76,0x61,0x72,0x20,0x76,0x61,0x6c,0x75,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x69,0x66,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x6c,0x69,0x63,0x65,0x20,0x7c,0x7c,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x41,0x72,0x72,0x61,0x79,0x20,0x7b,0x0a,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x3d,0x20,0x73,0x6c,0x69,0x63,0x65,0x54,0x6f,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x29,0x0a,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x7b,0x0a,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x3d,0x20,0x70,0x72,0x69,0x6d,0x69,0x74,0x69,0x76,0x65,0x54,0x6f,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x54,0x6f,0x50,0x72,0x69,0x6d,0x69,0x74,0x69,0x76,0x65,0x28,0x73,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x70,0x61,0x72,0x61,0x6d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x70,0x61,0x72,0x61,0x6d,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x21,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x50,0x74,0x72,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x45,0x72,0x72,0x56,0x61,0x6c,0x75,0x65,0x49,0x73,0x4e,0x6f,0x74,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x7b,0x76,0x61,0x6c,0x75,0x65,0x3a,0x20,0x70,0x61,0x72,0x61,0x6d,0x7d,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x76,0x61,0x72,0x20,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x0a,0x09,0x65,0x6c,0x6d,0x20,0x3a,0x3d,0x20,0x70,0x61,0x72,0x61,0x6d,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x0a,0x0a,0x09,0x73,0x77,0x69,0x74,0x63,0x68,0x20,0x65,0x6c,0x6d,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x7b,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x38,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x31,0x36,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x33,0x32,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x36,0x34,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x20,0x3a,0x3d,0x20,0x69,0x6e,0x74,0x36,0x34,0x28,0x30,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x73,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x76,0x61,0x6c,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x50,0x61,0x72,0x73,0x65,0x49,0x6e,0x74,0x28,0x73,0x2c,0x20,0x30,0x2c,0x20,0x36,0x34,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x6c,0x6d,0x2e,0x4f,0x76,0x65,0x72,0x66,0x6c,0x6f,0x77,0x49,0x6e,0x74,0x28,0x76,0x61,0x6c,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x45,0x72,0x72,0x54,0x79,0x70,0x65,0x56,0x61,0x6c,0x75,0x65,0x4f,0x76,0x65,0x72,0x66,0x6c,0x6f,0x77,0x7b,0x76,0x61,0x6c,0x75,0x65,0x3a,0x20,0x73,0x7d,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x65,0x6c,0x6d,0x2e,0x53,0x65,0x74,0x49,0x6e,0x74,0x28,0x76,0x61,0x6c,0x29,0x0a,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x38,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x31,0x36,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x33,0x32,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x36,0x34,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x20,0x3a,0x3d,0x20,0x75,0x69,0x6e,0x74,0x36,0x34,0x28,0x30,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x73,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x76,0x61,0x6c,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x50,0x61,0x72,0x73,0x65,0x55,0x69,0x6e,0x74,0x28,0x73,0x2c,0x20,0x30,0x2c,0x20,0x36,0x34,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x6c,0x6d,0x2e,0x4f,0x76,0x65,0x72,0x66,0x6c,0x6f,0x77,0x55,0x69,0x6e,0x74,0x28,0x76,0x61,0x6c,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x45,0x72,0x72,0x54,0x79,0x70,0x65,0x56,0x61,0x6c,0x75,0x65,0x4f,0x76,0x65,0x72,0x66,0x6c,0x6f,0x77,0x7b,0x76,0x61,0x6c,0x75,0x65,0x3a,0x20,0x73,0x7d,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x65,0x6c,0x6d,0x2e,0x53,0x65,0x74,0x55,0x69,0x6e,0x74,0x28,0x76,0x61,0x6c,0x29,0x0a,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x46,0x6c,0x6f,0x61,0x74,0x33,0x32,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x20,0x3a,0x3d,0x20,0x66,0x6c,0x6f,0x61,0x74,0x36,0x34,0x28,0x30,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x73,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x76,0x61,0x6c,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x50,0x61,0x72,0x73,0x65,0x46,0x6c,0x6f,0x61,0x74,0x28,0x73,0x2c,0x20,0x33,0x32,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x65,0x6c,0x6d,0x2e,0x53,0x65,0x74,0x46,0x6c,0x6f,0x61,0x74,0x28,0x76,0x61,0x6c,0x29,0x0a,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x46,0x6c,0x6f,0x61,0x74,0x36,0x34,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x20,0x3a,0x3d,0x20,0x66,0x6c,0x6f,0x61,0x74,0x36,0x34,0x28,0x30,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x73,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x76,0x61,0x6c,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x50,0x61,0x72,0x73,0x65,0x46,0x6c,0x6f,0x61,0x74,0x28,0x73,0x2c,0x20,0x36,0x34,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x65,0x6c,0x6d,0x2e,0x53,0x65,0x74,0x46,0x6c,0x6f,0x61,0x74,0x28,0x76,0x61,0x6c,0x29,0x0a,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x3a,0x0a,0x09,0x09,0x65,0x6c,0x6d,0x2e,0x53,0x65,0x74,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x73,0x29,0x0a,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x42,0x6f,0x6f,0x6c,0x3a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x20,0x3a,0x3d,0x20,0x66,0x61,0x6c,0x73,0x65,0x0a,0x09,0x09,0x69,0x66,0x20,0x73,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x76,0x61,0x6c,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x50,0x61,0x72,0x73,0x65,0x42,0x6f,0x6f,0x6c,0x28,0x73,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x65,0x6c,0x6d,0x2e,0x53,0x65,0x74,0x42,0x6f,0x6f,0x6c,0x28,0x76,0x61,0x6c,0x29,0x0a,0x0a,0x09,0x64,0x65,0x66,0x61,0x75,0x6c,0x74,0x3a,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x45,0x72,0x72,0x55,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x50,0x72,0x69,0x6d,0x69,0x74,0x69,0x76,0x65,0x54,0x79,0x70,0x65,0x7b,0x76,0x61,0x6c,0x75,0x65,0x3a,0x20,0x70,0x61,0x72,0x61,0x6d,0x7d,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x54,0x6f,0x53,0x6c,0x69,0x63,0x65,0x28,0x73,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x70,0x61,0x72,0x61,0x6d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x76,0x61,0x6c,0x75,0x65,0x73,0x20,0x3a,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x53,0x70,0x6c,0x69,0x74,0x28,0x73,0x2c,0x20,0x22,0x2c,0x22,0x29,0x0a,0x09,0x65,0x6c,0x65,0x6d,0x46,0x6f,0x72,0x49,0x6e,0x6a,0x65,0x63,0x74,0x69,0x6f,0x6e,0x20,0x3a,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x4e,0x65,0x77,0x28,0x70,0x61,0x72,0x61,0x6d,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x2e,0x54,0x79,0x70,0x65,0x28,0x29,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x29,0x0a,0x09,0x66,0x6f,0x72,0x20,0x5f,0x2c,0x20,0x76,0x61,0x6c,0x75,0x65,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x76,0x61,0x6c,0x75,0x65,0x73,0x20,0x7b,0x0a,0x09,0x09,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x46,0x72,0x6f,0x6d,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x65,0x6c,0x65,0x6d,0x46,0x6f,0x72,0x49,0x6e,0x6a,0x65,0x63,0x74,0x69,0x6f,0x6e,0x2e,0x49,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x28,0x29,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x73,0x6c,0x69,0x63,0x65,0x20,0x3a,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x41,0x70,0x70,0x65,0x6e,0x64,0x28,0x70,0x61,0x72,0x61,0x6d,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x2c,0x20,0x65,0x6c,0x65,0x6d,0x46,0x6f,0x72,0x49,0x6e,0x6a,0x65,0x63,0x74,0x69,0x6f,0x6e,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x29,0x0a,0x09,0x09,0x70,0x61,0x72,0x61,0x6d,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x2e,0x53,0x65,0x74,0x28,0x73,0x6c,0x69,0x63,0x65,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x46,0x72,0x6f,0x6d,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x73,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x70,0x61,0x72,0x61,0x6d,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x28,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x64,0x65,0x66,0x65,0x72,0x20,0x66,0x75,0x6e,0x63,0x28,0x29,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x76,0x20,0x3a,0x3d,0x20,0x72,0x65,0x63,0x6f,0x76,0x65,0x72,0x28,0x29,0x3b,0x20,0x76,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x73,0x74,0x61,0x63,0x6b,0x20,0x3a,0x3d,0x20,0x64,0x65,0x62,0x75,0x67,0x2e,0x53,0x74,0x61,0x63,0x6b,0x28,0x29,0x0a,0x09,0x09,0x09,0x65,0x72,0x72,0x20,0x3d,0x20,0x26,0x52,0x65,0x63,0x6f,0x76,0x65,0x72,0x45,0x72,0x72,0x6f,0x72,0x7b,0x45,0x72,0x72,0x3a,0x20,0x76,0x2c,0x20,0x53,0x74,0x61,0x63,0x6b,0x3a,0x20,0x73,0x74,0x61,0x63,0x6b,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x28,0x29,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x09,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x20,0x3a,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x4f,0x66,0x28,0x70,0x61,0x72,0x61,0x6d,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x21,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x50,0x74,0x72,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x45,0x72,0x72,0x50,0x61,0x72,0x61,0x6d,0x49,0x73,0x4e,0x6f,0x74,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x69,0x66,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x2e,0x49,0x73,0x4e,0x69,0x6c,0x28,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x45,0x72,0x72,0x50,0x61,0x72,0x61,0x6d,0x49,0x73,0x4e,0x69,0x6c,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x6b,0x69,0x6e,0x64,0x4f,0x66,0x45,0x6c,0x65,0x6d,0x65,0x6e,0x74,0x20,0x3a,0x3d,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x6b,0x69,0x6e,0x64,0x4f,0x66,0x45,0x6c,0x65,0x6d,0x65,0x6e,0x74,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x50,0x74,0x72,0x20,0x7b,0x0a,0x09,0x09,0x09,0x70,0x74,0x72,0x20,0x3a,0x3d,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x70,0x74,0x72,0x2e,0x49,0x73,0x4e,0x69,0x6c,0x28,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x70,0x74,0x72,0x2e,0x53,0x65,0x74,0x28,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x4e,0x65,0x77,0x28,0x70,0x74,0x72,0x2e,0x54,0x79,0x70,0x65,0x28,0x29,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x29,0x29,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x09,0x70,0x61,0x72,0x61,0x6d,0x20,0x3d,0x20,0x70,0x74,0x72,0x2e,0x49,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x28,0x29,0x0a,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x7b,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x6b,0x69,0x6e,0x64,0x4f,0x66,0x45,0x6c,0x65,0x6d,0x65,0x6e,0x74,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x6c,0x69,0x63,0x65,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x65,0x72,0x72,0x20,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x54,0x6f,0x53,0x6c,0x69,0x63,0x65,0x28,0x73,0x2c,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x29,0x0a,0x09,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x69,0x66,0x20,0x6b,0x69,0x6e,0x64,0x4f,0x66,0x45,0x6c,0x65,0x6d,0x65,0x6e,0x74,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x41,0x72,0x72,0x61,0x79,0x20,0x7c,0x7c,0x20,0x6b,0x69,0x6e,0x64,0x4f,0x66,0x45,0x6c,0x65,0x6d,0x65,0x6e,0x74,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x4d,0x61,0x70,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x65,0x72,0x72,0x20,0x3d,0x20,0x26,0x45,0x72,0x72,0x55,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x4b,0x69,0x6e,0x64,0x7b,0x6b,0x69,0x6e,0x64,0x3a,0x20,0x6b,0x69,0x6e,0x64,0x4f,0x66,0x45,0x6c,0x65,0x6d,0x65,0x6e,0x74,0x7d,0x0a,0x09,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x65,0x72,0x72,0x20,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x54,0x6f,0x50,0x72,0x69,0x6d,0x69,0x74,0x69,0x76,0x65,0x28,0x73,0x2c,0x20,0x70,0x61,0x72,0x61,0x6d,0x52,0x65,0x66,0x6c,0x65,0x63,0x74,0x65,0x64,0x29,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x09,0x62,0x72,0x65,0x61,0x6b,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x0a,0x7d,0x0a,0x0a,0x76,0x61,0x72,0x20,0x45,0x72,0x72,0x50,0x61,0x72,0x61,0x6d,0x49,0x73,0x4e,0x6f,0x74,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x3d,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4e,0x65,0x77,0x28,0x22,0x70,0x61,0x72,0x61,0x6d,0x20,0x69,0x73,0x6e,0x27,0x74,0x20,0x61,0x20,0x70,0x6f,0x69,0x6e,0x74,0x65,0x72,0x22,0x29,0x0a,0x76,0x61,0x72,0x20,0x45,0x72,0x72,0x50,0x61,0x72,0x61,0x6d,0x49,0x73,0x4e,0x69,0x6c,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x3d,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4e,0x65,0x77,0x28,0x22,0x70,0x61,0x72,0x61,0x6d,0x20,0x69,0x73,0x20,0x6e,0x69,0x6c,0x22,0x29,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x45,0x72,0x72,0x55,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x4b,0x69,0x6e,0x64,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x6b,0x69,0x6e,0x64,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x4b,0x69,0x6e,0x64,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x72,0x72,0x20,0x2a,0x45,0x72,0x72,0x55,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x4b,0x69,0x6e,0x64,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x75,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x20,0x6b,0x69,0x6e,0x64,0x3a,0x20,0x27,0x25,0x73,0x27,0x22,0x2c,0x20,0x65,0x72,0x72,0x2e,0x6b,0x69,0x6e,0x64,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x29,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x45,0x72,0x72,0x56,0x61,0x6c,0x75,0x65,0x49,0x73,0x4e,0x6f,0x74,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x72,0x72,0x20,0x2a,0x45,0x72,0x72,0x56,0x61,0x6c,0x75,0x65,0x49,0x73,0x4e,0x6f,0x74,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x76,0x61,0x6c,0x75,0x65,0x20,0x69,0x73,0x20,0x6e,0x6f,0x74,0x20,0x61,0x20,0x70,0x6f,0x69,0x6e,0x74,0x65,0x72,0x3a,0x20,0x27,0x25,0x73,0x27,0x22,0x2c,0x20,0x65,0x72,0x72,0x2e,0x76,0x61,0x6c,0x75,0x65,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x29,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x45,0x72,0x72,0x54,0x79,0x70,0x65,0x56,0x61,0x6c,0x75,0x65,0x4f,0x76,0x65,0x72,0x66,0x6c,0x6f,0x77,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x72,0x72,0x20,0x2a,0x45,0x72,0x72,0x54,0x79,0x70,0x65,0x56,0x61,0x6c,0x75,0x65,0x4f,0x76,0x65,0x72,0x66,0x6c,0x6f,0x77,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x74,0x79,0x70,0x65,0x20,0x6f,0x76,0x65,0x72,0x66,0x6c,0x6f,0x77,0x3a,0x20,0x27,0x25,0x73,0x27,0x22,0x2c,0x20,0x65,0x72,0x72,0x2e,0x76,0x61,0x6c,0x75,0x65,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x45,0x72,0x72,0x55,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x50,0x72,0x69,0x6d,0x69,0x74,0x69,0x76,0x65,0x54,0x79,0x70,0x65,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x72,0x72,0x20,0x2a,0x45,0x72,0x72,0x55,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x50,0x72,0x69,0x6d,0x69,0x74,0x69,0x76,0x65,0x54,0x79,0x70,0x65,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x75,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x20,0x70,0x72,0x69,0x6d,0x69,0x74,0x69,0x76,0x65,0x20,0x74,0x79,0x70,0x65,0x3a,0x20,0x27,0x25,0x73,0x27,0x22,0x2c,0x20,0x65,0x72,0x72,0x2e,0x76,0x61,0x6c,0x75,0x65,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x29,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x52,0x65,0x63,0x6f,0x76,0x65,0x72,0x45,0x72,0x72,0x6f,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x45,0x72,0x72,0x20,0x20,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x0a,0x09,0x53,0x74,0x61,0x63,0x6b,0x20,0x5b,0x5d,0x62,0x79,0x74,0x65,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x52,0x65,0x63,0x6f,0x76,0x65,0x72,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x20,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x66,0x72,0x6f,0x6d,0x53,0x74,0x72,0x69,0x6e,0x67,0x20,0x70,0x61,0x6e,0x69,0x63,0x6b,0x65,0x64,0x3a,0x20,0x25,0x76,0x22,0x2c,0x20,0x65,0x2e,0x45,0x72,0x72,0x29,0x20,0x7d,0x0a,0x0a,0x76,0x61,0x72,0x20,0x28,0x0a,0x09,0x4e,0x75,0x6c,0x6c,0x45,0x72,0x72,0x6f,0x72,0x20,0x3d,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4e,0x65,0x77,0x28,0x22,0x75,0x6e,0x65,0x78,0x70,0x65,0x63,0x74,0x65,0x64,0x20,0x6e,0x75,0x6c,0x6c,0x20,0x76,0x61,0x6c,0x75,0x65,0x22,0x29,0x0a,0x09,0x54,0x79,0x70,0x65,0x45,0x72,0x72,0x6f,0x72,0x20,0x3d,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4e,0x65,0x77,0x28,0x22,0x75,0x6e,0x65,0x78,0x70,0x65,0x63,0x74,0x65,0x64,0x20,0x74,0x79,0x70,0x65,0x22,0x29,0x0a,0x29,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x44,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x45,0x72,0x72,0x20,0x20,0x20,0x20,0x20,0x65,0x72,0x72,0x6f,0x72,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x44,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x76,0x20,0x61,0x74,0x20,0x27,0x25,0x73,0x27,0x22,0x2c,0x20,0x65,0x2e,0x45,0x72,0x72,0x2c,0x20,0x65,0x2e,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x44,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x55,0x6e,0x77,0x72,0x61,0x70,0x28,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x2e,0x45,0x72,0x72,0x0a,0x7d,0x0a,0x0a,0x76,0x61,0x72,0x20,0x70,0x6f,0x69,0x6e,0x74,0x65,0x72,0x54,0x6f,0x6b,0x65,0x6e,0x45,0x73,0x63,0x61,0x70,0x65,0x72,0x20,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x4e,0x65,0x77,0x52,0x65,0x70,0x6c,0x61,0x63,0x65,0x72,0x28,0x22,0x7e,0x22,0x2c,0x20,0x22,0x7e,0x30,0x22,0x2c,0x20,0x22,0x2f,0x22,0x2c,0x20,0x22,0x7e,0x31,0x22,0x29,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x77,0x72,0x61,0x70,0x44,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x28,0x74,0x6f,0x6b,0x65,0x6e,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x74,0x6f,0x6b,0x65,0x6e,0x20,0x3d,0x20,0x22,0x2f,0x22,0x20,0x2b,0x20,0x70,0x6f,0x69,0x6e,0x74,0x65,0x72,0x54,0x6f,0x6b,0x65,0x6e,0x45,0x73,0x63,0x61,0x70,0x65,0x72,0x2e,0x52,0x65,0x70,0x6c,0x61,0x63,0x65,0x28,0x74,0x6f,0x6b,0x65,0x6e,0x29,0x0a,0x0a,0x09,0x69,0x66,0x20,0x64,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x2c,0x20,0x6f,0x6b,0x20,0x3a,0x3d,0x20,0x65,0x72,0x72,0x2e,0x28,0x2a,0x44,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x29,0x3b,0x20,0x6f,0x6b,0x20,0x7b,0x0a,0x09,0x09,0x64,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x2e,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x20,0x3d,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x20,0x2b,0x20,0x64,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x2e,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x64,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x44,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x7b,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x3a,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x2c,0x20,0x45,0x72,0x72,0x3a,0x20,0x65,0x72,0x72,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4a,0x53,0x4f,0x4e,0x28,0x72,0x20,0x69,0x6f,0x2e,0x52,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x76,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x2c,0x20,0x72,0x65,0x71,0x75,0x69,0x72,0x65,0x64,0x20,0x62,0x6f,0x6f,0x6c,0x29,0x20,0x28,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x64,0x65,0x66,0x65,0x72,0x20,0x66,0x75,0x6e,0x63,0x28,0x29,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x72,0x20,0x3a,0x3d,0x20,0x72,0x65,0x63,0x6f,0x76,0x65,0x72,0x28,0x29,0x3b,0x20,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x65,0x72,0x72,0x20,0x3d,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4e,0x65,0x77,0x28,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x2b,0x76,0x22,0x2c,0x20,0x72,0x29,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x28,0x29,0x0a,0x0a,0x09,0x74,0x79,0x70,0x20,0x3a,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x54,0x79,0x70,0x65,0x4f,0x66,0x28,0x76,0x29,0x0a,0x0a,0x09,0x69,0x66,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x21,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x50,0x74,0x72,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4e,0x65,0x77,0x28,0x22,0x70,0x6c,0x65,0x61,0x73,0x65,0x20,0x70,0x61,0x73,0x73,0x20,0x70,0x6f,0x69,0x6e,0x74,0x65,0x72,0x20,0x74,0x6f,0x20,0x74,0x61,0x72,0x67,0x65,0x74,0x22,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x50,0x74,0x72,0x20,0x7b,0x0a,0x09,0x09,0x74,0x79,0x70,0x20,0x3d,0x20,0x74,0x79,0x70,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x64,0x65,0x63,0x6f,0x64,0x65,0x72,0x20,0x3a,0x3d,0x20,0x6a,0x73,0x6f,0x6e,0x2e,0x4e,0x65,0x77,0x44,0x65,0x63,0x6f,0x64,0x65,0x72,0x28,0x72,0x29,0x0a,0x0a,0x09,0x69,0x66,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x6c,0x69,0x63,0x65,0x20,0x7c,0x7c,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x4d,0x61,0x70,0x20,0x7c,0x7c,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x68,0x61,0x6e,0x64,0x6c,0x65,0x4e,0x75,0x6c,0x6c,0x20,0x3a,0x3d,0x20,0x66,0x75,0x6e,0x63,0x28,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x72,0x65,0x71,0x75,0x69,0x72,0x65,0x64,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x4e,0x75,0x6c,0x6c,0x45,0x72,0x72,0x6f,0x72,0x0a,0x09,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x4f,0x66,0x28,0x76,0x29,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x2e,0x53,0x65,0x74,0x28,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x5a,0x65,0x72,0x6f,0x28,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x54,0x79,0x70,0x65,0x4f,0x66,0x28,0x76,0x29,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x29,0x29,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x76,0x61,0x72,0x20,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x0a,0x09,0x09,0x76,0x61,0x72,0x20,0x76,0x61,0x6c,0x75,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x0a,0x0a,0x09,0x09,0x69,0x66,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x6c,0x69,0x63,0x65,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x09,0x76,0x61,0x72,0x20,0x61,0x62,0x73,0x74,0x72,0x61,0x63,0x74,0x53,0x6c,0x69,0x63,0x65,0x20,0x5b,0x5d,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x64,0x65,0x63,0x6f,0x64,0x65,0x72,0x2e,0x44,0x65,0x63,0x6f,0x64,0x65,0x28,0x26,0x61,0x62,0x73,0x74,0x72,0x61,0x63,0x74,0x53,0x6c,0x69,0x63,0x65,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x74,0x79,0x70,0x65,0x45,0x72,0x72,0x6f,0x72,0x28,0x65,0x72,0x72,0x29,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x61,0x62,0x73,0x74,0x72,0x61,0x63,0x74,0x53,0x6c,0x69,0x63,0x65,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x61,0x6e,0x64,0x6c,0x65,0x4e,0x75,0x6c,0x6c,0x28,0x29,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x73,0x6c,0x69,0x63,0x65,0x32,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x65,0x28,0x74,0x79,0x70,0x2c,0x20,0x61,0x62,0x73,0x74,0x72,0x61,0x63,0x74,0x53,0x6c,0x69,0x63,0x65,0x29,0x0a,0x0a,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x09,0x76,0x61,0x72,0x20,0x61,0x62,0x73,0x74,0x72,0x61,0x63,0x74,0x4d,0x61,0x70,0x20,0x6d,0x61,0x70,0x5b,0x73,0x74,0x72,0x69,0x6e,0x67,0x5d,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x64,0x65,0x63,0x6f,0x64,0x65,0x72,0x2e,0x44,0x65,0x63,0x6f,0x64,0x65,0x28,0x26,0x61,0x62,0x73,0x74,0x72,0x61,0x63,0x74,0x4d,0x61,0x70,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x74,0x79,0x70,0x65,0x45,0x72,0x72,0x6f,0x72,0x28,0x65,0x72,0x72,0x29,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x61,0x62,0x73,0x74,0x72,0x61,0x63,0x74,0x4d,0x61,0x70,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x61,0x6e,0x64,0x6c,0x65,0x4e,0x75,0x6c,0x6c,0x28,0x29,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x4d,0x61,0x70,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x6d,0x61,0x70,0x32,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x65,0x28,0x74,0x79,0x70,0x2c,0x20,0x61,0x62,0x73,0x74,0x72,0x61,0x63,0x74,0x4d,0x61,0x70,0x29,0x0a,0x09,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x6d,0x61,0x70,0x32,0x6f,0x62,0x6a,0x65,0x63,0x74,0x28,0x74,0x79,0x70,0x2c,0x20,0x61,0x62,0x73,0x74,0x72,0x61,0x63,0x74,0x4d,0x61,0x70,0x29,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x73,0x65,0x74,0x56,0x61,0x6c,0x75,0x65,0x28,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x4f,0x66,0x28,0x76,0x29,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x29,0x0a,0x0a,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x69,0x66,0x20,0x21,0x72,0x65,0x71,0x75,0x69,0x72,0x65,0x64,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x74,0x79,0x70,0x65,0x45,0x72,0x72,0x6f,0x72,0x28,0x64,0x65,0x63,0x6f,0x64,0x65,0x72,0x2e,0x44,0x65,0x63,0x6f,0x64,0x65,0x28,0x76,0x29,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x64,0x65,0x63,0x6f,0x64,0x65,0x72,0x2e,0x44,0x65,0x63,0x6f,0x64,0x65,0x28,0x26,0x76,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x74,0x79,0x70,0x65,0x45,0x72,0x72,0x6f,0x72,0x28,0x65,0x72,0x72,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x69,0x66,0x20,0x76,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x4e,0x75,0x6c,0x6c,0x45,0x72,0x72,0x6f,0x72,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x74,0x79,0x70,0x65,0x45,0x72,0x72,0x6f,0x72,0x28,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x74,0x79,0x70,0x65,0x45,0x72,0x72,0x2c,0x20,0x6f,0x6b,0x20,0x3a,0x3d,0x20,0x65,0x72,0x72,0x2e,0x28,0x2a,0x6a,0x73,0x6f,0x6e,0x2e,0x55,0x6e,0x6d,0x61,0x72,0x73,0x68,0x61,0x6c,0x54,0x79,0x70,0x65,0x45,0x72,0x72,0x6f,0x72,0x29,0x3b,0x20,0x6f,0x6b,0x20,0x7b,0x0a,0x09,0x09,0x70,0x6f,0x69,0x6e,0x74,0x65,0x72,0x20,0x3a,0x3d,0x20,0x22,0x22,0x0a,0x09,0x09,0x69,0x66,0x20,0x74,0x79,0x70,0x65,0x45,0x72,0x72,0x2e,0x46,0x69,0x65,0x6c,0x64,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x66,0x6f,0x72,0x20,0x5f,0x2c,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x53,0x70,0x6c,0x69,0x74,0x28,0x74,0x79,0x70,0x65,0x45,0x72,0x72,0x2e,0x46,0x69,0x65,0x6c,0x64,0x2c,0x20,0x22,0x2e,0x22,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x70,0x6f,0x69,0x6e,0x74,0x65,0x72,0x20,0x2b,0x3d,0x20,0x22,0x2f,0x22,0x20,0x2b,0x20,0x70,0x6f,0x69,0x6e,0x74,0x65,0x72,0x54,0x6f,0x6b,0x65,0x6e,0x45,0x73,0x63,0x61,0x70,0x65,0x72,0x2e,0x52,0x65,0x70,0x6c,0x61,0x63,0x65,0x28,0x74,0x6f,0x6b,0x65,0x6e,0x29,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x44,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x7b,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x3a,0x20,0x70,0x6f,0x69,0x6e,0x74,0x65,0x72,0x2c,0x20,0x45,0x72,0x72,0x3a,0x20,0x54,0x79,0x70,0x65,0x45,0x72,0x72,0x6f,0x72,0x7d,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x73,0x65,0x74,0x56,0x61,0x6c,0x75,0x65,0x28,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x64,0x65,0x73,0x74,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x64,0x65,0x73,0x74,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x50,0x74,0x72,0x20,0x7b,0x0a,0x09,0x09,0x6e,0x65,0x77,0x44,0x65,0x73,0x74,0x20,0x3a,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x4e,0x65,0x77,0x28,0x64,0x65,0x73,0x74,0x2e,0x54,0x79,0x70,0x65,0x28,0x29,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x29,0x0a,0x09,0x09,0x64,0x65,0x73,0x74,0x2e,0x53,0x65,0x74,0x28,0x6e,0x65,0x77,0x44,0x65,0x73,0x74,0x29,0x0a,0x09,0x09,0x64,0x65,0x73,0x74,0x20,0x3d,0x20,0x6e,0x65,0x77,0x44,0x65,0x73,0x74,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x64,0x65,0x73,0x74,0x2e,0x53,0x65,0x74,0x28,0x76,0x61,0x6c,0x75,0x65,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x73,0x65,0x74,0x4d,0x61,0x70,0x56,0x61,0x6c,0x75,0x65,0x28,0x6b,0x65,0x79,0x2c,0x20,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x6d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x6d,0x61,0x70,0x56,0x61,0x6c,0x75,0x65,0x20,0x3a,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x4e,0x65,0x77,0x28,0x6d,0x2e,0x54,0x79,0x70,0x65,0x28,0x29,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x29,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x0a,0x09,0x73,0x65,0x74,0x56,0x61,0x6c,0x75,0x65,0x28,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x6d,0x61,0x70,0x56,0x61,0x6c,0x75,0x65,0x29,0x0a,0x09,0x6d,0x2e,0x53,0x65,0x74,0x4d,0x61,0x70,0x49,0x6e,0x64,0x65,0x78,0x28,0x6b,0x65,0x79,0x2c,0x20,0x6d,0x61,0x70,0x56,0x61,0x6c,0x75,0x65,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x73,0x6c,0x69,0x63,0x65,0x32,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x65,0x28,0x74,0x79,0x70,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x54,0x79,0x70,0x65,0x2c,0x20,0x73,0x20,0x5b,0x5d,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x28,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x53,0x6c,0x69,0x63,0x65,0x20,0x3a,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x4d,0x61,0x6b,0x65,0x53,0x6c,0x69,0x63,0x65,0x28,0x74,0x79,0x70,0x2c,0x20,0x6c,0x65,0x6e,0x28,0x73,0x29,0x2c,0x20,0x63,0x61,0x70,0x28,0x73,0x29,0x29,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x69,0x2c,0x20,0x76,0x61,0x6c,0x75,0x65,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x73,0x20,0x7b,0x0a,0x09,0x09,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x56,0x61,0x6c,0x75,0x65,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x63,0x6f,0x6e,0x76,0x65,0x72,0x74,0x28,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x74,0x79,0x70,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x7b,0x7d,0x2c,0x20,0x77,0x72,0x61,0x70,0x44,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x28,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x49,0x74,0x6f,0x61,0x28,0x69,0x29,0x2c,0x20,0x65,0x72,0x72,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x73,0x65,0x74,0x56,0x61,0x6c,0x75,0x65,0x28,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x56,0x61,0x6c,0x75,0x65,0x2c,0x20,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x53,0x6c,0x69,0x63,0x65,0x2e,0x49,0x6e,0x64,0x65,0x78,0x28,0x69,0x29,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x53,0x6c,0x69,0x63,0x65,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x6d,0x61,0x70,0x32,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x65,0x28,0x74,0x79,0x70,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x54,0x79,0x70,0x65,0x2c,0x20,0x6d,0x20,0x6d,0x61,0x70,0x5b,0x73,0x74,0x72,0x69,0x6e,0x67,0x5d,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x28,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x65,0x4d,0x61,0x70,0x20,0x3a,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x4d,0x61,0x6b,0x65,0x4d,0x61,0x70,0x28,0x74,0x79,0x70,0x29,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x6b,0x65,0x79,0x2c,0x20,0x76,0x61,0x6c,0x75,0x65,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x6d,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x65,0x4b,0x65,0x79,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x63,0x6f,0x6e,0x76,0x65,0x72,0x74,0x28,0x6b,0x65,0x79,0x2c,0x20,0x74,0x79,0x70,0x2e,0x4b,0x65,0x79,0x28,0x29,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x7b,0x7d,0x2c,0x20,0x77,0x72,0x61,0x70,0x44,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x28,0x6b,0x65,0x79,0x2c,0x20,0x65,0x72,0x72,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x65,0x56,0x61,0x6c,0x75,0x65,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x63,0x6f,0x6e,0x76,0x65,0x72,0x74,0x28,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x74,0x79,0x70,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x7b,0x7d,0x2c,0x20,0x77,0x72,0x61,0x70,0x44,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x28,0x6b,0x65,0x79,0x2c,0x20,0x65,0x72,0x72,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x73,0x65,0x74,0x4d,0x61,0x70,0x56,0x61,0x6c,0x75,0x65,0x28,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x65,0x4b,0x65,0x79,0x2c,0x20,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x65,0x56,0x61,0x6c,0x75,0x65,0x2c,0x20,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x65,0x4d,0x61,0x70,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x65,0x4d,0x61,0x70,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x6d,0x61,0x70,0x32,0x6f,0x62,0x6a,0x65,0x63,0x74,0x28,0x74,0x79,0x70,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x54,0x79,0x70,0x65,0x2c,0x20,0x6d,0x20,0x6d,0x61,0x70,0x5b,0x73,0x74,0x72,0x69,0x6e,0x67,0x5d,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x28,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x6f,0x62,0x6a,0x65,0x63,0x74,0x20,0x3a,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x4e,0x65,0x77,0x28,0x74,0x79,0x70,0x29,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x69,0x20,0x3a,0x3d,0x20,0x30,0x3b,0x20,0x69,0x20,0x3c,0x20,0x74,0x79,0x70,0x2e,0x4e,0x75,0x6d,0x46,0x69,0x65,0x6c,0x64,0x28,0x29,0x3b,0x20,0x69,0x2b,0x2b,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x66,0x69,0x65,0x6c,0x64,0x20,0x3a,0x3d,0x20,0x74,0x79,0x70,0x2e,0x46,0x69,0x65,0x6c,0x64,0x28,0x69,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x66,0x69,0x65,0x6c,0x64,0x2e,0x41,0x6e,0x6f,0x6e,0x79,0x6d,0x6f,0x75,0x73,0x20,0x7c,0x7c,0x20,0x21,0x6f,0x62,0x6a,0x65,0x63,0x74,0x2e,0x46,0x69,0x65,0x6c,0x64,0x28,0x69,0x29,0x2e,0x43,0x61,0x6e,0x53,0x65,0x74,0x28,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x63,0x6f,0x6e,0x74,0x69,0x6e,0x75,0x65,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x72,0x65,0x71,0x75,0x69,0x72,0x65,0x64,0x20,0x3a,0x3d,0x20,0x66,0x61,0x6c,0x73,0x65,0x0a,0x09,0x09,0x6b,0x65,0x79,0x20,0x3a,0x3d,0x20,0x66,0x69,0x65,0x6c,0x64,0x2e,0x4e,0x61,0x6d,0x65,0x0a,0x0a,0x09,0x09,0x6a,0x73,0x6f,0x6e,0x54,0x61,0x67,0x73,0x20,0x3a,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x53,0x70,0x6c,0x69,0x74,0x28,0x66,0x69,0x65,0x6c,0x64,0x2e,0x54,0x61,0x67,0x2e,0x47,0x65,0x74,0x28,0x22,0x6a,0x73,0x6f,0x6e,0x22,0x29,0x2c,0x20,0x22,0x2c,0x22,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x6c,0x65,0x6e,0x28,0x6a,0x73,0x6f,0x6e,0x54,0x61,0x67,0x73,0x29,0x20,0x3e,0x20,0x30,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x6a,0x73,0x6f,0x6e,0x54,0x61,0x67,0x73,0x5b,0x30,0x5d,0x20,0x3d,0x3d,0x20,0x22,0x2d,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x63,0x6f,0x6e,0x74,0x69,0x6e,0x75,0x65,0x0a,0x09,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x69,0x66,0x20,0x6a,0x73,0x6f,0x6e,0x54,0x61,0x67,0x73,0x5b,0x30,0x5d,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x6b,0x65,0x79,0x20,0x3d,0x20,0x6a,0x73,0x6f,0x6e,0x54,0x61,0x67,0x73,0x5b,0x30,0x5d,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x09,0x66,0x6f,0x72,0x20,0x69,0x20,0x3a,0x3d,0x20,0x31,0x3b,0x20,0x69,0x20,0x3c,0x20,0x6c,0x65,0x6e,0x28,0x6a,0x73,0x6f,0x6e,0x54,0x61,0x67,0x73,0x29,0x3b,0x20,0x69,0x2b,0x2b,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x69,0x66,0x20,0x6a,0x73,0x6f,0x6e,0x54,0x61,0x67,0x73,0x5b,0x69,0x5d,0x20,0x3d,0x3d,0x20,0x22,0x72,0x65,0x71,0x75,0x69,0x72,0x65,0x64,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x09,0x72,0x65,0x71,0x75,0x69,0x72,0x65,0x64,0x20,0x3d,0x20,0x74,0x72,0x75,0x65,0x0a,0x09,0x09,0x09,0x09,0x09,0x62,0x72,0x65,0x61,0x6b,0x0a,0x09,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x69,0x66,0x20,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x65,0x78,0x69,0x73,0x74,0x73,0x20,0x3a,0x3d,0x20,0x6d,0x5b,0x6b,0x65,0x79,0x5d,0x3b,0x20,0x65,0x78,0x69,0x73,0x74,0x73,0x20,0x26,0x26,0x20,0x76,0x61,0x6c,0x75,0x65,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x09,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x65,0x56,0x61,0x6c,0x75,0x65,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x63,0x6f,0x6e,0x76,0x65,0x72,0x74,0x28,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x66,0x69,0x65,0x6c,0x64,0x2e,0x54,0x79,0x70,0x65,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x7b,0x7d,0x2c,0x20,0x77,0x72,0x61,0x70,0x44,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x28,0x6b,0x65,0x79,0x2c,0x20,0x65,0x72,0x72,0x29,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x09,0x73,0x65,0x74,0x56,0x61,0x6c,0x75,0x65,0x28,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x65,0x56,0x61,0x6c,0x75,0x65,0x2c,0x20,0x6f,0x62,0x6a,0x65,0x63,0x74,0x2e,0x46,0x69,0x65,0x6c,0x64,0x28,0x69,0x29,0x29,0x0a,0x0a,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x69,0x66,0x20,0x72,0x65,0x71,0x75,0x69,0x72,0x65,0x64,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x7b,0x7d,0x2c,0x20,0x77,0x72,0x61,0x70,0x44,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x28,0x6b,0x65,0x79,0x2c,0x20,0x4e,0x75,0x6c,0x6c,0x45,0x72,0x72,0x6f,0x72,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6f,0x62,0x6a,0x65,0x63,0x74,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x63,0x6f,0x6e,0x76,0x65,0x72,0x74,0x28,0x64,0x61,0x74,0x61,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x2c,0x20,0x74,0x79,0x70,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x54,0x79,0x70,0x65,0x29,0x20,0x28,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x50,0x74,0x72,0x20,0x7b,0x0a,0x09,0x09,0x74,0x79,0x70,0x20,0x3d,0x20,0x74,0x79,0x70,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x69,0x66,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x6c,0x69,0x63,0x65,0x20,0x7c,0x7c,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x4d,0x61,0x70,0x20,0x7c,0x7c,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x76,0x61,0x72,0x20,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x0a,0x09,0x09,0x76,0x61,0x72,0x20,0x76,0x61,0x6c,0x75,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x0a,0x0a,0x09,0x09,0x69,0x66,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x6c,0x69,0x63,0x65,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x09,0x61,0x62,0x73,0x74,0x72,0x61,0x63,0x74,0x53,0x6c,0x69,0x63,0x65,0x2c,0x20,0x69,0x73,0x53,0x6c,0x69,0x63,0x65,0x20,0x3a,0x3d,0x20,0x64,0x61,0x74,0x61,0x2e,0x28,0x5b,0x5d,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x21,0x69,0x73,0x53,0x6c,0x69,0x63,0x65,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x7b,0x7d,0x2c,0x20,0x54,0x79,0x70,0x65,0x45,0x72,0x72,0x6f,0x72,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x73,0x6c,0x69,0x63,0x65,0x32,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x65,0x28,0x74,0x79,0x70,0x2c,0x20,0x61,0x62,0x73,0x74,0x72,0x61,0x63,0x74,0x53,0x6c,0x69,0x63,0x65,0x29,0x0a,0x0a,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x69,0x66,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x4d,0x61,0x70,0x20,0x7c,0x7c,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x09,0x61,0x62,0x73,0x74,0x72,0x61,0x63,0x74,0x4d,0x61,0x70,0x2c,0x20,0x69,0x73,0x4d,0x61,0x70,0x20,0x3a,0x3d,0x20,0x64,0x61,0x74,0x61,0x2e,0x28,0x6d,0x61,0x70,0x5b,0x73,0x74,0x72,0x69,0x6e,0x67,0x5d,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x0a,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x21,0x69,0x73,0x4d,0x61,0x70,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x7b,0x7d,0x2c,0x20,0x54,0x79,0x70,0x65,0x45,0x72,0x72,0x6f,0x72,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x4d,0x61,0x70,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x6d,0x61,0x70,0x32,0x63,0x6f,0x6e,0x63,0x72,0x65,0x74,0x65,0x28,0x74,0x79,0x70,0x2c,0x20,0x61,0x62,0x73,0x74,0x72,0x61,0x63,0x74,0x4d,0x61,0x70,0x29,0x0a,0x09,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x6d,0x61,0x70,0x32,0x6f,0x62,0x6a,0x65,0x63,0x74,0x28,0x74,0x79,0x70,0x2c,0x20,0x61,0x62,0x73,0x74,0x72,0x61,0x63,0x74,0x4d,0x61,0x70,0x29,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x7b,0x7d,0x2c,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x0a,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x3a,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x4f,0x66,0x28,0x64,0x61,0x74,0x61,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x21,0x76,0x61,0x6c,0x75,0x65,0x2e,0x49,0x73,0x56,0x61,0x6c,0x69,0x64,0x28,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x7b,0x7d,0x2c,0x20,0x4e,0x75,0x6c,0x6c,0x45,0x72,0x72,0x6f,0x72,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x69,0x66,0x20,0x21,0x63,0x6f,0x6e,0x76,0x65,0x72,0x74,0x69,0x62,0x6c,0x65,0x28,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x74,0x79,0x70,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x7b,0x7d,0x2c,0x20,0x54,0x79,0x70,0x65,0x45,0x72,0x72,0x6f,0x72,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x2e,0x43,0x6f,0x6e,0x76,0x65,0x72,0x74,0x28,0x74,0x79,0x70,0x29,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x63,0x6f,0x6e,0x76,0x65,0x72,0x74,0x69,0x62,0x6c,0x65,0x28,0x76,0x61,0x6c,0x75,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x2c,0x20,0x74,0x79,0x70,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x54,0x79,0x70,0x65,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x20,0x7b,0x0a,0x0a,0x09,0x73,0x77,0x69,0x74,0x63,0x68,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x7b,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x3a,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x2e,0x54,0x79,0x70,0x65,0x28,0x29,0x2e,0x49,0x6d,0x70,0x6c,0x65,0x6d,0x65,0x6e,0x74,0x73,0x28,0x74,0x79,0x70,0x29,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x42,0x6f,0x6f,0x6c,0x3a,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x42,0x6f,0x6f,0x6c,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x3a,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x46,0x6c,0x6f,0x61,0x74,0x33,0x32,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x46,0x6c,0x6f,0x61,0x74,0x36,0x34,0x3a,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x46,0x6c,0x6f,0x61,0x74,0x36,0x34,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x38,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x31,0x36,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x33,0x32,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x49,0x6e,0x74,0x36,0x34,0x3a,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x46,0x6c,0x6f,0x61,0x74,0x36,0x34,0x20,0x26,0x26,0x20,0x76,0x61,0x6c,0x75,0x65,0x2e,0x46,0x6c,0x6f,0x61,0x74,0x28,0x29,0x20,0x3d,0x3d,0x20,0x6d,0x61,0x74,0x68,0x2e,0x54,0x72,0x75,0x6e,0x63,0x28,0x76,0x61,0x6c,0x75,0x65,0x2e,0x46,0x6c,0x6f,0x61,0x74,0x28,0x29,0x29,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x38,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x31,0x36,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x33,0x32,0x2c,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x55,0x69,0x6e,0x74,0x36,0x34,0x3a,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x46,0x6c,0x6f,0x61,0x74,0x36,0x34,0x20,0x26,0x26,0x20,0x76,0x61,0x6c,0x75,0x65,0x2e,0x46,0x6c,0x6f,0x61,0x74,0x28,0x29,0x20,0x3d,0x3d,0x20,0x6d,0x61,0x74,0x68,0x2e,0x54,0x72,0x75,0x6e,0x63,0x28,0x76,0x61,0x6c,0x75,0x65,0x2e,0x46,0x6c,0x6f,0x61,0x74,0x28,0x29,0x29,0x20,0x26,0x26,0x20,0x76,0x61,0x6c,0x75,0x65,0x2e,0x46,0x6c,0x6f,0x61,0x74,0x28,0x29,0x20,0x3e,0x3d,0x20,0x30,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x61,0x6c,0x73,0x65,0x0a,0x7d,0x0a,0x0a,0x76,0x61,0x72,0x20,0x53,0x79,0x6e,0x74,0x61,0x78,0x45,0x72,0x72,0x6f,0x72,0x20,0x3d,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4e,0x65,0x77,0x28,0x22,0x69,0x6e,0x76,0x61,0x6c,0x69,0x64,0x20,0x58,0x4d,0x4c,0x20,0x64,0x6f,0x63,0x75,0x6d,0x65,0x6e,0x74,0x22,0x29,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x58,0x4d,0x4c,0x28,0x72,0x20,0x69,0x6f,0x2e,0x52,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x76,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x2c,0x20,0x72,0x65,0x71,0x75,0x69,0x72,0x65,0x64,0x20,0x62,0x6f,0x6f,0x6c,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x78,0x6d,0x6c,0x2e,0x4e,0x65,0x77,0x44,0x65,0x63,0x6f,0x64,0x65,0x72,0x28,0x72,0x29,0x2e,0x44,0x65,0x63,0x6f,0x64,0x65,0x28,0x76,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3d,0x3d,0x20,0x69,0x6f,0x2e,0x45,0x4f,0x46,0x20,0x7b,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x72,0x65,0x71,0x75,0x69,0x72,0x65,0x64,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x4e,0x75,0x6c,0x6c,0x45,0x72,0x72,0x6f,0x72,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x45,0x72,0x72,0x6f,0x72,0x66,0x28,0x22,0x25,0x77,0x3a,0x20,0x25,0x76,0x22,0x2c,0x20,0x53,0x79,0x6e,0x74,0x61,0x78,0x45,0x72,0x72,0x6f,0x72,0x2c,0x20,0x65,0x72,0x72,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x63,0x6f,0x6e,0x73,0x74,0x20,0x28,0x0a,0x09,0x4c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x50,0x61,0x74,0x68,0x20,0x20,0x20,0x20,0x20,0x3d,0x20,0x22,0x70,0x61,0x74,0x68,0x22,0x0a,0x09,0x4c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x51,0x75,0x65,0x72,0x79,0x20,0x20,0x20,0x20,0x3d,0x20,0x22,0x71,0x75,0x65,0x72,0x79,0x22,0x0a,0x09,0x4c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x48,0x65,0x61,0x64,0x65,0x72,0x20,0x20,0x20,0x3d,0x20,0x22,0x68,0x65,0x61,0x64,0x65,0x72,0x22,0x0a,0x09,0x4c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x46,0x6f,0x72,0x6d,0x44,0x61,0x74,0x61,0x20,0x3d,0x20,0x22,0x66,0x6f,0x72,0x6d,0x44,0x61,0x74,0x61,0x22,0x0a,0x09,0x4c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x42,0x6f,0x64,0x79,0x20,0x20,0x20,0x20,0x20,0x3d,0x20,0x22,0x62,0x6f,0x64,0x79,0x22,0x0a,0x29,0x0a,0x0a,0x63,0x6f,0x6e,0x73,0x74,0x20,0x28,0x0a,0x09,0x43,0x6f,0x64,0x65,0x52,0x65,0x71,0x75,0x69,0x72,0x65,0x64,0x20,0x20,0x20,0x20,0x3d,0x20,0x22,0x72,0x65,0x71,0x75,0x69,0x72,0x65,0x64,0x22,0x0a,0x09,0x43,0x6f,0x64,0x65,0x49,0x6e,0x76,0x61,0x6c,0x69,0x64,0x54,0x79,0x70,0x65,0x20,0x3d,0x20,0x22,0x69,0x6e,0x76,0x61,0x6c,0x69,0x64,0x2d,0x74,0x79,0x70,0x65,0x22,0x0a,0x09,0x43,0x6f,0x64,0x65,0x49,0x6e,0x76,0x61,0x6c,0x69,0x64,0x4a,0x53,0x4f,0x4e,0x20,0x3d,0x20,0x22,0x69,0x6e,0x76,0x61,0x6c,0x69,0x64,0x2d,0x6a,0x73,0x6f,0x6e,0x22,0x0a,0x09,0x43,0x6f,0x64,0x65,0x49,0x6e,0x76,0x61,0x6c,0x69,0x64,0x58,0x4d,0x4c,0x20,0x20,0x3d,0x20,0x22,0x69,0x6e,0x76,0x61,0x6c,0x69,0x64,0x2d,0x78,0x6d,0x6c,0x22,0x0a,0x0a,0x09,0x43,0x6f,0x64,0x65,0x49,0x6e,0x76,0x61,0x6c,0x69,0x64,0x50,0x61,0x74,0x74,0x65,0x72,0x6e,0x20,0x3d,0x20,0x22,0x69,0x6e,0x76,0x61,0x6c,0x69,0x64,0x2d,0x70,0x61,0x74,0x74,0x65,0x72,0x6e,0x22,0x0a,0x29,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x4f,0x62,0x6a,0x65,0x63,0x74,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x22,0x60,0x0a,0x09,0x45,0x72,0x72,0x6f,0x72,0x73,0x20,0x20,0x5b,0x5d,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x4f,0x62,0x6a,0x65,0x63,0x74,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x65,0x72,0x72,0x6f,0x72,0x73,0x22,0x60,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x4f,0x62,0x6a,0x65,0x63,0x74,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x22,0x60,0x0a,0x09,0x46,0x69,0x65,0x6c,0x64,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x66,0x69,0x65,0x6c,0x64,0x22,0x60,0x0a,0x09,0x43,0x6f,0x64,0x65,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x63,0x6f,0x64,0x65,0x22,0x60,0x0a,0x09,0x50,0x61,0x72,0x61,0x6d,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x70,0x61,0x72,0x61,0x6d,0x2c,0x6f,0x6d,0x69,0x74,0x65,0x6d,0x70,0x74,0x79,0x22,0x60,0x0a,0x09,0x4c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x6c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2c,0x6f,0x6d,0x69,0x74,0x65,0x6d,0x70,0x74,0x79,0x22,0x60,0x0a,0x09,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x70,0x6f,0x69,0x6e,0x74,0x65,0x72,0x2c,0x6f,0x6d,0x69,0x74,0x65,0x6d,0x70,0x74,0x79,0x22,0x60,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x76,0x20,0x2a,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x4f,0x62,0x6a,0x65,0x63,0x74,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x0a,0x09,0x66,0x69,0x65,0x6c,0x64,0x73,0x20,0x3a,0x3d,0x20,0x6d,0x61,0x6b,0x65,0x28,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x6c,0x65,0x6e,0x28,0x76,0x2e,0x45,0x72,0x72,0x6f,0x72,0x73,0x29,0x29,0x0a,0x09,0x66,0x6f,0x72,0x20,0x69,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x76,0x2e,0x45,0x72,0x72,0x6f,0x72,0x73,0x20,0x7b,0x0a,0x09,0x09,0x66,0x69,0x65,0x6c,0x64,0x73,0x5b,0x69,0x5d,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x73,0x20,0x28,0x25,0x73,0x29,0x22,0x2c,0x20,0x65,0x72,0x72,0x2e,0x46,0x69,0x65,0x6c,0x64,0x2c,0x20,0x65,0x72,0x72,0x2e,0x43,0x6f,0x64,0x65,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x73,0x3a,0x20,0x25,0x73,0x22,0x2c,0x20,0x76,0x2e,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x2c,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x4a,0x6f,0x69,0x6e,0x28,0x66,0x69,0x65,0x6c,0x64,0x73,0x2c,0x20,0x22,0x2c,0x20,0x22,0x29,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x28,0x29,0x20,0x2a,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x76,0x20,0x3a,0x3d,0x20,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x2e,0x4e,0x65,0x77,0x28,0x29,0x0a,0x09,0x76,0x2e,0x52,0x65,0x67,0x69,0x73,0x74,0x65,0x72,0x54,0x61,0x67,0x4e,0x61,0x6d,0x65,0x46,0x75,0x6e,0x63,0x28,0x6a,0x73,0x6f,0x6e,0x54,0x61,0x67,0x4e,0x61,0x6d,0x65,0x29,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x7b,0x0a,0x09,0x09,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x3a,0x20,0x76,0x2c,0x0a,0x09,0x09,0x70,0x61,0x74,0x74,0x65,0x72,0x6e,0x73,0x3a,0x20,0x6d,0x61,0x6b,0x65,0x28,0x6d,0x61,0x70,0x5b,0x73,0x74,0x72,0x69,0x6e,0x67,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x2c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x2a,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x2e,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x0a,0x09,0x70,0x61,0x74,0x74,0x65,0x72,0x6e,0x73,0x20,0x6d,0x61,0x70,0x5b,0x73,0x74,0x72,0x69,0x6e,0x67,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x6a,0x73,0x6f,0x6e,0x54,0x61,0x67,0x4e,0x61,0x6d,0x65,0x28,0x66,0x69,0x65,0x6c,0x64,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x74,0x72,0x75,0x63,0x74,0x46,0x69,0x65,0x6c,0x64,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x6e,0x61,0x6d,0x65,0x20,0x3a,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x53,0x70,0x6c,0x69,0x74,0x4e,0x28,0x66,0x69,0x65,0x6c,0x64,0x2e,0x54,0x61,0x67,0x2e,0x47,0x65,0x74,0x28,0x22,0x70,0x61,0x72,0x61,0x6d,0x22,0x29,0x2c,0x20,0x22,0x2c,0x22,0x2c,0x20,0x32,0x29,0x5b,0x30,0x5d,0x3b,0x20,0x6e,0x61,0x6d,0x65,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x61,0x6d,0x65,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x6e,0x61,0x6d,0x65,0x20,0x3a,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x53,0x70,0x6c,0x69,0x74,0x4e,0x28,0x66,0x69,0x65,0x6c,0x64,0x2e,0x54,0x61,0x67,0x2e,0x47,0x65,0x74,0x28,0x22,0x6a,0x73,0x6f,0x6e,0x22,0x29,0x2c,0x20,0x22,0x2c,0x22,0x2c,0x20,0x32,0x29,0x5b,0x30,0x5d,0x0a,0x09,0x69,0x66,0x20,0x6e,0x61,0x6d,0x65,0x20,0x3d,0x3d,0x20,0x22,0x2d,0x22,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x22,0x22,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x61,0x6d,0x65,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x70,0x61,0x72,0x61,0x6d,0x65,0x74,0x65,0x72,0x4c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x28,0x74,0x79,0x70,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x54,0x79,0x70,0x65,0x2c,0x20,0x66,0x69,0x65,0x6c,0x64,0x73,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x28,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x69,0x6e,0x74,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x69,0x2c,0x20,0x6e,0x61,0x6d,0x65,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x66,0x69,0x65,0x6c,0x64,0x73,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x66,0x6f,0x72,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x50,0x74,0x72,0x20,0x7b,0x0a,0x09,0x09,0x09,0x74,0x79,0x70,0x20,0x3d,0x20,0x74,0x79,0x70,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x69,0x66,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x21,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x09,0x09,0x62,0x72,0x65,0x61,0x6b,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x66,0x69,0x65,0x6c,0x64,0x2c,0x20,0x6f,0x6b,0x20,0x3a,0x3d,0x20,0x74,0x79,0x70,0x2e,0x46,0x69,0x65,0x6c,0x64,0x42,0x79,0x4e,0x61,0x6d,0x65,0x28,0x6e,0x61,0x6d,0x65,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x21,0x6f,0x6b,0x20,0x7b,0x0a,0x09,0x09,0x09,0x62,0x72,0x65,0x61,0x6b,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x69,0x66,0x20,0x70,0x61,0x72,0x61,0x6d,0x20,0x3a,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x53,0x70,0x6c,0x69,0x74,0x4e,0x28,0x66,0x69,0x65,0x6c,0x64,0x2e,0x54,0x61,0x67,0x2e,0x47,0x65,0x74,0x28,0x22,0x70,0x61,0x72,0x61,0x6d,0x22,0x29,0x2c,0x20,0x22,0x2c,0x22,0x2c,0x20,0x32,0x29,0x3b,0x20,0x6c,0x65,0x6e,0x28,0x70,0x61,0x72,0x61,0x6d,0x29,0x20,0x3d,0x3d,0x20,0x32,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x70,0x61,0x72,0x61,0x6d,0x5b,0x31,0x5d,0x2c,0x20,0x69,0x20,0x2b,0x20,0x31,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x74,0x79,0x70,0x20,0x3d,0x20,0x66,0x69,0x65,0x6c,0x64,0x2e,0x54,0x79,0x70,0x65,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x22,0x22,0x2c,0x20,0x30,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x76,0x20,0x2a,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x29,0x20,0x52,0x65,0x67,0x69,0x73,0x74,0x65,0x72,0x50,0x61,0x74,0x74,0x65,0x72,0x6e,0x28,0x74,0x61,0x67,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x72,0x65,0x67,0x65,0x78,0x20,0x2a,0x72,0x65,0x67,0x65,0x78,0x70,0x2e,0x52,0x65,0x67,0x65,0x78,0x70,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x76,0x2e,0x70,0x61,0x74,0x74,0x65,0x72,0x6e,0x73,0x5b,0x74,0x61,0x67,0x5d,0x20,0x3d,0x20,0x72,0x65,0x67,0x65,0x78,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x29,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x76,0x2e,0x52,0x65,0x67,0x69,0x73,0x74,0x65,0x72,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x28,0x74,0x61,0x67,0x2c,0x20,0x66,0x75,0x6e,0x63,0x28,0x66,0x6c,0x20,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x2e,0x46,0x69,0x65,0x6c,0x64,0x4c,0x65,0x76,0x65,0x6c,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x72,0x65,0x67,0x65,0x78,0x2e,0x4d,0x61,0x74,0x63,0x68,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x66,0x6c,0x2e,0x46,0x69,0x65,0x6c,0x64,0x28,0x29,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x29,0x29,0x0a,0x09,0x7d,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x76,0x20,0x2a,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x29,0x20,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x28,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x28,0x2a,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x4f,0x62,0x6a,0x65,0x63,0x74,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x76,0x2e,0x53,0x74,0x72,0x75,0x63,0x74,0x28,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x20,0x3a,0x3d,0x20,0x6e,0x65,0x77,0x28,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x4f,0x62,0x6a,0x65,0x63,0x74,0x29,0x0a,0x09,0x09,0x65,0x72,0x72,0x6f,0x72,0x73,0x2c,0x20,0x6f,0x6b,0x20,0x3a,0x3d,0x20,0x65,0x72,0x72,0x2e,0x28,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x2e,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x21,0x6f,0x6b,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x3d,0x20,0x22,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x20,0x66,0x61,0x69,0x6c,0x65,0x64,0x22,0x0a,0x09,0x09,0x66,0x6f,0x72,0x20,0x5f,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x09,0x65,0x72,0x72,0x6f,0x72,0x43,0x6f,0x64,0x65,0x20,0x3a,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x69,0x6e,0x76,0x61,0x6c,0x69,0x64,0x2d,0x25,0x73,0x22,0x2c,0x20,0x65,0x72,0x72,0x2e,0x54,0x61,0x67,0x28,0x29,0x29,0x0a,0x09,0x09,0x09,0x70,0x61,0x72,0x61,0x6d,0x20,0x3a,0x3d,0x20,0x65,0x72,0x72,0x2e,0x50,0x61,0x72,0x61,0x6d,0x28,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x2e,0x54,0x61,0x67,0x28,0x29,0x20,0x3d,0x3d,0x20,0x22,0x72,0x65,0x71,0x75,0x69,0x72,0x65,0x64,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x65,0x72,0x72,0x6f,0x72,0x43,0x6f,0x64,0x65,0x20,0x3d,0x20,0x65,0x72,0x72,0x2e,0x54,0x61,0x67,0x28,0x29,0x0a,0x09,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x69,0x66,0x20,0x70,0x61,0x74,0x74,0x65,0x72,0x6e,0x2c,0x20,0x6f,0x6b,0x20,0x3a,0x3d,0x20,0x76,0x2e,0x70,0x61,0x74,0x74,0x65,0x72,0x6e,0x73,0x5b,0x65,0x72,0x72,0x2e,0x54,0x61,0x67,0x28,0x29,0x5d,0x3b,0x20,0x6f,0x6b,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x65,0x72,0x72,0x6f,0x72,0x43,0x6f,0x64,0x65,0x20,0x3d,0x20,0x43,0x6f,0x64,0x65,0x49,0x6e,0x76,0x61,0x6c,0x69,0x64,0x50,0x61,0x74,0x74,0x65,0x72,0x6e,0x0a,0x09,0x09,0x09,0x09,0x70,0x61,0x72,0x61,0x6d,0x20,0x3d,0x20,0x70,0x61,0x74,0x74,0x65,0x72,0x6e,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x09,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x20,0x3a,0x3d,0x20,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x4f,0x62,0x6a,0x65,0x63,0x74,0x7b,0x0a,0x09,0x09,0x09,0x09,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x3a,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x28,0x65,0x72,0x72,0x29,0x2c,0x0a,0x09,0x09,0x09,0x09,0x46,0x69,0x65,0x6c,0x64,0x3a,0x20,0x20,0x20,0x65,0x72,0x72,0x2e,0x46,0x69,0x65,0x6c,0x64,0x28,0x29,0x2c,0x0a,0x09,0x09,0x09,0x09,0x43,0x6f,0x64,0x65,0x3a,0x20,0x20,0x20,0x20,0x65,0x72,0x72,0x6f,0x72,0x43,0x6f,0x64,0x65,0x2c,0x0a,0x09,0x09,0x09,0x09,0x50,0x61,0x72,0x61,0x6d,0x3a,0x20,0x20,0x20,0x70,0x61,0x72,0x61,0x6d,0x2c,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x09,0x6c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2c,0x20,0x64,0x65,0x70,0x74,0x68,0x20,0x3a,0x3d,0x20,0x70,0x61,0x72,0x61,0x6d,0x65,0x74,0x65,0x72,0x4c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x28,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x54,0x79,0x70,0x65,0x4f,0x66,0x28,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x29,0x2c,0x20,0x6e,0x61,0x6d,0x65,0x73,0x70,0x61,0x63,0x65,0x54,0x6f,0x6b,0x65,0x6e,0x73,0x28,0x65,0x72,0x72,0x2e,0x53,0x74,0x72,0x75,0x63,0x74,0x4e,0x61,0x6d,0x65,0x73,0x70,0x61,0x63,0x65,0x28,0x29,0x29,0x29,0x0a,0x09,0x09,0x09,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x2e,0x4c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x20,0x3d,0x20,0x6c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x6c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x20,0x3d,0x3d,0x20,0x4c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x42,0x6f,0x64,0x79,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x2e,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x20,0x3d,0x20,0x6a,0x73,0x6f,0x6e,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x28,0x6e,0x61,0x6d,0x65,0x73,0x70,0x61,0x63,0x65,0x54,0x6f,0x6b,0x65,0x6e,0x73,0x28,0x65,0x72,0x72,0x2e,0x4e,0x61,0x6d,0x65,0x73,0x70,0x61,0x63,0x65,0x28,0x29,0x29,0x5b,0x64,0x65,0x70,0x74,0x68,0x3a,0x5d,0x29,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x09,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x2e,0x45,0x72,0x72,0x6f,0x72,0x73,0x20,0x3d,0x20,0x61,0x70,0x70,0x65,0x6e,0x64,0x28,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x2e,0x45,0x72,0x72,0x6f,0x72,0x73,0x2c,0x20,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x76,0x20,0x2a,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x29,0x20,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x28,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x74,0x79,0x70,0x20,0x3a,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x54,0x79,0x70,0x65,0x4f,0x66,0x28,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x29,0x0a,0x09,0x66,0x6f,0x72,0x20,0x74,0x79,0x70,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x26,0x26,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x3d,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x50,0x74,0x72,0x20,0x7b,0x0a,0x09,0x09,0x74,0x79,0x70,0x20,0x3d,0x20,0x74,0x79,0x70,0x2e,0x45,0x6c,0x65,0x6d,0x28,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x69,0x66,0x20,0x74,0x79,0x70,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7c,0x7c,0x20,0x74,0x79,0x70,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x21,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x76,0x2e,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x28,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x09,0x69,0x66,0x20,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x6e,0x61,0x6d,0x65,0x73,0x70,0x61,0x63,0x65,0x54,0x6f,0x6b,0x65,0x6e,0x73,0x28,0x6e,0x61,0x6d,0x65,0x73,0x70,0x61,0x63,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x0a,0x09,0x76,0x61,0x72,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x73,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x76,0x61,0x72,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x42,0x75,0x69,0x6c,0x64,0x65,0x72,0x0a,0x09,0x69,0x6e,0x49,0x6e,0x64,0x65,0x78,0x20,0x3a,0x3d,0x20,0x66,0x61,0x6c,0x73,0x65,0x0a,0x0a,0x09,0x66,0x6c,0x75,0x73,0x68,0x20,0x3a,0x3d,0x20,0x66,0x75,0x6e,0x63,0x28,0x29,0x20,0x7b,0x0a,0x09,0x09,0x74,0x6f,0x6b,0x65,0x6e,0x73,0x20,0x3d,0x20,0x61,0x70,0x70,0x65,0x6e,0x64,0x28,0x74,0x6f,0x6b,0x65,0x6e,0x73,0x2c,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x29,0x29,0x0a,0x09,0x09,0x74,0x6f,0x6b,0x65,0x6e,0x2e,0x52,0x65,0x73,0x65,0x74,0x28,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x5f,0x2c,0x20,0x63,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x6e,0x61,0x6d,0x65,0x73,0x70,0x61,0x63,0x65,0x20,0x7b,0x0a,0x09,0x09,0x73,0x77,0x69,0x74,0x63,0x68,0x20,0x7b,0x0a,0x09,0x09,0x63,0x61,0x73,0x65,0x20,0x63,0x20,0x3d,0x3d,0x20,0x27,0x2e,0x27,0x20,0x26,0x26,0x20,0x21,0x69,0x6e,0x49,0x6e,0x64,0x65,0x78,0x3a,0x0a,0x09,0x09,0x09,0x66,0x6c,0x75,0x73,0x68,0x28,0x29,0x0a,0x09,0x09,0x63,0x61,0x73,0x65,0x20,0x63,0x20,0x3d,0x3d,0x20,0x27,0x5b,0x27,0x20,0x26,0x26,0x20,0x21,0x69,0x6e,0x49,0x6e,0x64,0x65,0x78,0x3a,0x0a,0x09,0x09,0x09,0x66,0x6c,0x75,0x73,0x68,0x28,0x29,0x0a,0x09,0x09,0x09,0x69,0x6e,0x49,0x6e,0x64,0x65,0x78,0x20,0x3d,0x20,0x74,0x72,0x75,0x65,0x0a,0x09,0x09,0x63,0x61,0x73,0x65,0x20,0x63,0x20,0x3d,0x3d,0x20,0x27,0x5d,0x27,0x20,0x26,0x26,0x20,0x69,0x6e,0x49,0x6e,0x64,0x65,0x78,0x3a,0x0a,0x09,0x09,0x09,0x69,0x6e,0x49,0x6e,0x64,0x65,0x78,0x20,0x3d,0x20,0x66,0x61,0x6c,0x73,0x65,0x0a,0x09,0x09,0x64,0x65,0x66,0x61,0x75,0x6c,0x74,0x3a,0x0a,0x09,0x09,0x09,0x74,0x6f,0x6b,0x65,0x6e,0x2e,0x57,0x72,0x69,0x74,0x65,0x52,0x75,0x6e,0x65,0x28,0x63,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x09,0x66,0x6c,0x75,0x73,0x68,0x28,0x29,0x0a,0x0a,0x09,0x76,0x61,0x72,0x20,0x66,0x69,0x65,0x6c,0x64,0x73,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x66,0x6f,0x72,0x20,0x5f,0x2c,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x73,0x5b,0x31,0x3a,0x5d,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x66,0x69,0x65,0x6c,0x64,0x73,0x20,0x3d,0x20,0x61,0x70,0x70,0x65,0x6e,0x64,0x28,0x66,0x69,0x65,0x6c,0x64,0x73,0x2c,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x69,0x65,0x6c,0x64,0x73,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x6a,0x73,0x6f,0x6e,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x28,0x74,0x6f,0x6b,0x65,0x6e,0x73,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x0a,0x09,0x76,0x61,0x72,0x20,0x70,0x6f,0x69,0x6e,0x74,0x65,0x72,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x42,0x75,0x69,0x6c,0x64,0x65,0x72,0x0a,0x09,0x66,0x6f,0x72,0x20,0x5f,0x2c,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x73,0x20,0x7b,0x0a,0x09,0x09,0x70,0x6f,0x69,0x6e,0x74,0x65,0x72,0x2e,0x57,0x72,0x69,0x74,0x65,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x22,0x2f,0x22,0x29,0x0a,0x09,0x09,0x70,0x6f,0x69,0x6e,0x74,0x65,0x72,0x2e,0x57,0x72,0x69,0x74,0x65,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x4e,0x65,0x77,0x52,0x65,0x70,0x6c,0x61,0x63,0x65,0x72,0x28,0x22,0x7e,0x22,0x2c,0x20,0x22,0x7e,0x30,0x22,0x2c,0x20,0x22,0x2f,0x22,0x2c,0x20,0x22,0x7e,0x31,0x22,0x29,0x2e,0x52,0x65,0x70,0x6c,0x61,0x63,0x65,0x28,0x74,0x6f,0x6b,0x65,0x6e,0x29,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x70,0x6f,0x69,0x6e,0x74,0x65,0x72,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x50,0x61,0x72,0x61,0x6d,0x65,0x74,0x65,0x72,0x45,0x72,0x72,0x6f,0x72,0x28,0x6e,0x61,0x6d,0x65,0x2c,0x20,0x6c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2c,0x20,0x63,0x6f,0x64,0x65,0x2c,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x2a,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x4f,0x62,0x6a,0x65,0x63,0x74,0x20,0x7b,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x4f,0x62,0x6a,0x65,0x63,0x74,0x7b,0x0a,0x09,0x09,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x3a,0x20,0x22,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x20,0x66,0x61,0x69,0x6c,0x65,0x64,0x22,0x2c,0x0a,0x09,0x09,0x45,0x72,0x72,0x6f,0x72,0x73,0x3a,0x20,0x5b,0x5d,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x4f,0x62,0x6a,0x65,0x63,0x74,0x7b,0x0a,0x09,0x09,0x09,0x7b,0x0a,0x09,0x09,0x09,0x09,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x3a,0x20,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x2c,0x0a,0x09,0x09,0x09,0x09,0x46,0x69,0x65,0x6c,0x64,0x3a,0x20,0x20,0x20,0x20,0x6e,0x61,0x6d,0x65,0x2c,0x0a,0x09,0x09,0x09,0x09,0x43,0x6f,0x64,0x65,0x3a,0x20,0x20,0x20,0x20,0x20,0x63,0x6f,0x64,0x65,0x2c,0x0a,0x09,0x09,0x09,0x09,0x4c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x3a,0x20,0x6c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2c,0x0a,0x09,0x09,0x09,0x7d,0x2c,0x0a,0x09,0x09,0x7d,0x2c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x42,0x6f,0x64,0x79,0x45,0x72,0x72,0x6f,0x72,0x28,0x6e,0x61,0x6d,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x2a,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x4f,0x62,0x6a,0x65,0x63,0x74,0x20,0x7b,0x0a,0x0a,0x09,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x20,0x3a,0x3d,0x20,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x4f,0x62,0x6a,0x65,0x63,0x74,0x7b,0x0a,0x09,0x09,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x3a,0x20,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x28,0x65,0x72,0x72,0x29,0x2c,0x0a,0x09,0x09,0x46,0x69,0x65,0x6c,0x64,0x3a,0x20,0x20,0x20,0x20,0x6e,0x61,0x6d,0x65,0x2c,0x0a,0x09,0x09,0x43,0x6f,0x64,0x65,0x3a,0x20,0x20,0x20,0x20,0x20,0x43,0x6f,0x64,0x65,0x49,0x6e,0x76,0x61,0x6c,0x69,0x64,0x4a,0x53,0x4f,0x4e,0x2c,0x0a,0x09,0x09,0x4c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x3a,0x20,0x4c,0x6f,0x63,0x61,0x74,0x69,0x6f,0x6e,0x42,0x6f,0x64,0x79,0x2c,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x76,0x61,0x72,0x20,0x64,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x20,0x2a,0x75,0x6e,0x6d,0x61,0x72,0x73,0x68,0x61,0x6c,0x2e,0x44,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x41,0x73,0x28,0x65,0x72,0x72,0x2c,0x20,0x26,0x64,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x29,0x20,0x7b,0x0a,0x09,0x09,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x2e,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x20,0x3d,0x20,0x64,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x2e,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x0a,0x09,0x09,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x2e,0x43,0x6f,0x64,0x65,0x20,0x3d,0x20,0x43,0x6f,0x64,0x65,0x49,0x6e,0x76,0x61,0x6c,0x69,0x64,0x54,0x79,0x70,0x65,0x0a,0x09,0x09,0x69,0x66,0x20,0x69,0x20,0x3a,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x4c,0x61,0x73,0x74,0x49,0x6e,0x64,0x65,0x78,0x28,0x64,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x2e,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x2c,0x20,0x22,0x2f,0x22,0x29,0x3b,0x20,0x69,0x20,0x3e,0x3d,0x20,0x30,0x20,0x7b,0x0a,0x09,0x09,0x09,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x2e,0x46,0x69,0x65,0x6c,0x64,0x20,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x4e,0x65,0x77,0x52,0x65,0x70,0x6c,0x61,0x63,0x65,0x72,0x28,0x22,0x7e,0x31,0x22,0x2c,0x20,0x22,0x2f,0x22,0x2c,0x20,0x22,0x7e,0x30,0x22,0x2c,0x20,0x22,0x7e,0x22,0x29,0x2e,0x52,0x65,0x70,0x6c,0x61,0x63,0x65,0x28,0x64,0x65,0x63,0x6f,0x64,0x65,0x45,0x72,0x72,0x2e,0x50,0x6f,0x69,0x6e,0x74,0x65,0x72,0x5b,0x69,0x2b,0x31,0x3a,0x5d,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x49,0x73,0x28,0x65,0x72,0x72,0x2c,0x20,0x75,0x6e,0x6d,0x61,0x72,0x73,0x68,0x61,0x6c,0x2e,0x4e,0x75,0x6c,0x6c,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x09,0x09,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x2e,0x43,0x6f,0x64,0x65,0x20,0x3d,0x20,0x43,0x6f,0x64,0x65,0x52,0x65,0x71,0x75,0x69,0x72,0x65,0x64,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x49,0x73,0x28,0x65,0x72,0x72,0x2c,0x20,0x75,0x6e,0x6d,0x61,0x72,0x73,0x68,0x61,0x6c,0x2e,0x53,0x79,0x6e,0x74,0x61,0x78,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x09,0x09,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x2e,0x43,0x6f,0x64,0x65,0x20,0x3d,0x20,0x43,0x6f,0x64,0x65,0x49,0x6e,0x76,0x61,0x6c,0x69,0x64,0x58,0x4d,0x4c,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x4f,0x62,0x6a,0x65,0x63,0x74,0x7b,0x0a,0x09,0x09,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x3a,0x20,0x22,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x20,0x66,0x61,0x69,0x6c,0x65,0x64,0x22,0x2c,0x0a,0x09,0x09,0x45,0x72,0x72,0x6f,0x72,0x73,0x3a,0x20,0x20,0x5b,0x5d,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x4f,0x62,0x6a,0x65,0x63,0x74,0x7b,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x7d,0x2c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x76,0x61,0x72,0x20,0x28,0x0a,0x09,0x47,0x69,0x74,0x43,0x6f,0x6d,0x6d,0x69,0x74,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x61,0x38,0x37,0x31,0x39,0x65,0x37,0x64,0x37,0x36,0x32,0x32,0x37,0x63,0x66,0x32,0x38,0x64,0x33,0x35,0x35,0x66,0x65,0x66,0x32,0x35,0x66,0x38,0x66,0x32,0x37,0x35,0x65,0x36,0x64,0x35,0x31,0x36,0x32,0x31,0x22,0x0a,0x09,0x47,0x69,0x74,0x42,0x72,0x61,0x6e,0x63,0x68,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x6d,0x61,0x73,0x74,0x65,0x72,0x22,0x0a,0x09,0x47,0x69,0x74,0x54,0x61,0x67,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x76,0x31,0x2e,0x30,0x2e,0x30,0x22,0x0a,0x09,0x42,0x75,0x69,0x6c,0x64,0x54,0x69,0x6d,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x57,0x65,0x64,0x20,0x32,0x37,0x20,0x4f,0x63,0x74,0x20,0x32,0x30,0x32,0x31,0x20,0x32,0x32,0x3a,0x31,0x37,0x3a,0x32,0x38,0x20,0x42,0x53,0x54,0x22,0x0a,0x29,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x49,0x6e,0x66,0x6f,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x47,0x6f,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x67,0x6f,0x5f,0x76,0x65,0x72,0x73,0x69,0x6f,0x6e,0x22,0x60,0x0a,0x09,0x47,0x69,0x74,0x54,0x61,0x67,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x67,0x69,0x74,0x5f,0x74,0x61,0x67,0x22,0x60,0x0a,0x09,0x47,0x69,0x74,0x43,0x6f,0x6d,0x6d,0x69,0x74,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x67,0x69,0x74,0x5f,0x63,0x6f,0x6d,0x6d,0x69,0x74,0x22,0x60,0x0a,0x09,0x47,0x69,0x74,0x42,0x72,0x61,0x6e,0x63,0x68,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x67,0x69,0x74,0x5f,0x62,0x72,0x61,0x6e,0x63,0x68,0x22,0x60,0x0a,0x09,0x42,0x75,0x69,0x6c,0x64,0x54,0x69,0x6d,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x62,0x75,0x69,0x6c,0x64,0x5f,0x74,0x69,0x6d,0x65,0x22,0x60,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x41,0x70,0x69,0x6b,0x69,0x74,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x28,0x29,0x20,0x2a,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x49,0x6e,0x66,0x6f,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x49,0x6e,0x66,0x6f,0x7b,0x0a,0x09,0x09,0x47,0x6f,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x3a,0x20,0x72,0x75,0x6e,0x74,0x69,0x6d,0x65,0x2e,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x28,0x29,0x2c,0x0a,0x09,0x09,0x47,0x69,0x74,0x54,0x61,0x67,0x3a,0x20,0x20,0x20,0x20,0x47,0x69,0x74,0x54,0x61,0x67,0x2c,0x0a,0x09,0x09,0x47,0x69,0x74,0x43,0x6f,0x6d,0x6d,0x69,0x74,0x3a,0x20,0x47,0x69,0x74,0x43,0x6f,0x6d,0x6d,0x69,0x74,0x2c,0x0a,0x09,0x09,0x47,0x69,0x74,0x42,0x72,0x61,0x6e,0x63,0x68,0x3a,0x20,0x47,0x69,0x74,0x42,0x72,0x61,0x6e,0x63,0x68,0x2c,0x0a,0x09,0x09,0x42,0x75,0x69,0x6c,0x64,0x54,0x69,0x6d,0x65,0x3a,0x20,0x42,0x75,0x69,0x6c,0x64,0x54,0x69,0x6d,0x65,0x2c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x76,0x69,0x20,0x2a,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x49,0x6e,0x66,0x6f,0x29,0x20,0x50,0x72,0x69,0x6e,0x74,0x54,0x61,0x62,0x6c,0x65,0x28,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x77,0x20,0x3a,0x3d,0x20,0x74,0x61,0x62,0x77,0x72,0x69,0x74,0x65,0x72,0x2e,0x4e,0x65,0x77,0x57,0x72,0x69,0x74,0x65,0x72,0x28,0x6f,0x73,0x2e,0x53,0x74,0x64,0x6f,0x75,0x74,0x2c,0x20,0x30,0x2c,0x20,0x30,0x2c,0x20,0x31,0x2c,0x20,0x27,0x2e,0x27,0x2c,0x20,0x74,0x61,0x62,0x77,0x72,0x69,0x74,0x65,0x72,0x2e,0x41,0x6c,0x69,0x67,0x6e,0x52,0x69,0x67,0x68,0x74,0x7c,0x74,0x61,0x62,0x77,0x72,0x69,0x74,0x65,0x72,0x2e,0x44,0x65,0x62,0x75,0x67,0x29,0x0a,0x0a,0x09,0x5f,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x46,0x70,0x72,0x69,0x6e,0x74,0x6c,0x6e,0x28,0x77,0x2c,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x47,0x6f,0x20,0x76,0x65,0x72,0x73,0x69,0x6f,0x6e,0x3a,0x20,0x25,0x73,0x22,0x2c,0x20,0x76,0x69,0x2e,0x47,0x6f,0x56,0x65,0x72,0x73,0x69,0x6f,0x6e,0x29,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x5f,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x46,0x70,0x72,0x69,0x6e,0x74,0x6c,0x6e,0x28,0x77,0x2c,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x47,0x69,0x74,0x20,0x74,0x61,0x67,0x3a,0x20,0x25,0x73,0x22,0x2c,0x20,0x76,0x69,0x2e,0x47,0x69,0x74,0x54,0x61,0x67,0x29,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x5f,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x46,0x70,0x72,0x69,0x6e,0x74,0x6c,0x6e,0x28,0x77,0x2c,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x47,0x69,0x74,0x20,0x63,0x6f,0x6d,0x6d,0x69,0x74,0x3a,0x20,0x25,0x73,0x22,0x2c,0x20,0x76,0x69,0x2e,0x47,0x69,0x74,0x43,0x6f,0x6d,0x6d,0x69,0x74,0x29,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x5f,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x46,0x70,0x72,0x69,0x6e,0x74,0x6c,0x6e,0x28,0x77,0x2c,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x47,0x69,0x74,0x20,0x62,0x72,0x61,0x6e,0x63,0x68,0x3a,0x20,0x25,0x73,0x22,0x2c,0x20,0x76,0x69,0x2e,0x47,0x69,0x74,0x42,0x72,0x61,0x6e,0x63,0x68,0x29,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x5f,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x46,0x70,0x72,0x69,0x6e,0x74,0x6c,0x6e,0x28,0x77,0x2c,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x42,0x75,0x69,0x6c,0x64,0x74,0x69,0x6d,0x65,0x3a,0x20,0x25,0x73,0x22,0x2c,0x20,0x76,0x69,0x2e,0x42,0x75,0x69,0x6c,0x64,0x54,0x69,0x6d,0x65,0x29,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x77,0x2e,0x46,0x6c,0x75,0x73,0x68,0x28,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x4f,0x70,0x74,0x73,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x48,0x6f,0x6f,0x6b,0x73,0x20,0x68,0x6f,0x6f,0x6b,0x73,0x2e,0x48,0x6f,0x6f,0x6b,0x73,0x43,0x6c,0x69,0x65,0x6e,0x74,0x0a,0x09,0x43,0x74,0x78,0x20,0x20,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x0a,0x0a,0x09,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x20,0x62,0x6f,0x6f,0x6c,0x0a,0x0a,0x09,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x73,0x20,0x6d,0x61,0x70,0x5b,0x73,0x74,0x72,0x69,0x6e,0x67,0x5d,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x50,0x72,0x6f,0x76,0x69,0x64,0x65,0x72,0x0a,0x0a,0x09,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x45,0x72,0x72,0x6f,0x72,0x73,0x20,0x62,0x6f,0x6f,0x6c,0x0a,0x0a,0x09,0x53,0x74,0x72,0x65,0x61,0x6d,0x49,0x74,0x65,0x6d,0x73,0x20,0x62,0x6f,0x6f,0x6c,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x48,0x74,0x74,0x70,0x43,0x6c,0x69,0x65,0x6e,0x74,0x57,0x72,0x61,0x70,0x70,0x65,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x42,0x61,0x73,0x65,0x55,0x52,0x4c,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x0a,0x09,0x2a,0x68,0x74,0x74,0x70,0x2e,0x43,0x6c,0x69,0x65,0x6e,0x74,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x48,0x74,0x74,0x70,0x43,0x6c,0x69,0x65,0x6e,0x74,0x57,0x72,0x61,0x70,0x70,0x65,0x72,0x28,0x63,0x6c,0x69,0x65,0x6e,0x74,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x43,0x6c,0x69,0x65,0x6e,0x74,0x2c,0x20,0x62,0x61,0x73,0x65,0x55,0x72,0x6c,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x2a,0x48,0x74,0x74,0x70,0x43,0x6c,0x69,0x65,0x6e,0x74,0x57,0x72,0x61,0x70,0x70,0x65,0x72,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x48,0x74,0x74,0x70,0x43,0x6c,0x69,0x65,0x6e,0x74,0x57,0x72,0x61,0x70,0x70,0x65,0x72,0x7b,0x0a,0x09,0x09,0x43,0x6c,0x69,0x65,0x6e,0x74,0x3a,0x20,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x2c,0x0a,0x09,0x09,0x42,0x61,0x73,0x65,0x55,0x52,0x4c,0x3a,0x20,0x62,0x61,0x73,0x65,0x55,0x72,0x6c,0x2c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x4e,0x65,0x77,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x66,0x75,0x6e,0x63,0x28,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x69,0x6f,0x2e,0x52,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x28,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x63,0x20,0x2a,0x48,0x74,0x74,0x70,0x43,0x6c,0x69,0x65,0x6e,0x74,0x57,0x72,0x61,0x70,0x70,0x65,0x72,0x29,0x20,0x56,0x65,0x72,0x62,0x28,0x76,0x65,0x72,0x62,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x4e,0x65,0x77,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x7b,0x0a,0x09,0x62,0x61,0x73,0x65,0x55,0x52,0x4c,0x20,0x3a,0x3d,0x20,0x63,0x2e,0x42,0x61,0x73,0x65,0x55,0x52,0x4c,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x75,0x6e,0x63,0x28,0x65,0x6e,0x64,0x70,0x6f,0x69,0x6e,0x74,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x62,0x6f,0x64,0x79,0x20,0x69,0x6f,0x2e,0x52,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x28,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x71,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x68,0x74,0x74,0x70,0x2e,0x4e,0x65,0x77,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x28,0x76,0x65,0x72,0x62,0x2c,0x20,0x62,0x61,0x73,0x65,0x55,0x52,0x4c,0x2b,0x65,0x6e,0x64,0x70,0x6f,0x69,0x6e,0x74,0x2c,0x20,0x62,0x6f,0x64,0x79,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x72,0x65,0x71,0x2c,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x63,0x20,0x2a,0x48,0x74,0x74,0x70,0x43,0x6c,0x69,0x65,0x6e,0x74,0x57,0x72,0x61,0x70,0x70,0x65,0x72,0x29,0x20,0x47,0x65,0x74,0x28,0x29,0x20,0x4e,0x65,0x77,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x63,0x2e,0x56,0x65,0x72,0x62,0x28,0x68,0x74,0x74,0x70,0x2e,0x4d,0x65,0x74,0x68,0x6f,0x64,0x47,0x65,0x74,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x63,0x20,0x2a,0x48,0x74,0x74,0x70,0x43,0x6c,0x69,0x65,0x6e,0x74,0x57,0x72,0x61,0x70,0x70,0x65,0x72,0x29,0x20,0x49,0x6e,0x74,0x6f,0x28,0x62,0x6f,0x64,0x79,0x20,0x69,0x6f,0x2e,0x52,0x65,0x61,0x64,0x43,0x6c,0x6f,0x73,0x65,0x72,0x2c,0x20,0x72,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x6a,0x73,0x6f,0x6e,0x2e,0x4e,0x65,0x77,0x44,0x65,0x63,0x6f,0x64,0x65,0x72,0x28,0x62,0x6f,0x64,0x79,0x29,0x2e,0x44,0x65,0x63,0x6f,0x64,0x65,0x28,0x26,0x72,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x09,0x64,0x65,0x66,0x65,0x72,0x20,0x62,0x6f,0x64,0x79,0x2e,0x43,0x6c,0x6f,0x73,0x65,0x28,0x29,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x63,0x6f,0x6e,0x73,0x74,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x52,0x65,0x66,0x72,0x65,0x73,0x68,0x44,0x65,0x6c,0x74,0x61,0x20,0x3d,0x20,0x33,0x30,0x20,0x2a,0x20,0x74,0x69,0x6d,0x65,0x2e,0x53,0x65,0x63,0x6f,0x6e,0x64,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x50,0x72,0x6f,0x76,0x69,0x64,0x65,0x72,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x20,0x7b,0x0a,0x09,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x28,0x63,0x74,0x78,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x29,0x20,0x28,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x46,0x75,0x6e,0x63,0x20,0x66,0x75,0x6e,0x63,0x28,0x63,0x74,0x78,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x29,0x20,0x28,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x66,0x20,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x46,0x75,0x6e,0x63,0x29,0x20,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x28,0x63,0x74,0x78,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x29,0x20,0x28,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x28,0x63,0x74,0x78,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x41,0x50,0x49,0x4b,0x65,0x79,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x28,0x6b,0x65,0x79,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x50,0x72,0x6f,0x76,0x69,0x64,0x65,0x72,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x46,0x75,0x6e,0x63,0x28,0x66,0x75,0x6e,0x63,0x28,0x63,0x74,0x78,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x29,0x20,0x28,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6b,0x65,0x79,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x7d,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x42,0x61,0x73,0x69,0x63,0x41,0x75,0x74,0x68,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x28,0x75,0x73,0x65,0x72,0x2c,0x20,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x50,0x72,0x6f,0x76,0x69,0x64,0x65,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x63,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x20,0x3a,0x3d,0x20,0x22,0x42,0x61,0x73,0x69,0x63,0x20,0x22,0x20,0x2b,0x20,0x62,0x61,0x73,0x65,0x36,0x34,0x2e,0x53,0x74,0x64,0x45,0x6e,0x63,0x6f,0x64,0x69,0x6e,0x67,0x2e,0x45,0x6e,0x63,0x6f,0x64,0x65,0x54,0x6f,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x5b,0x5d,0x62,0x79,0x74,0x65,0x28,0x75,0x73,0x65,0x72,0x2b,0x22,0x3a,0x22,0x2b,0x70,0x61,0x73,0x73,0x77,0x6f,0x72,0x64,0x29,0x29,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x46,0x75,0x6e,0x63,0x28,0x66,0x75,0x6e,0x63,0x28,0x63,0x74,0x78,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x29,0x20,0x28,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x63,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x7d,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x4f,0x41,0x75,0x74,0x68,0x32,0x54,0x6f,0x6b,0x65,0x6e,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x41,0x63,0x63,0x65,0x73,0x73,0x54,0x6f,0x6b,0x65,0x6e,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x45,0x78,0x70,0x69,0x72,0x79,0x20,0x20,0x20,0x20,0x20,0x20,0x74,0x69,0x6d,0x65,0x2e,0x54,0x69,0x6d,0x65,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x4f,0x41,0x75,0x74,0x68,0x32,0x54,0x6f,0x6b,0x65,0x6e,0x53,0x6f,0x75,0x72,0x63,0x65,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x20,0x7b,0x0a,0x09,0x54,0x6f,0x6b,0x65,0x6e,0x28,0x63,0x74,0x78,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x29,0x20,0x28,0x2a,0x4f,0x41,0x75,0x74,0x68,0x32,0x54,0x6f,0x6b,0x65,0x6e,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x42,0x65,0x61,0x72,0x65,0x72,0x54,0x6f,0x6b,0x65,0x6e,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x28,0x73,0x6f,0x75,0x72,0x63,0x65,0x20,0x4f,0x41,0x75,0x74,0x68,0x32,0x54,0x6f,0x6b,0x65,0x6e,0x53,0x6f,0x75,0x72,0x63,0x65,0x29,0x20,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x50,0x72,0x6f,0x76,0x69,0x64,0x65,0x72,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x62,0x65,0x61,0x72,0x65,0x72,0x54,0x6f,0x6b,0x65,0x6e,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x7b,0x73,0x6f,0x75,0x72,0x63,0x65,0x3a,0x20,0x73,0x6f,0x75,0x72,0x63,0x65,0x7d,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x62,0x65,0x61,0x72,0x65,0x72,0x54,0x6f,0x6b,0x65,0x6e,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x73,0x6f,0x75,0x72,0x63,0x65,0x20,0x4f,0x41,0x75,0x74,0x68,0x32,0x54,0x6f,0x6b,0x65,0x6e,0x53,0x6f,0x75,0x72,0x63,0x65,0x0a,0x09,0x6d,0x75,0x74,0x65,0x78,0x20,0x20,0x73,0x79,0x6e,0x63,0x2e,0x4d,0x75,0x74,0x65,0x78,0x0a,0x09,0x74,0x6f,0x6b,0x65,0x6e,0x20,0x20,0x2a,0x4f,0x41,0x75,0x74,0x68,0x32,0x54,0x6f,0x6b,0x65,0x6e,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x63,0x20,0x2a,0x62,0x65,0x61,0x72,0x65,0x72,0x54,0x6f,0x6b,0x65,0x6e,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x29,0x20,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x28,0x63,0x74,0x78,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x29,0x20,0x28,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x63,0x2e,0x6d,0x75,0x74,0x65,0x78,0x2e,0x4c,0x6f,0x63,0x6b,0x28,0x29,0x0a,0x09,0x64,0x65,0x66,0x65,0x72,0x20,0x63,0x2e,0x6d,0x75,0x74,0x65,0x78,0x2e,0x55,0x6e,0x6c,0x6f,0x63,0x6b,0x28,0x29,0x0a,0x0a,0x09,0x69,0x66,0x20,0x63,0x2e,0x74,0x6f,0x6b,0x65,0x6e,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7c,0x7c,0x20,0x28,0x21,0x63,0x2e,0x74,0x6f,0x6b,0x65,0x6e,0x2e,0x45,0x78,0x70,0x69,0x72,0x79,0x2e,0x49,0x73,0x5a,0x65,0x72,0x6f,0x28,0x29,0x20,0x26,0x26,0x20,0x74,0x69,0x6d,0x65,0x2e,0x4e,0x6f,0x77,0x28,0x29,0x2e,0x41,0x64,0x64,0x28,0x74,0x6f,0x6b,0x65,0x6e,0x52,0x65,0x66,0x72,0x65,0x73,0x68,0x44,0x65,0x6c,0x74,0x61,0x29,0x2e,0x41,0x66,0x74,0x65,0x72,0x28,0x63,0x2e,0x74,0x6f,0x6b,0x65,0x6e,0x2e,0x45,0x78,0x70,0x69,0x72,0x79,0x29,0x29,0x20,0x7b,0x0a,0x09,0x09,0x74,0x6f,0x6b,0x65,0x6e,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x63,0x2e,0x73,0x6f,0x75,0x72,0x63,0x65,0x2e,0x54,0x6f,0x6b,0x65,0x6e,0x28,0x63,0x74,0x78,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x22,0x22,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x57,0x72,0x61,0x70,0x28,0x65,0x72,0x72,0x2c,0x20,0x22,0x65,0x72,0x72,0x6f,0x72,0x20,0x66,0x65,0x74,0x63,0x68,0x69,0x6e,0x67,0x20,0x62,0x65,0x61,0x72,0x65,0x72,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x22,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x63,0x2e,0x74,0x6f,0x6b,0x65,0x6e,0x20,0x3d,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x22,0x42,0x65,0x61,0x72,0x65,0x72,0x20,0x22,0x20,0x2b,0x20,0x63,0x2e,0x74,0x6f,0x6b,0x65,0x6e,0x2e,0x41,0x63,0x63,0x65,0x73,0x73,0x54,0x6f,0x6b,0x65,0x6e,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x43,0x6c,0x69,0x65,0x6e,0x74,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x73,0x54,0x6f,0x6b,0x65,0x6e,0x53,0x6f,0x75,0x72,0x63,0x65,0x28,0x63,0x6c,0x69,0x65,0x6e,0x74,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x43,0x6c,0x69,0x65,0x6e,0x74,0x2c,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x55,0x52,0x4c,0x2c,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x49,0x44,0x2c,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x53,0x65,0x63,0x72,0x65,0x74,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x73,0x63,0x6f,0x70,0x65,0x73,0x20,0x2e,0x2e,0x2e,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x4f,0x41,0x75,0x74,0x68,0x32,0x54,0x6f,0x6b,0x65,0x6e,0x53,0x6f,0x75,0x72,0x63,0x65,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x63,0x6c,0x69,0x65,0x6e,0x74,0x20,0x3d,0x20,0x68,0x74,0x74,0x70,0x2e,0x44,0x65,0x66,0x61,0x75,0x6c,0x74,0x43,0x6c,0x69,0x65,0x6e,0x74,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x63,0x6c,0x69,0x65,0x6e,0x74,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x73,0x54,0x6f,0x6b,0x65,0x6e,0x53,0x6f,0x75,0x72,0x63,0x65,0x7b,0x0a,0x09,0x09,0x63,0x6c,0x69,0x65,0x6e,0x74,0x3a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x2c,0x0a,0x09,0x09,0x74,0x6f,0x6b,0x65,0x6e,0x55,0x52,0x4c,0x3a,0x20,0x20,0x20,0x20,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x55,0x52,0x4c,0x2c,0x0a,0x09,0x09,0x63,0x6c,0x69,0x65,0x6e,0x74,0x49,0x44,0x3a,0x20,0x20,0x20,0x20,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x49,0x44,0x2c,0x0a,0x09,0x09,0x63,0x6c,0x69,0x65,0x6e,0x74,0x53,0x65,0x63,0x72,0x65,0x74,0x3a,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x53,0x65,0x63,0x72,0x65,0x74,0x2c,0x0a,0x09,0x09,0x73,0x63,0x6f,0x70,0x65,0x73,0x3a,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x63,0x6f,0x70,0x65,0x73,0x2c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x73,0x54,0x6f,0x6b,0x65,0x6e,0x53,0x6f,0x75,0x72,0x63,0x65,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x63,0x6c,0x69,0x65,0x6e,0x74,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x43,0x6c,0x69,0x65,0x6e,0x74,0x0a,0x09,0x74,0x6f,0x6b,0x65,0x6e,0x55,0x52,0x4c,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x63,0x6c,0x69,0x65,0x6e,0x74,0x49,0x44,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x63,0x6c,0x69,0x65,0x6e,0x74,0x53,0x65,0x63,0x72,0x65,0x74,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x73,0x63,0x6f,0x70,0x65,0x73,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x73,0x20,0x2a,0x63,0x6c,0x69,0x65,0x6e,0x74,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x73,0x54,0x6f,0x6b,0x65,0x6e,0x53,0x6f,0x75,0x72,0x63,0x65,0x29,0x20,0x54,0x6f,0x6b,0x65,0x6e,0x28,0x63,0x74,0x78,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x29,0x20,0x28,0x2a,0x4f,0x41,0x75,0x74,0x68,0x32,0x54,0x6f,0x6b,0x65,0x6e,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x6d,0x20,0x3a,0x3d,0x20,0x75,0x72,0x6c,0x2e,0x56,0x61,0x6c,0x75,0x65,0x73,0x7b,0x22,0x67,0x72,0x61,0x6e,0x74,0x5f,0x74,0x79,0x70,0x65,0x22,0x3a,0x20,0x7b,0x22,0x63,0x6c,0x69,0x65,0x6e,0x74,0x5f,0x63,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x73,0x22,0x7d,0x7d,0x0a,0x09,0x69,0x66,0x20,0x6c,0x65,0x6e,0x28,0x73,0x2e,0x73,0x63,0x6f,0x70,0x65,0x73,0x29,0x20,0x3e,0x20,0x30,0x20,0x7b,0x0a,0x09,0x09,0x66,0x6f,0x72,0x6d,0x2e,0x53,0x65,0x74,0x28,0x22,0x73,0x63,0x6f,0x70,0x65,0x22,0x2c,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x4a,0x6f,0x69,0x6e,0x28,0x73,0x2e,0x73,0x63,0x6f,0x70,0x65,0x73,0x2c,0x20,0x22,0x20,0x22,0x29,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x68,0x74,0x74,0x70,0x2e,0x4e,0x65,0x77,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x57,0x69,0x74,0x68,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x28,0x63,0x74,0x78,0x2c,0x20,0x68,0x74,0x74,0x70,0x2e,0x4d,0x65,0x74,0x68,0x6f,0x64,0x50,0x6f,0x73,0x74,0x2c,0x20,0x73,0x2e,0x74,0x6f,0x6b,0x65,0x6e,0x55,0x52,0x4c,0x2c,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x4e,0x65,0x77,0x52,0x65,0x61,0x64,0x65,0x72,0x28,0x66,0x6f,0x72,0x6d,0x2e,0x45,0x6e,0x63,0x6f,0x64,0x65,0x28,0x29,0x29,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2e,0x53,0x65,0x74,0x28,0x22,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x2d,0x54,0x79,0x70,0x65,0x22,0x2c,0x20,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2f,0x78,0x2d,0x77,0x77,0x77,0x2d,0x66,0x6f,0x72,0x6d,0x2d,0x75,0x72,0x6c,0x65,0x6e,0x63,0x6f,0x64,0x65,0x64,0x22,0x29,0x0a,0x09,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2e,0x53,0x65,0x74,0x28,0x22,0x41,0x63,0x63,0x65,0x70,0x74,0x22,0x2c,0x20,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2f,0x6a,0x73,0x6f,0x6e,0x22,0x29,0x0a,0x09,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x2e,0x53,0x65,0x74,0x42,0x61,0x73,0x69,0x63,0x41,0x75,0x74,0x68,0x28,0x75,0x72,0x6c,0x2e,0x51,0x75,0x65,0x72,0x79,0x45,0x73,0x63,0x61,0x70,0x65,0x28,0x73,0x2e,0x63,0x6c,0x69,0x65,0x6e,0x74,0x49,0x44,0x29,0x2c,0x20,0x75,0x72,0x6c,0x2e,0x51,0x75,0x65,0x72,0x79,0x45,0x73,0x63,0x61,0x70,0x65,0x28,0x73,0x2e,0x63,0x6c,0x69,0x65,0x6e,0x74,0x53,0x65,0x63,0x72,0x65,0x74,0x29,0x29,0x0a,0x0a,0x09,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x73,0x2e,0x63,0x6c,0x69,0x65,0x6e,0x74,0x2e,0x44,0x6f,0x28,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x09,0x64,0x65,0x66,0x65,0x72,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2e,0x42,0x6f,0x64,0x79,0x2e,0x43,0x6c,0x6f,0x73,0x65,0x28,0x29,0x0a,0x0a,0x09,0x69,0x66,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x20,0x21,0x3d,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x4f,0x4b,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x66,0x6d,0x74,0x2e,0x45,0x72,0x72,0x6f,0x72,0x66,0x28,0x22,0x74,0x6f,0x6b,0x65,0x6e,0x20,0x65,0x6e,0x64,0x70,0x6f,0x69,0x6e,0x74,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x64,0x65,0x64,0x20,0x77,0x69,0x74,0x68,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x63,0x6f,0x64,0x65,0x20,0x25,0x64,0x22,0x2c,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x76,0x61,0x72,0x20,0x62,0x6f,0x64,0x79,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x09,0x41,0x63,0x63,0x65,0x73,0x73,0x54,0x6f,0x6b,0x65,0x6e,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x61,0x63,0x63,0x65,0x73,0x73,0x5f,0x74,0x6f,0x6b,0x65,0x6e,0x22,0x60,0x0a,0x09,0x09,0x54,0x6f,0x6b,0x65,0x6e,0x54,0x79,0x70,0x65,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x74,0x6f,0x6b,0x65,0x6e,0x5f,0x74,0x79,0x70,0x65,0x22,0x60,0x0a,0x09,0x09,0x45,0x78,0x70,0x69,0x72,0x65,0x73,0x49,0x6e,0x20,0x20,0x20,0x69,0x6e,0x74,0x36,0x34,0x20,0x20,0x60,0x6a,0x73,0x6f,0x6e,0x3a,0x22,0x65,0x78,0x70,0x69,0x72,0x65,0x73,0x5f,0x69,0x6e,0x22,0x60,0x0a,0x09,0x7d,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x6a,0x73,0x6f,0x6e,0x2e,0x4e,0x65,0x77,0x44,0x65,0x63,0x6f,0x64,0x65,0x72,0x28,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2e,0x42,0x6f,0x64,0x79,0x29,0x2e,0x44,0x65,0x63,0x6f,0x64,0x65,0x28,0x26,0x62,0x6f,0x64,0x79,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x57,0x72,0x61,0x70,0x28,0x65,0x72,0x72,0x2c,0x20,0x22,0x65,0x72,0x72,0x6f,0x72,0x20,0x64,0x65,0x63,0x6f,0x64,0x69,0x6e,0x67,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x22,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x69,0x66,0x20,0x62,0x6f,0x64,0x79,0x2e,0x41,0x63,0x63,0x65,0x73,0x73,0x54,0x6f,0x6b,0x65,0x6e,0x20,0x3d,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4e,0x65,0x77,0x28,0x22,0x74,0x6f,0x6b,0x65,0x6e,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x77,0x69,0x74,0x68,0x6f,0x75,0x74,0x20,0x61,0x63,0x63,0x65,0x73,0x73,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x22,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x69,0x66,0x20,0x62,0x6f,0x64,0x79,0x2e,0x54,0x6f,0x6b,0x65,0x6e,0x54,0x79,0x70,0x65,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x26,0x26,0x20,0x21,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x45,0x71,0x75,0x61,0x6c,0x46,0x6f,0x6c,0x64,0x28,0x62,0x6f,0x64,0x79,0x2e,0x54,0x6f,0x6b,0x65,0x6e,0x54,0x79,0x70,0x65,0x2c,0x20,0x22,0x62,0x65,0x61,0x72,0x65,0x72,0x22,0x29,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x66,0x6d,0x74,0x2e,0x45,0x72,0x72,0x6f,0x72,0x66,0x28,0x22,0x75,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x20,0x74,0x79,0x70,0x65,0x20,0x27,0x25,0x73,0x27,0x22,0x2c,0x20,0x62,0x6f,0x64,0x79,0x2e,0x54,0x6f,0x6b,0x65,0x6e,0x54,0x79,0x70,0x65,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x74,0x6f,0x6b,0x65,0x6e,0x20,0x3a,0x3d,0x20,0x26,0x4f,0x41,0x75,0x74,0x68,0x32,0x54,0x6f,0x6b,0x65,0x6e,0x7b,0x41,0x63,0x63,0x65,0x73,0x73,0x54,0x6f,0x6b,0x65,0x6e,0x3a,0x20,0x62,0x6f,0x64,0x79,0x2e,0x41,0x63,0x63,0x65,0x73,0x73,0x54,0x6f,0x6b,0x65,0x6e,0x7d,0x0a,0x09,0x69,0x66,0x20,0x62,0x6f,0x64,0x79,0x2e,0x45,0x78,0x70,0x69,0x72,0x65,0x73,0x49,0x6e,0x20,0x3e,0x20,0x30,0x20,0x7b,0x0a,0x09,0x09,0x74,0x6f,0x6b,0x65,0x6e,0x2e,0x45,0x78,0x70,0x69,0x72,0x79,0x20,0x3d,0x20,0x74,0x69,0x6d,0x65,0x2e,0x4e,0x6f,0x77,0x28,0x29,0x2e,0x41,0x64,0x64,0x28,0x74,0x69,0x6d,0x65,0x2e,0x44,0x75,0x72,0x61,0x74,0x69,0x6f,0x6e,0x28,0x62,0x6f,0x64,0x79,0x2e,0x45,0x78,0x70,0x69,0x72,0x65,0x73,0x49,0x6e,0x29,0x20,0x2a,0x20,0x74,0x69,0x6d,0x65,0x2e,0x53,0x65,0x63,0x6f,0x6e,0x64,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x74,0x6f,0x6b,0x65,0x6e,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x53,0x65,0x74,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x28,0x63,0x74,0x78,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2c,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x68,0x74,0x74,0x70,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x63,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x73,0x20,0x6d,0x61,0x70,0x5b,0x73,0x74,0x72,0x69,0x6e,0x67,0x5d,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x50,0x72,0x6f,0x76,0x69,0x64,0x65,0x72,0x2c,0x20,0x64,0x65,0x66,0x69,0x6e,0x69,0x74,0x69,0x6f,0x6e,0x2c,0x20,0x6e,0x61,0x6d,0x65,0x2c,0x20,0x76,0x61,0x6c,0x75,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x70,0x72,0x6f,0x76,0x69,0x64,0x65,0x72,0x2c,0x20,0x6f,0x6b,0x20,0x3a,0x3d,0x20,0x63,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x73,0x5b,0x64,0x65,0x66,0x69,0x6e,0x69,0x74,0x69,0x6f,0x6e,0x5d,0x3b,0x20,0x6f,0x6b,0x20,0x26,0x26,0x20,0x76,0x61,0x6c,0x75,0x65,0x20,0x3d,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x63,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x70,0x72,0x6f,0x76,0x69,0x64,0x65,0x72,0x2e,0x43,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x28,0x63,0x74,0x78,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x57,0x72,0x61,0x70,0x66,0x28,0x65,0x72,0x72,0x2c,0x20,0x22,0x65,0x72,0x72,0x6f,0x72,0x20,0x70,0x72,0x6f,0x76,0x69,0x64,0x69,0x6e,0x67,0x20,0x63,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x20,0x6f,0x66,0x20,0x73,0x65,0x63,0x75,0x72,0x69,0x74,0x79,0x20,0x64,0x65,0x66,0x69,0x6e,0x69,0x74,0x69,0x6f,0x6e,0x20,0x27,0x25,0x73,0x27,0x22,0x2c,0x20,0x64,0x65,0x66,0x69,0x6e,0x69,0x74,0x69,0x6f,0x6e,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x3d,0x20,0x63,0x72,0x65,0x64,0x65,0x6e,0x74,0x69,0x61,0x6c,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x68,0x65,0x61,0x64,0x65,0x72,0x5b,0x6e,0x61,0x6d,0x65,0x5d,0x20,0x3d,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x7b,0x76,0x61,0x6c,0x75,0x65,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x63,0x6f,0x6e,0x73,0x74,0x20,0x6d,0x61,0x78,0x55,0x6e,0x65,0x78,0x70,0x65,0x63,0x74,0x65,0x64,0x42,0x6f,0x64,0x79,0x53,0x69,0x7a,0x65,0x20,0x69,0x6e,0x74,0x36,0x34,0x20,0x3d,0x20,0x36,0x34,0x20,0x3c,0x3c,0x20,0x31,0x30,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x55,0x6e,0x65,0x78,0x70,0x65,0x63,0x74,0x65,0x64,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x45,0x72,0x72,0x6f,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x20,0x69,0x6e,0x74,0x0a,0x09,0x48,0x65,0x61,0x64,0x65,0x72,0x20,0x20,0x20,0x20,0x20,0x68,0x74,0x74,0x70,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x0a,0x0a,0x09,0x42,0x6f,0x64,0x79,0x20,0x5b,0x5d,0x62,0x79,0x74,0x65,0x0a,0x0a,0x09,0x4d,0x65,0x74,0x68,0x6f,0x64,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x55,0x52,0x4c,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x55,0x6e,0x65,0x78,0x70,0x65,0x63,0x74,0x65,0x64,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x75,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x63,0x6f,0x64,0x65,0x20,0x27,0x25,0x64,0x27,0x20,0x6f,0x66,0x20,0x25,0x73,0x20,0x25,0x73,0x22,0x2c,0x20,0x65,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x2c,0x20,0x65,0x2e,0x4d,0x65,0x74,0x68,0x6f,0x64,0x2c,0x20,0x65,0x2e,0x55,0x52,0x4c,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x55,0x6e,0x65,0x78,0x70,0x65,0x63,0x74,0x65,0x64,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x55,0x6e,0x65,0x78,0x70,0x65,0x63,0x74,0x65,0x64,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x29,0x20,0x69,0x6e,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x55,0x6e,0x65,0x78,0x70,0x65,0x63,0x74,0x65,0x64,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x45,0x72,0x72,0x6f,0x72,0x28,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x29,0x20,0x2a,0x55,0x6e,0x65,0x78,0x70,0x65,0x63,0x74,0x65,0x64,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x45,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x26,0x55,0x6e,0x65,0x78,0x70,0x65,0x63,0x74,0x65,0x64,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x45,0x72,0x72,0x6f,0x72,0x7b,0x0a,0x09,0x09,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x3a,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x2c,0x0a,0x09,0x09,0x48,0x65,0x61,0x64,0x65,0x72,0x3a,0x20,0x20,0x20,0x20,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2c,0x0a,0x09,0x09,0x4d,0x65,0x74,0x68,0x6f,0x64,0x3a,0x20,0x20,0x20,0x20,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x2e,0x4d,0x65,0x74,0x68,0x6f,0x64,0x2c,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x69,0x66,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x2e,0x55,0x52,0x4c,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x75,0x72,0x6c,0x20,0x3a,0x3d,0x20,0x2a,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x2e,0x55,0x52,0x4c,0x0a,0x09,0x09,0x75,0x72,0x6c,0x2e,0x55,0x73,0x65,0x72,0x20,0x3d,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x09,0x65,0x72,0x72,0x2e,0x55,0x52,0x4c,0x20,0x3d,0x20,0x75,0x72,0x6c,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x69,0x66,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2e,0x42,0x6f,0x64,0x79,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x65,0x72,0x72,0x2e,0x42,0x6f,0x64,0x79,0x2c,0x20,0x5f,0x20,0x3d,0x20,0x69,0x6f,0x75,0x74,0x69,0x6c,0x2e,0x52,0x65,0x61,0x64,0x41,0x6c,0x6c,0x28,0x69,0x6f,0x2e,0x4c,0x69,0x6d,0x69,0x74,0x52,0x65,0x61,0x64,0x65,0x72,0x28,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2e,0x42,0x6f,0x64,0x79,0x2c,0x20,0x6d,0x61,0x78,0x55,0x6e,0x65,0x78,0x70,0x65,0x63,0x74,0x65,0x64,0x42,0x6f,0x64,0x79,0x53,0x69,0x7a,0x65,0x29,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x2e,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x4f,0x62,0x6a,0x65,0x63,0x74,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x22,0x69,0x6e,0x76,0x61,0x6c,0x69,0x64,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x3a,0x20,0x22,0x20,0x2b,0x20,0x65,0x2e,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x4f,0x62,0x6a,0x65,0x63,0x74,0x2e,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x43,0x6c,0x69,0x65,0x6e,0x74,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x28,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x20,0x2a,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x2e,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x2c,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x2e,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x28,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x09,0x69,0x66,0x20,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x7b,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x4f,0x62,0x6a,0x65,0x63,0x74,0x3a,0x20,0x2a,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x7d,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x43,0x6c,0x69,0x65,0x6e,0x74,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x28,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x20,0x2a,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x2e,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x29,0x20,0x78,0x68,0x74,0x74,0x70,0x2e,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x2e,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x4d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x54,0x79,0x70,0x65,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x53,0x75,0x62,0x74,0x79,0x70,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x51,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x66,0x6c,0x6f,0x61,0x74,0x36,0x34,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x6d,0x20,0x4d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x29,0x20,0x6d,0x61,0x74,0x63,0x68,0x65,0x73,0x28,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x28,0x69,0x6e,0x74,0x2c,0x20,0x62,0x6f,0x6f,0x6c,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x74,0x79,0x70,0x2c,0x20,0x73,0x75,0x62,0x74,0x79,0x70,0x65,0x20,0x3a,0x3d,0x20,0x73,0x70,0x6c,0x69,0x74,0x4d,0x65,0x64,0x69,0x61,0x54,0x79,0x70,0x65,0x28,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x29,0x0a,0x0a,0x09,0x73,0x77,0x69,0x74,0x63,0x68,0x20,0x7b,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x6d,0x2e,0x54,0x79,0x70,0x65,0x20,0x3d,0x3d,0x20,0x22,0x2a,0x22,0x20,0x26,0x26,0x20,0x6d,0x2e,0x53,0x75,0x62,0x74,0x79,0x70,0x65,0x20,0x3d,0x3d,0x20,0x22,0x2a,0x22,0x3a,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x30,0x2c,0x20,0x74,0x72,0x75,0x65,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x6d,0x2e,0x54,0x79,0x70,0x65,0x20,0x3d,0x3d,0x20,0x74,0x79,0x70,0x20,0x26,0x26,0x20,0x6d,0x2e,0x53,0x75,0x62,0x74,0x79,0x70,0x65,0x20,0x3d,0x3d,0x20,0x22,0x2a,0x22,0x3a,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x31,0x2c,0x20,0x74,0x72,0x75,0x65,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x6d,0x2e,0x54,0x79,0x70,0x65,0x20,0x3d,0x3d,0x20,0x74,0x79,0x70,0x20,0x26,0x26,0x20,0x6d,0x2e,0x53,0x75,0x62,0x74,0x79,0x70,0x65,0x20,0x3d,0x3d,0x20,0x73,0x75,0x62,0x74,0x79,0x70,0x65,0x3a,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x32,0x2c,0x20,0x74,0x72,0x75,0x65,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x30,0x2c,0x20,0x66,0x61,0x6c,0x73,0x65,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x73,0x70,0x6c,0x69,0x74,0x4d,0x65,0x64,0x69,0x61,0x54,0x79,0x70,0x65,0x28,0x6d,0x65,0x64,0x69,0x61,0x54,0x79,0x70,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x28,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x6d,0x65,0x64,0x69,0x61,0x54,0x79,0x70,0x65,0x20,0x3d,0x20,0x45,0x78,0x74,0x72,0x61,0x63,0x74,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x28,0x6d,0x65,0x64,0x69,0x61,0x54,0x79,0x70,0x65,0x29,0x0a,0x09,0x69,0x20,0x3a,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x49,0x6e,0x64,0x65,0x78,0x28,0x6d,0x65,0x64,0x69,0x61,0x54,0x79,0x70,0x65,0x2c,0x20,0x22,0x2f,0x22,0x29,0x0a,0x09,0x69,0x66,0x20,0x69,0x20,0x3d,0x3d,0x20,0x2d,0x31,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6d,0x65,0x64,0x69,0x61,0x54,0x79,0x70,0x65,0x2c,0x20,0x22,0x22,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6d,0x65,0x64,0x69,0x61,0x54,0x79,0x70,0x65,0x5b,0x3a,0x69,0x5d,0x2c,0x20,0x6d,0x65,0x64,0x69,0x61,0x54,0x79,0x70,0x65,0x5b,0x69,0x2b,0x31,0x3a,0x5d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x50,0x61,0x72,0x73,0x65,0x41,0x63,0x63,0x65,0x70,0x74,0x28,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x5b,0x5d,0x4d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x20,0x7b,0x0a,0x0a,0x09,0x76,0x61,0x72,0x20,0x6d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x73,0x20,0x5b,0x5d,0x4d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x5f,0x2c,0x20,0x65,0x6c,0x65,0x6d,0x65,0x6e,0x74,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x53,0x70,0x6c,0x69,0x74,0x28,0x68,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x22,0x2c,0x22,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x70,0x61,0x72,0x61,0x6d,0x73,0x20,0x3a,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x53,0x70,0x6c,0x69,0x74,0x28,0x65,0x6c,0x65,0x6d,0x65,0x6e,0x74,0x2c,0x20,0x22,0x3b,0x22,0x29,0x0a,0x09,0x09,0x74,0x79,0x70,0x2c,0x20,0x73,0x75,0x62,0x74,0x79,0x70,0x65,0x20,0x3a,0x3d,0x20,0x73,0x70,0x6c,0x69,0x74,0x4d,0x65,0x64,0x69,0x61,0x54,0x79,0x70,0x65,0x28,0x70,0x61,0x72,0x61,0x6d,0x73,0x5b,0x30,0x5d,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x74,0x79,0x70,0x20,0x3d,0x3d,0x20,0x22,0x22,0x20,0x7c,0x7c,0x20,0x73,0x75,0x62,0x74,0x79,0x70,0x65,0x20,0x3d,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x63,0x6f,0x6e,0x74,0x69,0x6e,0x75,0x65,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x6d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x20,0x3a,0x3d,0x20,0x4d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x7b,0x54,0x79,0x70,0x65,0x3a,0x20,0x74,0x79,0x70,0x2c,0x20,0x53,0x75,0x62,0x74,0x79,0x70,0x65,0x3a,0x20,0x73,0x75,0x62,0x74,0x79,0x70,0x65,0x2c,0x20,0x51,0x3a,0x20,0x31,0x7d,0x0a,0x09,0x09,0x76,0x61,0x6c,0x69,0x64,0x20,0x3a,0x3d,0x20,0x74,0x72,0x75,0x65,0x0a,0x09,0x09,0x66,0x6f,0x72,0x20,0x5f,0x2c,0x20,0x70,0x61,0x72,0x61,0x6d,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x70,0x61,0x72,0x61,0x6d,0x73,0x5b,0x31,0x3a,0x5d,0x20,0x7b,0x0a,0x09,0x09,0x09,0x70,0x61,0x72,0x61,0x6d,0x20,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x54,0x72,0x69,0x6d,0x53,0x70,0x61,0x63,0x65,0x28,0x70,0x61,0x72,0x61,0x6d,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x21,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x48,0x61,0x73,0x50,0x72,0x65,0x66,0x69,0x78,0x28,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x54,0x6f,0x4c,0x6f,0x77,0x65,0x72,0x28,0x70,0x61,0x72,0x61,0x6d,0x29,0x2c,0x20,0x22,0x71,0x3d,0x22,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x63,0x6f,0x6e,0x74,0x69,0x6e,0x75,0x65,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x09,0x71,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x50,0x61,0x72,0x73,0x65,0x46,0x6c,0x6f,0x61,0x74,0x28,0x70,0x61,0x72,0x61,0x6d,0x5b,0x32,0x3a,0x5d,0x2c,0x20,0x36,0x34,0x29,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7c,0x7c,0x20,0x71,0x20,0x3c,0x20,0x30,0x20,0x7c,0x7c,0x20,0x71,0x20,0x3e,0x20,0x31,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x76,0x61,0x6c,0x69,0x64,0x20,0x3d,0x20,0x66,0x61,0x6c,0x73,0x65,0x0a,0x09,0x09,0x09,0x09,0x62,0x72,0x65,0x61,0x6b,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x09,0x6d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x2e,0x51,0x20,0x3d,0x20,0x71,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x69,0x66,0x20,0x76,0x61,0x6c,0x69,0x64,0x20,0x7b,0x0a,0x09,0x09,0x09,0x6d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x73,0x20,0x3d,0x20,0x61,0x70,0x70,0x65,0x6e,0x64,0x28,0x6d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x73,0x2c,0x20,0x6d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x73,0x6f,0x72,0x74,0x2e,0x53,0x6c,0x69,0x63,0x65,0x53,0x74,0x61,0x62,0x6c,0x65,0x28,0x6d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x73,0x2c,0x20,0x66,0x75,0x6e,0x63,0x28,0x69,0x2c,0x20,0x6a,0x20,0x69,0x6e,0x74,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x73,0x5b,0x69,0x5d,0x2e,0x51,0x20,0x3e,0x20,0x6d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x73,0x5b,0x6a,0x5d,0x2e,0x51,0x0a,0x09,0x7d,0x29,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x73,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x67,0x6f,0x74,0x69,0x61,0x74,0x65,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x28,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x6f,0x66,0x66,0x65,0x72,0x73,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x28,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x62,0x6f,0x6f,0x6c,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x6c,0x65,0x6e,0x28,0x6f,0x66,0x66,0x65,0x72,0x73,0x29,0x20,0x3d,0x3d,0x20,0x30,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x22,0x22,0x2c,0x20,0x66,0x61,0x6c,0x73,0x65,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x69,0x66,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x54,0x72,0x69,0x6d,0x53,0x70,0x61,0x63,0x65,0x28,0x68,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x3d,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6f,0x66,0x66,0x65,0x72,0x73,0x5b,0x30,0x5d,0x2c,0x20,0x74,0x72,0x75,0x65,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x6d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x73,0x20,0x3a,0x3d,0x20,0x50,0x61,0x72,0x73,0x65,0x41,0x63,0x63,0x65,0x70,0x74,0x28,0x68,0x65,0x61,0x64,0x65,0x72,0x29,0x0a,0x0a,0x09,0x76,0x61,0x72,0x20,0x28,0x0a,0x09,0x09,0x62,0x65,0x73,0x74,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x09,0x62,0x65,0x73,0x74,0x51,0x20,0x66,0x6c,0x6f,0x61,0x74,0x36,0x34,0x0a,0x09,0x29,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x5f,0x2c,0x20,0x6f,0x66,0x66,0x65,0x72,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x6f,0x66,0x66,0x65,0x72,0x73,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x71,0x2c,0x20,0x73,0x70,0x65,0x63,0x69,0x66,0x69,0x63,0x69,0x74,0x79,0x20,0x3a,0x3d,0x20,0x30,0x2e,0x30,0x2c,0x20,0x2d,0x31,0x0a,0x09,0x09,0x66,0x6f,0x72,0x20,0x5f,0x2c,0x20,0x6d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x6d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x73,0x20,0x7b,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x73,0x2c,0x20,0x6f,0x6b,0x20,0x3a,0x3d,0x20,0x6d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x2e,0x6d,0x61,0x74,0x63,0x68,0x65,0x73,0x28,0x6f,0x66,0x66,0x65,0x72,0x29,0x3b,0x20,0x6f,0x6b,0x20,0x26,0x26,0x20,0x73,0x20,0x3e,0x20,0x73,0x70,0x65,0x63,0x69,0x66,0x69,0x63,0x69,0x74,0x79,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x71,0x2c,0x20,0x73,0x70,0x65,0x63,0x69,0x66,0x69,0x63,0x69,0x74,0x79,0x20,0x3d,0x20,0x6d,0x65,0x64,0x69,0x61,0x52,0x61,0x6e,0x67,0x65,0x2e,0x51,0x2c,0x20,0x73,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x69,0x66,0x20,0x71,0x20,0x3e,0x20,0x62,0x65,0x73,0x74,0x51,0x20,0x7b,0x0a,0x09,0x09,0x09,0x62,0x65,0x73,0x74,0x2c,0x20,0x62,0x65,0x73,0x74,0x51,0x20,0x3d,0x20,0x6f,0x66,0x66,0x65,0x72,0x2c,0x20,0x71,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x62,0x65,0x73,0x74,0x2c,0x20,0x62,0x65,0x73,0x74,0x51,0x20,0x3e,0x20,0x30,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x6e,0x65,0x67,0x6f,0x74,0x69,0x61,0x74,0x65,0x64,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x4b,0x65,0x79,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x7b,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x57,0x69,0x74,0x68,0x4e,0x65,0x67,0x6f,0x74,0x69,0x61,0x74,0x65,0x64,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x28,0x63,0x74,0x78,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2c,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x57,0x69,0x74,0x68,0x56,0x61,0x6c,0x75,0x65,0x28,0x63,0x74,0x78,0x2c,0x20,0x6e,0x65,0x67,0x6f,0x74,0x69,0x61,0x74,0x65,0x64,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x4b,0x65,0x79,0x7b,0x7d,0x2c,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x67,0x6f,0x74,0x69,0x61,0x74,0x65,0x64,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x28,0x63,0x74,0x78,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x0a,0x09,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x2c,0x20,0x5f,0x20,0x3a,0x3d,0x20,0x63,0x74,0x78,0x2e,0x56,0x61,0x6c,0x75,0x65,0x28,0x6e,0x65,0x67,0x6f,0x74,0x69,0x61,0x74,0x65,0x64,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x4b,0x65,0x79,0x7b,0x7d,0x29,0x2e,0x28,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x0a,0x7d,0x0a,0x0a,0x63,0x6f,0x6e,0x73,0x74,0x20,0x28,0x0a,0x09,0x45,0x54,0x61,0x67,0x48,0x65,0x61,0x64,0x65,0x72,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x45,0x54,0x61,0x67,0x22,0x0a,0x09,0x4c,0x61,0x73,0x74,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x48,0x65,0x61,0x64,0x65,0x72,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x4c,0x61,0x73,0x74,0x2d,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x22,0x0a,0x09,0x49,0x66,0x4d,0x61,0x74,0x63,0x68,0x48,0x65,0x61,0x64,0x65,0x72,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x49,0x66,0x2d,0x4d,0x61,0x74,0x63,0x68,0x22,0x0a,0x09,0x49,0x66,0x4e,0x6f,0x6e,0x65,0x4d,0x61,0x74,0x63,0x68,0x48,0x65,0x61,0x64,0x65,0x72,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x49,0x66,0x2d,0x4e,0x6f,0x6e,0x65,0x2d,0x4d,0x61,0x74,0x63,0x68,0x22,0x0a,0x09,0x49,0x66,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x53,0x69,0x6e,0x63,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x49,0x66,0x2d,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x2d,0x53,0x69,0x6e,0x63,0x65,0x22,0x0a,0x09,0x49,0x66,0x55,0x6e,0x6d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x53,0x69,0x6e,0x63,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x49,0x66,0x2d,0x55,0x6e,0x6d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x2d,0x53,0x69,0x6e,0x63,0x65,0x22,0x0a,0x29,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x46,0x6f,0x72,0x6d,0x61,0x74,0x45,0x54,0x61,0x67,0x28,0x65,0x74,0x61,0x67,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x74,0x61,0x67,0x20,0x3d,0x3d,0x20,0x22,0x22,0x20,0x7c,0x7c,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x48,0x61,0x73,0x50,0x72,0x65,0x66,0x69,0x78,0x28,0x65,0x74,0x61,0x67,0x2c,0x20,0x22,0x5c,0x22,0x22,0x29,0x20,0x7c,0x7c,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x48,0x61,0x73,0x50,0x72,0x65,0x66,0x69,0x78,0x28,0x65,0x74,0x61,0x67,0x2c,0x20,0x22,0x57,0x2f,0x5c,0x22,0x22,0x29,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x74,0x61,0x67,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x22,0x5c,0x22,0x22,0x20,0x2b,0x20,0x65,0x74,0x61,0x67,0x20,0x2b,0x20,0x22,0x5c,0x22,0x22,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x53,0x65,0x74,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x73,0x28,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x68,0x74,0x74,0x70,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x65,0x74,0x61,0x67,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x6c,0x61,0x73,0x74,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x20,0x74,0x69,0x6d,0x65,0x2e,0x54,0x69,0x6d,0x65,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x74,0x61,0x67,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x68,0x65,0x61,0x64,0x65,0x72,0x2e,0x53,0x65,0x74,0x28,0x45,0x54,0x61,0x67,0x48,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x46,0x6f,0x72,0x6d,0x61,0x74,0x45,0x54,0x61,0x67,0x28,0x65,0x74,0x61,0x67,0x29,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x69,0x66,0x20,0x21,0x6c,0x61,0x73,0x74,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x2e,0x49,0x73,0x5a,0x65,0x72,0x6f,0x28,0x29,0x20,0x7b,0x0a,0x09,0x09,0x68,0x65,0x61,0x64,0x65,0x72,0x2e,0x53,0x65,0x74,0x28,0x4c,0x61,0x73,0x74,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x48,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x46,0x6f,0x72,0x6d,0x61,0x74,0x48,0x54,0x54,0x50,0x54,0x69,0x6d,0x65,0x28,0x6c,0x61,0x73,0x74,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x29,0x29,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x46,0x6f,0x72,0x6d,0x61,0x74,0x48,0x54,0x54,0x50,0x54,0x69,0x6d,0x65,0x28,0x74,0x20,0x74,0x69,0x6d,0x65,0x2e,0x54,0x69,0x6d,0x65,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x74,0x2e,0x49,0x73,0x5a,0x65,0x72,0x6f,0x28,0x29,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x22,0x22,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x74,0x2e,0x55,0x54,0x43,0x28,0x29,0x2e,0x46,0x6f,0x72,0x6d,0x61,0x74,0x28,0x68,0x74,0x74,0x70,0x2e,0x54,0x69,0x6d,0x65,0x46,0x6f,0x72,0x6d,0x61,0x74,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x50,0x61,0x72,0x73,0x65,0x48,0x54,0x54,0x50,0x54,0x69,0x6d,0x65,0x28,0x76,0x61,0x6c,0x75,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x74,0x69,0x6d,0x65,0x2e,0x54,0x69,0x6d,0x65,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x76,0x61,0x6c,0x75,0x65,0x20,0x3d,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x74,0x69,0x6d,0x65,0x2e,0x54,0x69,0x6d,0x65,0x7b,0x7d,0x0a,0x09,0x7d,0x0a,0x09,0x74,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x68,0x74,0x74,0x70,0x2e,0x50,0x61,0x72,0x73,0x65,0x54,0x69,0x6d,0x65,0x28,0x76,0x61,0x6c,0x75,0x65,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x74,0x69,0x6d,0x65,0x2e,0x54,0x69,0x6d,0x65,0x7b,0x7d,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x74,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x48,0x61,0x73,0x50,0x72,0x65,0x63,0x6f,0x6e,0x64,0x69,0x74,0x69,0x6f,0x6e,0x73,0x28,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x68,0x74,0x74,0x70,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x20,0x7b,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x2e,0x47,0x65,0x74,0x28,0x49,0x66,0x4d,0x61,0x74,0x63,0x68,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7c,0x7c,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x2e,0x47,0x65,0x74,0x28,0x49,0x66,0x4e,0x6f,0x6e,0x65,0x4d,0x61,0x74,0x63,0x68,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7c,0x7c,0x0a,0x09,0x09,0x68,0x65,0x61,0x64,0x65,0x72,0x2e,0x47,0x65,0x74,0x28,0x49,0x66,0x55,0x6e,0x6d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x53,0x69,0x6e,0x63,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7c,0x7c,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x2e,0x47,0x65,0x74,0x28,0x49,0x66,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x53,0x69,0x6e,0x63,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x21,0x3d,0x20,0x22,0x22,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x45,0x76,0x61,0x6c,0x75,0x61,0x74,0x65,0x50,0x72,0x65,0x63,0x6f,0x6e,0x64,0x69,0x74,0x69,0x6f,0x6e,0x73,0x28,0x72,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x65,0x74,0x61,0x67,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x6c,0x61,0x73,0x74,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x20,0x74,0x69,0x6d,0x65,0x2e,0x54,0x69,0x6d,0x65,0x29,0x20,0x69,0x6e,0x74,0x20,0x7b,0x0a,0x0a,0x09,0x65,0x74,0x61,0x67,0x20,0x3d,0x20,0x46,0x6f,0x72,0x6d,0x61,0x74,0x45,0x54,0x61,0x67,0x28,0x65,0x74,0x61,0x67,0x29,0x0a,0x09,0x65,0x78,0x69,0x73,0x74,0x73,0x20,0x3a,0x3d,0x20,0x65,0x74,0x61,0x67,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7c,0x7c,0x20,0x21,0x6c,0x61,0x73,0x74,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x2e,0x49,0x73,0x5a,0x65,0x72,0x6f,0x28,0x29,0x0a,0x09,0x6c,0x61,0x73,0x74,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x20,0x3d,0x20,0x6c,0x61,0x73,0x74,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x2e,0x54,0x72,0x75,0x6e,0x63,0x61,0x74,0x65,0x28,0x74,0x69,0x6d,0x65,0x2e,0x53,0x65,0x63,0x6f,0x6e,0x64,0x29,0x0a,0x09,0x73,0x61,0x66,0x65,0x20,0x3a,0x3d,0x20,0x72,0x2e,0x4d,0x65,0x74,0x68,0x6f,0x64,0x20,0x3d,0x3d,0x20,0x68,0x74,0x74,0x70,0x2e,0x4d,0x65,0x74,0x68,0x6f,0x64,0x47,0x65,0x74,0x20,0x7c,0x7c,0x20,0x72,0x2e,0x4d,0x65,0x74,0x68,0x6f,0x64,0x20,0x3d,0x3d,0x20,0x68,0x74,0x74,0x70,0x2e,0x4d,0x65,0x74,0x68,0x6f,0x64,0x48,0x65,0x61,0x64,0x0a,0x0a,0x09,0x69,0x66,0x20,0x69,0x66,0x4d,0x61,0x74,0x63,0x68,0x20,0x3a,0x3d,0x20,0x72,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2e,0x47,0x65,0x74,0x28,0x49,0x66,0x4d,0x61,0x74,0x63,0x68,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x3b,0x20,0x69,0x66,0x4d,0x61,0x74,0x63,0x68,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x21,0x6d,0x61,0x74,0x63,0x68,0x45,0x54,0x61,0x67,0x28,0x69,0x66,0x4d,0x61,0x74,0x63,0x68,0x2c,0x20,0x65,0x74,0x61,0x67,0x2c,0x20,0x65,0x78,0x69,0x73,0x74,0x73,0x2c,0x20,0x74,0x72,0x75,0x65,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x50,0x72,0x65,0x63,0x6f,0x6e,0x64,0x69,0x74,0x69,0x6f,0x6e,0x46,0x61,0x69,0x6c,0x65,0x64,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x69,0x66,0x20,0x73,0x69,0x6e,0x63,0x65,0x20,0x3a,0x3d,0x20,0x50,0x61,0x72,0x73,0x65,0x48,0x54,0x54,0x50,0x54,0x69,0x6d,0x65,0x28,0x72,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2e,0x47,0x65,0x74,0x28,0x49,0x66,0x55,0x6e,0x6d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x53,0x69,0x6e,0x63,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x29,0x3b,0x20,0x21,0x73,0x69,0x6e,0x63,0x65,0x2e,0x49,0x73,0x5a,0x65,0x72,0x6f,0x28,0x29,0x20,0x26,0x26,0x20,0x21,0x6c,0x61,0x73,0x74,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x2e,0x49,0x73,0x5a,0x65,0x72,0x6f,0x28,0x29,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x6c,0x61,0x73,0x74,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x2e,0x41,0x66,0x74,0x65,0x72,0x28,0x73,0x69,0x6e,0x63,0x65,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x50,0x72,0x65,0x63,0x6f,0x6e,0x64,0x69,0x74,0x69,0x6f,0x6e,0x46,0x61,0x69,0x6c,0x65,0x64,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x69,0x66,0x20,0x69,0x66,0x4e,0x6f,0x6e,0x65,0x4d,0x61,0x74,0x63,0x68,0x20,0x3a,0x3d,0x20,0x72,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2e,0x47,0x65,0x74,0x28,0x49,0x66,0x4e,0x6f,0x6e,0x65,0x4d,0x61,0x74,0x63,0x68,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x3b,0x20,0x69,0x66,0x4e,0x6f,0x6e,0x65,0x4d,0x61,0x74,0x63,0x68,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x6d,0x61,0x74,0x63,0x68,0x45,0x54,0x61,0x67,0x28,0x69,0x66,0x4e,0x6f,0x6e,0x65,0x4d,0x61,0x74,0x63,0x68,0x2c,0x20,0x65,0x74,0x61,0x67,0x2c,0x20,0x65,0x78,0x69,0x73,0x74,0x73,0x2c,0x20,0x66,0x61,0x6c,0x73,0x65,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x73,0x61,0x66,0x65,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x4e,0x6f,0x74,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x50,0x72,0x65,0x63,0x6f,0x6e,0x64,0x69,0x74,0x69,0x6f,0x6e,0x46,0x61,0x69,0x6c,0x65,0x64,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x69,0x66,0x20,0x73,0x69,0x6e,0x63,0x65,0x20,0x3a,0x3d,0x20,0x50,0x61,0x72,0x73,0x65,0x48,0x54,0x54,0x50,0x54,0x69,0x6d,0x65,0x28,0x72,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2e,0x47,0x65,0x74,0x28,0x49,0x66,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x53,0x69,0x6e,0x63,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x29,0x3b,0x20,0x73,0x61,0x66,0x65,0x20,0x26,0x26,0x20,0x21,0x73,0x69,0x6e,0x63,0x65,0x2e,0x49,0x73,0x5a,0x65,0x72,0x6f,0x28,0x29,0x20,0x26,0x26,0x20,0x21,0x6c,0x61,0x73,0x74,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x2e,0x49,0x73,0x5a,0x65,0x72,0x6f,0x28,0x29,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x21,0x6c,0x61,0x73,0x74,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x2e,0x41,0x66,0x74,0x65,0x72,0x28,0x73,0x69,0x6e,0x63,0x65,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x4e,0x6f,0x74,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x30,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x6d,0x61,0x74,0x63,0x68,0x45,0x54,0x61,0x67,0x28,0x6c,0x69,0x73,0x74,0x2c,0x20,0x65,0x74,0x61,0x67,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x65,0x78,0x69,0x73,0x74,0x73,0x2c,0x20,0x73,0x74,0x72,0x6f,0x6e,0x67,0x20,0x62,0x6f,0x6f,0x6c,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x54,0x72,0x69,0x6d,0x53,0x70,0x61,0x63,0x65,0x28,0x6c,0x69,0x73,0x74,0x29,0x20,0x3d,0x3d,0x20,0x22,0x2a,0x22,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x78,0x69,0x73,0x74,0x73,0x0a,0x09,0x7d,0x0a,0x09,0x69,0x66,0x20,0x65,0x74,0x61,0x67,0x20,0x3d,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x61,0x6c,0x73,0x65,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x5f,0x2c,0x20,0x63,0x61,0x6e,0x64,0x69,0x64,0x61,0x74,0x65,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x73,0x70,0x6c,0x69,0x74,0x45,0x54,0x61,0x67,0x73,0x28,0x6c,0x69,0x73,0x74,0x29,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x73,0x74,0x72,0x6f,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x63,0x61,0x6e,0x64,0x69,0x64,0x61,0x74,0x65,0x20,0x3d,0x3d,0x20,0x65,0x74,0x61,0x67,0x20,0x26,0x26,0x20,0x21,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x48,0x61,0x73,0x50,0x72,0x65,0x66,0x69,0x78,0x28,0x65,0x74,0x61,0x67,0x2c,0x20,0x22,0x57,0x2f,0x22,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x74,0x72,0x75,0x65,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x69,0x66,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x54,0x72,0x69,0x6d,0x50,0x72,0x65,0x66,0x69,0x78,0x28,0x63,0x61,0x6e,0x64,0x69,0x64,0x61,0x74,0x65,0x2c,0x20,0x22,0x57,0x2f,0x22,0x29,0x20,0x3d,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x54,0x72,0x69,0x6d,0x50,0x72,0x65,0x66,0x69,0x78,0x28,0x65,0x74,0x61,0x67,0x2c,0x20,0x22,0x57,0x2f,0x22,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x74,0x72,0x75,0x65,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x61,0x6c,0x73,0x65,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x73,0x70,0x6c,0x69,0x74,0x45,0x54,0x61,0x67,0x73,0x28,0x6c,0x69,0x73,0x74,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x0a,0x09,0x76,0x61,0x72,0x20,0x28,0x0a,0x09,0x09,0x65,0x74,0x61,0x67,0x73,0x20,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x09,0x71,0x75,0x6f,0x74,0x65,0x64,0x20,0x62,0x6f,0x6f,0x6c,0x0a,0x09,0x09,0x73,0x74,0x61,0x72,0x74,0x20,0x20,0x69,0x6e,0x74,0x0a,0x09,0x29,0x0a,0x09,0x66,0x6f,0x72,0x20,0x69,0x20,0x3a,0x3d,0x20,0x30,0x3b,0x20,0x69,0x20,0x3c,0x20,0x6c,0x65,0x6e,0x28,0x6c,0x69,0x73,0x74,0x29,0x3b,0x20,0x69,0x2b,0x2b,0x20,0x7b,0x0a,0x09,0x09,0x73,0x77,0x69,0x74,0x63,0x68,0x20,0x6c,0x69,0x73,0x74,0x5b,0x69,0x5d,0x20,0x7b,0x0a,0x09,0x09,0x63,0x61,0x73,0x65,0x20,0x27,0x22,0x27,0x3a,0x0a,0x09,0x09,0x09,0x71,0x75,0x6f,0x74,0x65,0x64,0x20,0x3d,0x20,0x21,0x71,0x75,0x6f,0x74,0x65,0x64,0x0a,0x09,0x09,0x63,0x61,0x73,0x65,0x20,0x27,0x2c,0x27,0x3a,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x21,0x71,0x75,0x6f,0x74,0x65,0x64,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x65,0x74,0x61,0x67,0x73,0x20,0x3d,0x20,0x61,0x70,0x70,0x65,0x6e,0x64,0x28,0x65,0x74,0x61,0x67,0x73,0x2c,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x54,0x72,0x69,0x6d,0x53,0x70,0x61,0x63,0x65,0x28,0x6c,0x69,0x73,0x74,0x5b,0x73,0x74,0x61,0x72,0x74,0x3a,0x69,0x5d,0x29,0x29,0x0a,0x09,0x09,0x09,0x09,0x73,0x74,0x61,0x72,0x74,0x20,0x3d,0x20,0x69,0x20,0x2b,0x20,0x31,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x61,0x70,0x70,0x65,0x6e,0x64,0x28,0x65,0x74,0x61,0x67,0x73,0x2c,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x54,0x72,0x69,0x6d,0x53,0x70,0x61,0x63,0x65,0x28,0x6c,0x69,0x73,0x74,0x5b,0x73,0x74,0x61,0x72,0x74,0x3a,0x5d,0x29,0x29,0x0a,0x7d,0x0a,0x0a,0x63,0x6f,0x6e,0x73,0x74,0x20,0x28,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x2d,0x54,0x79,0x70,0x65,0x22,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x41,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x4a,0x73,0x6f,0x6e,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2f,0x6a,0x73,0x6f,0x6e,0x22,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x41,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x48,0x61,0x6c,0x4a,0x73,0x6f,0x6e,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2f,0x68,0x61,0x6c,0x2b,0x6a,0x73,0x6f,0x6e,0x22,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x54,0x65,0x78,0x74,0x50,0x6c,0x61,0x69,0x6e,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x74,0x65,0x78,0x74,0x2f,0x70,0x6c,0x61,0x69,0x6e,0x22,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x4d,0x75,0x6c,0x74,0x69,0x70,0x61,0x72,0x74,0x46,0x6f,0x72,0x6d,0x44,0x61,0x74,0x61,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x6d,0x75,0x6c,0x74,0x69,0x70,0x61,0x72,0x74,0x2f,0x66,0x6f,0x72,0x6d,0x2d,0x64,0x61,0x74,0x61,0x22,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x41,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x46,0x6f,0x72,0x6d,0x55,0x72,0x6c,0x65,0x6e,0x63,0x6f,0x64,0x65,0x64,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2f,0x78,0x2d,0x77,0x77,0x77,0x2d,0x66,0x6f,0x72,0x6d,0x2d,0x75,0x72,0x6c,0x65,0x6e,0x63,0x6f,0x64,0x65,0x64,0x22,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x41,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x58,0x6d,0x6c,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2f,0x78,0x6d,0x6c,0x22,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x54,0x65,0x78,0x74,0x58,0x6d,0x6c,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x74,0x65,0x78,0x74,0x2f,0x78,0x6d,0x6c,0x22,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x41,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x4e,0x64,0x6a,0x73,0x6f,0x6e,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2f,0x78,0x2d,0x6e,0x64,0x6a,0x73,0x6f,0x6e,0x22,0x0a,0x29,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x45,0x78,0x74,0x72,0x61,0x63,0x74,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x28,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x69,0x66,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x3d,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x22,0x22,0x0a,0x09,0x7d,0x0a,0x09,0x69,0x20,0x3a,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x49,0x6e,0x64,0x65,0x78,0x28,0x68,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x22,0x3b,0x22,0x29,0x0a,0x09,0x69,0x66,0x20,0x69,0x20,0x3d,0x3d,0x20,0x2d,0x31,0x20,0x7b,0x0a,0x09,0x09,0x69,0x20,0x3d,0x20,0x6c,0x65,0x6e,0x28,0x68,0x65,0x61,0x64,0x65,0x72,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x54,0x72,0x69,0x6d,0x53,0x70,0x61,0x63,0x65,0x28,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x54,0x6f,0x4c,0x6f,0x77,0x65,0x72,0x28,0x68,0x65,0x61,0x64,0x65,0x72,0x5b,0x3a,0x69,0x5d,0x29,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x49,0x6e,0x4c,0x69,0x73,0x74,0x28,0x74,0x79,0x70,0x65,0x73,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x74,0x79,0x70,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x20,0x7b,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x5f,0x2c,0x20,0x74,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x74,0x79,0x70,0x65,0x73,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x74,0x20,0x3d,0x3d,0x20,0x74,0x79,0x70,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x74,0x72,0x75,0x65,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x61,0x6c,0x73,0x65,0x0a,0x7d,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x4e,0x6f,0x74,0x53,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x28,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x20,0x69,0x6e,0x74,0x2c,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x4e,0x6f,0x74,0x53,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x7b,0x0a,0x09,0x09,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x3a,0x20,0x20,0x20,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x2c,0x0a,0x09,0x09,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x3a,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x2c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x4e,0x6f,0x74,0x53,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x20,0x69,0x6e,0x74,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x4e,0x6f,0x74,0x53,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x65,0x72,0x72,0x6f,0x72,0x20,0x75,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x20,0x6d,0x65,0x64,0x69,0x61,0x20,0x74,0x79,0x70,0x65,0x20,0x28,0x25,0x73,0x29,0x22,0x2c,0x20,0x65,0x2e,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x4e,0x6f,0x74,0x53,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x29,0x20,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x29,0x20,0x69,0x6e,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x2e,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x0a,0x7d,0x0a,0x0a,0x76,0x61,0x72,0x20,0x4e,0x65,0x77,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x4f,0x62,0x6a,0x65,0x63,0x74,0x49,0x73,0x4e,0x69,0x6c,0x45,0x72,0x72,0x6f,0x72,0x20,0x3d,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4e,0x65,0x77,0x28,0x22,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x6f,0x62,0x6a,0x65,0x63,0x74,0x20,0x69,0x73,0x20,0x6e,0x69,0x6c,0x22,0x29,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x45,0x72,0x72,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x28,0x63,0x6f,0x64,0x65,0x20,0x69,0x6e,0x74,0x29,0x20,0x2a,0x45,0x72,0x72,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x45,0x72,0x72,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x7b,0x0a,0x09,0x09,0x63,0x6f,0x64,0x65,0x3a,0x20,0x63,0x6f,0x64,0x65,0x2c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x45,0x72,0x72,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x63,0x6f,0x64,0x65,0x20,0x69,0x6e,0x74,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x72,0x72,0x20,0x2a,0x45,0x72,0x72,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x75,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x63,0x6f,0x64,0x65,0x20,0x27,0x25,0x64,0x27,0x22,0x2c,0x20,0x65,0x72,0x72,0x2e,0x63,0x6f,0x64,0x65,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x45,0x72,0x72,0x4f,0x6e,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x28,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x20,0x69,0x6e,0x74,0x29,0x20,0x2a,0x45,0x72,0x72,0x4f,0x6e,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x45,0x72,0x72,0x4f,0x6e,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x7b,0x0a,0x09,0x09,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x3a,0x20,0x20,0x20,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x2c,0x0a,0x09,0x09,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x3a,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x2c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x45,0x72,0x72,0x4f,0x6e,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x20,0x69,0x6e,0x74,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x72,0x72,0x20,0x2a,0x45,0x72,0x72,0x4f,0x6e,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x65,0x72,0x72,0x2e,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x72,0x72,0x20,0x2a,0x45,0x72,0x72,0x4f,0x6e,0x55,0x6e,0x6b,0x6e,0x6f,0x77,0x6e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x43,0x6f,0x64,0x65,0x29,0x20,0x55,0x6e,0x65,0x78,0x70,0x65,0x63,0x74,0x65,0x64,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x29,0x20,0x69,0x6e,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x2e,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x45,0x72,0x72,0x6f,0x72,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x20,0x7b,0x0a,0x09,0x65,0x72,0x72,0x6f,0x72,0x0a,0x09,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x29,0x20,0x69,0x6e,0x74,0x0a,0x09,0x4f,0x70,0x65,0x72,0x61,0x74,0x69,0x6f,0x6e,0x49,0x44,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x45,0x72,0x72,0x6f,0x72,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x28,0x6f,0x70,0x65,0x72,0x61,0x74,0x69,0x6f,0x6e,0x49,0x44,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x20,0x69,0x6e,0x74,0x2c,0x20,0x62,0x6f,0x64,0x79,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x0a,0x09,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x3a,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x73,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x64,0x65,0x64,0x20,0x77,0x69,0x74,0x68,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x63,0x6f,0x64,0x65,0x20,0x25,0x64,0x20,0x28,0x25,0x73,0x29,0x22,0x2c,0x20,0x6f,0x70,0x65,0x72,0x61,0x74,0x69,0x6f,0x6e,0x49,0x44,0x2c,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x2c,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x54,0x65,0x78,0x74,0x28,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x29,0x29,0x0a,0x09,0x69,0x66,0x20,0x62,0x6f,0x64,0x79,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x0a,0x09,0x7d,0x0a,0x09,0x69,0x66,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x6a,0x73,0x6f,0x6e,0x2e,0x4d,0x61,0x72,0x73,0x68,0x61,0x6c,0x28,0x62,0x6f,0x64,0x79,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x2b,0x20,0x22,0x3a,0x20,0x22,0x20,0x2b,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x28,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x2b,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x3a,0x20,0x25,0x2b,0x76,0x22,0x2c,0x20,0x62,0x6f,0x64,0x79,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x75,0x6e,0x65,0x78,0x70,0x65,0x63,0x74,0x65,0x64,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x45,0x72,0x72,0x6f,0x72,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x20,0x7b,0x0a,0x09,0x65,0x72,0x72,0x6f,0x72,0x0a,0x09,0x55,0x6e,0x65,0x78,0x70,0x65,0x63,0x74,0x65,0x64,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x29,0x20,0x69,0x6e,0x74,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x28,0x69,0x6e,0x74,0x2c,0x20,0x62,0x6f,0x6f,0x6c,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x76,0x61,0x72,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x45,0x72,0x72,0x20,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x45,0x72,0x72,0x6f,0x72,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x41,0x73,0x28,0x65,0x72,0x72,0x2c,0x20,0x26,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x45,0x72,0x72,0x29,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x45,0x72,0x72,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x29,0x2c,0x20,0x74,0x72,0x75,0x65,0x0a,0x09,0x7d,0x0a,0x09,0x76,0x61,0x72,0x20,0x75,0x6e,0x65,0x78,0x70,0x65,0x63,0x74,0x65,0x64,0x45,0x72,0x72,0x20,0x75,0x6e,0x65,0x78,0x70,0x65,0x63,0x74,0x65,0x64,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x45,0x72,0x72,0x6f,0x72,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x41,0x73,0x28,0x65,0x72,0x72,0x2c,0x20,0x26,0x75,0x6e,0x65,0x78,0x70,0x65,0x63,0x74,0x65,0x64,0x45,0x72,0x72,0x29,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x75,0x6e,0x65,0x78,0x70,0x65,0x63,0x74,0x65,0x64,0x45,0x72,0x72,0x2e,0x55,0x6e,0x65,0x78,0x70,0x65,0x63,0x74,0x65,0x64,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x29,0x2c,0x20,0x74,0x72,0x75,0x65,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x30,0x2c,0x20,0x66,0x61,0x6c,0x73,0x65,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x49,0x73,0x42,0x61,0x64,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x28,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x61,0x73,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x65,0x72,0x72,0x2c,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x42,0x61,0x64,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x49,0x73,0x55,0x6e,0x61,0x75,0x74,0x68,0x6f,0x72,0x69,0x7a,0x65,0x64,0x28,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x61,0x73,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x65,0x72,0x72,0x2c,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x55,0x6e,0x61,0x75,0x74,0x68,0x6f,0x72,0x69,0x7a,0x65,0x64,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x49,0x73,0x46,0x6f,0x72,0x62,0x69,0x64,0x64,0x65,0x6e,0x28,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x61,0x73,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x65,0x72,0x72,0x2c,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x46,0x6f,0x72,0x62,0x69,0x64,0x64,0x65,0x6e,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x49,0x73,0x4e,0x6f,0x74,0x46,0x6f,0x75,0x6e,0x64,0x28,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x61,0x73,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x65,0x72,0x72,0x2c,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x4e,0x6f,0x74,0x46,0x6f,0x75,0x6e,0x64,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x49,0x73,0x43,0x6f,0x6e,0x66,0x6c,0x69,0x63,0x74,0x28,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x61,0x73,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x65,0x72,0x72,0x2c,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x6e,0x66,0x6c,0x69,0x63,0x74,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x49,0x73,0x50,0x72,0x65,0x63,0x6f,0x6e,0x64,0x69,0x74,0x69,0x6f,0x6e,0x46,0x61,0x69,0x6c,0x65,0x64,0x28,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x61,0x73,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x65,0x72,0x72,0x2c,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x50,0x72,0x65,0x63,0x6f,0x6e,0x64,0x69,0x74,0x69,0x6f,0x6e,0x46,0x61,0x69,0x6c,0x65,0x64,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x49,0x73,0x54,0x6f,0x6f,0x4d,0x61,0x6e,0x79,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x73,0x28,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x61,0x73,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x65,0x72,0x72,0x2c,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x54,0x6f,0x6f,0x4d,0x61,0x6e,0x79,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x73,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x49,0x73,0x53,0x65,0x72,0x76,0x65,0x72,0x45,0x72,0x72,0x6f,0x72,0x28,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x20,0x7b,0x0a,0x09,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x2c,0x20,0x6f,0x6b,0x20,0x3a,0x3d,0x20,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x65,0x72,0x72,0x29,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6f,0x6b,0x20,0x26,0x26,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x20,0x3e,0x3d,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x49,0x6e,0x74,0x65,0x72,0x6e,0x61,0x6c,0x53,0x65,0x72,0x76,0x65,0x72,0x45,0x72,0x72,0x6f,0x72,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x68,0x61,0x73,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x2c,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x20,0x69,0x6e,0x74,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x20,0x7b,0x0a,0x09,0x63,0x6f,0x64,0x65,0x2c,0x20,0x6f,0x6b,0x20,0x3a,0x3d,0x20,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x65,0x72,0x72,0x29,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6f,0x6b,0x20,0x26,0x26,0x20,0x63,0x6f,0x64,0x65,0x20,0x3d,0x3d,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x0a,0x7d,0x0a,0x0a,0x63,0x6f,0x6e,0x73,0x74,0x20,0x28,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x54,0x65,0x78,0x74,0x45,0x76,0x65,0x6e,0x74,0x53,0x74,0x72,0x65,0x61,0x6d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x74,0x65,0x78,0x74,0x2f,0x65,0x76,0x65,0x6e,0x74,0x2d,0x73,0x74,0x72,0x65,0x61,0x6d,0x22,0x0a,0x09,0x4c,0x61,0x73,0x74,0x45,0x76,0x65,0x6e,0x74,0x49,0x44,0x48,0x65,0x61,0x64,0x65,0x72,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x4c,0x61,0x73,0x74,0x2d,0x45,0x76,0x65,0x6e,0x74,0x2d,0x49,0x44,0x22,0x0a,0x29,0x0a,0x0a,0x76,0x61,0x72,0x20,0x45,0x72,0x72,0x53,0x74,0x72,0x65,0x61,0x6d,0x69,0x6e,0x67,0x4e,0x6f,0x74,0x53,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x20,0x3d,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4e,0x65,0x77,0x28,0x22,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x20,0x77,0x72,0x69,0x74,0x65,0x72,0x20,0x64,0x6f,0x65,0x73,0x20,0x6e,0x6f,0x74,0x20,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x20,0x73,0x74,0x72,0x65,0x61,0x6d,0x69,0x6e,0x67,0x22,0x29,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x53,0x65,0x72,0x76,0x65,0x72,0x53,0x65,0x6e,0x74,0x45,0x76,0x65,0x6e,0x74,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x4e,0x61,0x6d,0x65,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x49,0x44,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x44,0x61,0x74,0x61,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x52,0x65,0x74,0x72,0x79,0x20,0x74,0x69,0x6d,0x65,0x2e,0x44,0x75,0x72,0x61,0x74,0x69,0x6f,0x6e,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x45,0x76,0x65,0x6e,0x74,0x53,0x74,0x72,0x65,0x61,0x6d,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x77,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x57,0x72,0x69,0x74,0x65,0x72,0x0a,0x09,0x72,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x0a,0x09,0x73,0x74,0x61,0x72,0x74,0x65,0x64,0x20,0x62,0x6f,0x6f,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x45,0x76,0x65,0x6e,0x74,0x53,0x74,0x72,0x65,0x61,0x6d,0x28,0x77,0x20,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x57,0x72,0x69,0x74,0x65,0x72,0x2c,0x20,0x72,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x29,0x20,0x2a,0x45,0x76,0x65,0x6e,0x74,0x53,0x74,0x72,0x65,0x61,0x6d,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x45,0x76,0x65,0x6e,0x74,0x53,0x74,0x72,0x65,0x61,0x6d,0x7b,0x77,0x3a,0x20,0x77,0x2c,0x20,0x72,0x3a,0x20,0x72,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x73,0x20,0x2a,0x45,0x76,0x65,0x6e,0x74,0x53,0x74,0x72,0x65,0x61,0x6d,0x29,0x20,0x53,0x65,0x6e,0x64,0x28,0x6e,0x61,0x6d,0x65,0x2c,0x20,0x69,0x64,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x64,0x61,0x74,0x61,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x2c,0x20,0x72,0x65,0x74,0x72,0x79,0x20,0x74,0x69,0x6d,0x65,0x2e,0x44,0x75,0x72,0x61,0x74,0x69,0x6f,0x6e,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x73,0x2e,0x72,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x28,0x29,0x2e,0x45,0x72,0x72,0x28,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x66,0x6c,0x75,0x73,0x68,0x65,0x72,0x2c,0x20,0x6f,0x6b,0x20,0x3a,0x3d,0x20,0x73,0x2e,0x77,0x2e,0x28,0x68,0x74,0x74,0x70,0x2e,0x46,0x6c,0x75,0x73,0x68,0x65,0x72,0x29,0x0a,0x09,0x69,0x66,0x20,0x21,0x6f,0x6b,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x45,0x72,0x72,0x53,0x74,0x72,0x65,0x61,0x6d,0x69,0x6e,0x67,0x4e,0x6f,0x74,0x53,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x65,0x6e,0x63,0x6f,0x64,0x65,0x64,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x6a,0x73,0x6f,0x6e,0x2e,0x4d,0x61,0x72,0x73,0x68,0x61,0x6c,0x28,0x64,0x61,0x74,0x61,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x69,0x66,0x20,0x21,0x73,0x2e,0x73,0x74,0x61,0x72,0x74,0x65,0x64,0x20,0x7b,0x0a,0x0a,0x09,0x09,0x73,0x2e,0x77,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x28,0x29,0x5b,0x22,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x2d,0x54,0x79,0x70,0x65,0x22,0x5d,0x20,0x3d,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x7b,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x54,0x65,0x78,0x74,0x45,0x76,0x65,0x6e,0x74,0x53,0x74,0x72,0x65,0x61,0x6d,0x7d,0x0a,0x09,0x09,0x73,0x2e,0x77,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x28,0x29,0x5b,0x22,0x43,0x61,0x63,0x68,0x65,0x2d,0x43,0x6f,0x6e,0x74,0x72,0x6f,0x6c,0x22,0x5d,0x20,0x3d,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x7b,0x22,0x6e,0x6f,0x2d,0x63,0x61,0x63,0x68,0x65,0x22,0x7d,0x0a,0x09,0x09,0x73,0x2e,0x77,0x2e,0x57,0x72,0x69,0x74,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x28,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x4f,0x4b,0x29,0x0a,0x09,0x09,0x73,0x2e,0x73,0x74,0x61,0x72,0x74,0x65,0x64,0x20,0x3d,0x20,0x74,0x72,0x75,0x65,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x76,0x61,0x72,0x20,0x65,0x76,0x65,0x6e,0x74,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x42,0x75,0x69,0x6c,0x64,0x65,0x72,0x0a,0x09,0x69,0x66,0x20,0x6e,0x61,0x6d,0x65,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x66,0x6d,0x74,0x2e,0x46,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x26,0x65,0x76,0x65,0x6e,0x74,0x2c,0x20,0x22,0x65,0x76,0x65,0x6e,0x74,0x3a,0x20,0x25,0x73,0x5c,0x6e,0x22,0x2c,0x20,0x73,0x74,0x72,0x69,0x70,0x4c,0x69,0x6e,0x65,0x42,0x72,0x65,0x61,0x6b,0x73,0x28,0x6e,0x61,0x6d,0x65,0x29,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x69,0x66,0x20,0x69,0x64,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x66,0x6d,0x74,0x2e,0x46,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x26,0x65,0x76,0x65,0x6e,0x74,0x2c,0x20,0x22,0x69,0x64,0x3a,0x20,0x25,0x73,0x5c,0x6e,0x22,0x2c,0x20,0x73,0x74,0x72,0x69,0x70,0x4c,0x69,0x6e,0x65,0x42,0x72,0x65,0x61,0x6b,0x73,0x28,0x69,0x64,0x29,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x69,0x66,0x20,0x72,0x65,0x74,0x72,0x79,0x20,0x3e,0x20,0x30,0x20,0x7b,0x0a,0x09,0x09,0x66,0x6d,0x74,0x2e,0x46,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x26,0x65,0x76,0x65,0x6e,0x74,0x2c,0x20,0x22,0x72,0x65,0x74,0x72,0x79,0x3a,0x20,0x25,0x64,0x5c,0x6e,0x22,0x2c,0x20,0x72,0x65,0x74,0x72,0x79,0x2e,0x4d,0x69,0x6c,0x6c,0x69,0x73,0x65,0x63,0x6f,0x6e,0x64,0x73,0x28,0x29,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x66,0x6d,0x74,0x2e,0x46,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x26,0x65,0x76,0x65,0x6e,0x74,0x2c,0x20,0x22,0x64,0x61,0x74,0x61,0x3a,0x20,0x25,0x73,0x5c,0x6e,0x5c,0x6e,0x22,0x2c,0x20,0x65,0x6e,0x63,0x6f,0x64,0x65,0x64,0x29,0x0a,0x0a,0x09,0x69,0x66,0x20,0x5f,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x69,0x6f,0x2e,0x57,0x72,0x69,0x74,0x65,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x73,0x2e,0x77,0x2c,0x20,0x65,0x76,0x65,0x6e,0x74,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x29,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x09,0x66,0x6c,0x75,0x73,0x68,0x65,0x72,0x2e,0x46,0x6c,0x75,0x73,0x68,0x28,0x29,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x73,0x20,0x2a,0x45,0x76,0x65,0x6e,0x74,0x53,0x74,0x72,0x65,0x61,0x6d,0x29,0x20,0x53,0x74,0x61,0x72,0x74,0x65,0x64,0x28,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x73,0x2e,0x73,0x74,0x61,0x72,0x74,0x65,0x64,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x73,0x74,0x72,0x69,0x70,0x4c,0x69,0x6e,0x65,0x42,0x72,0x65,0x61,0x6b,0x73,0x28,0x73,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x4e,0x65,0x77,0x52,0x65,0x70,0x6c,0x61,0x63,0x65,0x72,0x28,0x22,0x5c,0x72,0x22,0x2c,0x20,0x22,0x22,0x2c,0x20,0x22,0x5c,0x6e,0x22,0x2c,0x20,0x22,0x22,0x29,0x2e,0x52,0x65,0x70,0x6c,0x61,0x63,0x65,0x28,0x73,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x45,0x76,0x65,0x6e,0x74,0x52,0x65,0x61,0x64,0x65,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x62,0x6f,0x64,0x79,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x69,0x6f,0x2e,0x52,0x65,0x61,0x64,0x43,0x6c,0x6f,0x73,0x65,0x72,0x0a,0x09,0x72,0x65,0x61,0x64,0x65,0x72,0x20,0x20,0x20,0x20,0x20,0x20,0x2a,0x62,0x75,0x66,0x69,0x6f,0x2e,0x52,0x65,0x61,0x64,0x65,0x72,0x0a,0x09,0x6c,0x61,0x73,0x74,0x45,0x76,0x65,0x6e,0x74,0x49,0x44,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x45,0x76,0x65,0x6e,0x74,0x52,0x65,0x61,0x64,0x65,0x72,0x28,0x62,0x6f,0x64,0x79,0x20,0x69,0x6f,0x2e,0x52,0x65,0x61,0x64,0x43,0x6c,0x6f,0x73,0x65,0x72,0x29,0x20,0x2a,0x45,0x76,0x65,0x6e,0x74,0x52,0x65,0x61,0x64,0x65,0x72,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x45,0x76,0x65,0x6e,0x74,0x52,0x65,0x61,0x64,0x65,0x72,0x7b,0x62,0x6f,0x64,0x79,0x3a,0x20,0x62,0x6f,0x64,0x79,0x2c,0x20,0x72,0x65,0x61,0x64,0x65,0x72,0x3a,0x20,0x62,0x75,0x66,0x69,0x6f,0x2e,0x4e,0x65,0x77,0x52,0x65,0x61,0x64,0x65,0x72,0x28,0x62,0x6f,0x64,0x79,0x29,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x72,0x20,0x2a,0x45,0x76,0x65,0x6e,0x74,0x52,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x4e,0x65,0x78,0x74,0x28,0x29,0x20,0x28,0x2a,0x53,0x65,0x72,0x76,0x65,0x72,0x53,0x65,0x6e,0x74,0x45,0x76,0x65,0x6e,0x74,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x65,0x76,0x65,0x6e,0x74,0x20,0x3a,0x3d,0x20,0x6e,0x65,0x77,0x28,0x53,0x65,0x72,0x76,0x65,0x72,0x53,0x65,0x6e,0x74,0x45,0x76,0x65,0x6e,0x74,0x29,0x0a,0x09,0x76,0x61,0x72,0x20,0x64,0x61,0x74,0x61,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x09,0x6c,0x69,0x6e,0x65,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x72,0x2e,0x72,0x65,0x61,0x64,0x65,0x72,0x2e,0x52,0x65,0x61,0x64,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x27,0x5c,0x6e,0x27,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x26,0x26,0x20,0x28,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x69,0x6f,0x2e,0x45,0x4f,0x46,0x20,0x7c,0x7c,0x20,0x6c,0x69,0x6e,0x65,0x20,0x3d,0x3d,0x20,0x22,0x22,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x6c,0x69,0x6e,0x65,0x20,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x54,0x72,0x69,0x6d,0x52,0x69,0x67,0x68,0x74,0x28,0x6c,0x69,0x6e,0x65,0x2c,0x20,0x22,0x5c,0x72,0x5c,0x6e,0x22,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x6c,0x69,0x6e,0x65,0x20,0x3d,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x6c,0x65,0x6e,0x28,0x64,0x61,0x74,0x61,0x29,0x20,0x3d,0x3d,0x20,0x30,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x65,0x76,0x65,0x6e,0x74,0x20,0x3d,0x20,0x6e,0x65,0x77,0x28,0x53,0x65,0x72,0x76,0x65,0x72,0x53,0x65,0x6e,0x74,0x45,0x76,0x65,0x6e,0x74,0x29,0x0a,0x09,0x09,0x09,0x09,0x63,0x6f,0x6e,0x74,0x69,0x6e,0x75,0x65,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x09,0x65,0x76,0x65,0x6e,0x74,0x2e,0x49,0x44,0x20,0x3d,0x20,0x72,0x2e,0x6c,0x61,0x73,0x74,0x45,0x76,0x65,0x6e,0x74,0x49,0x44,0x0a,0x09,0x09,0x09,0x65,0x76,0x65,0x6e,0x74,0x2e,0x44,0x61,0x74,0x61,0x20,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x4a,0x6f,0x69,0x6e,0x28,0x64,0x61,0x74,0x61,0x2c,0x20,0x22,0x5c,0x6e,0x22,0x29,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x76,0x65,0x6e,0x74,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x69,0x66,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x48,0x61,0x73,0x50,0x72,0x65,0x66,0x69,0x78,0x28,0x6c,0x69,0x6e,0x65,0x2c,0x20,0x22,0x3a,0x22,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x63,0x6f,0x6e,0x74,0x69,0x6e,0x75,0x65,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x66,0x69,0x65,0x6c,0x64,0x2c,0x20,0x76,0x61,0x6c,0x75,0x65,0x20,0x3a,0x3d,0x20,0x6c,0x69,0x6e,0x65,0x2c,0x20,0x22,0x22,0x0a,0x09,0x09,0x69,0x66,0x20,0x69,0x20,0x3a,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x49,0x6e,0x64,0x65,0x78,0x28,0x6c,0x69,0x6e,0x65,0x2c,0x20,0x22,0x3a,0x22,0x29,0x3b,0x20,0x69,0x20,0x3e,0x3d,0x20,0x30,0x20,0x7b,0x0a,0x09,0x09,0x09,0x66,0x69,0x65,0x6c,0x64,0x2c,0x20,0x76,0x61,0x6c,0x75,0x65,0x20,0x3d,0x20,0x6c,0x69,0x6e,0x65,0x5b,0x3a,0x69,0x5d,0x2c,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x54,0x72,0x69,0x6d,0x50,0x72,0x65,0x66,0x69,0x78,0x28,0x6c,0x69,0x6e,0x65,0x5b,0x69,0x2b,0x31,0x3a,0x5d,0x2c,0x20,0x22,0x20,0x22,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x73,0x77,0x69,0x74,0x63,0x68,0x20,0x66,0x69,0x65,0x6c,0x64,0x20,0x7b,0x0a,0x09,0x09,0x63,0x61,0x73,0x65,0x20,0x22,0x65,0x76,0x65,0x6e,0x74,0x22,0x3a,0x0a,0x09,0x09,0x09,0x65,0x76,0x65,0x6e,0x74,0x2e,0x4e,0x61,0x6d,0x65,0x20,0x3d,0x20,0x76,0x61,0x6c,0x75,0x65,0x0a,0x09,0x09,0x63,0x61,0x73,0x65,0x20,0x22,0x64,0x61,0x74,0x61,0x22,0x3a,0x0a,0x09,0x09,0x09,0x64,0x61,0x74,0x61,0x20,0x3d,0x20,0x61,0x70,0x70,0x65,0x6e,0x64,0x28,0x64,0x61,0x74,0x61,0x2c,0x20,0x76,0x61,0x6c,0x75,0x65,0x29,0x0a,0x09,0x09,0x63,0x61,0x73,0x65,0x20,0x22,0x69,0x64,0x22,0x3a,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x21,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x43,0x6f,0x6e,0x74,0x61,0x69,0x6e,0x73,0x28,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x22,0x5c,0x78,0x30,0x30,0x22,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x2e,0x6c,0x61,0x73,0x74,0x45,0x76,0x65,0x6e,0x74,0x49,0x44,0x20,0x3d,0x20,0x76,0x61,0x6c,0x75,0x65,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x63,0x61,0x73,0x65,0x20,0x22,0x72,0x65,0x74,0x72,0x79,0x22,0x3a,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x72,0x65,0x74,0x72,0x79,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x50,0x61,0x72,0x73,0x65,0x49,0x6e,0x74,0x28,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x31,0x30,0x2c,0x20,0x36,0x34,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x65,0x76,0x65,0x6e,0x74,0x2e,0x52,0x65,0x74,0x72,0x79,0x20,0x3d,0x20,0x74,0x69,0x6d,0x65,0x2e,0x44,0x75,0x72,0x61,0x74,0x69,0x6f,0x6e,0x28,0x72,0x65,0x74,0x72,0x79,0x29,0x20,0x2a,0x20,0x74,0x69,0x6d,0x65,0x2e,0x4d,0x69,0x6c,0x6c,0x69,0x73,0x65,0x63,0x6f,0x6e,0x64,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x72,0x20,0x2a,0x45,0x76,0x65,0x6e,0x74,0x52,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x4c,0x61,0x73,0x74,0x45,0x76,0x65,0x6e,0x74,0x49,0x44,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x72,0x2e,0x6c,0x61,0x73,0x74,0x45,0x76,0x65,0x6e,0x74,0x49,0x44,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x72,0x20,0x2a,0x45,0x76,0x65,0x6e,0x74,0x52,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x43,0x6c,0x6f,0x73,0x65,0x28,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x72,0x2e,0x62,0x6f,0x64,0x79,0x2e,0x43,0x6c,0x6f,0x73,0x65,0x28,0x29,0x0a,0x7d,0x0a,0x66,0x75,0x6e,0x63,0x20,0x53,0x65,0x72,0x76,0x65,0x4a,0x73,0x6f,0x6e,0x28,0x77,0x20,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x57,0x72,0x69,0x74,0x65,0x72,0x2c,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x69,0x6e,0x74,0x2c,0x20,0x76,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x77,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x28,0x29,0x5b,0x22,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x2d,0x54,0x79,0x70,0x65,0x22,0x5d,0x20,0x3d,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x7b,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2f,0x6a,0x73,0x6f,0x6e,0x22,0x7d,0x0a,0x09,0x77,0x2e,0x57,0x72,0x69,0x74,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x28,0x73,0x74,0x61,0x74,0x75,0x73,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x6a,0x73,0x6f,0x6e,0x2e,0x4e,0x65,0x77,0x45,0x6e,0x63,0x6f,0x64,0x65,0x72,0x28,0x77,0x29,0x2e,0x45,0x6e,0x63,0x6f,0x64,0x65,0x28,0x76,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x53,0x65,0x72,0x76,0x65,0x48,0x61,0x6c,0x4a,0x73,0x6f,0x6e,0x28,0x77,0x20,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x57,0x72,0x69,0x74,0x65,0x72,0x2c,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x69,0x6e,0x74,0x2c,0x20,0x76,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x77,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x28,0x29,0x5b,0x22,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x2d,0x54,0x79,0x70,0x65,0x22,0x5d,0x20,0x3d,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x7b,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2f,0x68,0x61,0x6c,0x2b,0x6a,0x73,0x6f,0x6e,0x22,0x7d,0x0a,0x09,0x77,0x2e,0x57,0x72,0x69,0x74,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x28,0x73,0x74,0x61,0x74,0x75,0x73,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x6a,0x73,0x6f,0x6e,0x2e,0x4e,0x65,0x77,0x45,0x6e,0x63,0x6f,0x64,0x65,0x72,0x28,0x77,0x29,0x2e,0x45,0x6e,0x63,0x6f,0x64,0x65,0x28,0x76,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x49,0x74,0x65,0x6d,0x50,0x72,0x6f,0x64,0x75,0x63,0x65,0x72,0x20,0x66,0x75,0x6e,0x63,0x28,0x29,0x20,0x28,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x53,0x65,0x72,0x76,0x65,0x4e,0x64,0x6a,0x73,0x6f,0x6e,0x28,0x77,0x20,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x57,0x72,0x69,0x74,0x65,0x72,0x2c,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x69,0x6e,0x74,0x2c,0x20,0x6e,0x65,0x78,0x74,0x20,0x49,0x74,0x65,0x6d,0x50,0x72,0x6f,0x64,0x75,0x63,0x65,0x72,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x77,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x28,0x29,0x5b,0x22,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x2d,0x54,0x79,0x70,0x65,0x22,0x5d,0x20,0x3d,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x7b,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2f,0x78,0x2d,0x6e,0x64,0x6a,0x73,0x6f,0x6e,0x22,0x7d,0x0a,0x09,0x77,0x2e,0x57,0x72,0x69,0x74,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x28,0x73,0x74,0x61,0x74,0x75,0x73,0x29,0x0a,0x0a,0x09,0x65,0x6e,0x63,0x6f,0x64,0x65,0x72,0x20,0x3a,0x3d,0x20,0x6a,0x73,0x6f,0x6e,0x2e,0x4e,0x65,0x77,0x45,0x6e,0x63,0x6f,0x64,0x65,0x72,0x28,0x77,0x29,0x0a,0x09,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x73,0x74,0x72,0x65,0x61,0x6d,0x49,0x74,0x65,0x6d,0x73,0x28,0x77,0x2c,0x20,0x6e,0x65,0x78,0x74,0x2c,0x20,0x66,0x75,0x6e,0x63,0x28,0x69,0x74,0x65,0x6d,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x2c,0x20,0x66,0x69,0x72,0x73,0x74,0x20,0x62,0x6f,0x6f,0x6c,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x6e,0x63,0x6f,0x64,0x65,0x72,0x2e,0x45,0x6e,0x63,0x6f,0x64,0x65,0x28,0x69,0x74,0x65,0x6d,0x29,0x0a,0x09,0x7d,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x78,0x68,0x74,0x74,0x70,0x65,0x72,0x72,0x6f,0x72,0x2e,0x53,0x74,0x72,0x65,0x61,0x6d,0x45,0x72,0x72,0x6f,0x72,0x7b,0x45,0x72,0x72,0x3a,0x20,0x65,0x72,0x72,0x7d,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x53,0x65,0x72,0x76,0x65,0x4a,0x73,0x6f,0x6e,0x41,0x72,0x72,0x61,0x79,0x28,0x77,0x20,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x57,0x72,0x69,0x74,0x65,0x72,0x2c,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x69,0x6e,0x74,0x2c,0x20,0x6e,0x65,0x78,0x74,0x20,0x49,0x74,0x65,0x6d,0x50,0x72,0x6f,0x64,0x75,0x63,0x65,0x72,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x77,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x28,0x29,0x5b,0x22,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x2d,0x54,0x79,0x70,0x65,0x22,0x5d,0x20,0x3d,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x7b,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2f,0x6a,0x73,0x6f,0x6e,0x22,0x7d,0x0a,0x09,0x77,0x2e,0x57,0x72,0x69,0x74,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x28,0x73,0x74,0x61,0x74,0x75,0x73,0x29,0x0a,0x0a,0x09,0x69,0x66,0x20,0x5f,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x69,0x6f,0x2e,0x57,0x72,0x69,0x74,0x65,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x77,0x2c,0x20,0x22,0x5b,0x22,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x78,0x68,0x74,0x74,0x70,0x65,0x72,0x72,0x6f,0x72,0x2e,0x53,0x74,0x72,0x65,0x61,0x6d,0x45,0x72,0x72,0x6f,0x72,0x7b,0x45,0x72,0x72,0x3a,0x20,0x65,0x72,0x72,0x7d,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x73,0x74,0x72,0x65,0x61,0x6d,0x49,0x74,0x65,0x6d,0x73,0x28,0x77,0x2c,0x20,0x6e,0x65,0x78,0x74,0x2c,0x20,0x66,0x75,0x6e,0x63,0x28,0x69,0x74,0x65,0x6d,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x2c,0x20,0x66,0x69,0x72,0x73,0x74,0x20,0x62,0x6f,0x6f,0x6c,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x21,0x66,0x69,0x72,0x73,0x74,0x20,0x7b,0x0a,0x09,0x09,0x09,0x69,0x66,0x20,0x5f,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x69,0x6f,0x2e,0x57,0x72,0x69,0x74,0x65,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x77,0x2c,0x20,0x22,0x2c,0x22,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x09,0x7d,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x64,0x61,0x74,0x61,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x6a,0x73,0x6f,0x6e,0x2e,0x4d,0x61,0x72,0x73,0x68,0x61,0x6c,0x28,0x69,0x74,0x65,0x6d,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x5f,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x77,0x2e,0x57,0x72,0x69,0x74,0x65,0x28,0x64,0x61,0x74,0x61,0x29,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x78,0x68,0x74,0x74,0x70,0x65,0x72,0x72,0x6f,0x72,0x2e,0x53,0x74,0x72,0x65,0x61,0x6d,0x45,0x72,0x72,0x6f,0x72,0x7b,0x45,0x72,0x72,0x3a,0x20,0x65,0x72,0x72,0x7d,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x69,0x66,0x20,0x5f,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x69,0x6f,0x2e,0x57,0x72,0x69,0x74,0x65,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x77,0x2c,0x20,0x22,0x5d,0x5c,0x6e,0x22,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x78,0x68,0x74,0x74,0x70,0x65,0x72,0x72,0x6f,0x72,0x2e,0x53,0x74,0x72,0x65,0x61,0x6d,0x45,0x72,0x72,0x6f,0x72,0x7b,0x45,0x72,0x72,0x3a,0x20,0x65,0x72,0x72,0x7d,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x73,0x74,0x72,0x65,0x61,0x6d,0x49,0x74,0x65,0x6d,0x73,0x28,0x77,0x20,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x57,0x72,0x69,0x74,0x65,0x72,0x2c,0x20,0x6e,0x65,0x78,0x74,0x20,0x49,0x74,0x65,0x6d,0x50,0x72,0x6f,0x64,0x75,0x63,0x65,0x72,0x2c,0x20,0x77,0x72,0x69,0x74,0x65,0x20,0x66,0x75,0x6e,0x63,0x28,0x69,0x74,0x65,0x6d,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x2c,0x20,0x66,0x69,0x72,0x73,0x74,0x20,0x62,0x6f,0x6f,0x6c,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x66,0x6c,0x75,0x73,0x68,0x65,0x72,0x2c,0x20,0x5f,0x20,0x3a,0x3d,0x20,0x77,0x2e,0x28,0x68,0x74,0x74,0x70,0x2e,0x46,0x6c,0x75,0x73,0x68,0x65,0x72,0x29,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x66,0x69,0x72,0x73,0x74,0x20,0x3a,0x3d,0x20,0x74,0x72,0x75,0x65,0x3b,0x20,0x3b,0x20,0x66,0x69,0x72,0x73,0x74,0x20,0x3d,0x20,0x66,0x61,0x6c,0x73,0x65,0x20,0x7b,0x0a,0x09,0x09,0x69,0x74,0x65,0x6d,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x6e,0x65,0x78,0x74,0x28,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3d,0x3d,0x20,0x69,0x6f,0x2e,0x45,0x4f,0x46,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x09,0x7d,0x20,0x65,0x6c,0x73,0x65,0x20,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x77,0x72,0x69,0x74,0x65,0x28,0x69,0x74,0x65,0x6d,0x2c,0x20,0x66,0x69,0x72,0x73,0x74,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x69,0x66,0x20,0x66,0x6c,0x75,0x73,0x68,0x65,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x66,0x6c,0x75,0x73,0x68,0x65,0x72,0x2e,0x46,0x6c,0x75,0x73,0x68,0x28,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x53,0x6c,0x69,0x63,0x65,0x49,0x74,0x65,0x6d,0x73,0x28,0x76,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x49,0x74,0x65,0x6d,0x50,0x72,0x6f,0x64,0x75,0x63,0x65,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x3a,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x4f,0x66,0x28,0x76,0x29,0x0a,0x09,0x69,0x66,0x20,0x76,0x61,0x6c,0x75,0x65,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x21,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x53,0x6c,0x69,0x63,0x65,0x20,0x26,0x26,0x20,0x76,0x61,0x6c,0x75,0x65,0x2e,0x4b,0x69,0x6e,0x64,0x28,0x29,0x20,0x21,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x41,0x72,0x72,0x61,0x79,0x20,0x7b,0x0a,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x20,0x3d,0x20,0x72,0x65,0x66,0x6c,0x65,0x63,0x74,0x2e,0x56,0x61,0x6c,0x75,0x65,0x4f,0x66,0x28,0x5b,0x5d,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x7b,0x76,0x7d,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x69,0x20,0x3a,0x3d,0x20,0x30,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x75,0x6e,0x63,0x28,0x29,0x20,0x28,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x69,0x20,0x3e,0x3d,0x20,0x76,0x61,0x6c,0x75,0x65,0x2e,0x4c,0x65,0x6e,0x28,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x69,0x6f,0x2e,0x45,0x4f,0x46,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x69,0x2b,0x2b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x2e,0x49,0x6e,0x64,0x65,0x78,0x28,0x69,0x20,0x2d,0x20,0x31,0x29,0x2e,0x49,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x28,0x29,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x49,0x74,0x65,0x6d,0x52,0x65,0x61,0x64,0x65,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x62,0x6f,0x64,0x79,0x20,0x20,0x20,0x20,0x69,0x6f,0x2e,0x52,0x65,0x61,0x64,0x43,0x6c,0x6f,0x73,0x65,0x72,0x0a,0x09,0x64,0x65,0x63,0x6f,0x64,0x65,0x72,0x20,0x2a,0x6a,0x73,0x6f,0x6e,0x2e,0x44,0x65,0x63,0x6f,0x64,0x65,0x72,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x49,0x74,0x65,0x6d,0x52,0x65,0x61,0x64,0x65,0x72,0x28,0x62,0x6f,0x64,0x79,0x20,0x69,0x6f,0x2e,0x52,0x65,0x61,0x64,0x43,0x6c,0x6f,0x73,0x65,0x72,0x29,0x20,0x2a,0x49,0x74,0x65,0x6d,0x52,0x65,0x61,0x64,0x65,0x72,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x49,0x74,0x65,0x6d,0x52,0x65,0x61,0x64,0x65,0x72,0x7b,0x62,0x6f,0x64,0x79,0x3a,0x20,0x62,0x6f,0x64,0x79,0x2c,0x20,0x64,0x65,0x63,0x6f,0x64,0x65,0x72,0x3a,0x20,0x6a,0x73,0x6f,0x6e,0x2e,0x4e,0x65,0x77,0x44,0x65,0x63,0x6f,0x64,0x65,0x72,0x28,0x62,0x6f,0x64,0x79,0x29,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x72,0x20,0x2a,0x49,0x74,0x65,0x6d,0x52,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x4e,0x65,0x78,0x74,0x28,0x76,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x72,0x2e,0x64,0x65,0x63,0x6f,0x64,0x65,0x72,0x2e,0x44,0x65,0x63,0x6f,0x64,0x65,0x28,0x76,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x72,0x20,0x2a,0x49,0x74,0x65,0x6d,0x52,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x43,0x6c,0x6f,0x73,0x65,0x28,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x72,0x2e,0x62,0x6f,0x64,0x79,0x2e,0x43,0x6c,0x6f,0x73,0x65,0x28,0x29,0x0a,0x7d,0x0a,0x0a,0x63,0x6f,0x6e,0x73,0x74,0x20,0x28,0x0a,0x09,0x52,0x61,0x6e,0x67,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x52,0x61,0x6e,0x67,0x65,0x22,0x0a,0x09,0x49,0x66,0x52,0x61,0x6e,0x67,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x49,0x66,0x2d,0x52,0x61,0x6e,0x67,0x65,0x22,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x52,0x61,0x6e,0x67,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x2d,0x52,0x61,0x6e,0x67,0x65,0x22,0x0a,0x09,0x41,0x63,0x63,0x65,0x70,0x74,0x52,0x61,0x6e,0x67,0x65,0x73,0x48,0x65,0x61,0x64,0x65,0x72,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x3d,0x20,0x22,0x41,0x63,0x63,0x65,0x70,0x74,0x2d,0x52,0x61,0x6e,0x67,0x65,0x73,0x22,0x0a,0x29,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x53,0x65,0x72,0x76,0x65,0x52,0x61,0x6e,0x67,0x65,0x73,0x28,0x77,0x20,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x57,0x72,0x69,0x74,0x65,0x72,0x2c,0x20,0x72,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x20,0x69,0x6f,0x2e,0x52,0x65,0x61,0x64,0x53,0x65,0x65,0x6b,0x65,0x72,0x2c,0x20,0x6c,0x61,0x73,0x74,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x20,0x74,0x69,0x6d,0x65,0x2e,0x54,0x69,0x6d,0x65,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x68,0x74,0x74,0x70,0x2e,0x53,0x65,0x72,0x76,0x65,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x28,0x77,0x2c,0x20,0x72,0x2c,0x20,0x22,0x22,0x2c,0x20,0x6c,0x61,0x73,0x74,0x4d,0x6f,0x64,0x69,0x66,0x69,0x65,0x64,0x2c,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x4d,0x69,0x6d,0x65,0x46,0x69,0x6c,0x65,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x48,0x65,0x61,0x64,0x65,0x72,0x20,0x20,0x2a,0x6d,0x75,0x6c,0x74,0x69,0x70,0x61,0x72,0x74,0x2e,0x46,0x69,0x6c,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x20,0x69,0x6f,0x2e,0x52,0x65,0x61,0x64,0x43,0x6c,0x6f,0x73,0x65,0x72,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x45,0x78,0x74,0x72,0x61,0x63,0x74,0x55,0x70,0x6c,0x6f,0x61,0x64,0x28,0x66,0x69,0x6c,0x65,0x49,0x44,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x72,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x29,0x20,0x28,0x2a,0x4d,0x69,0x6d,0x65,0x46,0x69,0x6c,0x65,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x72,0x2e,0x50,0x61,0x72,0x73,0x65,0x4d,0x75,0x6c,0x74,0x69,0x70,0x61,0x72,0x74,0x46,0x6f,0x72,0x6d,0x28,0x31,0x30,0x32,0x34,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x66,0x69,0x6c,0x65,0x2c,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x72,0x2e,0x46,0x6f,0x72,0x6d,0x46,0x69,0x6c,0x65,0x28,0x66,0x69,0x6c,0x65,0x49,0x44,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x6d,0x69,0x6d,0x65,0x46,0x69,0x6c,0x65,0x20,0x3a,0x3d,0x20,0x4d,0x69,0x6d,0x65,0x46,0x69,0x6c,0x65,0x7b,0x0a,0x09,0x09,0x48,0x65,0x61,0x64,0x65,0x72,0x3a,0x20,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x2c,0x0a,0x09,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x3a,0x20,0x66,0x69,0x6c,0x65,0x2c,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x6d,0x69,0x6d,0x65,0x46,0x69,0x6c,0x65,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x63,0x6f,0x6e,0x73,0x74,0x20,0x6d,0x61,0x78,0x55,0x70,0x6c,0x6f,0x61,0x64,0x56,0x61,0x6c,0x75,0x65,0x73,0x53,0x69,0x7a,0x65,0x20,0x69,0x6e,0x74,0x36,0x34,0x20,0x3d,0x20,0x31,0x30,0x20,0x3c,0x3c,0x20,0x32,0x30,0x0a,0x0a,0x76,0x61,0x72,0x20,0x28,0x0a,0x09,0x45,0x72,0x72,0x55,0x70,0x6c,0x6f,0x61,0x64,0x54,0x6f,0x6f,0x4c,0x61,0x72,0x67,0x65,0x20,0x3d,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4e,0x65,0x77,0x28,0x22,0x75,0x70,0x6c,0x6f,0x61,0x64,0x20,0x74,0x6f,0x6f,0x20,0x6c,0x61,0x72,0x67,0x65,0x22,0x29,0x0a,0x0a,0x09,0x45,0x72,0x72,0x55,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x55,0x70,0x6c,0x6f,0x61,0x64,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x20,0x3d,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4e,0x65,0x77,0x28,0x22,0x75,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x20,0x74,0x79,0x70,0x65,0x20,0x6f,0x66,0x20,0x75,0x70,0x6c,0x6f,0x61,0x64,0x22,0x29,0x0a,0x29,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x55,0x70,0x6c,0x6f,0x61,0x64,0x4c,0x69,0x6d,0x69,0x74,0x73,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x4d,0x61,0x78,0x46,0x69,0x6c,0x65,0x53,0x69,0x7a,0x65,0x20,0x69,0x6e,0x74,0x36,0x34,0x0a,0x0a,0x09,0x4d,0x61,0x78,0x54,0x6f,0x74,0x61,0x6c,0x53,0x69,0x7a,0x65,0x20,0x69,0x6e,0x74,0x36,0x34,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4c,0x69,0x6d,0x69,0x74,0x55,0x70,0x6c,0x6f,0x61,0x64,0x73,0x28,0x72,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x6c,0x69,0x6d,0x69,0x74,0x73,0x20,0x55,0x70,0x6c,0x6f,0x61,0x64,0x4c,0x69,0x6d,0x69,0x74,0x73,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x6c,0x69,0x6d,0x69,0x74,0x73,0x2e,0x4d,0x61,0x78,0x54,0x6f,0x74,0x61,0x6c,0x53,0x69,0x7a,0x65,0x20,0x3e,0x20,0x30,0x20,0x26,0x26,0x20,0x72,0x2e,0x42,0x6f,0x64,0x79,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x2e,0x42,0x6f,0x64,0x79,0x20,0x3d,0x20,0x26,0x6c,0x69,0x6d,0x69,0x74,0x65,0x64,0x42,0x6f,0x64,0x79,0x7b,0x6c,0x69,0x6d,0x69,0x74,0x65,0x64,0x52,0x65,0x61,0x64,0x65,0x72,0x3a,0x20,0x6c,0x69,0x6d,0x69,0x74,0x65,0x64,0x52,0x65,0x61,0x64,0x65,0x72,0x7b,0x72,0x65,0x61,0x64,0x65,0x72,0x3a,0x20,0x72,0x2e,0x42,0x6f,0x64,0x79,0x2c,0x20,0x72,0x65,0x6d,0x61,0x69,0x6e,0x69,0x6e,0x67,0x3a,0x20,0x6c,0x69,0x6d,0x69,0x74,0x73,0x2e,0x4d,0x61,0x78,0x54,0x6f,0x74,0x61,0x6c,0x53,0x69,0x7a,0x65,0x7d,0x2c,0x20,0x63,0x6c,0x6f,0x73,0x65,0x72,0x3a,0x20,0x72,0x2e,0x42,0x6f,0x64,0x79,0x7d,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x45,0x78,0x74,0x72,0x61,0x63,0x74,0x55,0x70,0x6c,0x6f,0x61,0x64,0x73,0x28,0x66,0x69,0x6c,0x65,0x49,0x44,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x72,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x6c,0x69,0x6d,0x69,0x74,0x73,0x20,0x55,0x70,0x6c,0x6f,0x61,0x64,0x4c,0x69,0x6d,0x69,0x74,0x73,0x2c,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x73,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x28,0x5b,0x5d,0x4d,0x69,0x6d,0x65,0x46,0x69,0x6c,0x65,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x72,0x2e,0x50,0x61,0x72,0x73,0x65,0x4d,0x75,0x6c,0x74,0x69,0x70,0x61,0x72,0x74,0x46,0x6f,0x72,0x6d,0x28,0x31,0x30,0x32,0x34,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x49,0x73,0x28,0x65,0x72,0x72,0x2c,0x20,0x45,0x72,0x72,0x55,0x70,0x6c,0x6f,0x61,0x64,0x54,0x6f,0x6f,0x4c,0x61,0x72,0x67,0x65,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x45,0x72,0x72,0x55,0x70,0x6c,0x6f,0x61,0x64,0x54,0x6f,0x6f,0x4c,0x61,0x72,0x67,0x65,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x68,0x65,0x61,0x64,0x65,0x72,0x73,0x20,0x3a,0x3d,0x20,0x72,0x2e,0x4d,0x75,0x6c,0x74,0x69,0x70,0x61,0x72,0x74,0x46,0x6f,0x72,0x6d,0x2e,0x46,0x69,0x6c,0x65,0x5b,0x66,0x69,0x6c,0x65,0x49,0x44,0x5d,0x0a,0x09,0x66,0x69,0x6c,0x65,0x73,0x20,0x3a,0x3d,0x20,0x6d,0x61,0x6b,0x65,0x28,0x5b,0x5d,0x4d,0x69,0x6d,0x65,0x46,0x69,0x6c,0x65,0x2c,0x20,0x30,0x2c,0x20,0x6c,0x65,0x6e,0x28,0x68,0x65,0x61,0x64,0x65,0x72,0x73,0x29,0x29,0x0a,0x09,0x66,0x6f,0x72,0x20,0x5f,0x2c,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x73,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x6c,0x69,0x6d,0x69,0x74,0x73,0x2e,0x4d,0x61,0x78,0x46,0x69,0x6c,0x65,0x53,0x69,0x7a,0x65,0x20,0x3e,0x20,0x30,0x20,0x26,0x26,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x2e,0x53,0x69,0x7a,0x65,0x20,0x3e,0x20,0x6c,0x69,0x6d,0x69,0x74,0x73,0x2e,0x4d,0x61,0x78,0x46,0x69,0x6c,0x65,0x53,0x69,0x7a,0x65,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x57,0x72,0x61,0x70,0x66,0x28,0x45,0x72,0x72,0x55,0x70,0x6c,0x6f,0x61,0x64,0x54,0x6f,0x6f,0x4c,0x61,0x72,0x67,0x65,0x2c,0x20,0x22,0x66,0x69,0x6c,0x65,0x20,0x27,0x25,0x73,0x27,0x20,0x6f,0x66,0x20,0x66,0x69,0x65,0x6c,0x64,0x20,0x27,0x25,0x73,0x27,0x22,0x2c,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x2e,0x46,0x69,0x6c,0x65,0x6e,0x61,0x6d,0x65,0x2c,0x20,0x66,0x69,0x6c,0x65,0x49,0x44,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x69,0x66,0x20,0x21,0x61,0x63,0x63,0x65,0x70,0x74,0x73,0x55,0x70,0x6c,0x6f,0x61,0x64,0x28,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x73,0x2c,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2e,0x47,0x65,0x74,0x28,0x22,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x2d,0x54,0x79,0x70,0x65,0x22,0x29,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x57,0x72,0x61,0x70,0x66,0x28,0x45,0x72,0x72,0x55,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x55,0x70,0x6c,0x6f,0x61,0x64,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x2c,0x20,0x22,0x66,0x69,0x6c,0x65,0x20,0x27,0x25,0x73,0x27,0x20,0x6f,0x66,0x20,0x66,0x69,0x65,0x6c,0x64,0x20,0x27,0x25,0x73,0x27,0x22,0x2c,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x2e,0x46,0x69,0x6c,0x65,0x6e,0x61,0x6d,0x65,0x2c,0x20,0x66,0x69,0x6c,0x65,0x49,0x44,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x66,0x69,0x6c,0x65,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x2e,0x4f,0x70,0x65,0x6e,0x28,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x66,0x69,0x6c,0x65,0x73,0x20,0x3d,0x20,0x61,0x70,0x70,0x65,0x6e,0x64,0x28,0x66,0x69,0x6c,0x65,0x73,0x2c,0x20,0x4d,0x69,0x6d,0x65,0x46,0x69,0x6c,0x65,0x7b,0x48,0x65,0x61,0x64,0x65,0x72,0x3a,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x3a,0x20,0x66,0x69,0x6c,0x65,0x7d,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x69,0x6c,0x65,0x73,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x55,0x70,0x6c,0x6f,0x61,0x64,0x45,0x72,0x72,0x6f,0x72,0x53,0x74,0x61,0x74,0x75,0x73,0x28,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x69,0x6e,0x74,0x20,0x7b,0x0a,0x0a,0x09,0x73,0x77,0x69,0x74,0x63,0x68,0x20,0x7b,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x49,0x73,0x28,0x65,0x72,0x72,0x2c,0x20,0x45,0x72,0x72,0x55,0x70,0x6c,0x6f,0x61,0x64,0x54,0x6f,0x6f,0x4c,0x61,0x72,0x67,0x65,0x29,0x3a,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x45,0x6e,0x74,0x69,0x74,0x79,0x54,0x6f,0x6f,0x4c,0x61,0x72,0x67,0x65,0x0a,0x09,0x63,0x61,0x73,0x65,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x49,0x73,0x28,0x65,0x72,0x72,0x2c,0x20,0x45,0x72,0x72,0x55,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x55,0x70,0x6c,0x6f,0x61,0x64,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x29,0x3a,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x55,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x4d,0x65,0x64,0x69,0x61,0x54,0x79,0x70,0x65,0x0a,0x09,0x64,0x65,0x66,0x61,0x75,0x6c,0x74,0x3a,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x42,0x61,0x64,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x57,0x72,0x69,0x74,0x65,0x55,0x70,0x6c,0x6f,0x61,0x64,0x28,0x77,0x72,0x69,0x74,0x65,0x72,0x20,0x2a,0x6d,0x75,0x6c,0x74,0x69,0x70,0x61,0x72,0x74,0x2e,0x57,0x72,0x69,0x74,0x65,0x72,0x2c,0x20,0x66,0x69,0x65,0x6c,0x64,0x4e,0x61,0x6d,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x66,0x69,0x6c,0x65,0x20,0x2a,0x4d,0x69,0x6d,0x65,0x46,0x69,0x6c,0x65,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x66,0x69,0x6c,0x65,0x4e,0x61,0x6d,0x65,0x2c,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x20,0x3a,0x3d,0x20,0x66,0x69,0x65,0x6c,0x64,0x4e,0x61,0x6d,0x65,0x2c,0x20,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2f,0x6f,0x63,0x74,0x65,0x74,0x2d,0x73,0x74,0x72,0x65,0x61,0x6d,0x22,0x0a,0x09,0x69,0x66,0x20,0x66,0x69,0x6c,0x65,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x66,0x69,0x6c,0x65,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2e,0x46,0x69,0x6c,0x65,0x6e,0x61,0x6d,0x65,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x66,0x69,0x6c,0x65,0x4e,0x61,0x6d,0x65,0x20,0x3d,0x20,0x66,0x69,0x6c,0x65,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2e,0x46,0x69,0x6c,0x65,0x6e,0x61,0x6d,0x65,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x69,0x66,0x20,0x76,0x61,0x6c,0x75,0x65,0x20,0x3a,0x3d,0x20,0x66,0x69,0x6c,0x65,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2e,0x47,0x65,0x74,0x28,0x22,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x2d,0x54,0x79,0x70,0x65,0x22,0x29,0x3b,0x20,0x76,0x61,0x6c,0x75,0x65,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x20,0x3d,0x20,0x76,0x61,0x6c,0x75,0x65,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x3a,0x3d,0x20,0x6d,0x61,0x6b,0x65,0x28,0x74,0x65,0x78,0x74,0x70,0x72,0x6f,0x74,0x6f,0x2e,0x4d,0x49,0x4d,0x45,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x0a,0x09,0x68,0x65,0x61,0x64,0x65,0x72,0x2e,0x53,0x65,0x74,0x28,0x22,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x2d,0x44,0x69,0x73,0x70,0x6f,0x73,0x69,0x74,0x69,0x6f,0x6e,0x22,0x2c,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x60,0x66,0x6f,0x72,0x6d,0x2d,0x64,0x61,0x74,0x61,0x3b,0x20,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x25,0x73,0x22,0x3b,0x20,0x66,0x69,0x6c,0x65,0x6e,0x61,0x6d,0x65,0x3d,0x22,0x25,0x73,0x22,0x60,0x2c,0x20,0x71,0x75,0x6f,0x74,0x65,0x45,0x73,0x63,0x61,0x70,0x65,0x72,0x2e,0x52,0x65,0x70,0x6c,0x61,0x63,0x65,0x28,0x66,0x69,0x65,0x6c,0x64,0x4e,0x61,0x6d,0x65,0x29,0x2c,0x20,0x71,0x75,0x6f,0x74,0x65,0x45,0x73,0x63,0x61,0x70,0x65,0x72,0x2e,0x52,0x65,0x70,0x6c,0x61,0x63,0x65,0x28,0x66,0x69,0x6c,0x65,0x4e,0x61,0x6d,0x65,0x29,0x29,0x29,0x0a,0x09,0x68,0x65,0x61,0x64,0x65,0x72,0x2e,0x53,0x65,0x74,0x28,0x22,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x2d,0x54,0x79,0x70,0x65,0x22,0x2c,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x29,0x0a,0x0a,0x09,0x70,0x61,0x72,0x74,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x77,0x72,0x69,0x74,0x65,0x72,0x2e,0x43,0x72,0x65,0x61,0x74,0x65,0x50,0x61,0x72,0x74,0x28,0x68,0x65,0x61,0x64,0x65,0x72,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x09,0x5f,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x69,0x6f,0x2e,0x43,0x6f,0x70,0x79,0x28,0x70,0x61,0x72,0x74,0x2c,0x20,0x66,0x69,0x6c,0x65,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x29,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x7d,0x0a,0x0a,0x76,0x61,0x72,0x20,0x71,0x75,0x6f,0x74,0x65,0x45,0x73,0x63,0x61,0x70,0x65,0x72,0x20,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x4e,0x65,0x77,0x52,0x65,0x70,0x6c,0x61,0x63,0x65,0x72,0x28,0x22,0x5c,0x5c,0x22,0x2c,0x20,0x22,0x5c,0x5c,0x5c,0x5c,0x22,0x2c,0x20,0x60,0x22,0x60,0x2c,0x20,0x22,0x5c,0x5c,0x5c,0x22,0x22,0x29,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x55,0x70,0x6c,0x6f,0x61,0x64,0x52,0x65,0x61,0x64,0x65,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x61,0x64,0x65,0x72,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x2a,0x6d,0x75,0x6c,0x74,0x69,0x70,0x61,0x72,0x74,0x2e,0x52,0x65,0x61,0x64,0x65,0x72,0x0a,0x09,0x6c,0x69,0x6d,0x69,0x74,0x73,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x55,0x70,0x6c,0x6f,0x61,0x64,0x4c,0x69,0x6d,0x69,0x74,0x73,0x0a,0x09,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x73,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x70,0x65,0x6e,0x64,0x69,0x6e,0x67,0x20,0x20,0x20,0x20,0x20,0x20,0x2a,0x6d,0x75,0x6c,0x74,0x69,0x70,0x61,0x72,0x74,0x2e,0x50,0x61,0x72,0x74,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x55,0x70,0x6c,0x6f,0x61,0x64,0x50,0x61,0x72,0x74,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x46,0x69,0x65,0x6c,0x64,0x4e,0x61,0x6d,0x65,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x46,0x69,0x6c,0x65,0x4e,0x61,0x6d,0x65,0x20,0x20,0x20,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x48,0x65,0x61,0x64,0x65,0x72,0x20,0x20,0x20,0x20,0x20,0x20,0x74,0x65,0x78,0x74,0x70,0x72,0x6f,0x74,0x6f,0x2e,0x4d,0x49,0x4d,0x45,0x48,0x65,0x61,0x64,0x65,0x72,0x0a,0x09,0x72,0x65,0x61,0x64,0x65,0x72,0x20,0x20,0x20,0x20,0x20,0x20,0x69,0x6f,0x2e,0x52,0x65,0x61,0x64,0x65,0x72,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x70,0x20,0x2a,0x55,0x70,0x6c,0x6f,0x61,0x64,0x50,0x61,0x72,0x74,0x29,0x20,0x52,0x65,0x61,0x64,0x28,0x62,0x20,0x5b,0x5d,0x62,0x79,0x74,0x65,0x29,0x20,0x28,0x69,0x6e,0x74,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x70,0x2e,0x72,0x65,0x61,0x64,0x65,0x72,0x2e,0x52,0x65,0x61,0x64,0x28,0x62,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x55,0x70,0x6c,0x6f,0x61,0x64,0x52,0x65,0x61,0x64,0x65,0x72,0x28,0x72,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x6c,0x69,0x6d,0x69,0x74,0x73,0x20,0x55,0x70,0x6c,0x6f,0x61,0x64,0x4c,0x69,0x6d,0x69,0x74,0x73,0x2c,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x73,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x28,0x2a,0x55,0x70,0x6c,0x6f,0x61,0x64,0x52,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x4c,0x69,0x6d,0x69,0x74,0x55,0x70,0x6c,0x6f,0x61,0x64,0x73,0x28,0x72,0x2c,0x20,0x6c,0x69,0x6d,0x69,0x74,0x73,0x29,0x0a,0x0a,0x09,0x72,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x72,0x2e,0x4d,0x75,0x6c,0x74,0x69,0x70,0x61,0x72,0x74,0x52,0x65,0x61,0x64,0x65,0x72,0x28,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x55,0x70,0x6c,0x6f,0x61,0x64,0x52,0x65,0x61,0x64,0x65,0x72,0x7b,0x72,0x65,0x61,0x64,0x65,0x72,0x3a,0x20,0x72,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x6c,0x69,0x6d,0x69,0x74,0x73,0x3a,0x20,0x6c,0x69,0x6d,0x69,0x74,0x73,0x2c,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x73,0x3a,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x73,0x7d,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x75,0x20,0x2a,0x55,0x70,0x6c,0x6f,0x61,0x64,0x52,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x52,0x65,0x61,0x64,0x56,0x61,0x6c,0x75,0x65,0x73,0x28,0x29,0x20,0x28,0x75,0x72,0x6c,0x2e,0x56,0x61,0x6c,0x75,0x65,0x73,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x76,0x61,0x6c,0x75,0x65,0x73,0x20,0x3a,0x3d,0x20,0x6d,0x61,0x6b,0x65,0x28,0x75,0x72,0x6c,0x2e,0x56,0x61,0x6c,0x75,0x65,0x73,0x29,0x0a,0x09,0x72,0x65,0x6d,0x61,0x69,0x6e,0x69,0x6e,0x67,0x20,0x3a,0x3d,0x20,0x6d,0x61,0x78,0x55,0x70,0x6c,0x6f,0x61,0x64,0x56,0x61,0x6c,0x75,0x65,0x73,0x53,0x69,0x7a,0x65,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x09,0x70,0x61,0x72,0x74,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x75,0x2e,0x72,0x65,0x61,0x64,0x65,0x72,0x2e,0x4e,0x65,0x78,0x74,0x50,0x61,0x72,0x74,0x28,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3d,0x3d,0x20,0x69,0x6f,0x2e,0x45,0x4f,0x46,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x73,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x75,0x2e,0x65,0x72,0x72,0x6f,0x72,0x28,0x65,0x72,0x72,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x69,0x66,0x20,0x70,0x61,0x72,0x74,0x2e,0x46,0x69,0x6c,0x65,0x4e,0x61,0x6d,0x65,0x28,0x29,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x7b,0x0a,0x09,0x09,0x09,0x75,0x2e,0x70,0x65,0x6e,0x64,0x69,0x6e,0x67,0x20,0x3d,0x20,0x70,0x61,0x72,0x74,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x76,0x61,0x6c,0x75,0x65,0x73,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x76,0x61,0x72,0x20,0x76,0x61,0x6c,0x75,0x65,0x20,0x62,0x79,0x74,0x65,0x73,0x2e,0x42,0x75,0x66,0x66,0x65,0x72,0x0a,0x09,0x09,0x6e,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x69,0x6f,0x2e,0x43,0x6f,0x70,0x79,0x4e,0x28,0x26,0x76,0x61,0x6c,0x75,0x65,0x2c,0x20,0x70,0x61,0x72,0x74,0x2c,0x20,0x72,0x65,0x6d,0x61,0x69,0x6e,0x69,0x6e,0x67,0x2b,0x31,0x29,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x26,0x26,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x69,0x6f,0x2e,0x45,0x4f,0x46,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x75,0x2e,0x65,0x72,0x72,0x6f,0x72,0x28,0x65,0x72,0x72,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x72,0x65,0x6d,0x61,0x69,0x6e,0x69,0x6e,0x67,0x20,0x2d,0x3d,0x20,0x6e,0x0a,0x09,0x09,0x69,0x66,0x20,0x72,0x65,0x6d,0x61,0x69,0x6e,0x69,0x6e,0x67,0x20,0x3c,0x20,0x30,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x57,0x72,0x61,0x70,0x28,0x45,0x72,0x72,0x55,0x70,0x6c,0x6f,0x61,0x64,0x54,0x6f,0x6f,0x4c,0x61,0x72,0x67,0x65,0x2c,0x20,0x22,0x66,0x6f,0x72,0x6d,0x20,0x76,0x61,0x6c,0x75,0x65,0x73,0x22,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x76,0x61,0x6c,0x75,0x65,0x73,0x2e,0x41,0x64,0x64,0x28,0x70,0x61,0x72,0x74,0x2e,0x46,0x6f,0x72,0x6d,0x4e,0x61,0x6d,0x65,0x28,0x29,0x2c,0x20,0x76,0x61,0x6c,0x75,0x65,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x29,0x29,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x75,0x20,0x2a,0x55,0x70,0x6c,0x6f,0x61,0x64,0x52,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x4e,0x65,0x78,0x74,0x28,0x29,0x20,0x28,0x2a,0x55,0x70,0x6c,0x6f,0x61,0x64,0x50,0x61,0x72,0x74,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x70,0x61,0x72,0x74,0x20,0x3a,0x3d,0x20,0x75,0x2e,0x70,0x65,0x6e,0x64,0x69,0x6e,0x67,0x0a,0x09,0x75,0x2e,0x70,0x65,0x6e,0x64,0x69,0x6e,0x67,0x20,0x3d,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x69,0x66,0x20,0x70,0x61,0x72,0x74,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x76,0x61,0x72,0x20,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x0a,0x09,0x09,0x69,0x66,0x20,0x70,0x61,0x72,0x74,0x2c,0x20,0x65,0x72,0x72,0x20,0x3d,0x20,0x75,0x2e,0x72,0x65,0x61,0x64,0x65,0x72,0x2e,0x4e,0x65,0x78,0x74,0x50,0x61,0x72,0x74,0x28,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x75,0x2e,0x65,0x72,0x72,0x6f,0x72,0x28,0x65,0x72,0x72,0x29,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x20,0x3a,0x3d,0x20,0x70,0x61,0x72,0x74,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2e,0x47,0x65,0x74,0x28,0x22,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x2d,0x54,0x79,0x70,0x65,0x22,0x29,0x0a,0x09,0x69,0x66,0x20,0x70,0x61,0x72,0x74,0x2e,0x46,0x69,0x6c,0x65,0x4e,0x61,0x6d,0x65,0x28,0x29,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x26,0x26,0x20,0x21,0x61,0x63,0x63,0x65,0x70,0x74,0x73,0x55,0x70,0x6c,0x6f,0x61,0x64,0x28,0x75,0x2e,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x73,0x2c,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x29,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x57,0x72,0x61,0x70,0x66,0x28,0x45,0x72,0x72,0x55,0x6e,0x73,0x75,0x70,0x70,0x6f,0x72,0x74,0x65,0x64,0x55,0x70,0x6c,0x6f,0x61,0x64,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x2c,0x20,0x22,0x66,0x69,0x6c,0x65,0x20,0x27,0x25,0x73,0x27,0x20,0x6f,0x66,0x20,0x66,0x69,0x65,0x6c,0x64,0x20,0x27,0x25,0x73,0x27,0x22,0x2c,0x20,0x70,0x61,0x72,0x74,0x2e,0x46,0x69,0x6c,0x65,0x4e,0x61,0x6d,0x65,0x28,0x29,0x2c,0x20,0x70,0x61,0x72,0x74,0x2e,0x46,0x6f,0x72,0x6d,0x4e,0x61,0x6d,0x65,0x28,0x29,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x76,0x61,0x72,0x20,0x72,0x65,0x61,0x64,0x65,0x72,0x20,0x69,0x6f,0x2e,0x52,0x65,0x61,0x64,0x65,0x72,0x20,0x3d,0x20,0x70,0x61,0x72,0x74,0x0a,0x09,0x69,0x66,0x20,0x70,0x61,0x72,0x74,0x2e,0x46,0x69,0x6c,0x65,0x4e,0x61,0x6d,0x65,0x28,0x29,0x20,0x21,0x3d,0x20,0x22,0x22,0x20,0x26,0x26,0x20,0x75,0x2e,0x6c,0x69,0x6d,0x69,0x74,0x73,0x2e,0x4d,0x61,0x78,0x46,0x69,0x6c,0x65,0x53,0x69,0x7a,0x65,0x20,0x3e,0x20,0x30,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x61,0x64,0x65,0x72,0x20,0x3d,0x20,0x26,0x6c,0x69,0x6d,0x69,0x74,0x65,0x64,0x52,0x65,0x61,0x64,0x65,0x72,0x7b,0x72,0x65,0x61,0x64,0x65,0x72,0x3a,0x20,0x70,0x61,0x72,0x74,0x2c,0x20,0x72,0x65,0x6d,0x61,0x69,0x6e,0x69,0x6e,0x67,0x3a,0x20,0x75,0x2e,0x6c,0x69,0x6d,0x69,0x74,0x73,0x2e,0x4d,0x61,0x78,0x46,0x69,0x6c,0x65,0x53,0x69,0x7a,0x65,0x7d,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x55,0x70,0x6c,0x6f,0x61,0x64,0x50,0x61,0x72,0x74,0x7b,0x0a,0x09,0x09,0x46,0x69,0x65,0x6c,0x64,0x4e,0x61,0x6d,0x65,0x3a,0x20,0x20,0x20,0x70,0x61,0x72,0x74,0x2e,0x46,0x6f,0x72,0x6d,0x4e,0x61,0x6d,0x65,0x28,0x29,0x2c,0x0a,0x09,0x09,0x46,0x69,0x6c,0x65,0x4e,0x61,0x6d,0x65,0x3a,0x20,0x20,0x20,0x20,0x70,0x61,0x72,0x74,0x2e,0x46,0x69,0x6c,0x65,0x4e,0x61,0x6d,0x65,0x28,0x29,0x2c,0x0a,0x09,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x3a,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x2c,0x0a,0x09,0x09,0x48,0x65,0x61,0x64,0x65,0x72,0x3a,0x20,0x20,0x20,0x20,0x20,0x20,0x70,0x61,0x72,0x74,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2c,0x0a,0x09,0x09,0x72,0x65,0x61,0x64,0x65,0x72,0x3a,0x20,0x20,0x20,0x20,0x20,0x20,0x72,0x65,0x61,0x64,0x65,0x72,0x2c,0x0a,0x09,0x7d,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x75,0x20,0x2a,0x55,0x70,0x6c,0x6f,0x61,0x64,0x52,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x28,0x65,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x49,0x73,0x28,0x65,0x72,0x72,0x2c,0x20,0x45,0x72,0x72,0x55,0x70,0x6c,0x6f,0x61,0x64,0x54,0x6f,0x6f,0x4c,0x61,0x72,0x67,0x65,0x29,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x45,0x72,0x72,0x55,0x70,0x6c,0x6f,0x61,0x64,0x54,0x6f,0x6f,0x4c,0x61,0x72,0x67,0x65,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x61,0x63,0x63,0x65,0x70,0x74,0x73,0x55,0x70,0x6c,0x6f,0x61,0x64,0x28,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x73,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x6c,0x65,0x6e,0x28,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x73,0x29,0x20,0x3d,0x3d,0x20,0x30,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x74,0x72,0x75,0x65,0x0a,0x09,0x7d,0x0a,0x09,0x6d,0x65,0x64,0x69,0x61,0x54,0x79,0x70,0x65,0x2c,0x20,0x5f,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x6d,0x69,0x6d,0x65,0x2e,0x50,0x61,0x72,0x73,0x65,0x4d,0x65,0x64,0x69,0x61,0x54,0x79,0x70,0x65,0x28,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x6d,0x65,0x64,0x69,0x61,0x54,0x79,0x70,0x65,0x20,0x3d,0x20,0x22,0x61,0x70,0x70,0x6c,0x69,0x63,0x61,0x74,0x69,0x6f,0x6e,0x2f,0x6f,0x63,0x74,0x65,0x74,0x2d,0x73,0x74,0x72,0x65,0x61,0x6d,0x22,0x0a,0x09,0x7d,0x0a,0x09,0x66,0x6f,0x72,0x20,0x5f,0x2c,0x20,0x61,0x63,0x63,0x65,0x70,0x74,0x65,0x64,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x73,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x45,0x71,0x75,0x61,0x6c,0x46,0x6f,0x6c,0x64,0x28,0x61,0x63,0x63,0x65,0x70,0x74,0x65,0x64,0x2c,0x20,0x6d,0x65,0x64,0x69,0x61,0x54,0x79,0x70,0x65,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x74,0x72,0x75,0x65,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x61,0x6c,0x73,0x65,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x6c,0x69,0x6d,0x69,0x74,0x65,0x64,0x42,0x6f,0x64,0x79,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x6c,0x69,0x6d,0x69,0x74,0x65,0x64,0x52,0x65,0x61,0x64,0x65,0x72,0x0a,0x09,0x63,0x6c,0x6f,0x73,0x65,0x72,0x20,0x69,0x6f,0x2e,0x43,0x6c,0x6f,0x73,0x65,0x72,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x62,0x20,0x2a,0x6c,0x69,0x6d,0x69,0x74,0x65,0x64,0x42,0x6f,0x64,0x79,0x29,0x20,0x43,0x6c,0x6f,0x73,0x65,0x28,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x62,0x2e,0x63,0x6c,0x6f,0x73,0x65,0x72,0x2e,0x43,0x6c,0x6f,0x73,0x65,0x28,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x6c,0x69,0x6d,0x69,0x74,0x65,0x64,0x52,0x65,0x61,0x64,0x65,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x61,0x64,0x65,0x72,0x20,0x20,0x20,0x20,0x69,0x6f,0x2e,0x52,0x65,0x61,0x64,0x65,0x72,0x0a,0x09,0x72,0x65,0x6d,0x61,0x69,0x6e,0x69,0x6e,0x67,0x20,0x69,0x6e,0x74,0x36,0x34,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x72,0x20,0x2a,0x6c,0x69,0x6d,0x69,0x74,0x65,0x64,0x52,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x52,0x65,0x61,0x64,0x28,0x70,0x20,0x5b,0x5d,0x62,0x79,0x74,0x65,0x29,0x20,0x28,0x69,0x6e,0x74,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x72,0x2e,0x72,0x65,0x6d,0x61,0x69,0x6e,0x69,0x6e,0x67,0x20,0x3c,0x20,0x30,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x30,0x2c,0x20,0x45,0x72,0x72,0x55,0x70,0x6c,0x6f,0x61,0x64,0x54,0x6f,0x6f,0x4c,0x61,0x72,0x67,0x65,0x0a,0x09,0x7d,0x0a,0x09,0x69,0x66,0x20,0x69,0x6e,0x74,0x36,0x34,0x28,0x6c,0x65,0x6e,0x28,0x70,0x29,0x29,0x20,0x3e,0x20,0x72,0x2e,0x72,0x65,0x6d,0x61,0x69,0x6e,0x69,0x6e,0x67,0x2b,0x31,0x20,0x7b,0x0a,0x09,0x09,0x70,0x20,0x3d,0x20,0x70,0x5b,0x3a,0x72,0x2e,0x72,0x65,0x6d,0x61,0x69,0x6e,0x69,0x6e,0x67,0x2b,0x31,0x5d,0x0a,0x09,0x7d,0x0a,0x09,0x6e,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x72,0x2e,0x72,0x65,0x61,0x64,0x65,0x72,0x2e,0x52,0x65,0x61,0x64,0x28,0x70,0x29,0x0a,0x09,0x72,0x2e,0x72,0x65,0x6d,0x61,0x69,0x6e,0x69,0x6e,0x67,0x20,0x2d,0x3d,0x20,0x69,0x6e,0x74,0x36,0x34,0x28,0x6e,0x29,0x0a,0x09,0x69,0x66,0x20,0x72,0x2e,0x72,0x65,0x6d,0x61,0x69,0x6e,0x69,0x6e,0x67,0x20,0x3c,0x20,0x30,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x20,0x2b,0x20,0x69,0x6e,0x74,0x28,0x72,0x2e,0x72,0x65,0x6d,0x61,0x69,0x6e,0x69,0x6e,0x67,0x29,0x2c,0x20,0x45,0x72,0x72,0x55,0x70,0x6c,0x6f,0x61,0x64,0x54,0x6f,0x6f,0x4c,0x61,0x72,0x67,0x65,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x2c,0x20,0x65,0x72,0x72,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x20,0x66,0x75,0x6e,0x63,0x28,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x0a,0x0a,0x63,0x6f,0x6e,0x73,0x74,0x20,0x77,0x65,0x62,0x53,0x6f,0x63,0x6b,0x65,0x74,0x43,0x6c,0x6f,0x73,0x65,0x54,0x69,0x6d,0x65,0x6f,0x75,0x74,0x20,0x3d,0x20,0x74,0x69,0x6d,0x65,0x2e,0x53,0x65,0x63,0x6f,0x6e,0x64,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x57,0x65,0x62,0x53,0x6f,0x63,0x6b,0x65,0x74,0x4f,0x70,0x74,0x73,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x43,0x68,0x65,0x63,0x6b,0x4f,0x72,0x69,0x67,0x69,0x6e,0x20,0x66,0x75,0x6e,0x63,0x28,0x72,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x0a,0x0a,0x09,0x52,0x65,0x61,0x64,0x42,0x75,0x66,0x66,0x65,0x72,0x53,0x69,0x7a,0x65,0x20,0x20,0x69,0x6e,0x74,0x0a,0x09,0x57,0x72,0x69,0x74,0x65,0x42,0x75,0x66,0x66,0x65,0x72,0x53,0x69,0x7a,0x65,0x20,0x69,0x6e,0x74,0x0a,0x0a,0x09,0x48,0x61,0x6e,0x64,0x73,0x68,0x61,0x6b,0x65,0x54,0x69,0x6d,0x65,0x6f,0x75,0x74,0x20,0x74,0x69,0x6d,0x65,0x2e,0x44,0x75,0x72,0x61,0x74,0x69,0x6f,0x6e,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x57,0x65,0x62,0x53,0x6f,0x63,0x6b,0x65,0x74,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x77,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x57,0x72,0x69,0x74,0x65,0x72,0x0a,0x09,0x72,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x0a,0x09,0x6f,0x70,0x74,0x73,0x20,0x20,0x20,0x20,0x20,0x57,0x65,0x62,0x53,0x6f,0x63,0x6b,0x65,0x74,0x4f,0x70,0x74,0x73,0x0a,0x09,0x63,0x6f,0x6e,0x6e,0x20,0x20,0x20,0x20,0x20,0x2a,0x77,0x65,0x62,0x73,0x6f,0x63,0x6b,0x65,0x74,0x2e,0x43,0x6f,0x6e,0x6e,0x0a,0x09,0x73,0x74,0x61,0x72,0x74,0x65,0x64,0x20,0x20,0x62,0x6f,0x6f,0x6c,0x0a,0x09,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x20,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x57,0x65,0x62,0x53,0x6f,0x63,0x6b,0x65,0x74,0x28,0x77,0x20,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x57,0x72,0x69,0x74,0x65,0x72,0x2c,0x20,0x72,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x6f,0x70,0x74,0x73,0x20,0x57,0x65,0x62,0x53,0x6f,0x63,0x6b,0x65,0x74,0x4f,0x70,0x74,0x73,0x2c,0x20,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x20,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x29,0x20,0x2a,0x57,0x65,0x62,0x53,0x6f,0x63,0x6b,0x65,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x57,0x65,0x62,0x53,0x6f,0x63,0x6b,0x65,0x74,0x7b,0x77,0x3a,0x20,0x77,0x2c,0x20,0x72,0x3a,0x20,0x72,0x2c,0x20,0x6f,0x70,0x74,0x73,0x3a,0x20,0x6f,0x70,0x74,0x73,0x2c,0x20,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x3a,0x20,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x44,0x69,0x61,0x6c,0x57,0x65,0x62,0x53,0x6f,0x63,0x6b,0x65,0x74,0x28,0x63,0x6c,0x69,0x65,0x6e,0x74,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x43,0x6c,0x69,0x65,0x6e,0x74,0x2c,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x20,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x6f,0x72,0x29,0x20,0x28,0x2a,0x57,0x65,0x62,0x53,0x6f,0x63,0x6b,0x65,0x74,0x2c,0x20,0x2a,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x76,0x61,0x72,0x20,0x74,0x72,0x61,0x6e,0x73,0x70,0x6f,0x72,0x74,0x20,0x68,0x74,0x74,0x70,0x2e,0x52,0x6f,0x75,0x6e,0x64,0x54,0x72,0x69,0x70,0x70,0x65,0x72,0x20,0x3d,0x20,0x68,0x74,0x74,0x70,0x2e,0x44,0x65,0x66,0x61,0x75,0x6c,0x74,0x54,0x72,0x61,0x6e,0x73,0x70,0x6f,0x72,0x74,0x0a,0x09,0x64,0x69,0x61,0x6c,0x65,0x72,0x20,0x3a,0x3d,0x20,0x26,0x77,0x65,0x62,0x73,0x6f,0x63,0x6b,0x65,0x74,0x2e,0x44,0x69,0x61,0x6c,0x65,0x72,0x7b,0x50,0x72,0x6f,0x78,0x79,0x3a,0x20,0x68,0x74,0x74,0x70,0x2e,0x50,0x72,0x6f,0x78,0x79,0x46,0x72,0x6f,0x6d,0x45,0x6e,0x76,0x69,0x72,0x6f,0x6e,0x6d,0x65,0x6e,0x74,0x7d,0x0a,0x09,0x69,0x66,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x64,0x69,0x61,0x6c,0x65,0x72,0x2e,0x48,0x61,0x6e,0x64,0x73,0x68,0x61,0x6b,0x65,0x54,0x69,0x6d,0x65,0x6f,0x75,0x74,0x20,0x3d,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x2e,0x54,0x69,0x6d,0x65,0x6f,0x75,0x74,0x0a,0x09,0x09,0x64,0x69,0x61,0x6c,0x65,0x72,0x2e,0x4a,0x61,0x72,0x20,0x3d,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x2e,0x4a,0x61,0x72,0x0a,0x09,0x09,0x69,0x66,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x2e,0x54,0x72,0x61,0x6e,0x73,0x70,0x6f,0x72,0x74,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x74,0x72,0x61,0x6e,0x73,0x70,0x6f,0x72,0x74,0x20,0x3d,0x20,0x63,0x6c,0x69,0x65,0x6e,0x74,0x2e,0x54,0x72,0x61,0x6e,0x73,0x70,0x6f,0x72,0x74,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x69,0x66,0x20,0x74,0x2c,0x20,0x6f,0x6b,0x20,0x3a,0x3d,0x20,0x74,0x72,0x61,0x6e,0x73,0x70,0x6f,0x72,0x74,0x2e,0x28,0x2a,0x68,0x74,0x74,0x70,0x2e,0x54,0x72,0x61,0x6e,0x73,0x70,0x6f,0x72,0x74,0x29,0x3b,0x20,0x6f,0x6b,0x20,0x7b,0x0a,0x09,0x09,0x64,0x69,0x61,0x6c,0x65,0x72,0x2e,0x54,0x4c,0x53,0x43,0x6c,0x69,0x65,0x6e,0x74,0x43,0x6f,0x6e,0x66,0x69,0x67,0x20,0x3d,0x20,0x74,0x2e,0x54,0x4c,0x53,0x43,0x6c,0x69,0x65,0x6e,0x74,0x43,0x6f,0x6e,0x66,0x69,0x67,0x2e,0x43,0x6c,0x6f,0x6e,0x65,0x28,0x29,0x0a,0x09,0x09,0x64,0x69,0x61,0x6c,0x65,0x72,0x2e,0x50,0x72,0x6f,0x78,0x79,0x20,0x3d,0x20,0x74,0x2e,0x50,0x72,0x6f,0x78,0x79,0x0a,0x09,0x09,0x64,0x69,0x61,0x6c,0x65,0x72,0x2e,0x4e,0x65,0x74,0x44,0x69,0x61,0x6c,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x3d,0x20,0x74,0x2e,0x44,0x69,0x61,0x6c,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x75,0x20,0x3a,0x3d,0x20,0x2a,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x2e,0x55,0x52,0x4c,0x0a,0x09,0x75,0x2e,0x53,0x63,0x68,0x65,0x6d,0x65,0x20,0x3d,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x73,0x2e,0x52,0x65,0x70,0x6c,0x61,0x63,0x65,0x28,0x75,0x2e,0x53,0x63,0x68,0x65,0x6d,0x65,0x2c,0x20,0x22,0x68,0x74,0x74,0x70,0x22,0x2c,0x20,0x22,0x77,0x73,0x22,0x2c,0x20,0x31,0x29,0x0a,0x0a,0x09,0x63,0x6f,0x6e,0x6e,0x2c,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x64,0x69,0x61,0x6c,0x65,0x72,0x2e,0x44,0x69,0x61,0x6c,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x28,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x28,0x29,0x2c,0x20,0x75,0x2e,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x29,0x2c,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x2c,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2c,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x57,0x65,0x62,0x53,0x6f,0x63,0x6b,0x65,0x74,0x7b,0x63,0x6f,0x6e,0x6e,0x3a,0x20,0x63,0x6f,0x6e,0x6e,0x2c,0x20,0x73,0x74,0x61,0x72,0x74,0x65,0x64,0x3a,0x20,0x74,0x72,0x75,0x65,0x2c,0x20,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x3a,0x20,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x7d,0x2c,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x2c,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x73,0x20,0x2a,0x57,0x65,0x62,0x53,0x6f,0x63,0x6b,0x65,0x74,0x29,0x20,0x55,0x70,0x67,0x72,0x61,0x64,0x65,0x28,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x73,0x2e,0x73,0x74,0x61,0x72,0x74,0x65,0x64,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x73,0x2e,0x63,0x6f,0x6e,0x6e,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x77,0x65,0x62,0x73,0x6f,0x63,0x6b,0x65,0x74,0x2e,0x45,0x72,0x72,0x42,0x61,0x64,0x48,0x61,0x6e,0x64,0x73,0x68,0x61,0x6b,0x65,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x7d,0x0a,0x09,0x73,0x2e,0x73,0x74,0x61,0x72,0x74,0x65,0x64,0x20,0x3d,0x20,0x74,0x72,0x75,0x65,0x0a,0x0a,0x09,0x75,0x70,0x67,0x72,0x61,0x64,0x65,0x72,0x20,0x3a,0x3d,0x20,0x26,0x77,0x65,0x62,0x73,0x6f,0x63,0x6b,0x65,0x74,0x2e,0x55,0x70,0x67,0x72,0x61,0x64,0x65,0x72,0x7b,0x0a,0x09,0x09,0x43,0x68,0x65,0x63,0x6b,0x4f,0x72,0x69,0x67,0x69,0x6e,0x3a,0x20,0x20,0x20,0x20,0x20,0x20,0x73,0x2e,0x6f,0x70,0x74,0x73,0x2e,0x43,0x68,0x65,0x63,0x6b,0x4f,0x72,0x69,0x67,0x69,0x6e,0x2c,0x0a,0x09,0x09,0x52,0x65,0x61,0x64,0x42,0x75,0x66,0x66,0x65,0x72,0x53,0x69,0x7a,0x65,0x3a,0x20,0x20,0x20,0x73,0x2e,0x6f,0x70,0x74,0x73,0x2e,0x52,0x65,0x61,0x64,0x42,0x75,0x66,0x66,0x65,0x72,0x53,0x69,0x7a,0x65,0x2c,0x0a,0x09,0x09,0x57,0x72,0x69,0x74,0x65,0x42,0x75,0x66,0x66,0x65,0x72,0x53,0x69,0x7a,0x65,0x3a,0x20,0x20,0x73,0x2e,0x6f,0x70,0x74,0x73,0x2e,0x57,0x72,0x69,0x74,0x65,0x42,0x75,0x66,0x66,0x65,0x72,0x53,0x69,0x7a,0x65,0x2c,0x0a,0x09,0x09,0x48,0x61,0x6e,0x64,0x73,0x68,0x61,0x6b,0x65,0x54,0x69,0x6d,0x65,0x6f,0x75,0x74,0x3a,0x20,0x73,0x2e,0x6f,0x70,0x74,0x73,0x2e,0x48,0x61,0x6e,0x64,0x73,0x68,0x61,0x6b,0x65,0x54,0x69,0x6d,0x65,0x6f,0x75,0x74,0x2c,0x0a,0x09,0x7d,0x0a,0x09,0x63,0x6f,0x6e,0x6e,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x75,0x70,0x67,0x72,0x61,0x64,0x65,0x72,0x2e,0x55,0x70,0x67,0x72,0x61,0x64,0x65,0x28,0x73,0x2e,0x77,0x2c,0x20,0x73,0x2e,0x72,0x2c,0x20,0x6e,0x69,0x6c,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x09,0x73,0x2e,0x63,0x6f,0x6e,0x6e,0x20,0x3d,0x20,0x63,0x6f,0x6e,0x6e,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x73,0x20,0x2a,0x57,0x65,0x62,0x53,0x6f,0x63,0x6b,0x65,0x74,0x29,0x20,0x53,0x74,0x61,0x72,0x74,0x65,0x64,0x28,0x29,0x20,0x62,0x6f,0x6f,0x6c,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x73,0x2e,0x73,0x74,0x61,0x72,0x74,0x65,0x64,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x73,0x20,0x2a,0x57,0x65,0x62,0x53,0x6f,0x63,0x6b,0x65,0x74,0x29,0x20,0x52,0x65,0x61,0x64,0x28,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x73,0x2e,0x55,0x70,0x67,0x72,0x61,0x64,0x65,0x28,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x73,0x2e,0x63,0x6f,0x6e,0x6e,0x2e,0x52,0x65,0x61,0x64,0x4a,0x53,0x4f,0x4e,0x28,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x77,0x65,0x62,0x73,0x6f,0x63,0x6b,0x65,0x74,0x2e,0x49,0x73,0x43,0x6c,0x6f,0x73,0x65,0x45,0x72,0x72,0x6f,0x72,0x28,0x65,0x72,0x72,0x2c,0x20,0x77,0x65,0x62,0x73,0x6f,0x63,0x6b,0x65,0x74,0x2e,0x43,0x6c,0x6f,0x73,0x65,0x4e,0x6f,0x72,0x6d,0x61,0x6c,0x43,0x6c,0x6f,0x73,0x75,0x72,0x65,0x2c,0x20,0x77,0x65,0x62,0x73,0x6f,0x63,0x6b,0x65,0x74,0x2e,0x43,0x6c,0x6f,0x73,0x65,0x47,0x6f,0x69,0x6e,0x67,0x41,0x77,0x61,0x79,0x29,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x69,0x6f,0x2e,0x45,0x4f,0x46,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x69,0x66,0x20,0x73,0x2e,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x73,0x2e,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x28,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x73,0x20,0x2a,0x57,0x65,0x62,0x53,0x6f,0x63,0x6b,0x65,0x74,0x29,0x20,0x57,0x72,0x69,0x74,0x65,0x28,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x73,0x2e,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x73,0x2e,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x65,0x28,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x09,0x7d,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x73,0x2e,0x55,0x70,0x67,0x72,0x61,0x64,0x65,0x28,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x73,0x2e,0x63,0x6f,0x6e,0x6e,0x2e,0x57,0x72,0x69,0x74,0x65,0x4a,0x53,0x4f,0x4e,0x28,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x73,0x20,0x2a,0x57,0x65,0x62,0x53,0x6f,0x63,0x6b,0x65,0x74,0x29,0x20,0x43,0x6c,0x6f,0x73,0x65,0x28,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x73,0x2e,0x63,0x6f,0x6e,0x6e,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x3a,0x3d,0x20,0x77,0x65,0x62,0x73,0x6f,0x63,0x6b,0x65,0x74,0x2e,0x46,0x6f,0x72,0x6d,0x61,0x74,0x43,0x6c,0x6f,0x73,0x65,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x28,0x77,0x65,0x62,0x73,0x6f,0x63,0x6b,0x65,0x74,0x2e,0x43,0x6c,0x6f,0x73,0x65,0x4e,0x6f,0x72,0x6d,0x61,0x6c,0x43,0x6c,0x6f,0x73,0x75,0x72,0x65,0x2c,0x20,0x22,0x22,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x73,0x2e,0x63,0x6f,0x6e,0x6e,0x2e,0x57,0x72,0x69,0x74,0x65,0x43,0x6f,0x6e,0x74,0x72,0x6f,0x6c,0x28,0x77,0x65,0x62,0x73,0x6f,0x63,0x6b,0x65,0x74,0x2e,0x43,0x6c,0x6f,0x73,0x65,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x2c,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x2c,0x20,0x74,0x69,0x6d,0x65,0x2e,0x4e,0x6f,0x77,0x28,0x29,0x2e,0x41,0x64,0x64,0x28,0x77,0x65,0x62,0x53,0x6f,0x63,0x6b,0x65,0x74,0x43,0x6c,0x6f,0x73,0x65,0x54,0x69,0x6d,0x65,0x6f,0x75,0x74,0x29,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x26,0x26,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x77,0x65,0x62,0x73,0x6f,0x63,0x6b,0x65,0x74,0x2e,0x45,0x72,0x72,0x43,0x6c,0x6f,0x73,0x65,0x53,0x65,0x6e,0x74,0x20,0x7b,0x0a,0x09,0x09,0x73,0x2e,0x63,0x6f,0x6e,0x6e,0x2e,0x43,0x6c,0x6f,0x73,0x65,0x28,0x29,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x73,0x2e,0x63,0x6f,0x6e,0x6e,0x2e,0x43,0x6c,0x6f,0x73,0x65,0x28,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x4b,0x65,0x79,0x20,0x69,0x6e,0x74,0x0a,0x0a,0x63,0x6f,0x6e,0x73,0x74,0x20,0x28,0x0a,0x09,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x48,0x65,0x61,0x64,0x65,0x72,0x4b,0x65,0x79,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x4b,0x65,0x79,0x20,0x3d,0x20,0x31,0x20,0x2b,0x20,0x69,0x6f,0x74,0x61,0x0a,0x29,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x48,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x20,0x7b,0x0a,0x09,0x47,0x65,0x74,0x48,0x54,0x54,0x50,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x48,0x65,0x61,0x64,0x65,0x72,0x73,0x28,0x29,0x20,0x28,0x68,0x74,0x74,0x70,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x62,0x6f,0x6f,0x6c,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x43,0x72,0x65,0x61,0x74,0x65,0x48,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x28,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x68,0x74,0x74,0x70,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x7b,0x0a,0x09,0x63,0x74,0x78,0x20,0x3a,0x3d,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x42,0x61,0x63,0x6b,0x67,0x72,0x6f,0x75,0x6e,0x64,0x28,0x29,0x0a,0x09,0x63,0x74,0x78,0x20,0x3d,0x20,0x68,0x54,0x54,0x50,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x48,0x65,0x61,0x64,0x65,0x72,0x73,0x28,0x63,0x74,0x78,0x2c,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x29,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x63,0x74,0x78,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x68,0x54,0x54,0x50,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x48,0x65,0x61,0x64,0x65,0x72,0x73,0x28,0x63,0x74,0x78,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2c,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x68,0x74,0x74,0x70,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x57,0x69,0x74,0x68,0x56,0x61,0x6c,0x75,0x65,0x28,0x63,0x74,0x78,0x2c,0x20,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x48,0x65,0x61,0x64,0x65,0x72,0x4b,0x65,0x79,0x2c,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x48,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x57,0x72,0x61,0x70,0x70,0x65,0x72,0x28,0x63,0x74,0x78,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x29,0x20,0x48,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x7b,0x0a,0x09,0x69,0x66,0x20,0x63,0x74,0x78,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x63,0x74,0x78,0x20,0x3d,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x42,0x61,0x63,0x6b,0x67,0x72,0x6f,0x75,0x6e,0x64,0x28,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x68,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x7b,0x0a,0x09,0x09,0x63,0x74,0x78,0x2c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x68,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x63,0x20,0x2a,0x68,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x29,0x20,0x47,0x65,0x74,0x48,0x54,0x54,0x50,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x48,0x65,0x61,0x64,0x65,0x72,0x73,0x28,0x29,0x20,0x28,0x68,0x74,0x74,0x70,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x62,0x6f,0x6f,0x6c,0x29,0x20,0x7b,0x0a,0x09,0x68,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x6f,0x6b,0x20,0x3a,0x3d,0x20,0x63,0x2e,0x56,0x61,0x6c,0x75,0x65,0x28,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x48,0x65,0x61,0x64,0x65,0x72,0x4b,0x65,0x79,0x29,0x2e,0x28,0x68,0x74,0x74,0x70,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x2c,0x20,0x6f,0x6b,0x0a,0x7d,0x0a,0x0a,0x76,0x61,0x72,0x20,0x45,0x72,0x72,0x43,0x6f,0x6c,0x6c,0x69,0x73,0x69,0x6f,0x6e,0x4d,0x61,0x70,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x3d,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4e,0x65,0x77,0x28,0x22,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x66,0x72,0x6f,0x6d,0x20,0x63,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x6f,0x76,0x65,0x72,0x77,0x72,0x69,0x74,0x65,0x73,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x69,0x6e,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x20,0x6f,0x62,0x6a,0x65,0x63,0x74,0x22,0x29,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x53,0x65,0x74,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x48,0x65,0x61,0x64,0x65,0x72,0x73,0x46,0x72,0x6f,0x6d,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x28,0x68,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x48,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2c,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x20,0x68,0x74,0x74,0x70,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x69,0x66,0x20,0x68,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x68,0x65,0x61,0x64,0x65,0x72,0x73,0x46,0x72,0x6f,0x6d,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2c,0x20,0x6f,0x6b,0x20,0x3a,0x3d,0x20,0x68,0x74,0x74,0x70,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x2e,0x47,0x65,0x74,0x48,0x54,0x54,0x50,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x48,0x65,0x61,0x64,0x65,0x72,0x73,0x28,0x29,0x0a,0x09,0x69,0x66,0x20,0x21,0x6f,0x6b,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x66,0x6f,0x72,0x20,0x6b,0x65,0x79,0x2c,0x20,0x76,0x61,0x6c,0x75,0x65,0x73,0x20,0x3a,0x3d,0x20,0x72,0x61,0x6e,0x67,0x65,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x73,0x46,0x72,0x6f,0x6d,0x43,0x6f,0x6e,0x74,0x65,0x78,0x74,0x20,0x7b,0x0a,0x09,0x09,0x69,0x66,0x20,0x5f,0x2c,0x20,0x65,0x78,0x69,0x73,0x74,0x73,0x20,0x3a,0x3d,0x20,0x68,0x65,0x61,0x64,0x65,0x72,0x5b,0x6b,0x65,0x79,0x5d,0x3b,0x20,0x65,0x78,0x69,0x73,0x74,0x73,0x20,0x7b,0x0a,0x09,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x45,0x72,0x72,0x43,0x6f,0x6c,0x6c,0x69,0x73,0x69,0x6f,0x6e,0x4d,0x61,0x70,0x0a,0x09,0x09,0x7d,0x0a,0x0a,0x09,0x09,0x68,0x65,0x61,0x64,0x65,0x72,0x5b,0x6b,0x65,0x79,0x5d,0x20,0x3d,0x20,0x76,0x61,0x6c,0x75,0x65,0x73,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x66,0x75,0x6e,0x63,0x20,0x53,0x65,0x72,0x76,0x65,0x58,0x6d,0x6c,0x28,0x77,0x20,0x68,0x74,0x74,0x70,0x2e,0x52,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x57,0x72,0x69,0x74,0x65,0x72,0x2c,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x69,0x6e,0x74,0x2c,0x20,0x76,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x77,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x28,0x29,0x5b,0x22,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x2d,0x54,0x79,0x70,0x65,0x22,0x5d,0x20,0x3d,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x7b,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x7d,0x0a,0x09,0x77,0x2e,0x57,0x72,0x69,0x74,0x65,0x48,0x65,0x61,0x64,0x65,0x72,0x28,0x73,0x74,0x61,0x74,0x75,0x73,0x29,0x0a,0x09,0x69,0x66,0x20,0x5f,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x69,0x6f,0x2e,0x57,0x72,0x69,0x74,0x65,0x53,0x74,0x72,0x69,0x6e,0x67,0x28,0x77,0x2c,0x20,0x78,0x6d,0x6c,0x2e,0x48,0x65,0x61,0x64,0x65,0x72,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x78,0x6d,0x6c,0x2e,0x4e,0x65,0x77,0x45,0x6e,0x63,0x6f,0x64,0x65,0x72,0x28,0x77,0x29,0x2e,0x45,0x6e,0x63,0x6f,0x64,0x65,0x28,0x76,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x72,0x72,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x6e,0x69,0x6c,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x58,0x48,0x54,0x54,0x50,0x45,0x72,0x72,0x6f,0x72,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x20,0x7b,0x0a,0x09,0x65,0x72,0x72,0x6f,0x72,0x0a,0x0a,0x09,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x29,0x20,0x69,0x6e,0x74,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x48,0x74,0x74,0x70,0x43,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x20,0x69,0x6e,0x74,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x48,0x54,0x54,0x50,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x28,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x69,0x6e,0x74,0x29,0x20,0x58,0x48,0x54,0x54,0x50,0x45,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x48,0x74,0x74,0x70,0x43,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x7b,0x73,0x74,0x61,0x74,0x75,0x73,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x48,0x74,0x74,0x70,0x43,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x54,0x65,0x78,0x74,0x28,0x65,0x2e,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x48,0x74,0x74,0x70,0x43,0x6f,0x64,0x65,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x29,0x20,0x69,0x6e,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x2e,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x48,0x74,0x74,0x70,0x4a,0x73,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x20,0x69,0x6e,0x74,0x0a,0x09,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x20,0x20,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x4a,0x73,0x6f,0x6e,0x48,0x54,0x54,0x50,0x45,0x72,0x72,0x6f,0x72,0x28,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x69,0x6e,0x74,0x2c,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x58,0x48,0x54,0x54,0x50,0x45,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x48,0x74,0x74,0x70,0x4a,0x73,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x7b,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x3a,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x2c,0x20,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x3a,0x20,0x6d,0x65,0x73,0x73,0x61,0x67,0x65,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x48,0x74,0x74,0x70,0x4a,0x73,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x29,0x20,0x69,0x6e,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x2e,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x48,0x74,0x74,0x70,0x4a,0x73,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x73,0x3a,0x20,0x25,0x76,0x22,0x2c,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x54,0x65,0x78,0x74,0x28,0x65,0x2e,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x29,0x2c,0x20,0x65,0x2e,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x48,0x74,0x74,0x70,0x58,0x6d,0x6c,0x45,0x72,0x72,0x6f,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x20,0x20,0x69,0x6e,0x74,0x0a,0x09,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x0a,0x09,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x20,0x20,0x20,0x20,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x0a,0x0a,0x09,0x45,0x72,0x72,0x6f,0x72,0x73,0x20,0x2a,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x2e,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x4f,0x62,0x6a,0x65,0x63,0x74,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x58,0x6d,0x6c,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x48,0x54,0x54,0x50,0x45,0x72,0x72,0x6f,0x72,0x28,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x20,0x2a,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x2e,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x4f,0x62,0x6a,0x65,0x63,0x74,0x2c,0x20,0x62,0x6f,0x64,0x79,0x20,0x69,0x6e,0x74,0x65,0x72,0x66,0x61,0x63,0x65,0x7b,0x7d,0x29,0x20,0x58,0x48,0x54,0x54,0x50,0x45,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x64,0x61,0x74,0x61,0x2c,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x6a,0x73,0x6f,0x6e,0x2e,0x4d,0x61,0x72,0x73,0x68,0x61,0x6c,0x28,0x65,0x72,0x72,0x6f,0x72,0x73,0x29,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x65,0x72,0x72,0x20,0x3d,0x20,0x6a,0x73,0x6f,0x6e,0x2e,0x55,0x6e,0x6d,0x61,0x72,0x73,0x68,0x61,0x6c,0x28,0x64,0x61,0x74,0x61,0x2c,0x20,0x62,0x6f,0x64,0x79,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x4e,0x65,0x77,0x4a,0x73,0x6f,0x6e,0x48,0x54,0x54,0x50,0x45,0x72,0x72,0x6f,0x72,0x28,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x42,0x61,0x64,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x48,0x74,0x74,0x70,0x58,0x6d,0x6c,0x45,0x72,0x72,0x6f,0x72,0x7b,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x3a,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x42,0x61,0x64,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x2c,0x20,0x43,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x3a,0x20,0x63,0x6f,0x6e,0x74,0x65,0x6e,0x74,0x54,0x79,0x70,0x65,0x2c,0x20,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x3a,0x20,0x62,0x6f,0x64,0x79,0x2c,0x20,0x45,0x72,0x72,0x6f,0x72,0x73,0x3a,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x48,0x74,0x74,0x70,0x58,0x6d,0x6c,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x29,0x20,0x69,0x6e,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x2e,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x48,0x74,0x74,0x70,0x58,0x6d,0x6c,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x69,0x66,0x20,0x65,0x2e,0x45,0x72,0x72,0x6f,0x72,0x73,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x73,0x3a,0x20,0x25,0x73,0x22,0x2c,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x54,0x65,0x78,0x74,0x28,0x65,0x2e,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x29,0x2c,0x20,0x65,0x2e,0x45,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x73,0x3a,0x20,0x25,0x76,0x22,0x2c,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x54,0x65,0x78,0x74,0x28,0x65,0x2e,0x73,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x29,0x2c,0x20,0x65,0x2e,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x48,0x74,0x74,0x70,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x45,0x72,0x72,0x6f,0x72,0x73,0x20,0x2a,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x2e,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x4f,0x62,0x6a,0x65,0x63,0x74,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x48,0x54,0x54,0x50,0x45,0x72,0x72,0x6f,0x72,0x28,0x65,0x72,0x72,0x6f,0x72,0x73,0x20,0x2a,0x76,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x2e,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x73,0x4f,0x62,0x6a,0x65,0x63,0x74,0x29,0x20,0x58,0x48,0x54,0x54,0x50,0x45,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x48,0x74,0x74,0x70,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x7b,0x45,0x72,0x72,0x6f,0x72,0x73,0x3a,0x20,0x65,0x72,0x72,0x6f,0x72,0x73,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x48,0x74,0x74,0x70,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x53,0x74,0x61,0x74,0x75,0x73,0x43,0x6f,0x64,0x65,0x28,0x29,0x20,0x69,0x6e,0x74,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x42,0x61,0x64,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x48,0x74,0x74,0x70,0x56,0x61,0x6c,0x69,0x64,0x61,0x74,0x69,0x6f,0x6e,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x69,0x66,0x20,0x65,0x2e,0x45,0x72,0x72,0x6f,0x72,0x73,0x20,0x3d,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x54,0x65,0x78,0x74,0x28,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x42,0x61,0x64,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x29,0x0a,0x09,0x7d,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x73,0x3a,0x20,0x25,0x73,0x22,0x2c,0x20,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x54,0x65,0x78,0x74,0x28,0x68,0x74,0x74,0x70,0x2e,0x53,0x74,0x61,0x74,0x75,0x73,0x42,0x61,0x64,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x29,0x2c,0x20,0x65,0x2e,0x45,0x72,0x72,0x6f,0x72,0x73,0x2e,0x4d,0x65,0x73,0x73,0x61,0x67,0x65,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x53,0x74,0x72,0x65,0x61,0x6d,0x45,0x72,0x72,0x6f,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x45,0x72,0x72,0x20,0x65,0x72,0x72,0x6f,0x72,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x53,0x74,0x72,0x65,0x61,0x6d,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x45,0x72,0x72,0x6f,0x72,0x28,0x29,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x65,0x72,0x72,0x6f,0x72,0x20,0x73,0x74,0x72,0x65,0x61,0x6d,0x69,0x6e,0x67,0x20,0x72,0x65,0x73,0x70,0x6f,0x6e,0x73,0x65,0x3a,0x20,0x25,0x76,0x22,0x2c,0x20,0x65,0x2e,0x45,0x72,0x72,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x65,0x20,0x2a,0x53,0x74,0x72,0x65,0x61,0x6d,0x45,0x72,0x72,0x6f,0x72,0x29,0x20,0x55,0x6e,0x77,0x72,0x61,0x70,0x28,0x29,0x20,0x65,0x72,0x72,0x6f,0x72,0x20,0x7b,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x65,0x2e,0x45,0x72,0x72,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x50,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x48,0x61,0x6e,0x64,0x6c,0x65,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x20,0x20,0x20,0x2a,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x56,0x65,0x63,0x0a,0x09,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x20,0x2a,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x48,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x56,0x65,0x63,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x50,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x48,0x61,0x6e,0x64,0x6c,0x65,0x72,0x28,0x6e,0x61,0x6d,0x65,0x73,0x70,0x61,0x63,0x65,0x20,0x2a,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x2a,0x50,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x48,0x61,0x6e,0x64,0x6c,0x65,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x4e,0x61,0x6d,0x65,0x20,0x3a,0x3d,0x20,0x22,0x61,0x70,0x69,0x5f,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x5f,0x6e,0x75,0x6d,0x62,0x65,0x72,0x5f,0x74,0x6f,0x74,0x61,0x6c,0x22,0x0a,0x09,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x4e,0x61,0x6d,0x65,0x20,0x3a,0x3d,0x20,0x22,0x61,0x70,0x69,0x5f,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x5f,0x64,0x75,0x72,0x61,0x74,0x69,0x6f,0x6e,0x5f,0x73,0x65,0x63,0x6f,0x6e,0x64,0x73,0x22,0x0a,0x0a,0x09,0x69,0x66,0x20,0x6e,0x61,0x6d,0x65,0x73,0x70,0x61,0x63,0x65,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x4e,0x61,0x6d,0x65,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x73,0x5f,0x25,0x73,0x22,0x2c,0x20,0x2a,0x6e,0x61,0x6d,0x65,0x73,0x70,0x61,0x63,0x65,0x2c,0x20,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x4e,0x61,0x6d,0x65,0x29,0x0a,0x09,0x09,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x4e,0x61,0x6d,0x65,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x73,0x5f,0x25,0x73,0x22,0x2c,0x20,0x2a,0x6e,0x61,0x6d,0x65,0x73,0x70,0x61,0x63,0x65,0x2c,0x20,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x4e,0x61,0x6d,0x65,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x20,0x3a,0x3d,0x20,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x4e,0x65,0x77,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x56,0x65,0x63,0x28,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x4f,0x70,0x74,0x73,0x7b,0x0a,0x09,0x09,0x4e,0x61,0x6d,0x65,0x3a,0x20,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x4e,0x61,0x6d,0x65,0x2c,0x0a,0x09,0x09,0x48,0x65,0x6c,0x70,0x3a,0x20,0x22,0x54,0x6f,0x74,0x61,0x6c,0x20,0x6e,0x75,0x6d,0x62,0x65,0x72,0x20,0x41,0x50,0x49,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x73,0x20,0x73,0x65,0x6e,0x74,0x20,0x62,0x79,0x20,0x74,0x68,0x65,0x20,0x73,0x65,0x72,0x76,0x69,0x63,0x65,0x2e,0x22,0x2c,0x0a,0x09,0x7d,0x2c,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x7b,0x22,0x68,0x61,0x6e,0x64,0x6c,0x65,0x72,0x22,0x2c,0x20,0x22,0x6d,0x65,0x74,0x68,0x6f,0x64,0x22,0x2c,0x20,0x22,0x73,0x74,0x61,0x74,0x75,0x73,0x22,0x7d,0x29,0x0a,0x0a,0x09,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x20,0x3a,0x3d,0x20,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x4e,0x65,0x77,0x48,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x56,0x65,0x63,0x28,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x48,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x4f,0x70,0x74,0x73,0x7b,0x0a,0x09,0x09,0x4e,0x61,0x6d,0x65,0x3a,0x20,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x4e,0x61,0x6d,0x65,0x2c,0x0a,0x09,0x09,0x48,0x65,0x6c,0x70,0x3a,0x20,0x22,0x44,0x75,0x72,0x61,0x74,0x69,0x6f,0x6e,0x20,0x6f,0x66,0x20,0x74,0x68,0x65,0x20,0x41,0x50,0x49,0x20,0x72,0x65,0x71,0x75,0x65,0x73,0x74,0x73,0x20,0x62,0x65,0x69,0x6e,0x67,0x20,0x68,0x61,0x6e,0x64,0x6c,0x65,0x64,0x22,0x2c,0x0a,0x09,0x7d,0x2c,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x7b,0x22,0x68,0x61,0x6e,0x64,0x6c,0x65,0x72,0x22,0x7d,0x29,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x52,0x65,0x67,0x69,0x73,0x74,0x65,0x72,0x28,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x6c,0x6f,0x67,0x72,0x75,0x73,0x2e,0x57,0x69,0x74,0x68,0x45,0x72,0x72,0x6f,0x72,0x28,0x65,0x72,0x72,0x29,0x2e,0x57,0x61,0x72,0x6e,0x28,0x22,0x66,0x61,0x69,0x6c,0x65,0x64,0x20,0x74,0x6f,0x20,0x72,0x65,0x67,0x69,0x73,0x74,0x65,0x72,0x20,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x20,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x22,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x52,0x65,0x67,0x69,0x73,0x74,0x65,0x72,0x28,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x6c,0x6f,0x67,0x72,0x75,0x73,0x2e,0x57,0x69,0x74,0x68,0x45,0x72,0x72,0x6f,0x72,0x28,0x65,0x72,0x72,0x29,0x2e,0x57,0x61,0x72,0x6e,0x28,0x22,0x66,0x61,0x69,0x6c,0x65,0x64,0x20,0x74,0x6f,0x20,0x72,0x65,0x67,0x69,0x73,0x74,0x65,0x72,0x20,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x20,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x22,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x68,0x20,0x3a,0x3d,0x20,0x26,0x50,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x48,0x61,0x6e,0x64,0x6c,0x65,0x72,0x7b,0x0a,0x09,0x09,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x3a,0x20,0x20,0x20,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x2c,0x0a,0x09,0x09,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x3a,0x20,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x2c,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x68,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x68,0x20,0x2a,0x50,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x48,0x61,0x6e,0x64,0x6c,0x65,0x72,0x29,0x20,0x49,0x6e,0x69,0x74,0x4d,0x65,0x74,0x72,0x69,0x63,0x28,0x70,0x61,0x74,0x68,0x2c,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x68,0x2e,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x2e,0x57,0x69,0x74,0x68,0x4c,0x61,0x62,0x65,0x6c,0x56,0x61,0x6c,0x75,0x65,0x73,0x28,0x70,0x61,0x74,0x68,0x2c,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x2c,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x49,0x74,0x6f,0x61,0x28,0x32,0x30,0x30,0x29,0x29,0x0a,0x09,0x68,0x2e,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x2e,0x57,0x69,0x74,0x68,0x4c,0x61,0x62,0x65,0x6c,0x56,0x61,0x6c,0x75,0x65,0x73,0x28,0x70,0x61,0x74,0x68,0x29,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x68,0x20,0x2a,0x50,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x48,0x61,0x6e,0x64,0x6c,0x65,0x72,0x29,0x20,0x48,0x61,0x6e,0x64,0x6c,0x65,0x52,0x65,0x71,0x75,0x65,0x73,0x74,0x28,0x70,0x61,0x74,0x68,0x2c,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x2c,0x20,0x73,0x74,0x61,0x74,0x75,0x73,0x20,0x69,0x6e,0x74,0x2c,0x20,0x64,0x75,0x72,0x61,0x74,0x69,0x6f,0x6e,0x20,0x74,0x69,0x6d,0x65,0x2e,0x44,0x75,0x72,0x61,0x74,0x69,0x6f,0x6e,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x68,0x2e,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x2e,0x57,0x69,0x74,0x68,0x4c,0x61,0x62,0x65,0x6c,0x56,0x61,0x6c,0x75,0x65,0x73,0x28,0x70,0x61,0x74,0x68,0x2c,0x20,0x6d,0x65,0x74,0x68,0x6f,0x64,0x2c,0x20,0x73,0x74,0x72,0x63,0x6f,0x6e,0x76,0x2e,0x49,0x74,0x6f,0x61,0x28,0x73,0x74,0x61,0x74,0x75,0x73,0x29,0x29,0x2e,0x49,0x6e,0x63,0x28,0x29,0x0a,0x09,0x68,0x2e,0x68,0x69,0x73,0x74,0x6f,0x67,0x72,0x61,0x6d,0x2e,0x57,0x69,0x74,0x68,0x4c,0x61,0x62,0x65,0x6c,0x56,0x61,0x6c,0x75,0x65,0x73,0x28,0x70,0x61,0x74,0x68,0x29,0x2e,0x4f,0x62,0x73,0x65,0x72,0x76,0x65,0x28,0x64,0x75,0x72,0x61,0x74,0x69,0x6f,0x6e,0x2e,0x53,0x65,0x63,0x6f,0x6e,0x64,0x73,0x28,0x29,0x29,0x0a,0x7d,0x0a,0x0a,0x74,0x79,0x70,0x65,0x20,0x43,0x69,0x72,0x63,0x75,0x69,0x74,0x42,0x72,0x65,0x61,0x6b,0x65,0x72,0x48,0x61,0x6e,0x64,0x6c,0x65,0x72,0x20,0x73,0x74,0x72,0x75,0x63,0x74,0x20,0x7b,0x0a,0x09,0x73,0x74,0x61,0x74,0x65,0x20,0x20,0x20,0x2a,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x47,0x61,0x75,0x67,0x65,0x56,0x65,0x63,0x0a,0x09,0x63,0x68,0x61,0x6e,0x67,0x65,0x73,0x20,0x2a,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x56,0x65,0x63,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x4e,0x65,0x77,0x43,0x69,0x72,0x63,0x75,0x69,0x74,0x42,0x72,0x65,0x61,0x6b,0x65,0x72,0x48,0x61,0x6e,0x64,0x6c,0x65,0x72,0x28,0x6e,0x61,0x6d,0x65,0x73,0x70,0x61,0x63,0x65,0x20,0x2a,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x2a,0x43,0x69,0x72,0x63,0x75,0x69,0x74,0x42,0x72,0x65,0x61,0x6b,0x65,0x72,0x48,0x61,0x6e,0x64,0x6c,0x65,0x72,0x20,0x7b,0x0a,0x0a,0x09,0x73,0x74,0x61,0x74,0x65,0x4e,0x61,0x6d,0x65,0x20,0x3a,0x3d,0x20,0x22,0x61,0x70,0x69,0x5f,0x63,0x69,0x72,0x63,0x75,0x69,0x74,0x5f,0x62,0x72,0x65,0x61,0x6b,0x65,0x72,0x5f,0x73,0x74,0x61,0x74,0x65,0x22,0x0a,0x09,0x63,0x68,0x61,0x6e,0x67,0x65,0x73,0x4e,0x61,0x6d,0x65,0x20,0x3a,0x3d,0x20,0x22,0x61,0x70,0x69,0x5f,0x63,0x69,0x72,0x63,0x75,0x69,0x74,0x5f,0x62,0x72,0x65,0x61,0x6b,0x65,0x72,0x5f,0x73,0x74,0x61,0x74,0x65,0x5f,0x63,0x68,0x61,0x6e,0x67,0x65,0x73,0x5f,0x74,0x6f,0x74,0x61,0x6c,0x22,0x0a,0x0a,0x09,0x69,0x66,0x20,0x6e,0x61,0x6d,0x65,0x73,0x70,0x61,0x63,0x65,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x73,0x74,0x61,0x74,0x65,0x4e,0x61,0x6d,0x65,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x73,0x5f,0x25,0x73,0x22,0x2c,0x20,0x2a,0x6e,0x61,0x6d,0x65,0x73,0x70,0x61,0x63,0x65,0x2c,0x20,0x73,0x74,0x61,0x74,0x65,0x4e,0x61,0x6d,0x65,0x29,0x0a,0x09,0x09,0x63,0x68,0x61,0x6e,0x67,0x65,0x73,0x4e,0x61,0x6d,0x65,0x20,0x3d,0x20,0x66,0x6d,0x74,0x2e,0x53,0x70,0x72,0x69,0x6e,0x74,0x66,0x28,0x22,0x25,0x73,0x5f,0x25,0x73,0x22,0x2c,0x20,0x2a,0x6e,0x61,0x6d,0x65,0x73,0x70,0x61,0x63,0x65,0x2c,0x20,0x63,0x68,0x61,0x6e,0x67,0x65,0x73,0x4e,0x61,0x6d,0x65,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x73,0x74,0x61,0x74,0x65,0x20,0x3a,0x3d,0x20,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x4e,0x65,0x77,0x47,0x61,0x75,0x67,0x65,0x56,0x65,0x63,0x28,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x47,0x61,0x75,0x67,0x65,0x4f,0x70,0x74,0x73,0x7b,0x0a,0x09,0x09,0x4e,0x61,0x6d,0x65,0x3a,0x20,0x73,0x74,0x61,0x74,0x65,0x4e,0x61,0x6d,0x65,0x2c,0x0a,0x09,0x09,0x48,0x65,0x6c,0x70,0x3a,0x20,0x22,0x43,0x75,0x72,0x72,0x65,0x6e,0x74,0x20,0x73,0x74,0x61,0x74,0x65,0x20,0x6f,0x66,0x20,0x74,0x68,0x65,0x20,0x63,0x69,0x72,0x63,0x75,0x69,0x74,0x73,0x2c,0x20,0x74,0x68,0x65,0x20,0x67,0x61,0x75,0x67,0x65,0x20,0x6f,0x66,0x20,0x74,0x68,0x65,0x20,0x63,0x75,0x72,0x72,0x65,0x6e,0x74,0x20,0x73,0x74,0x61,0x74,0x65,0x20,0x69,0x73,0x20,0x31,0x2e,0x22,0x2c,0x0a,0x09,0x7d,0x2c,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x7b,0x22,0x63,0x69,0x72,0x63,0x75,0x69,0x74,0x22,0x2c,0x20,0x22,0x73,0x74,0x61,0x74,0x65,0x22,0x7d,0x29,0x0a,0x0a,0x09,0x63,0x68,0x61,0x6e,0x67,0x65,0x73,0x20,0x3a,0x3d,0x20,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x4e,0x65,0x77,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x56,0x65,0x63,0x28,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x43,0x6f,0x75,0x6e,0x74,0x65,0x72,0x4f,0x70,0x74,0x73,0x7b,0x0a,0x09,0x09,0x4e,0x61,0x6d,0x65,0x3a,0x20,0x63,0x68,0x61,0x6e,0x67,0x65,0x73,0x4e,0x61,0x6d,0x65,0x2c,0x0a,0x09,0x09,0x48,0x65,0x6c,0x70,0x3a,0x20,0x22,0x54,0x6f,0x74,0x61,0x6c,0x20,0x6e,0x75,0x6d,0x62,0x65,0x72,0x20,0x6f,0x66,0x20,0x73,0x74,0x61,0x74,0x65,0x20,0x63,0x68,0x61,0x6e,0x67,0x65,0x73,0x20,0x6f,0x66,0x20,0x74,0x68,0x65,0x20,0x63,0x69,0x72,0x63,0x75,0x69,0x74,0x73,0x2e,0x22,0x2c,0x0a,0x09,0x7d,0x2c,0x20,0x5b,0x5d,0x73,0x74,0x72,0x69,0x6e,0x67,0x7b,0x22,0x63,0x69,0x72,0x63,0x75,0x69,0x74,0x22,0x2c,0x20,0x22,0x66,0x72,0x6f,0x6d,0x22,0x2c,0x20,0x22,0x74,0x6f,0x22,0x7d,0x29,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x52,0x65,0x67,0x69,0x73,0x74,0x65,0x72,0x28,0x73,0x74,0x61,0x74,0x65,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x6c,0x6f,0x67,0x72,0x75,0x73,0x2e,0x57,0x69,0x74,0x68,0x45,0x72,0x72,0x6f,0x72,0x28,0x65,0x72,0x72,0x29,0x2e,0x57,0x61,0x72,0x6e,0x28,0x22,0x66,0x61,0x69,0x6c,0x65,0x64,0x20,0x74,0x6f,0x20,0x72,0x65,0x67,0x69,0x73,0x74,0x65,0x72,0x20,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x20,0x67,0x61,0x75,0x67,0x65,0x22,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x69,0x66,0x20,0x65,0x72,0x72,0x20,0x3a,0x3d,0x20,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x2e,0x52,0x65,0x67,0x69,0x73,0x74,0x65,0x72,0x28,0x63,0x68,0x61,0x6e,0x67,0x65,0x73,0x29,0x3b,0x20,0x65,0x72,0x72,0x20,0x21,0x3d,0x20,0x6e,0x69,0x6c,0x20,0x7b,0x0a,0x09,0x09,0x6c,0x6f,0x67,0x72,0x75,0x73,0x2e,0x57,0x69,0x74,0x68,0x45,0x72,0x72,0x6f,0x72,0x28,0x65,0x72,0x72,0x29,0x2e,0x57,0x61,0x72,0x6e,0x28,0x22,0x66,0x61,0x69,0x6c,0x65,0x64,0x20,0x74,0x6f,0x20,0x72,0x65,0x67,0x69,0x73,0x74,0x65,0x72,0x20,0x70,0x72,0x6f,0x6d,0x65,0x74,0x68,0x65,0x75,0x73,0x20,0x63,0x6f,0x75,0x6e,0x74,0x65,0x72,0x22,0x29,0x0a,0x09,0x7d,0x0a,0x0a,0x09,0x72,0x65,0x74,0x75,0x72,0x6e,0x20,0x26,0x43,0x69,0x72,0x63,0x75,0x69,0x74,0x42,0x72,0x65,0x61,0x6b,0x65,0x72,0x48,0x61,0x6e,0x64,0x6c,0x65,0x72,0x7b,0x0a,0x09,0x09,0x73,0x74,0x61,0x74,0x65,0x3a,0x20,0x20,0x20,0x73,0x74,0x61,0x74,0x65,0x2c,0x0a,0x09,0x09,0x63,0x68,0x61,0x6e,0x67,0x65,0x73,0x3a,0x20,0x63,0x68,0x61,0x6e,0x67,0x65,0x73,0x2c,0x0a,0x09,0x7d,0x0a,0x7d,0x0a,0x0a,0x66,0x75,0x6e,0x63,0x20,0x28,0x68,0x20,0x2a,0x43,0x69,0x72,0x63,0x75,0x69,0x74,0x42,0x72,0x65,0x61,0x6b,0x65,0x72,0x48,0x61,0x6e,0x64,0x6c,0x65,0x72,0x29,0x20,0x48,0x61,0x6e,0x64,0x6c,0x65,0x53,0x74,0x61,0x74,0x65,0x43,0x68,0x61,0x6e,0x67,0x65,0x28,0x63,0x69,0x72,0x63,0x75,0x69,0x74,0x2c,0x20,0x66,0x72,0x6f,0x6d,0x2c,0x20,0x74,0x6f,0x20,0x73,0x74,0x72,0x69,0x6e,0x67,0x29,0x20,0x7b,0x0a,0x0a,0x09,0x68,0x2e,0x73,0x74,0x61,0x74,0x65,0x2e,0x57,0x69,0x74,0x68,0x4c,0x61,0x62,0x65,0x6c,0x56,0x61,0x6c,0x75,0x65,0x73,0x28,0x63,0x69,0x72,0x63,0x75,0x69,0x74,0x2c,0x20,0x66,0x72,0x6f,0x6d,0x29,0x2e,0x53,0x65,0x74,0x28,0x30,0x29,0x0a,0x09,0x68,0x2e,0x73,0x74,0x61,0x74,0x65,0x2e,0x57,0x69,0x74,0x68,0x4c,0x61,0x62,0x65,0x6c,0x56,0x61,0x6c,0x75,0x65,0x73,0x28,0x63,0x69,0x72,0x63,0x75,0x69,0x74,0x2c,0x20,0x74,0x6f,0x29,0x2e,0x53,0x65,0x74,0x28,0x31,0x29,0x0a,0x09,0x68,0x2e,0x63,0x68,0x61,0x6e,0x67,0x65,0x73,0x2e,0x57,0x69,0x74,0x68,0x4c,0x61,0x62,0x65,0x6c,0x56,0x61,0x6c,0x75,0x65,0x73,0x28,0x63,0x69,0x72,0x63,0x75,0x69,0x74,0x2c,0x20,0x66,0x72,0x6f,0x6d,0x2c,0x20,0x74,0x6f,0x29,0x2e,0x49,0x6e,0x63,0x28,0x29,0x0a,0x7d,0x0a, }
\ No newline at end of file
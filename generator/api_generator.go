@@ -20,6 +20,8 @@ var (
 		"SetRequestHeadersFromContext",
 		"NewHttpContextWrapper",
 		"ExtractContentType",
+		"NegotiateContentType",
+		"WithNegotiatedContentType",
 		"ContentTypeInList",
 		"ContentTypeHeader",
 		"ContentTypeApplicationJson",
@@ -27,10 +29,34 @@ var (
 		"ContentTypeMultipartFormData",
 		"NewNotSupportedContentType",
 		"ContentTypeApplicationFormUrlencoded",
+		"ContentTypeApplicationXml",
+		"ContentTypeTextXml",
+		"ContentTypeTextEventStream",
+		"LastEventIDHeader",
+		"NewEventStream",
+		"EventStream",
+		"NewEventReader",
+		"EventReader",
+		"NewWebSocket",
+		"DialWebSocket",
+		"ValidateClientRequest",
+		"ClientMessageValidator",
+		"SetCredential",
+		"CallOnRequest",
+		"CallOnResponse",
+		"CallOnError",
+		"WebSocket",
 		"ToString",
 		"NewErrOnUnknownResponseCode",
 		"NewErrUnknownResponse",
+		"NewUnexpectedResponseError",
+		"ResponseErrorMessage",
 		"ExtractUpload",
+		"ExtractUploads",
+		"LimitUploads",
+		"UploadErrorStatus",
+		"WriteUpload",
+		"NewUploadReader",
 		"FromString",
 		"NewServer",
 		"NewHttpClientWrapper",
@@ -38,9 +64,36 @@ var (
 		"NewRequestObjectIsNilError",
 		"ServeJson",
 		"ServeHalJson",
+		"ServeXml",
+		"ServeNdjson",
+		"ServeJsonArray",
+		"SliceItems",
+		"ItemProducer",
+		"NewItemReader",
+		"ItemReader",
+		"ContentTypeApplicationNdjson",
+		"ETagHeader",
+		"LastModifiedHeader",
+		"IfMatchHeader",
+		"IfNoneMatchHeader",
+		"IfModifiedSinceHeader",
+		"IfUnmodifiedSinceHeader",
+		"FormatETag",
+		"SetValidators",
+		"FormatHTTPTime",
+		"ParseHTTPTime",
+		"HasPreconditions",
+		"EvaluatePreconditions",
+		"RangeHeader",
+		"IfRangeHeader",
+		"ContentRangeHeader",
+		"AcceptRangesHeader",
+		"ServeRanges",
 		"HttpCodeError",
 		"XHTTPError",
 		"NewJsonHTTPError",
+		"NewValidationHTTPError",
+		"NewXmlValidationHTTPError",
 		"JsonHTTPError",
 	}
 
@@ -48,7 +101,9 @@ var (
 		"hooks",
 		"middleware",
 		"parameter",
+		"requestutil",
 		"roundtripper",
+		"unmarshal",
 		"validation",
 		"version",
 		"xclient",
@@ -126,7 +181,7 @@ func (gen *ApiGenerator) generate(path, pkg string, client, server, generateProm
 	}
 
 	if client {
-		err = gen.goClientGenerator.Generate(filepath.Join(path, clientFile), pkg, generatePrometheus, generateMocks)
+		err = gen.goClientGenerator.Generate(filepath.Join(path, clientFile), pkg, validators, generatePrometheus, generateMocks)
 		if err != nil {
 			return errors.Wrap(err, "error generating client")
 		}
@@ -2,6 +2,7 @@ package generator
 
 import (
 	"fmt"
+	"net/http"
 	"sort"
 	"strconv"
 	"strings"
@@ -36,7 +37,7 @@ func (gen goClientGenerator) clientName() string {
 	return stringutil.UnTitle(identifier.MakeIdentifier(gen.Spec.Info().Title + "Client"))
 }
 
-func (gen *goClientGenerator) Generate(path, pckg string, generatePrometheus bool, generateMocks bool) error {
+func (gen *goClientGenerator) Generate(path, pckg string, validators ValidatorMap, generatePrometheus bool, generateMocks bool) error {
 
 	file := file.NewFile(pckg)
 
@@ -44,7 +45,7 @@ func (gen *goClientGenerator) Generate(path, pckg string, generatePrometheus boo
 	var clientMethods []jen.Code
 	operations := make([]*Operation, 0)
 	if err := gen.WalkOperations(func(operation *Operation) error {
-		methodDef := jen.Id(strings.Title(operation.ID)).Params(jen.Id("request").Op("*").Id(strings.Title(operation.ID+"Request"))).Params(jen.Id(strings.Title(operation.ID+"Response")), jen.Error())
+		methodDef := jen.Id(strings.Title(operation.ID)).Params(jen.Id("ctx").Qual("context", "Context"), jen.Id("request").Op("*").Id(strings.Title(operation.ID+"Request"))).Params(jen.Id(strings.Title(operation.ID+"Response")), jen.Error())
 		methodInterfaceIdentifier := strings.Title(strings.Title(operation.ID)+"Method")
 		clientMethods = append(clientMethods,  jen.Id(methodInterfaceIdentifier).Interface(methodDef))
 		clientInterfaceMethods = append(clientInterfaceMethods, jen.Id(methodInterfaceIdentifier))
@@ -60,6 +61,7 @@ func (gen *goClientGenerator) Generate(path, pckg string, generatePrometheus boo
 	}
 
 	gen.generateConstructor(gen.clientName(), pckg, operations, generatePrometheus, file)
+	gen.generateWithoutContext(gen.clientName(), operations, file)
 
 	clientMembers := []jen.Code{
 		jen.Id("baseURL").String(),
@@ -67,6 +69,10 @@ func (gen *goClientGenerator) Generate(path, pckg string, generatePrometheus boo
 		jen.Id("ctx").Qual("context", "Context"),
 		jen.Id("httpClient").Op("*").Id("httpClientWrapper"),
 		jen.Id("xmlMatcher").Op("*").Qual("regexp", "Regexp"),
+		jen.Id("validator").Op("*").Id("Validator"),
+		jen.Id("credentials").Map(jen.String()).Id("CredentialProvider"),
+		jen.Id("responseErrors").Bool(),
+		jen.Id("streamItems").Bool(),
 	}
 
 	if generatePrometheus {
@@ -86,6 +92,8 @@ func (gen *goClientGenerator) Generate(path, pckg string, generatePrometheus boo
 		return errors.Wrap(err, "error generating operations")
 	}
 
+	gen.generateRegisterValidators(jen.Id("client").Op("*").Id(gen.clientName()), jen.Id("client").Dot("validator"), validators, file)
+
 	err := file.Save(path)
 	if err != nil {
 		return errors.Wrapf(err, "error writing generated code to file '%s'", path)
@@ -129,11 +137,19 @@ func (gen *goClientGenerator) generateConstructor(nameOfClient, nameOfPackage st
 			stmts.Line()
 		}
 
+		stmts.Id("ctx").Op(":=").Id("options").Dot("Ctx")
+		stmts.If(jen.Id("ctx").Op("==").Nil()).Block(
+			jen.Id("ctx").Op("=").Qual("context", "Background").Call(),
+		).Line()
+
 		handlerParameters := []jen.Code{
 			jen.Id("httpClient").Op(":").Id("newHttpClientWrapper").Call(jen.Id("httpClient"), jen.Id("baseUrl")),
 			jen.Id("baseURL").Op(":").Id("baseUrl"),
 			jen.Id("hooks").Op(":").Id("options").Dot("Hooks"),
-			jen.Id("ctx").Op(":").Id("options").Dot("Ctx"),
+			jen.Id("ctx").Op(":").Id("ctx"),
+			jen.Id("credentials").Op(":").Id("options").Dot("Credentials"),
+			jen.Id("responseErrors").Op(":").Id("options").Dot("ResponseErrors"),
+			jen.Id("streamItems").Op(":").Id("options").Dot("StreamItems"),
 			jen.Id("xmlMatcher").Op(":").Qual("regexp", "MustCompile").Call(jen.Lit(ContentTypeApplicationXMLPattern)),
 		}
 
@@ -141,10 +157,50 @@ func (gen *goClientGenerator) generateConstructor(nameOfClient, nameOfPackage st
 			handlerParameters = append(handlerParameters, jen.Id("prometheusHandler").Op(":").Id("h"))
 		}
 
-		stmts.Return(jen.Op("&").Id(nameOfClient).Values(handlerParameters...))
+		stmts.Id("client").Op(":=").Op("&").Id(nameOfClient).Values(handlerParameters...)
+		stmts.If(jen.Id("options").Dot("Validate")).Block(
+			jen.Id("client").Dot("validator").Op("=").Id("NewValidation").Call(),
+			jen.Id("client").Dot("registerValidators").Call(),
+		)
+		stmts.Return(jen.Id("client"))
 	}).Line()
 }
 
+// generateWithoutContext generates a client with the methods of former versions which haven't a context parameter,
+// its calls use the context of the client options
+func (gen *goClientGenerator) generateWithoutContext(nameOfClient string, operations []*Operation, file *file.File) {
+
+	interfaceName := strings.Title(nameOfClient)
+	compatName := nameOfClient + "WithoutContext"
+
+	methodDefs := make([]jen.Code, 0, len(operations))
+	for _, op := range operations {
+		methodDefs = append(methodDefs, jen.Id(strings.Title(op.ID)).Params(jen.Id("request").Op("*").Id(strings.Title(op.ID+"Request"))).Params(jen.Id(strings.Title(op.ID+"Response")), jen.Error()))
+	}
+
+	file.Comment(strings.Title(compatName) + " is the client interface of former versions without context parameters, use " + interfaceName + " instead")
+	file.Type().Id(strings.Title(compatName)).Interface(methodDefs...)
+
+	file.Comment("New" + strings.Title(compatName) + " creates a client for existing callers of the methods without context parameter, the calls use the context of the options")
+	file.Func().Id("New"+strings.Title(compatName)).Params(jen.Id("httpClient").Op("*").Qual("net/http", "Client"), jen.Id("baseUrl").String(), jen.Id("options").Id("Opts")).Id(strings.Title(compatName)).Block(
+		jen.Return(jen.Op("&").Id(compatName).Values(
+			jen.Id("client").Op(":").Id("New"+interfaceName).Call(jen.Id("httpClient"), jen.Id("baseUrl"), jen.Id("options")),
+			jen.Id("ctx").Op(":").Id("options").Dot("Ctx"),
+		)),
+	).Line()
+
+	file.Type().Id(compatName).Struct(
+		jen.Id("client").Id(interfaceName),
+		jen.Id("ctx").Qual("context", "Context"),
+	)
+
+	for _, op := range operations {
+		file.Func().Params(jen.Id("client").Op("*").Id(compatName)).Id(strings.Title(op.ID)).Params(jen.Id("request").Op("*").Id(strings.Title(op.ID+"Request"))).Params(jen.Id(strings.Title(op.ID+"Response")), jen.Error()).Block(
+			jen.Return(jen.Id("client").Dot("client").Dot(strings.Title(op.ID)).Call(jen.Id("client").Dot("ctx"), jen.Id("request"))),
+		).Line()
+	}
+}
+
 func (gen *goClientGenerator) generateOperation(operation *Operation, nameOfClient string, generatePrometheus bool, file *file.File) error {
 
 	if operation.Description != "" {
@@ -160,7 +216,7 @@ func (gen *goClientGenerator) generateOperation(operation *Operation, nameOfClie
 		return err
 	}
 
-	file.Func().Params(jen.Id("client").Op("*").Id(nameOfClient)).Id(strings.Title(operation.ID)).Params(jen.Id("request").Op("*").Id(strings.Title(operation.ID+"Request"))).Params(jen.Id(strings.Title(operation.ID+"Response")), jen.Error()).BlockFunc(func(stmts *jen.Group) {
+	file.Func().Params(jen.Id("client").Op("*").Id(nameOfClient)).Id(strings.Title(operation.ID)).Params(jen.Id("ctx").Qual("context", "Context"), jen.Id("request").Op("*").Id(strings.Title(operation.ID+"Request"))).Params(jen.Id(strings.Title(operation.ID+"Response")), jen.Error()).BlockFunc(func(stmts *jen.Group) {
 
 		if !operation.HasValidConsumes() || !operation.HasValidProduces() {
 			gen.generateNotSupported(operation, "no supported content type", true, stmts)
@@ -181,10 +237,18 @@ func (gen *goClientGenerator) generateOperation(operation *Operation, nameOfClie
 			jen.Return(jen.Nil(), jen.Id("newRequestObjectIsNilError")),
 		)
 
+		stmts.If(jen.Id("err").Op(":=").Id("validateClientRequest").Call(jen.Id("client").Dot("validator"), jen.Id("request")), jen.Id("err").Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Id("err")),
+		)
+
 		stmts.Id("path").Op(":=").Lit(operation.Route)
 		stmts.Id("method").Op(":=").Lit(operation.Method)
 		stmts.Id("endpoint").Op(":=").Id("client").Dot("baseURL").Op("+").Id("path")
-		stmts.Id("httpContext").Op(":=").Id("newHttpContextWrapper").Call(jen.Id("client").Dot("ctx"))
+		// calls without context use the context of the client options
+		stmts.If(jen.Id("ctx").Op("==").Nil()).Block(
+			jen.Id("ctx").Op("=").Id("client").Dot("ctx"),
+		)
+		stmts.Id("httpContext").Op(":=").Id("newHttpContextWrapper").Call(jen.Id("ctx"))
 
 		for _, param := range bucket.Path {
 			stmts.Id("endpoint").Op("=").Qual("strings", "Replace").Call(
@@ -209,77 +273,11 @@ func (gen *goClientGenerator) generateOperation(operation *Operation, nameOfClie
 			gen.generateQueryString(bucket.FormData, "queryInBody", queryParamCount, stmts)
 			stmts.Id("encodedQueryInBody").Op(":=").Id("queryInBody").Dot("Encode").Call()
 			stmts.Id("formData").Op(":=").Qual("bytes", "NewBufferString").Call(jen.Id("encodedQueryInBody"))
-			stmts.List(jen.Id("httpRequest"), jen.Id("reqErr")).Op(":=").Qual("net/http", "NewRequest").Call(jen.Id("method"), jen.Id("endpoint"), jen.Id("formData"))
+			stmts.List(jen.Id("httpRequest"), jen.Id("reqErr")).Op(":=").Qual("net/http", "NewRequestWithContext").Call(jen.Id("ctx"), jen.Id("method"), jen.Id("endpoint"), jen.Id("formData"))
 
 		} else if operation.HasConsume(ContentTypeMultipartFormData) {
 
-			stmts.Id("formData").Op(":=").New(jen.Qual("bytes", "Buffer"))
-			stmts.Id("bodyWriter").Op(":=").Qual("mime/multipart", "NewWriter").Call(jen.Id("formData"))
-
-			for i, param := range bucket.FormDataFiles {
-
-				fileField := strings.Title(identifier.MakeIdentifier(param.Name))
-
-				writerErr := "writerErr" + strconv.Itoa(i)
-				fileWriter := "fileWriter" + strconv.Itoa(i)
-
-				createFormFile := jen.List(jen.Id(fileWriter), jen.Id(writerErr)).Op(":=").Id("bodyWriter").Dot("CreateFormFile").Call(jen.Lit(param.Name), jen.Lit(param.Name))
-				createFormFileError := jen.If(jen.Id(writerErr).Op("!=").Nil()).Block(
-					jen.Id("bodyWriter").Dot("Close").Call(),
-					jen.Return(jen.Nil(), jen.Id(writerErr)),
-				)
-
-				copyErr := "copyFileErr" + strconv.Itoa(i)
-				copyFormFile := jen.List(jen.Id("_"), jen.Id(copyErr)).Op(":=").Qual("io", "Copy").Call(jen.Id(fileWriter), jen.Id("request").Dot("FormData").Dot(fileField).Dot("Content"))
-				copyFormFileError := jen.If(jen.Id(copyErr).Op("!=").Nil()).Block(
-					jen.Id("bodyWriter").Dot("Close").Call(),
-					jen.Return(jen.Nil(), jen.Id(copyErr)),
-				)
-
-				if param.Required {
-					stmts.Block(createFormFile, createFormFileError, copyFormFile, copyFormFileError)
-				} else {
-					stmts.If(jen.Id("request").Dot("FormData").Dot(fileField).Op("!=").Nil()).Block(
-						createFormFile, createFormFileError, copyFormFile, copyFormFileError,
-					)
-				}
-			}
-
-			for i, param := range bucket.FormData {
-
-				fieldValue := "fieldData" + strconv.Itoa(i)
-				dataField := strings.Title(identifier.MakeIdentifier(param.Name))
-
-				toString := jen.Id(fieldValue).Op(":=").Id("toString").Call(jen.Id("request").Dot("FormData").Dot(dataField))
-
-				fieldErr := "fieldErr" + strconv.Itoa(i)
-				fieldWriter := "fieldWriter" + strconv.Itoa(i)
-
-				createFormField := jen.List(jen.Id(fieldWriter), jen.Id(fieldErr)).Op(":=").Id("bodyWriter").Dot("CreateFormField").Call(jen.Lit(param.Name))
-				createFormFieldError := jen.If(jen.Id(fieldErr).Op("!=").Nil()).Block(
-					jen.Id("bodyWriter").Dot("Close").Call(),
-					jen.Return(jen.Nil(), jen.Id(fieldErr)),
-				)
-
-				writeErr := "writeFieldErr" + strconv.Itoa(i)
-				writeFormField := jen.List(jen.Id("_"), jen.Id(writeErr)).Op(":=").Id(fieldWriter).Dot("Write").Call(jen.Index().Byte().Params(jen.Id(fieldValue)))
-				writeFormFieldError := jen.If(jen.Id(writeErr).Op("!=").Nil()).Block(
-					jen.Id("bodyWriter").Dot("Close").Call(),
-					jen.Return(jen.Nil(), jen.Id(writeErr)),
-				)
-
-				if param.Required {
-					stmts.Add(toString, createFormField, createFormFieldError, writeFormField, writeFormFieldError)
-				} else {
-					stmts.If(jen.Id("request").Dot("FormData").Dot(dataField)).Op("!=").Nil().Block(
-						toString, createFormField, createFormFieldError, writeFormField, writeFormFieldError,
-					)
-				}
-			}
-
-			stmts.Id("contentType").Op(":=").Id("bodyWriter").Dot("FormDataContentType").Call()
-			stmts.Id("bodyWriter").Dot("Close").Call()
-			stmts.List(jen.Id("httpRequest"), jen.Id("reqErr")).Op(":=").Qual("net/http", "NewRequest").Call(jen.Id("method"), jen.Id("endpoint"), jen.Id("formData"))
+			gen.generateMultipartForm(operation, bucket, stmts)
 
 		} else if bucket.HasBody {
 
@@ -295,15 +293,24 @@ func (gen *goClientGenerator) generateOperation(operation *Operation, nameOfClie
 				bodyName = "Body"
 			}
 
-			stmts.Id("jsonData").Op(":=").New(jen.Qual("bytes", "Buffer"))
-			stmts.Id("encodeErr").Op(":=").Qual("encoding/json", "NewEncoder").Call(jen.Id("jsonData")).Dot("Encode").Call(jen.Op("&").Id("request").Dot(bodyName))
-			stmts.If(jen.Id("encodeErr").Op("!=").Nil()).Block(
-				jen.Return(jen.Nil(), jen.Id("encodeErr")),
-			)
-			stmts.List(jen.Id("httpRequest"), jen.Id("reqErr")).Op(":=").Qual("net/http", "NewRequest").Call(jen.Id("method"), jen.Id("endpoint"), jen.Id("jsonData"))
+			if consumesXML(operation) {
+				stmts.Id("xmlData").Op(":=").New(jen.Qual("bytes", "Buffer"))
+				stmts.Id("encodeErr").Op(":=").Qual("encoding/xml", "NewEncoder").Call(jen.Id("xmlData")).Dot("Encode").Call(jen.Op("&").Id("request").Dot(bodyName))
+				stmts.If(jen.Id("encodeErr").Op("!=").Nil()).Block(
+					jen.Return(jen.Nil(), jen.Id("encodeErr")),
+				)
+				stmts.List(jen.Id("httpRequest"), jen.Id("reqErr")).Op(":=").Qual("net/http", "NewRequestWithContext").Call(jen.Id("ctx"), jen.Id("method"), jen.Id("endpoint"), jen.Id("xmlData"))
+			} else {
+				stmts.Id("jsonData").Op(":=").New(jen.Qual("bytes", "Buffer"))
+				stmts.Id("encodeErr").Op(":=").Qual("encoding/json", "NewEncoder").Call(jen.Id("jsonData")).Dot("Encode").Call(jen.Op("&").Id("request").Dot(bodyName))
+				stmts.If(jen.Id("encodeErr").Op("!=").Nil()).Block(
+					jen.Return(jen.Nil(), jen.Id("encodeErr")),
+				)
+				stmts.List(jen.Id("httpRequest"), jen.Id("reqErr")).Op(":=").Qual("net/http", "NewRequestWithContext").Call(jen.Id("ctx"), jen.Id("method"), jen.Id("endpoint"), jen.Id("jsonData"))
+			}
 
 		} else {
-			stmts.List(jen.Id("httpRequest"), jen.Id("reqErr")).Op(":=").Qual("net/http", "NewRequest").Call(jen.Id("method"), jen.Id("endpoint"), jen.Nil())
+			stmts.List(jen.Id("httpRequest"), jen.Id("reqErr")).Op(":=").Qual("net/http", "NewRequestWithContext").Call(jen.Id("ctx"), jen.Id("method"), jen.Id("endpoint"), jen.Nil())
 		}
 
 		stmts.If(jen.Id("reqErr").Op("!=").Nil()).Block(
@@ -317,22 +324,36 @@ func (gen *goClientGenerator) generateOperation(operation *Operation, nameOfClie
 			stmts.Id("httpRequest").Dot("Header").Index(jen.Id("contentTypeHeader")).Op("=").Index().String().Values(jen.Id("contentType"))
 		} else if operation.HasConsume(ContentTypeApplicationHalJson) {
 			stmts.Id("httpRequest").Dot("Header").Index(jen.Id("contentTypeHeader")).Op("=").Index().String().Values(jen.Id("ContentTypeApplicationHalJson"))
+		} else if bucket.HasBody && consumesXML(operation) {
+			stmts.Id("httpRequest").Dot("Header").Index(jen.Id("contentTypeHeader")).Op("=").Index().String().Values(jen.Lit(operation.XMLConsumes()[0]))
 		} else if bucket.HasBody {
 			stmts.Id("httpRequest").Dot("Header").Index(jen.Id("contentTypeHeader")).Op("=").Index().String().Values(jen.Id("contentTypeApplicationJson"))
 		}
 
 		for _, param := range bucket.Security {
 
+			// the credential provider of the security definition is used if the request doesn't contain a value
+			setCredential := func(headerName string) {
+				stmts.If(jen.Id("err").Op(":=").Id("setCredential").Call(
+					jen.Id("ctx"),
+					jen.Id("httpRequest").Dot("Header"),
+					jen.Id("client").Dot("credentials"),
+					jen.Lit(param.Definition),
+					jen.Lit(headerName),
+					jen.Id("request").Dot(identifier.MakeIdentifier(strings.Title(headerName))),
+				), jen.Id("err").Op("!=").Nil()).Block(
+					jen.Return(jen.Nil(), jen.Id("err")),
+				)
+			}
+
 			if param.Type == openapi.ApiKey.String() && param.In == openapi.Header.String() {
-				// don't use Header().Set() or Header().Add(): https://github.com/golang/go/issues/5022
-				stmts.Id("httpRequest").Dot("Header").Index(jen.Lit(param.Name)).Op("=").Index().String().Values(jen.Id("request").Dot(identifier.MakeIdentifier(strings.Title(param.Name))))
-			} else if param.Type == openapi.Basic.String() {
+				setCredential(param.Name)
+			} else if param.Type == openapi.Basic.String() || param.Type == openapi.OAuth2.String() {
 				paramName := param.Name
 				if paramName == "" {
 					paramName = openapi.BasicAuthHeaderName
 				}
-				// don't use Header().Set() or Header().Add(): https://github.com/golang/go/issues/5022
-				stmts.Id("httpRequest").Dot("Header").Index(jen.Lit(paramName)).Op("=").Index().String().Values(jen.Id("request").Dot(identifier.MakeIdentifier(strings.Title(paramName))))
+				setCredential(paramName)
 			} else {
 				log.Error(fmt.Sprintf("unsupported security scheme type '%s' in '%s'", param.Type, param.In))
 			}
@@ -351,39 +372,111 @@ func (gen *goClientGenerator) generateOperation(operation *Operation, nameOfClie
 			}
 		}
 
+		if operation.IsEventStream() {
+			stmts.If(jen.Id("request").Dot("LastEventID").Op("!=").Lit("")).Block(
+				// don't use Header().Set() or Header().Add(): https://github.com/golang/go/issues/5022
+				jen.Id("httpRequest").Dot("Header").Index(jen.Id("lastEventIDHeader")).Op("=").Index().String().Values(jen.Id("request").Dot("LastEventID")),
+			)
+		}
+
+		if operation.IsConditional() {
+			setHeader := func(header string, value jen.Code) jen.Code {
+				return jen.Id("httpRequest").Dot("Header").Index(jen.Id(header)).Op("=").Index().String().Values(value)
+			}
+			setETags := func(header, field string) {
+				stmts.If(jen.Id("request").Dot(field).Op("!=").Lit("")).Block(
+					setHeader(header, jen.Id("request").Dot(field)),
+				)
+			}
+			setTime := func(header, field string) {
+				stmts.If(jen.Op("!").Id("request").Dot(field).Dot("IsZero").Call()).Block(
+					setHeader(header, jen.Id("formatHTTPTime").Call(jen.Id("request").Dot(field))),
+				)
+			}
+			if operation.IsSafe() {
+				setETags("ifNoneMatchHeader", "IfNoneMatch")
+				setTime("ifModifiedSinceHeader", "IfModifiedSince")
+			} else {
+				setETags("ifMatchHeader", "IfMatch")
+				setETags("ifNoneMatchHeader", "IfNoneMatch")
+				setTime("ifUnmodifiedSinceHeader", "IfUnmodifiedSince")
+			}
+		}
+
+		if operation.HasRanges() {
+			stmts.If(jen.Id("request").Dot("Range").Op("!=").Lit("")).Block(
+				// don't use Header().Set() or Header().Add(): https://github.com/golang/go/issues/5022
+				jen.Id("httpRequest").Dot("Header").Index(jen.Id("rangeHeader")).Op("=").Index().String().Values(jen.Id("request").Dot("Range")),
+			)
+			stmts.If(jen.Id("request").Dot("IfRange").Op("!=").Lit("")).Block(
+				jen.Id("httpRequest").Dot("Header").Index(jen.Id("ifRangeHeader")).Op("=").Index().String().Values(jen.Id("request").Dot("IfRange")),
+			)
+		}
+
 		stmts.Comment("set all headers from client context")
 		stmts.Id("err").Op(":=").Id("setRequestHeadersFromContext").Call(jen.Id("httpContext"), jen.Id("httpRequest").Dot("Header"))
 		stmts.If(jen.Id("err").Op("!=").Nil()).Block(
 			jen.Return(jen.Nil(), jen.Id("err")),
 		)
 
-		if operation.HasValidProduces() {
-			stmts.If(jen.Len(jen.Id("httpRequest").Dot("Header").Index(jen.Lit("accept"))).Op("==").Lit(0).Op("&&").Len(jen.Id("httpRequest").Dot("Header").Index(jen.Lit("Accept"))).Op("==").Lit(0)).Block(
-				jen.Id("httpRequest").Dot("Header").Index(jen.Lit("Accept")).Op("=").Index().String().Values(jen.Lit(strings.Join(operation.Produces, ", "))),
-			)
-		}
-
-		if generatePrometheus {
-			stmts.Id("start").Op(":=").Qual("time", "Now").Call()
+		if operation.HasValidProduces() && !operation.IsWebSocket() {
+			noAccept := jen.Len(jen.Id("httpRequest").Dot("Header").Index(jen.Lit("accept"))).Op("==").Lit(0).Op("&&").Len(jen.Id("httpRequest").Dot("Header").Index(jen.Lit("Accept"))).Op("==").Lit(0)
+			if gen.itemsSchema(operation) != nil {
+				stmts.If(noAccept).Block(
+					jen.Id("httpRequest").Dot("Header").Index(jen.Lit("Accept")).Op("=").Index().String().Values(jen.Lit(gen.accept(operation))),
+					jen.If(jen.Id("client").Dot("streamItems")).Block(
+						jen.Id("httpRequest").Dot("Header").Index(jen.Lit("Accept")).Op("=").Index().String().Values(jen.Lit(gen.streamAccept(operation))),
+					),
+				)
+			} else {
+				stmts.If(noAccept).Block(
+					jen.Id("httpRequest").Dot("Header").Index(jen.Lit("Accept")).Op("=").Index().String().Values(jen.Lit(gen.accept(operation))),
+				)
+			}
 		}
 
-		stmts.List(jen.Id("httpResponse"), jen.Id("err")).Op(":=").Id("client").Dot("httpClient").Dot("Do").Call(jen.Id("httpRequest"))
-		stmts.If(jen.Id("err").Op("!=").Nil()).Block(
-			jen.Return(jen.Nil(), jen.Id("err")),
+		stmts.Id("operation").Op(":=").Id("ClientOperation").Values(
+			jen.Id("ID").Op(":").Lit(operation.ID),
+			jen.Id("Route").Op(":").Id("path"),
+			jen.Id("Method").Op(":").Id("method"),
 		)
+		stmts.Id("client").Dot("hooks").Dot("callOnRequest").Call(jen.Id("operation"), jen.Id("httpRequest"))
+		stmts.Id("start").Op(":=").Qual("time", "Now").Call()
+
+		if operation.IsWebSocket() {
+			// the response of a failed handshake is handled like other responses
+			socket := "socket"
+			if _, ok := operation.Responses.StatusCodeResponses[http.StatusSwitchingProtocols]; !ok {
+				socket = "_"
+			}
+			stmts.List(jen.Id(socket), jen.Id("httpResponse"), jen.Id("err")).Op(":=").Id("dialWebSocket").Call(jen.Id("client").Dot("httpClient").Dot("Client"), jen.Id("httpRequest"), jen.Id("clientMessageValidator").Call(jen.Id("client").Dot("validator")))
+			stmts.If(jen.Id("err").Op("!=").Nil().Op("&&").Parens(jen.Id("httpResponse").Op("==").Nil().Op("||").Id("httpResponse").Dot("StatusCode").Op("==").Qual("net/http", "StatusSwitchingProtocols"))).Block(
+				jen.Return(jen.Nil(), jen.Id("client").Dot("hooks").Dot("callOnError").Call(jen.Id("operation"), jen.Id("httpRequest"), jen.Nil(), jen.Id("err"))),
+			)
+		} else {
+			stmts.List(jen.Id("httpResponse"), jen.Id("err")).Op(":=").Id("client").Dot("httpClient").Dot("Do").Call(jen.Id("httpRequest"))
+			stmts.If(jen.Id("err").Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Id("client").Dot("hooks").Dot("callOnError").Call(jen.Id("operation"), jen.Id("httpRequest"), jen.Nil(), jen.Id("err"))),
+			)
+		}
 
 		lastFileResponseIndex := -1
 		walkResponses(operation, func(statusCode int, response spec.Response) {
 			if response.Schema != nil && response.Schema.Type.Contains("file") && operation.HasProduces(ContentTypesForFiles...) {
 				lastFileResponseIndex++
+			} else if (operation.IsEventStream() || gen.itemsSchema(operation) != nil) && statusCode == http.StatusOK {
+				// the body of an event stream or streamed items is closed by its reader
+				lastFileResponseIndex++
 			}
 		})
 
-		// if the list of responses has a file response then don't close the http body response type here
+		// if the list of responses has a file or event stream response then don't close the http body response type here
 		if lastFileResponseIndex == -1 {
 			stmts.Defer().Id("httpResponse").Dot("Body").Dot("Close").Call()
 		}
 
+		stmts.Id("client").Dot("hooks").Dot("callOnResponse").Call(jen.Id("operation"), jen.Id("httpRequest"), jen.Id("httpResponse"), jen.Id("start"))
+
 		if generatePrometheus {
 			stmts.Id("client").Dot("prometheusHandler").Dot("HandleRequest").Call(
 				jen.Id("path"),
@@ -393,6 +486,20 @@ func (gen *goClientGenerator) generateOperation(operation *Operation, nameOfClie
 			)
 		}
 
+		if operation.HasRanges() {
+			// the body of a partial file is read by the caller like the whole file
+			stmts.If(jen.Id("httpResponse").Dot("StatusCode").Op("==").Qual("net/http", "StatusPartialContent")).BlockFunc(func(stmts *jen.Group) {
+				stmts.Id("response").Op(":=").New(jen.Id(strings.Title(fmt.Sprintf("%s%dResponse", operation.ID, http.StatusPartialContent))))
+				stmts.Id("response").Dot("ContentType").Op("=").Id("httpResponse").Dot("Header").Dot("Get").Call(jen.Id("contentTypeHeader"))
+				stmts.Id("response").Dot("ContentRange").Op("=").Id("httpResponse").Dot("Header").Dot("Get").Call(jen.Id("contentRangeHeader"))
+				if operation.HasValidators(http.StatusPartialContent) {
+					gen.generateValidators(stmts)
+				}
+				stmts.Id("response").Dot("Body").Op("=").Id("httpResponse").Dot("Body")
+				stmts.Return(jen.Id("response"), jen.Nil())
+			})
+		}
+
 		var currentIndex int
 		var addedDeferStatement bool
 		walkResponses(operation, func(statusCode int, response spec.Response) {
@@ -405,16 +512,142 @@ func (gen *goClientGenerator) generateOperation(operation *Operation, nameOfClie
 			currentIndex++
 		})
 
+		if _, ok := operation.Responses.StatusCodeResponses[http.StatusNotModified]; operation.IsConditional() && operation.IsSafe() && !ok {
+			stmts.If(jen.Id("httpResponse").Dot("StatusCode").Op("==").Qual("net/http", "StatusNotModified")).BlockFunc(func(stmts *jen.Group) {
+				stmts.Id("response").Op(":=").New(jen.Id(strings.Title(fmt.Sprintf("%s%dResponse", operation.ID, http.StatusNotModified))))
+				gen.generateValidators(stmts)
+				stmts.Return(jen.Id("response"), jen.Nil())
+			})
+		}
+
+		if operation.Responses.Default != nil {
+			gen.generateDefaultResponse(operation, *operation.Responses.Default, stmts)
+			return
+		}
+
 		stmts.If(jen.Id("client").Dot("hooks").Dot("OnUnknownResponseCode").Op("!=").Nil()).Block(
 			jen.Id("message").Op(":=").Id("client").Dot("hooks").Dot("OnUnknownResponseCode").Call(jen.Id("httpResponse"), jen.Id("httpRequest")),
-			jen.Return(jen.Nil(), jen.Id("newErrOnUnknownResponseCode").Call(jen.Id("message"))),
+			jen.Return(jen.Nil(), jen.Id("newErrOnUnknownResponseCode").Call(jen.Id("message"), jen.Id("httpResponse").Dot("StatusCode"))),
 		)
 
-		stmts.Return(jen.Nil(), jen.Id("newErrUnknownResponse").Call(jen.Id("httpResponse").Dot("StatusCode")))
+		stmts.Return(jen.Nil(), jen.Id("newUnexpectedResponseError").Call(jen.Id("httpRequest"), jen.Id("httpResponse")))
 	}).Line()
 	return nil
 }
 
+// generateMultipartForm generates the writing of a multipart request, the values are written before the files so
+// the server can read them before the files. The request of an upload stream is written while it is sent.
+func (gen *goClientGenerator) generateMultipartForm(operation *Operation, bucket *ParametersBucket, stmts *jen.Group) {
+
+	writeForm := jen.Func().Params(jen.Id("bodyWriter").Op("*").Qual("mime/multipart", "Writer")).Error().BlockFunc(func(stmts *jen.Group) {
+
+		for i, param := range bucket.FormData {
+
+			fieldValue := "fieldData" + strconv.Itoa(i)
+			dataField := strings.Title(identifier.MakeIdentifier(param.Name))
+
+			toString := jen.Id(fieldValue).Op(":=").Id("toString").Call(jen.Id("request").Dot("FormData").Dot(dataField))
+			writeFormField := jen.If(jen.Id("err").Op(":=").Id("bodyWriter").Dot("WriteField").Call(jen.Lit(param.Name), jen.Id(fieldValue)), jen.Id("err").Op("!=").Nil()).Block(
+				jen.Return(jen.Id("err")),
+			)
+
+			if param.Required {
+				stmts.Add(toString)
+				stmts.Add(writeFormField)
+			} else {
+				stmts.If(jen.Id("request").Dot("FormData").Dot(dataField)).Op("!=").Nil().Block(
+					toString, writeFormField,
+				)
+			}
+		}
+
+		for _, param := range bucket.FormDataFiles {
+
+			fileField := jen.Id("request").Dot("FormData").Dot(strings.Title(identifier.MakeIdentifier(param.Name)))
+			writeUpload := func(file jen.Code) jen.Code {
+				return jen.If(jen.Id("err").Op(":=").Id("writeUpload").Call(jen.Id("bodyWriter"), jen.Lit(param.Name), file), jen.Id("err").Op("!=").Nil()).Block(
+					jen.Return(jen.Id("err")),
+				)
+			}
+
+			if param.Type == "array" {
+				stmts.For(jen.Id("i").Op(":=").Range().Add(fileField)).Block(
+					writeUpload(jen.Op("&").Add(fileField).Index(jen.Id("i"))),
+				)
+			} else if param.Required {
+				stmts.Add(writeUpload(jen.Op("&").Add(fileField)))
+			} else {
+				stmts.If(jen.Add(fileField).Op("!=").Nil()).Block(
+					writeUpload(fileField),
+				)
+			}
+		}
+
+		stmts.Return(jen.Id("bodyWriter").Dot("Close").Call())
+	})
+	stmts.Id("writeForm").Op(":=").Add(writeForm)
+
+	if operation.IsUploadStream() {
+		// the files aren't buffered, they are copied to the request while it is sent
+		stmts.List(jen.Id("formData"), jen.Id("pipeWriter")).Op(":=").Qual("io", "Pipe").Call()
+		stmts.Id("bodyWriter").Op(":=").Qual("mime/multipart", "NewWriter").Call(jen.Id("pipeWriter"))
+		stmts.Id("contentType").Op(":=").Id("bodyWriter").Dot("FormDataContentType").Call()
+		stmts.List(jen.Id("httpRequest"), jen.Id("reqErr")).Op(":=").Qual("net/http", "NewRequestWithContext").Call(jen.Id("ctx"), jen.Id("method"), jen.Id("endpoint"), jen.Id("formData"))
+		stmts.If(jen.Id("reqErr").Op("==").Nil()).Block(
+			jen.Go().Func().Params().Block(
+				jen.Id("pipeWriter").Dot("CloseWithError").Call(jen.Id("writeForm").Call(jen.Id("bodyWriter"))),
+			).Call(),
+		)
+	} else {
+		stmts.Id("formData").Op(":=").New(jen.Qual("bytes", "Buffer"))
+		stmts.Id("bodyWriter").Op(":=").Qual("mime/multipart", "NewWriter").Call(jen.Id("formData"))
+		stmts.If(jen.Id("err").Op(":=").Id("writeForm").Call(jen.Id("bodyWriter")), jen.Id("err").Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Id("err")),
+		)
+		stmts.Id("contentType").Op(":=").Id("bodyWriter").Dot("FormDataContentType").Call()
+		stmts.List(jen.Id("httpRequest"), jen.Id("reqErr")).Op(":=").Qual("net/http", "NewRequestWithContext").Call(jen.Id("ctx"), jen.Id("method"), jen.Id("endpoint"), jen.Id("formData"))
+	}
+}
+
+// accept returns the accept header of an operation, newline delimited JSON is only accepted by operations which
+// stream the items of their response if it's their only content type
+func (gen *goClientGenerator) accept(operation *Operation) string {
+
+	if gen.itemsSchema(operation) == nil {
+		return strings.Join(operation.Produces, ", ")
+	}
+
+	var mediaRanges []string
+	for _, contentType := range operation.Produces {
+		if contentType != ContentTypeApplicationNdjson {
+			mediaRanges = append(mediaRanges, contentType)
+		}
+	}
+	if len(mediaRanges) == 0 {
+		return ContentTypeApplicationNdjson
+	}
+	return strings.Join(mediaRanges, ", ")
+}
+
+// streamAccept returns the accept header of an operation which streams the items of its response, if the client
+// streams the items. Newline delimited JSON is preferred.
+func (gen *goClientGenerator) streamAccept(operation *Operation) string {
+
+	mediaRanges := []string{ContentTypeApplicationNdjson}
+	for _, contentType := range operation.Produces {
+		if contentType != ContentTypeApplicationNdjson {
+			mediaRanges = append(mediaRanges, contentType+";q=0.9")
+		}
+	}
+	return strings.Join(mediaRanges, ", ")
+}
+
+// consumesXML checks if the body of a request is encoded as XML, JSON is preferred if the operation consumes both
+func consumesXML(operation *Operation) bool {
+
+	return len(operation.XMLConsumes()) != 0 && !operation.HasConsumes(ContentTypeApplicationJson, ContentTypeApplicationHalJson)
+}
+
 func (gen *goClientGenerator) generateQueryString(params []*spec.Parameter, id string, lastIndex int, stmts *jen.Group) int {
 
 	stmts.Id(id).Op(":=").Make(jen.Qual("net/url", "Values"))
@@ -446,55 +679,91 @@ func (gen *goClientGenerator) generateResponse(operation *Operation, statusCode
 
 	stmts.If(jen.Id("httpResponse").Dot("StatusCode").Op("==").Id(xhttp.ResolveStatusCode(statusCode))).BlockFunc(func(stmts *jen.Group) {
 
+		if operation.IsWebSocket() && statusCode == http.StatusSwitchingProtocols {
+
+			stmts.Id("response").Op(":=").New(jen.Id(strings.Title(operation.ID) + strconv.Itoa(statusCode) + "Response"))
+			gen.generateResponseHeaders(operation, statusCode, response, stmts)
+			stmts.Id("response").Dot("Conn").Op("=").Op("&").Id(strings.Title(operation.ID + "ClientConn")).Values(jen.Id("socket").Op(":").Id("socket"))
+			gen.generateReturnResponse(statusCode, stmts)
+			return
+		}
+
 		hasContentType := false
 
 		stmts.Id("contentTypeOfResponse").Op(":=").Id("extractContentType").Call(jen.Id("httpResponse").Dot("Header").Dot("Get").Call(jen.Id("contentTypeHeader")))
 
+		if operation.IsEventStream() && statusCode == http.StatusOK {
+
+			stmts.If(jen.Id("contentTypeOfResponse").Op("==").Id("contentTypeTextEventStream")).BlockFunc(func(stmts *jen.Group) {
+				stmts.Id("response").Op(":=").New(jen.Id(strings.Title(operation.ID) + strconv.Itoa(statusCode) + "Response"))
+				gen.generateResponseHeaders(operation, statusCode, response, stmts)
+				stmts.Id("response").Dot("Events").Op("=").Op("&").Id(strings.Title(operation.ID + "EventReader")).Values(jen.Id("reader").Op(":").Id("newEventReader").Call(jen.Id("httpResponse").Dot("Body")))
+				gen.generateReturnResponse(statusCode, stmts)
+			})
+			stmts.Return(jen.Nil(), jen.Id("newNotSupportedContentType").Call(jen.Lit(415), jen.Id("contentTypeOfResponse")))
+			return
+		}
+
+		if gen.itemsSchema(operation) != nil && statusCode == http.StatusOK {
+
+			stmts.If(jen.Id("contentTypeOfResponse").Op("==").Id("contentTypeApplicationNdjson")).BlockFunc(func(stmts *jen.Group) {
+				stmts.Id("response").Op(":=").New(jen.Id(strings.Title(operation.ID) + strconv.Itoa(statusCode) + "Response"))
+				gen.generateResponseHeaders(operation, statusCode, response, stmts)
+				stmts.Id("response").Dot("Items").Op("=").Id("read" + strings.Title(operation.ID+"Items")).Call(jen.Id("httpResponse").Dot("Body"))
+				gen.generateReturnResponse(statusCode, stmts)
+			})
+			// other content types are decoded as a whole
+			stmts.Defer().Id("httpResponse").Dot("Body").Dot("Close").Call()
+		}
+
 		if response.Schema != nil && response.Schema.Type.Contains("file") && operation.HasProduces(ContentTypesForFiles...) {
 
 			stmts.If(jen.Id("contentTypeInList").Call(jen.Id("contentTypesForFiles"), jen.Id("contentTypeOfResponse"))).BlockFunc(func(stmts *jen.Group) {
 				stmts.Id("response").Op(":=").New(jen.Id(strings.Title(operation.ID) + strconv.Itoa(statusCode) + "Response"))
-				gen.generateHeaders(response.Headers, stmts)
+				gen.generateResponseHeaders(operation, statusCode, response, stmts)
 				stmts.Id("response").Dot("Body").Op("=").Id("httpResponse").Dot("Body")
-				stmts.Return(jen.Id("response"), jen.Nil())
+				gen.generateReturnResponse(statusCode, stmts)
 			})
 
 			hasContentType = true
 		}
 
-		if response.Schema != nil && (operation.HasProduce(ContentTypeApplicationJson) || operation.HasProduce(ContentTypeApplicationHalJson)) {
+		// validation errors are written as JSON by the server regardless of the produced content types
+		isValidationErrors := statusCode == http.StatusBadRequest && hasValidationErrorsResponse(operation)
+
+		if response.Schema != nil && (operation.HasProduce(ContentTypeApplicationJson) || operation.HasProduce(ContentTypeApplicationHalJson) || isValidationErrors) {
 
 			stmts.If(jen.Id("contentTypeOfResponse").Op("==").Id("contentTypeApplicationJson").Op("||").Id("contentTypeOfResponse").Op("==").Id("contentTypeApplicationHalJson")).BlockFunc(func(stmts *jen.Group) {
 				stmts.Id("response").Op(":=").New(jen.Id(strings.Title(operation.ID) + strconv.Itoa(statusCode) + "Response"))
-				gen.generateHeaders(response.Headers, stmts)
+				gen.generateResponseHeaders(operation, statusCode, response, stmts)
 				stmts.Id("decodeErr").Op(":=").Qual("encoding/json", "NewDecoder").Call(jen.Id("httpResponse").Dot("Body")).Dot("Decode").Call(jen.Op("&").Id("response").Dot("Body"))
 				stmts.If(jen.Id("decodeErr").Op("!=").Nil()).Block(
-					jen.Return(jen.Nil(), jen.Id("decodeErr")),
+					jen.Return(jen.Nil(), jen.Id("client").Dot("hooks").Dot("callOnError").Call(jen.Id("operation"), jen.Id("httpRequest"), jen.Id("httpResponse"), jen.Id("decodeErr"))),
 				)
-				stmts.Return(jen.Id("response"), jen.Nil())
+				gen.generateReturnResponse(statusCode, stmts)
 			}).Else().If(jen.Id("contentTypeOfResponse").Op("==").Lit("")).BlockFunc(func(stmts *jen.Group) {
 				stmts.Id("response").Op(":=").New(jen.Id(strings.Title(operation.ID) + strconv.Itoa(statusCode) + "Response"))
-				gen.generateHeaders(response.Headers, stmts)
-				stmts.Return(jen.Id("response"), jen.Nil())
+				gen.generateResponseHeaders(operation, statusCode, response, stmts)
+				gen.generateReturnResponse(statusCode, stmts)
 			})
 
 			hasContentType = true
 		}
 
-		if response.Schema != nil && operation.RegexHasProduces(ContentTypeApplicationXMLPattern) {
+		if response.Schema != nil && !response.Schema.Type.Contains("file") && operation.RegexHasProduces(ContentTypeApplicationXMLPattern) {
 
 			stmts.If(jen.Id("client").Dot("xmlMatcher").Dot("MatchString").Call(jen.Id("contentTypeOfResponse"))).BlockFunc(func(stmts *jen.Group) {
 				stmts.Id("response").Op(":=").New(jen.Id(strings.Title(operation.ID) + strconv.Itoa(statusCode) + "Response"))
-				gen.generateHeaders(response.Headers, stmts)
+				gen.generateResponseHeaders(operation, statusCode, response, stmts)
 				stmts.Id("decodeErr").Op(":=").Qual("encoding/xml", "NewDecoder").Call(jen.Id("httpResponse").Dot("Body")).Dot("Decode").Call(jen.Op("&").Id("response").Dot("Body"))
 				stmts.If(jen.Id("decodeErr").Op("!=").Nil()).Block(
-					jen.Return(jen.Nil(), jen.Id("decodeErr")),
+					jen.Return(jen.Nil(), jen.Id("client").Dot("hooks").Dot("callOnError").Call(jen.Id("operation"), jen.Id("httpRequest"), jen.Id("httpResponse"), jen.Id("decodeErr"))),
 				)
-				stmts.Return(jen.Id("response"), jen.Nil())
-			}).Else().If(jen.Id("contentTypeOfResponse").Op("==").Lit("")).Block(
-				jen.Id("response").Op(":=").New(jen.Id(strings.Title(operation.ID)+strconv.Itoa(statusCode)+"Response")),
-				jen.Return(jen.Id("response"), jen.Nil()),
-			)
+				gen.generateReturnResponse(statusCode, stmts)
+			}).Else().If(jen.Id("contentTypeOfResponse").Op("==").Lit("")).BlockFunc(func(stmts *jen.Group) {
+				stmts.Id("response").Op(":=").New(jen.Id(strings.Title(operation.ID) + strconv.Itoa(statusCode) + "Response"))
+				gen.generateReturnResponse(statusCode, stmts)
+			})
 
 			hasContentType = true
 		}
@@ -502,8 +771,8 @@ func (gen *goClientGenerator) generateResponse(operation *Operation, statusCode
 		if !hasContentType {
 			stmts.If(jen.Id("contentTypeOfResponse").Op("==").Lit("")).BlockFunc(func(stmts *jen.Group) {
 				stmts.Id("response").Op(":=").New(jen.Id(strings.Title(operation.ID) + strconv.Itoa(statusCode) + "Response"))
-				gen.generateHeaders(response.Headers, stmts)
-				stmts.Return(jen.Id("response"), jen.Nil())
+				gen.generateResponseHeaders(operation, statusCode, response, stmts)
+				gen.generateReturnResponse(statusCode, stmts)
 			})
 		}
 
@@ -512,6 +781,76 @@ func (gen *goClientGenerator) generateResponse(operation *Operation, statusCode
 	}).Line()
 }
 
+// generateReturnResponse returns a decoded response, the responses of the status codes 4xx and 5xx are returned as
+// errors if the client is configured to do so
+func (gen *goClientGenerator) generateReturnResponse(statusCode int, stmts *jen.Group) {
+
+	if statusCode >= http.StatusBadRequest {
+		stmts.If(jen.Id("client").Dot("responseErrors")).Block(
+			jen.Return(jen.Nil(), jen.Id("response")),
+		)
+	}
+	stmts.Return(jen.Id("response"), jen.Nil())
+}
+
+// generateDefaultResponse decodes the responses of all undocumented status codes into the default response, which
+// is returned as error if it's a 4xx or 5xx and the client is configured to do so
+func (gen *goClientGenerator) generateDefaultResponse(operation *Operation, response spec.Response, stmts *jen.Group) {
+
+	hasBody := response.Schema != nil && !response.Schema.Type.Contains("file")
+	hasJson := operation.HasProduce(ContentTypeApplicationJson) || operation.HasProduce(ContentTypeApplicationHalJson)
+	hasXml := operation.RegexHasProduces(ContentTypeApplicationXMLPattern)
+
+	stmts.Id("response").Op(":=").Op("&").Id(strings.Title(operation.ID+"DefaultResponse")).Values(jen.Id("Status").Op(":").Id("httpResponse").Dot("StatusCode"))
+	gen.generateHeaders(response.Headers, stmts)
+
+	if hasBody && (hasJson || hasXml) {
+		decodeFailed := jen.If(jen.Id("decodeErr").Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Id("client").Dot("hooks").Dot("callOnError").Call(jen.Id("operation"), jen.Id("httpRequest"), jen.Id("httpResponse"), jen.Id("decodeErr"))),
+		)
+
+		stmts.Id("contentTypeOfResponse").Op(":=").Id("extractContentType").Call(jen.Id("httpResponse").Dot("Header").Dot("Get").Call(jen.Id("contentTypeHeader")))
+		stmts.Switch().BlockFunc(func(cases *jen.Group) {
+			if hasJson {
+				cases.Case(jen.Id("contentTypeOfResponse").Op("==").Id("contentTypeApplicationJson").Op("||").Id("contentTypeOfResponse").Op("==").Id("contentTypeApplicationHalJson")).Block(
+					jen.Id("decodeErr").Op(":=").Qual("encoding/json", "NewDecoder").Call(jen.Id("httpResponse").Dot("Body")).Dot("Decode").Call(jen.Op("&").Id("response").Dot("Body")),
+					decodeFailed,
+				)
+			}
+			if hasXml {
+				cases.Case(jen.Id("client").Dot("xmlMatcher").Dot("MatchString").Call(jen.Id("contentTypeOfResponse"))).Block(
+					jen.Id("decodeErr").Op(":=").Qual("encoding/xml", "NewDecoder").Call(jen.Id("httpResponse").Dot("Body")).Dot("Decode").Call(jen.Op("&").Id("response").Dot("Body")),
+					decodeFailed,
+				)
+			}
+			cases.Case(jen.Id("contentTypeOfResponse").Op("!=").Lit("")).Block(
+				jen.Return(jen.Nil(), jen.Id("newNotSupportedContentType").Call(jen.Lit(415), jen.Id("contentTypeOfResponse"))),
+			)
+		})
+	}
+
+	stmts.If(jen.Id("client").Dot("responseErrors").Op("&&").Id("response").Dot("Status").Op(">=").Qual("net/http", "StatusBadRequest")).Block(
+		jen.Return(jen.Nil(), jen.Id("response")),
+	)
+	stmts.Return(jen.Id("response"), jen.Nil())
+}
+
+// generateResponseHeaders reads the declared headers of a response and the validators of a conditional operation
+func (gen *goClientGenerator) generateResponseHeaders(operation *Operation, statusCode int, response spec.Response, stmts *jen.Group) {
+
+	gen.generateHeaders(response.Headers, stmts)
+	if operation.HasValidators(statusCode) {
+		gen.generateValidators(stmts)
+	}
+}
+
+// generateValidators reads the ETag and Last-Modified of a response
+func (gen *goClientGenerator) generateValidators(stmts *jen.Group) {
+
+	stmts.Id("response").Dot("ETag").Op("=").Id("httpResponse").Dot("Header").Dot("Get").Call(jen.Id("eTagHeader"))
+	stmts.Id("response").Dot("LastModified").Op("=").Id("parseHTTPTime").Call(jen.Id("httpResponse").Dot("Header").Dot("Get").Call(jen.Id("lastModifiedHeader")))
+}
+
 func (gen *goClientGenerator) generateHeaders(headers map[string]spec.Header, stmts *jen.Group) {
 
 	var headerNames []string
@@ -522,7 +861,7 @@ func (gen *goClientGenerator) generateHeaders(headers map[string]spec.Header, st
 
 	for _, name := range headerNames {
 		stmts.If(jen.Id("err").Op(":=").Id("fromString").Call(jen.Id("httpResponse").Dot("Header").Dot("Get").Call(jen.Lit(name)), jen.Op("&").Id("response").Dot(identifier.MakeIdentifier(strings.Title(name)))), jen.Id("err").Op("!=").Nil()).Block(
-			jen.Return(jen.Nil(), jen.Id("err")),
+			jen.Return(jen.Nil(), jen.Id("client").Dot("hooks").Dot("callOnError").Call(jen.Id("operation"), jen.Id("httpRequest"), jen.Id("httpResponse"), jen.Id("err"))),
 		)
 	}
 }
@@ -2,6 +2,7 @@ package generator
 
 import (
 	"math"
+	"net/http"
 	"regexp"
 	"sort"
 
@@ -19,6 +20,34 @@ func getDefinitionName(schema spec.Schema) string {
 	return ""
 }
 
+// itemsSchema returns the schema of the items of an operation which streams the array of its 200 response
+// as newline delimited JSON, nil is returned if the operation doesn't stream items
+func (gen *GoGenerator) itemsSchema(operation *Operation) *spec.Schema {
+
+	if !operation.HasProduce(ContentTypeApplicationNdjson) || operation.Responses == nil {
+		return nil
+	}
+
+	response, ok := operation.Responses.StatusCodeResponses[http.StatusOK]
+	if !ok || response.Schema == nil {
+		return nil
+	}
+
+	schema := response.Schema
+	if name := getDefinitionName(*schema); name != "" {
+		definition, ok := gen.Spec.Definitions()[name]
+		if !ok {
+			return nil
+		}
+		schema = &definition
+	}
+
+	if !schema.Type.Contains("array") || schema.Items == nil || schema.Items.Schema == nil {
+		return nil
+	}
+	return schema.Items.Schema
+}
+
 func walkResponses(operation *Operation, f func(statusCode int, response spec.Response)) {
 	responses := operation.Responses.StatusCodeResponses
 
@@ -86,6 +115,18 @@ func regexContains(strings []string, s string) bool {
 	return false
 }
 
+func regexFilter(strings []string, s string) []string {
+
+	var matches []string
+	regex := regexp.MustCompile(s)
+	for _, s := range strings {
+		if regex.MatchString(s) {
+			matches = append(matches, s)
+		}
+	}
+	return matches
+}
+
 func filterContentTypes(consumes []string, supportedConsumes []string) []string {
 
 	validConsumes := make([]string, 0)
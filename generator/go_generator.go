@@ -46,8 +46,10 @@ const (
 	ContentTypeTextHTML                  string = "text/html"
 	ContentTypeApplicationFormUrlencoded string = "application/x-www-form-urlencoded"
 	ContentTypeApplicationPDF            string = "application/pdf"
-	ContentTypeApplicationXMLPattern     string = `^application\/(.+)xml$`
+	ContentTypeApplicationXMLPattern     string = `^(application|text)\/(.+\+)?xml$`
 	ContentTypeApplicationOctetStream    string = "application/octet-stream"
+	ContentTypeTextEventStream           string = "text/event-stream"
+	ContentTypeApplicationNdjson         string = "application/x-ndjson"
 )
 
 var ContentTypesForFiles []string = []string{
@@ -83,6 +85,9 @@ var supportedProduces []string = []string{
 	ContentTypeApplicationPDF,
 	ContentTypeApplicationXMLPattern,
 	ContentTypeApplicationOctetStream,
+	// content types for streams
+	ContentTypeTextEventStream,
+	ContentTypeApplicationNdjson,
 }
 
 var supportedConsumes []string = []string{
@@ -190,12 +195,18 @@ func (gen *GoGenerator) WalkOperations(handler func(operation *Operation) error)
 	return nil
 }
 
-func (gen *GoGenerator) ConvertMapToSecuritySchemeSlice(security []map[string][]string) []spec.SecurityScheme {
+// SecurityRequirement is a security scheme required by an operation and the name of its security definition
+type SecurityRequirement struct {
+	spec.SecurityScheme
+	Definition string
+}
+
+func (gen *GoGenerator) ConvertMapToSecuritySchemeSlice(security []map[string][]string) []SecurityRequirement {
 
-	var securityParams []spec.SecurityScheme
+	var securityParams []SecurityRequirement
 	for _, security := range security {
 		for securityScheme := range security {
-			securityParams = append(securityParams, *gen.Spec.SecurityScheme(securityScheme))
+			securityParams = append(securityParams, SecurityRequirement{SecurityScheme: *gen.Spec.SecurityScheme(securityScheme), Definition: securityScheme})
 		}
 	}
 	return securityParams
@@ -207,7 +218,7 @@ type ParametersBucket struct {
 	Header        []*spec.Parameter
 	FormData      []*spec.Parameter
 	FormDataFiles []*spec.Parameter
-	Security      []spec.SecurityScheme
+	Security      []SecurityRequirement
 	Body          []*spec.Parameter
 	HasFormData   bool
 	HasBody       bool
@@ -244,19 +255,25 @@ func (gen *GoGenerator) resolveParam(param *spec.Parameter) (*spec.Parameter, er
 func (gen *GoGenerator) PopulateParametersBucket(bucket *ParametersBucket, parameters []spec.Parameter, security []map[string][]string) (*ParametersBucket, error) {
 
 	// operation level security schemes replace the global schemes (no merging, see spec!)
-	var securitySchemes []spec.SecurityScheme
+	var securitySchemes []SecurityRequirement
 	if len(security) > 0 {
 		securitySchemes = gen.ConvertMapToSecuritySchemeSlice(security)
 	} else {
 		securitySchemes = gen.ConvertMapToSecuritySchemeSlice(gen.Spec.GlobalSecurities())
 	}
 
-	// remove double header fields
+	// remove double header fields, basic authentication and OAuth2 share the Authorization header
+	headerName := func(security SecurityRequirement) string {
+		if security.Type == openapi.Basic.String() || security.Type == openapi.OAuth2.String() {
+			return openapi.BasicAuthHeaderName
+		}
+		return security.Name
+	}
 	for _, security := range securitySchemes {
-		if security.In == openapi.Header.String() {
+		if security.In == openapi.Header.String() || security.Type == openapi.Basic.String() || security.Type == openapi.OAuth2.String() {
 			alreadyRegistered := false
 			for _, s := range bucket.Security {
-				if s.In == openapi.Header.String() && s.Name == security.Name {
+				if (s.In == openapi.Header.String() || s.Type == openapi.Basic.String() || s.Type == openapi.OAuth2.String()) && headerName(s) == headerName(security) {
 					alreadyRegistered = true
 					break
 				}
@@ -289,7 +306,7 @@ func (gen *GoGenerator) PopulateParametersBucket(bucket *ParametersBucket, param
 			bucket.FormData = append(bucket.FormData, resolvedParam)
 		} else if resolvedParam.In == openapi.FormData.String() && bucket.HasBody {
 			bucket.Body = append(bucket.Body, resolvedParam)
-		} else if resolvedParam.In == openapi.FormData.String() && resolvedParam.Type == "file" {
+		} else if resolvedParam.In == openapi.FormData.String() && isFileParameter(resolvedParam) {
 			bucket.HasFormData = true
 			bucket.FormDataFiles = append(bucket.FormDataFiles, resolvedParam)
 		} else if resolvedParam.In == openapi.FormData.String() {
@@ -324,3 +341,46 @@ func (gen *GoGenerator) generateNotSupported(operation *Operation, message strin
 		stmts.Return(notSupported)
 	}
 }
+
+// isFileParameter checks if a form data parameter is a file or an array of files
+func isFileParameter(param *spec.Parameter) bool {
+
+	return param.Type == "file" || (param.Type == "array" && param.Items != nil && param.Items.Type == "file")
+}
+
+// generateRegisterValidators generates the registerValidators method, which registers the pattern validators of the
+// types at the validator of the receiver
+func (gen *GoGenerator) generateRegisterValidators(receiver jen.Code, validator *jen.Statement, validators ValidatorMap, file *file.File) {
+
+	// group validators by regex
+	regex2tags := make(map[string][]string)
+
+	for tag, regex := range validators {
+		regex2tags[regex] = append(regex2tags[regex], tag)
+	}
+
+	// sort tag names and regexs
+	var regexs []string
+	for regex, tags := range regex2tags {
+		sort.Strings(tags)
+		regex2tags[regex] = tags
+		regexs = append(regexs, regex)
+	}
+	sort.Strings(regexs)
+
+	file.Func().Params(receiver).Id("registerValidators").Params().BlockFunc(func(stmts *jen.Group) {
+		for _, regex := range regexs {
+			gen.generatePatternValidator(regex, regex2tags[regex], validator, stmts)
+		}
+	}).Line()
+}
+
+func (gen *GoGenerator) generatePatternValidator(regex string, tags []string, validator *jen.Statement, stmts *jen.Group) {
+
+	tagIdent := identifier.MakeIdentifier(tags[0])
+
+	stmts.Id(tagIdent).Op(":=").Qual("regexp", "MustCompile").Call(jen.Lit(regex))
+	for _, tag := range tags {
+		stmts.Add(validator.Clone()).Dot("RegisterPattern").Call(jen.Lit(tag), jen.Id(tagIdent))
+	}
+}
@@ -45,7 +45,7 @@ func (gen *goHandlersGenerator) Generate(path, pckg, serverPkg string) error {
 
 func (gen *goHandlersGenerator) generateHandler(operation *Operation, serverPkg string, stmts *jen.Group, file *jen.File) {
 
-	file.Func().Id(strings.Title(operation.ID)).Params(jen.Id("ctx").Qual("context", "Context"), jen.Id("request").Op("*").Qual(serverPkg, strings.Title(operation.ID)+"Request")).Qual(serverPkg, strings.Title(operation.ID)+"Response").BlockFunc(func(stmts *jen.Group) {
+	file.Func().Id(strings.Title(operation.ID)).Params(stubHandlerParams(operation, serverPkg)...).Qual(serverPkg, strings.Title(operation.ID)+"Response").BlockFunc(func(stmts *jen.Group) {
 		response, status, _ := operation.SuccessResponse()
 		if response == nil {
 			stmts.Return(jen.Nil())
@@ -56,3 +56,17 @@ func (gen *goHandlersGenerator) generateHandler(operation *Operation, serverPkg
 
 	stmts.Id("server").Dot("Set" + strings.Title(operation.ID) + "Handler").Call(jen.Id(strings.Title(operation.ID)))
 }
+
+// stubHandlerParams returns the parameters of a handler implementation, handlers of event stream and
+// WebSocket operations receive the stream or connection to exchange the messages
+func stubHandlerParams(operation *Operation, serverPkg string) []jen.Code {
+
+	params := []jen.Code{jen.Id("ctx").Qual("context", "Context"), jen.Id("request").Op("*").Qual(serverPkg, strings.Title(operation.ID)+"Request")}
+	if operation.IsEventStream() {
+		params = append(params, jen.Id("events").Op("*").Qual(serverPkg, strings.Title(operation.ID)+"EventStream"))
+	}
+	if operation.IsWebSocket() {
+		params = append(params, jen.Id("conn").Op("*").Qual(serverPkg, strings.Title(operation.ID)+"ServerConn"))
+	}
+	return params
+}
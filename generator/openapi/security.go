@@ -9,6 +9,8 @@ func (s SecurityType) String() string {
 const (
 	Basic  SecurityType = "basic"
 	ApiKey SecurityType = "apiKey"
+	OAuth2 SecurityType = "oauth2"
 )
 
+// BasicAuthHeaderName is the header of basic authentication and of OAuth2 access tokens
 const BasicAuthHeaderName string = "Authorization"
@@ -1,11 +1,31 @@
 package generator
 
 import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
 	"github.com/ExperienceOne/apikit/generator/stringutil"
 
 	"github.com/go-openapi/spec"
+	"github.com/pkg/errors"
 )
 
+// WebSocketExtension describes the messages of a WebSocket operation
+const WebSocketExtension = "x-websocket"
+
+// UploadExtension configures the multipart uploads of an operation
+const UploadExtension = "x-upload"
+
+// Upload configures the multipart uploads of an operation, a zero limit uses the limit of the server
+type Upload struct {
+	// Stream passes the files to the handler while they are read from the request. Only the form values which
+	// precede the first file are extracted, required values sent after a file are reported as missing.
+	Stream       bool  `json:"stream"`
+	MaxFileSize  int64 `json:"maxFileSize"`
+	MaxTotalSize int64 `json:"maxTotalSize"`
+}
+
 type Operation struct {
 	*spec.Operation
 	Method   string
@@ -52,3 +72,186 @@ func (o *Operation) HasValidConsumes() bool {
 func (o *Operation) HasValidProduces() bool {
 	return len(o.Produces) != 0
 }
+
+// XMLConsumes returns the consumed content types of XML documents
+func (o *Operation) XMLConsumes() []string {
+	return regexFilter(o.Consumes, ContentTypeApplicationXMLPattern)
+}
+
+// XMLProduces returns the produced content types of XML documents
+func (o *Operation) XMLProduces() []string {
+	return regexFilter(o.Produces, ContentTypeApplicationXMLPattern)
+}
+
+// IsEventStream checks if the operation responds with server-sent events, the data of the events
+// is described by the schema of the 200 response
+func (o *Operation) IsEventStream() bool {
+
+	if !o.HasProduce(ContentTypeTextEventStream) || o.Responses == nil {
+		return false
+	}
+
+	response, ok := o.Responses.StatusCodeResponses[http.StatusOK]
+	return ok && response.Schema != nil
+}
+
+// IsWebSocket checks if the operation is a WebSocket, either marked by the ws or wss scheme or the x-websocket extension
+func (o *Operation) IsWebSocket() bool {
+
+	if _, ok := o.Extensions[WebSocketExtension]; ok {
+		return true
+	}
+	return stringutil.InStringSlice(o.Schemes, "ws") || stringutil.InStringSlice(o.Schemes, "wss")
+}
+
+// WebSocketMessages returns the schemas of the inbound (client to server) and outbound (server to client)
+// messages of a WebSocket, the schema of a message is nil if it's not described by the x-websocket extension
+func (o *Operation) WebSocketMessages() (*spec.Schema, *spec.Schema, error) {
+
+	var messages struct {
+		Inbound  *spec.Schema `json:"inbound"`
+		Outbound *spec.Schema `json:"outbound"`
+	}
+
+	extension, ok := o.Extensions[WebSocketExtension]
+	if !ok {
+		return nil, nil, nil
+	}
+
+	data, err := json.Marshal(extension)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "error reading %s of '%s'", WebSocketExtension, o.ID)
+	}
+	if err := json.Unmarshal(data, &messages); err != nil {
+		return nil, nil, errors.Wrapf(err, "error reading %s of '%s'", WebSocketExtension, o.ID)
+	}
+
+	return messages.Inbound, messages.Outbound, nil
+}
+
+// Upload returns the configuration of the multipart uploads by the x-upload extension, the zero configuration
+// is returned if the operation doesn't have the extension
+func (o *Operation) Upload() (*Upload, error) {
+
+	upload := new(Upload)
+
+	extension, ok := o.Extensions[UploadExtension]
+	if !ok {
+		return upload, nil
+	}
+
+	data, err := json.Marshal(extension)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading %s of '%s'", UploadExtension, o.ID)
+	}
+	if err := json.Unmarshal(data, upload); err != nil {
+		return nil, errors.Wrapf(err, "error reading %s of '%s'", UploadExtension, o.ID)
+	}
+
+	return upload, nil
+}
+
+// IsUploadStream checks if the files of a multipart upload are passed to the handler while they are read
+func (o *Operation) IsUploadStream() bool {
+
+	upload, err := o.Upload()
+	return err == nil && upload.Stream && o.HasConsume(ContentTypeMultipartFormData)
+}
+
+// UploadContentTypes returns the content types of uploaded files, which are consumed by the operation besides
+// the form content types. All content types are accepted if there are none.
+func (o *Operation) UploadContentTypes() []string {
+
+	var contentTypes []string
+	for _, contentType := range o.Consumes {
+		if contentType != ContentTypeMultipartFormData && contentType != ContentTypeApplicationFormUrlencoded {
+			contentTypes = append(contentTypes, contentType)
+		}
+	}
+	return contentTypes
+}
+
+// conditionalHeaders are the request headers of conditional requests
+var conditionalHeaders = []string{"If-Match", "If-None-Match", "If-Modified-Since", "If-Unmodified-Since"}
+
+// IsConditional checks if the operation supports conditional requests based on the ETag and Last-Modified of its
+// responses. Streams and operations which declare conditional or validator headers themselves are excluded.
+func (o *Operation) IsConditional() bool {
+
+	switch strings.ToUpper(o.Method) {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return false
+	}
+
+	if o.IsEventStream() || o.IsWebSocket() || o.Responses == nil {
+		return false
+	}
+
+	if o.hasHeaderParameter(conditionalHeaders...) {
+		return false
+	}
+
+	for _, response := range o.Responses.StatusCodeResponses {
+		for header := range response.Headers {
+			if strings.EqualFold(header, "ETag") || strings.EqualFold(header, "Last-Modified") {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// IsSafe checks if the operation only reads the resource, conditional requests of a safe operation are answered
+// with 304 (Not Modified) if the resource wasn't modified
+func (o *Operation) IsSafe() bool {
+
+	method := strings.ToUpper(o.Method)
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// HasValidators checks if a response of a conditional operation carries the ETag and Last-Modified of the resource
+func (o *Operation) HasValidators(statusCode int) bool {
+
+	return o.IsConditional() && ((statusCode >= 200 && statusCode < 300) || statusCode == http.StatusNotModified)
+}
+
+// rangeHeaders are the request headers of range requests
+var rangeHeaders = []string{"Range", "If-Range"}
+
+// HasRanges checks if parts of the file of a download operation can be requested. Operations which declare range
+// headers or the 206 (Partial Content) response themselves are excluded.
+func (o *Operation) HasRanges() bool {
+
+	if !o.IsSafe() || o.Responses == nil || !o.HasProduces(ContentTypesForFiles...) {
+		return false
+	}
+
+	if _, ok := o.Responses.StatusCodeResponses[http.StatusPartialContent]; ok {
+		return false
+	}
+	response, ok := o.Responses.StatusCodeResponses[http.StatusOK]
+	if !ok || response.Schema == nil || !response.Schema.Type.Contains("file") {
+		return false
+	}
+
+	return !o.hasHeaderParameter(rangeHeaders...)
+}
+
+// hasHeaderParameter checks if the operation or its path declares one of the header parameters
+func (o *Operation) hasHeaderParameter(headers ...string) bool {
+
+	parameters := o.Parameters
+	if o.Path != nil {
+		parameters = append(parameters[:len(parameters):len(parameters)], o.Path.Parameters...)
+	}
+	for _, parameter := range parameters {
+		for _, header := range headers {
+			if parameter.In == "header" && strings.EqualFold(parameter.Name, header) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/ExperienceOne/apikit/generator/types"
 	"github.com/ExperienceOne/apikit/internal/framework/parameter"
 	"net/http"
-	"sort"
 	"strconv"
 	"strings"
 
@@ -19,11 +18,6 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-var additionalServerImports = []Library{
-	{Import: "github.com/go-ozzo/ozzo-routing", Alias: "routing"},
-	{Import: "github.com/go-ozzo/ozzo-routing/fault"},
-}
-
 type goServerGenerator struct {
 	*GoGenerator
 }
@@ -38,7 +32,6 @@ func NewGoServerGenerator(spec *openapi.Spec) *goServerGenerator {
 func (gen *goServerGenerator) Generate(path, pckg string, validators ValidatorMap, generatePrometheus bool) error {
 
 	file := file.NewFile(pckg)
-	gen.addImports(additionalServerImports, file)
 
 	serverFields := []jen.Code{
 		jen.Op("*").Id("Server"),
@@ -55,6 +48,9 @@ func (gen *goServerGenerator) Generate(path, pckg string, validators ValidatorMa
 		operations = append(operations, operation)
 		handlerName := stringutil.UnTitle(strings.Title(operation.ID + "Handler"))
 		serverFields = append(serverFields, jen.Id(handlerName).Op("*").Id(handlerName+"Route"))
+		if operation.IsConditional() && !operation.IsSafe() {
+			serverFields = append(serverFields, jen.Id(stringutil.UnTitle(operation.ID+"Precondition")).Id(strings.Title(operation.ID+"Precondition")))
+		}
 		return nil
 	}); err != nil {
 		return errors.Wrap(err, "error generating operations")
@@ -129,7 +125,7 @@ func (gen *goServerGenerator) Generate(path, pckg string, validators ValidatorMa
 		return errors.Wrap(err, "error generating operations and handlers")
 	}
 
-	gen.generateValidators(nameOfServer, validators, file)
+	gen.generateRegisterValidators(jen.Id("server").Op("*").Id(nameOfServer), jen.Id("server").Dot("Validator"), validators, file)
 
 	file.Func().Params(jen.Id("server").Op("*").Id(nameOfServer)).Id("Start").Params(jen.Id("port").Int()).Error().BlockFunc(func(stmts *jen.Group) {
 
@@ -160,45 +156,6 @@ func (gen *goServerGenerator) Generate(path, pckg string, validators ValidatorMa
 	return nil
 }
 
-func (gen *goServerGenerator) generateValidators(nameOfServer string, validators ValidatorMap, file *file.File) {
-
-	// group validators by regex
-	regex2tags := make(map[string][]string)
-
-	for tag, regex := range validators {
-		regex2tags[regex] = append(regex2tags[regex], tag)
-	}
-
-	// sort tag names and regexs
-	var regexs []string
-	for regex, tags := range regex2tags {
-		sort.Strings(tags)
-		regex2tags[regex] = tags
-		regexs = append(regexs, regex)
-	}
-	sort.Strings(regexs)
-
-	file.Func().Params(jen.Id("server").Op("*").Id(nameOfServer)).Id("registerValidators").Params().BlockFunc(func(stmts *jen.Group) {
-		for _, regex := range regexs {
-			gen.generateValidator(regex, regex2tags[regex], stmts)
-		}
-	}).Line()
-}
-
-func (gen *goServerGenerator) generateValidator(regex string, tags []string, stmts *jen.Group) {
-
-	tagIdent := identifier.MakeIdentifier(tags[0])
-	callback := "callback" + strings.Title(tagIdent)
-
-	stmts.Id(tagIdent).Op(":=").Qual("regexp", "MustCompile").Call(jen.Lit(regex))
-	stmts.Id(callback).Op(":=").Func().Params(jen.Id("fl").Qual("github.com/go-playground/validator", "FieldLevel")).Id("bool").Block(
-		jen.Return(jen.Id(tagIdent).Dot("MatchString").Call(jen.Id("fl").Dot("Field").Call().Dot("String").Call())),
-	)
-	for _, tag := range tags {
-		stmts.Id("server").Dot("Validator").Dot("RegisterValidation").Call(jen.Lit(tag), jen.Id(callback))
-	}
-}
-
 func (gen *goServerGenerator) generateOperation(operation *Operation, parametersBucket *ParametersBucket, nameOfServer string, file *file.File) error {
 
 	nameOfHandler := strings.Title(operation.ID + "Handler")
@@ -206,7 +163,14 @@ func (gen *goServerGenerator) generateOperation(operation *Operation, parameters
 	if operation.Description != "" {
 		file.Comment(operation.Description)
 	}
-	file.Type().Id(nameOfHandler).Func().Params(jen.Id("ctx").Qual("context", "Context"), jen.Id("request").Op("*").Id(strings.Title(operation.ID+"Request"))).Id(strings.Title(operation.ID + "Response")).Line()
+	handlerParams := []jen.Code{jen.Id("ctx").Qual("context", "Context"), jen.Id("request").Op("*").Id(strings.Title(operation.ID + "Request"))}
+	if operation.IsEventStream() {
+		handlerParams = append(handlerParams, jen.Id("events").Op("*").Id(strings.Title(operation.ID+"EventStream")))
+	}
+	if operation.IsWebSocket() {
+		handlerParams = append(handlerParams, jen.Id("conn").Op("*").Id(strings.Title(operation.ID+"ServerConn")))
+	}
+	file.Type().Id(nameOfHandler).Func().Params(handlerParams...).Id(strings.Title(operation.ID + "Response")).Line()
 
 	file.Type().Id(stringutil.UnTitle(nameOfHandler)+"Route").Struct(
 		jen.Id("routeDescription").Id("RouteDescription"),
@@ -218,19 +182,35 @@ func (gen *goServerGenerator) generateOperation(operation *Operation, parameters
 			jen.Id("customHandler").Op(":").Id("handler"),
 			jen.Id("routeDescription").Op(":").Id("RouteDescription").Values(
 				jen.Id("Method").Op(":").Lit(strings.ToUpper(operation.Method)),
-				jen.Id("Path").Op(":").Lit(makeMatcherForRoute(operation.Route)),
+				jen.Id("Path").Op(":").Lit(operation.Route),
 				jen.Id("Handler").Op(":").Id("server").Dot(nameOfHandler),
 				jen.Id("Middleware").Op(":").Id("middleware"),
 			),
 		),
 	).Line()
 
+	if operation.IsConditional() && !operation.IsSafe() {
+		nameOfPrecondition := strings.Title(operation.ID + "Precondition")
+
+		file.Commentf("%s returns the ETag and Last-Modified of the current representation of the resource, which are compared", nameOfPrecondition)
+		file.Comment("with the conditional headers of a request before it is handled. An empty ETag and a zero time stand for a missing resource.")
+		file.Type().Id(nameOfPrecondition).Func().Params(
+			jen.Id("ctx").Qual("context", "Context"),
+			jen.Id("request").Op("*").Id(strings.Title(operation.ID+"Request")),
+		).Params(jen.Id("etag").String(), jen.Id("lastModified").Qual("time", "Time"), jen.Id("err").Error()).Line()
+
+		file.Commentf("Set%s sets the precondition of %s requests, a failed precondition is answered with 412 (Precondition Failed)", nameOfPrecondition, operation.ID)
+		file.Func().Params(jen.Id("server").Op("*").Id(nameOfServer)).Id("Set" + nameOfPrecondition).Params(jen.Id("precondition").Id(nameOfPrecondition)).Block(
+			jen.Id("server").Dot(stringutil.UnTitle(nameOfPrecondition)).Op("=").Id("precondition"),
+		).Line()
+	}
+
 	return nil
 }
 
 func (gen *goServerGenerator) generateHandler(operation *Operation, parametersBucket *ParametersBucket, nameOfServer string, generatePrometheus bool, file *file.File) {
 
-	file.Func().Params(jen.Id("server").Op("*").Id(nameOfServer)).Id(strings.Title(operation.ID + "Handler")).Params(jen.Id("c").Op("*").Qual("github.com/go-ozzo/ozzo-routing", "Context")).Error().BlockFunc(func(stmts *jen.Group) {
+	file.Func().Params(jen.Id("server").Op("*").Id(nameOfServer)).Id(strings.Title(operation.ID + "Handler")).Params(jen.Id("w").Qual("net/http", "ResponseWriter"), jen.Id("r").Op("*").Qual("net/http", "Request")).Error().BlockFunc(func(stmts *jen.Group) {
 
 		if !operation.HasValidConsumes() || !operation.HasValidProduces() {
 			gen.generateNotSupported(operation, "no supported content type", false, stmts)
@@ -253,40 +233,85 @@ func (gen *goServerGenerator) generateHandler(operation *Operation, parametersBu
 				stmts.Id("start").Op(":=").Qual("time", "Now").Call()
 			}
 
+			stmts.List(jen.Id("negotiatedContentType"), jen.Id("acceptable")).Op(":=").Id("negotiateContentType").Call(
+				jen.Id("r").Dot("Header").Dot("Get").Call(jen.Lit("Accept")),
+				jen.Index().String().ValuesFunc(func(group *jen.Group) {
+					for _, contentType := range gen.negotiableProduces(operation) {
+						group.Lit(contentType)
+					}
+				}),
+			)
+			// keep the Vary header of middleware, e.g. Accept-Encoding of the compression
+			stmts.Id("w").Dot("Header").Call().Dot("Add").Call(jen.Lit("Vary"), jen.Lit("Accept"))
+			stmts.If(jen.Op("!").Id("acceptable")).Block(
+				jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"content type of response is not acceptable (accept: %s)"), jen.Id("r").Dot("Header").Dot("Get").Call(jen.Lit("Accept")))),
+				jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusNotAcceptable"))),
+			)
+			stmts.Id("r").Op("=").Id("r").Dot("WithContext").Call(jen.Id("withNegotiatedContentType").Call(jen.Id("r").Dot("Context").Call(), jen.Id("negotiatedContentType")))
+
 			stmts.Id("request").Op(":=").New(jen.Id(operation.ID + "Request"))
 
 			for _, param := range parametersBucket.Body {
-				if param.In == "body" && operation.HasConsume(ContentTypeApplicationJson) {
 
-					stmts.Id("contentTypeOfResponse").Op(":=").Id("extractContentType").Call(jen.Id("c").Dot("Request").Dot("Header").Dot("Get").Call(jen.Id("contentTypeHeader")))
-					stmts.If(jen.Id("contentTypeOfResponse").Op("==").Id("contentTypeApplicationJson")).Block(
-						jen.Id("err").Op(":=").Id("JSON").Call(jen.Id("c").Dot("Request").Dot("Body"), jen.Op("&").Id("request").Dot(strings.Title(identifier.MakeIdentifier(param.Name))), jen.Lit(param.Required)),
+				xmlConsumes := operation.XMLConsumes()
+				if param.In != "body" || (!operation.HasConsume(ContentTypeApplicationJson) && len(xmlConsumes) == 0) {
+					continue
+				}
+
+				var wantContentTypes []string
+				if operation.HasConsume(ContentTypeApplicationJson) {
+					wantContentTypes = append(wantContentTypes, ContentTypeApplicationJson)
+				}
+				wantContentTypes = append(wantContentTypes, xmlConsumes...)
+
+				decodeBody := func(decoder string) []jen.Code {
+					return []jen.Code{
+						jen.Id("err").Op(":=").Id(decoder).Call(jen.Id("r").Dot("Body"), jen.Op("&").Id("request").Dot(strings.Title(identifier.MakeIdentifier(param.Name))), jen.Lit(param.Required)),
 						jen.If(jen.Id("err").Op("!=").Nil()).Block(
 							jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"could not decode request body of incoming request (%v)"), jen.Id("err"))),
-							jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusBadRequest"))),
+							gen.generateBadRequest(operation, jen.Id("NewBodyError").Call(jen.Lit(param.Name), jen.Id("err"))),
 						),
-					).Else().BlockFunc(func(stmts *jen.Group) {
-						logError := jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"content type of incoming request is bad (want: application/json, got: %s)"), jen.Id("contentTypeOfResponse")))
-						returnUnsupported := jen.Return(jen.Id("newNotSupportedContentType").Call(jen.Lit(415), jen.Id("contentTypeOfResponse")))
-						if !param.Required {
-							stmts.If(jen.Id("contentTypeOfResponse").Op("!=").Lit("")).Block(logError, returnUnsupported)
-						} else {
-							stmts.Add(logError)
-							stmts.Add(returnUnsupported)
+					}
+				}
+
+				stmts.Id("contentTypeOfResponse").Op(":=").Id("extractContentType").Call(jen.Id("r").Dot("Header").Dot("Get").Call(jen.Id("contentTypeHeader")))
+
+				decoders := stmts.Null()
+				if operation.HasConsume(ContentTypeApplicationJson) {
+					decoders.If(jen.Id("contentTypeOfResponse").Op("==").Id("contentTypeApplicationJson")).Block(decodeBody("JSON")...)
+				}
+				if len(xmlConsumes) != 0 {
+					if operation.HasConsume(ContentTypeApplicationJson) {
+						decoders.Else()
+					}
+					xmlContentTypes := jen.Index().String().ValuesFunc(func(group *jen.Group) {
+						for _, contentType := range xmlConsumes {
+							group.Lit(contentType)
 						}
 					})
+					decoders.If(jen.Id("contentTypeInList").Call(xmlContentTypes, jen.Id("contentTypeOfResponse"))).Block(decodeBody("XML")...)
 				}
+				decoders.Else().BlockFunc(func(stmts *jen.Group) {
+					logError := jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"content type of incoming request is bad (want: "+strings.Join(wantContentTypes, ", ")+", got: %s)"), jen.Id("contentTypeOfResponse")))
+					returnUnsupported := jen.Return(jen.Id("newNotSupportedContentType").Call(jen.Lit(415), jen.Id("contentTypeOfResponse")))
+					if !param.Required {
+						stmts.If(jen.Id("contentTypeOfResponse").Op("!=").Lit("")).Block(logError, returnUnsupported)
+					} else {
+						stmts.Add(logError)
+						stmts.Add(returnUnsupported)
+					}
+				})
 			}
 
 			for _, param := range parametersBucket.Security {
 				if param.Type == openapi.ApiKey.String() && param.In == openapi.Header.String() {
-					stmts.Id("request").Dot(strings.Title(identifier.MakeIdentifier(param.Name))).Op("=").Id("c").Dot("Request").Dot("Header").Dot("Get").Call(jen.Lit(param.Name))
-				} else if param.Type == openapi.Basic.String() {
+					stmts.Id("request").Dot(strings.Title(identifier.MakeIdentifier(param.Name))).Op("=").Id("r").Dot("Header").Dot("Get").Call(jen.Lit(param.Name))
+				} else if param.Type == openapi.Basic.String() || param.Type == openapi.OAuth2.String() {
 					paramName := param.Name
 					if paramName == "" {
 						paramName = openapi.BasicAuthHeaderName
 					}
-					stmts.Id("request").Dot(strings.Title(identifier.MakeIdentifier(paramName))).Op("=").Id("c").Dot("Request").Dot("Header").Dot("Get").Call(jen.Lit(paramName))
+					stmts.Id("request").Dot(strings.Title(identifier.MakeIdentifier(paramName))).Op("=").Id("r").Dot("Header").Dot("Get").Call(jen.Lit(paramName))
 				} else {
 					log.Error(fmt.Sprintf("unsupported security scheme type '%s' in '%s'", param.Type, param.In))
 				}
@@ -294,48 +319,17 @@ func (gen *goServerGenerator) generateHandler(operation *Operation, parametersBu
 
 			if (parametersBucket.HasFormData && operation.HasConsume(ContentTypeMultipartFormData)) || operation.HasConsume(ContentTypeApplicationFormUrlencoded) {
 
-				stmts.Id("contentTypeOfResponse").Op(":=").Id("extractContentType").Call(jen.Id("c").Dot("Request").Dot("Header").Dot("Get").Call(jen.Id("contentTypeHeader")))
+				stmts.Id("contentTypeOfResponse").Op(":=").Id("extractContentType").Call(jen.Id("r").Dot("Header").Dot("Get").Call(jen.Id("contentTypeHeader")))
 				stmts.If(jen.Id("contentTypeOfResponse").Op("==").Id("contentTypeMultipartFormData")).BlockFunc(func(stmts *jen.Group) {
 
 					if operation.HasConsume(ContentTypeMultipartFormData) {
-
-						stmts.Id("formData").Op(":=").Op("&").Id("request").Dot("FormData")
-						for i, param := range parametersBucket.FormDataFiles {
-
-							file := "file" + strconv.Itoa(i)
-							extractErr := "extractErr" + strconv.Itoa(i)
-
-							stmts.List(jen.Id(file), jen.Id(extractErr)).Op(":=").Id("extractUpload").Call(jen.Lit(param.Name), jen.Id("c").Dot("Request"))
-							stmts.If(jen.Id(extractErr).Op("!=").Nil()).Block(
-								jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"could not extract upload from incoming request (error: %v)"), jen.Id(extractErr))),
-								jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusBadRequest"))),
-							)
-							if param.Required {
-								file = "*" + file
-							}
-							stmts.Id("formData").Dot(strings.Title(identifier.MakeIdentifier(param.Name))).Op("=").Id(file)
-						}
-
-						for _, param := range parametersBucket.FormData {
-							group := stmts.If(jen.Len(jen.Id("c").Dot("Request").Dot("Form").Index(jen.Lit(param.Name))).Op(">").Lit(0)).Block(
-								jen.If(jen.Id("err").Op(":=").Id("fromString").Call(jen.Id("c").Dot("Request").Dot("Form").Index(jen.Lit(param.Name)).Index(jen.Lit(0)), jen.Op("&").Id("formData").Dot(strings.Title(identifier.MakeIdentifier(param.Name)))), jen.Id("err").Op("!=").Nil()).Block(
-									jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"could not convert string to specific type (error: %v)"), jen.Id("err"))),
-									jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusBadRequest"))),
-								),
-							)
-
-							if param.Required {
-								group.Else().BlockFunc(func(stmts *jen.Group) {
-									stmts.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusBadRequest")))
-								})
-							}
-						}
+						gen.generateMultipartForm(operation, parametersBucket, logPrefix, stmts)
 					}
 				}).Else().If(jen.Id("contentTypeOfResponse").Op("==").Id("contentTypeApplicationFormUrlencoded")).BlockFunc(func(stmts *jen.Group) {
 
 					if operation.HasConsume(ContentTypeApplicationFormUrlencoded) {
 
-						stmts.List(jen.Id("rawBody"), jen.Id("err")).Op(":=").Qual("io/ioutil", "ReadAll").Call(jen.Id("c").Dot("Request").Dot("Body"))
+						stmts.List(jen.Id("rawBody"), jen.Id("err")).Op(":=").Qual("io/ioutil", "ReadAll").Call(jen.Id("r").Dot("Body"))
 						stmts.If(jen.Id("err").Op("!=").Nil()).Block(
 							jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"could not read body of incoming request (error: %v)"), jen.Id("err"))),
 							jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusInternalServerError"))),
@@ -351,12 +345,12 @@ func (gen *goServerGenerator) generateHandler(operation *Operation, parametersBu
 							group := stmts.If(jen.Len(jen.Id("queryInBody").Index(jen.Lit(param.Name))).Op(">").Lit(0)).Block(
 								jen.If(jen.Id("err").Op(":=").Id("fromString").Call(jen.Id("queryInBody").Dot("Get").Call(jen.Lit(param.Name)), jen.Op("&").Id("request").Dot(strings.Title(identifier.MakeIdentifier(param.Name)))), jen.Id("err").Op("!=").Nil()).Block(
 									jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"could not convert string to specific type (error: %v)"), jen.Id("err"))),
-									jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusBadRequest"))),
+									gen.generateBadRequest(operation, gen.parameterTypeError(param)),
 								),
 							)
 							if param.Required {
 								group.Else().BlockFunc(func(stmts *jen.Group) {
-									stmts.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusBadRequest")))
+									stmts.Add(gen.generateBadRequest(operation, gen.parameterRequiredError(param)))
 								})
 							}
 						}
@@ -369,22 +363,22 @@ func (gen *goServerGenerator) generateHandler(operation *Operation, parametersBu
 
 			for _, param := range parametersBucket.Path {
 				// ref: https://swagger.io/docs/specification/2-0/describing-parameters/#path-parameters
-				stmts.If(jen.Id("err").Op(":=").Id("fromString").Call(jen.Id("c").Dot("Param").Call(jen.Lit(param.Name)), jen.Op("&").Id("request").Dot(strings.Title(identifier.MakeIdentifier(param.Name)))), jen.Id("err").Op("!=").Nil()).Block(
+				stmts.If(jen.Id("err").Op(":=").Id("fromString").Call(jen.Id("server").Dot("PathParam").Call(jen.Id("r"), jen.Lit(param.Name)), jen.Op("&").Id("request").Dot(strings.Title(identifier.MakeIdentifier(param.Name)))), jen.Id("err").Op("!=").Nil()).Block(
 					jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"could not convert string to specific type (error: %v)"), jen.Id("err"))),
-					jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusBadRequest"))),
+					gen.generateBadRequest(operation, gen.parameterTypeError(param)),
 				)
 			}
 
 			for _, param := range parametersBucket.Header {
-				group := stmts.If(jen.Len(jen.Id("c").Dot("Request").Dot("Header").Index(jen.Lit(param.Name))).Op(">").Lit(0)).Block(
-					jen.If(jen.Id("err").Op(":=").Id("fromString").Call(jen.Id("c").Dot("Request").Dot("Header").Index(jen.Lit(param.Name)).Index(jen.Lit(0)), jen.Op("&").Id("request").Dot(strings.Title(identifier.MakeIdentifier(param.Name)))), jen.Id("err").Op("!=").Nil()).Block(
+				group := stmts.If(jen.Len(jen.Id("r").Dot("Header").Index(jen.Lit(param.Name))).Op(">").Lit(0)).Block(
+					jen.If(jen.Id("err").Op(":=").Id("fromString").Call(jen.Id("r").Dot("Header").Index(jen.Lit(param.Name)).Index(jen.Lit(0)), jen.Op("&").Id("request").Dot(strings.Title(identifier.MakeIdentifier(param.Name)))), jen.Id("err").Op("!=").Nil()).Block(
 						jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"could not convert string to specific type (error: %v)"), jen.Id("err"))),
-						jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusBadRequest"))),
+						gen.generateBadRequest(operation, gen.parameterTypeError(param)),
 					),
 				)
 				if param.Required {
 					group.Else().BlockFunc(func(stmts *jen.Group) {
-						stmts.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusBadRequest")))
+						stmts.Add(gen.generateBadRequest(operation, gen.parameterRequiredError(param)))
 					})
 				}
 			}
@@ -395,23 +389,23 @@ func (gen *goServerGenerator) generateHandler(operation *Operation, parametersBu
 
 				parameterMember := jen.Id("request").Dot(strings.Title(identifier.MakeIdentifier(param.Name)))
 
-				group := stmts.If(jen.Len(jen.Id("c").Dot("Request").Dot("URL").Dot("Query").Call().Index(jen.Lit(param.Name))).Op(">").Lit(0)).BlockFunc(func(group *jen.Group) {
-					group.If(jen.Id("err").Op(":=").Id("fromString").Call(jen.Id("c").Dot("Request").Dot("URL").Dot("Query").Call().Index(jen.Lit(param.Name)).Index(jen.Lit(0)), parameterMemberPointer), jen.Id("err").Op("!=").Nil()).Block(
+				group := stmts.If(jen.Len(jen.Id("r").Dot("URL").Dot("Query").Call().Index(jen.Lit(param.Name))).Op(">").Lit(0)).BlockFunc(func(group *jen.Group) {
+					group.If(jen.Id("err").Op(":=").Id("fromString").Call(jen.Id("r").Dot("URL").Dot("Query").Call().Index(jen.Lit(param.Name)).Index(jen.Lit(0)), parameterMemberPointer), jen.Id("err").Op("!=").Nil()).Block(
 						jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"could not convert string to specific type (error: %v)"), jen.Id("err"))),
-						jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusBadRequest"))),
+						gen.generateBadRequest(operation, gen.parameterTypeError(param)),
 					)
 
 					if param.Type == "array" {
 						if param.MinItems != nil {
 							group.Comment("minItems validator constrain at least " + strconv.Itoa(int(*param.MinItems)) + " items")
 							group.If(jen.Id("len").Call(parameterMember).Op("<").Lit(int(*param.MinItems))).Block(
-								jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusBadRequest"))),
+								gen.generateBadRequest(operation, gen.parameterError(param, jen.Lit("invalid-min"), jen.Lit(fmt.Sprintf("%s parameter '%s' requires at least %d items", param.In, param.Name, *param.MinItems)))),
 							)
 						}
 						if param.MaxItems != nil {
 							group.Comment("maxItems validator constrain at maximum " + strconv.Itoa(int(*param.MaxItems)) + " items")
 							group.If(jen.Id("len").Call(parameterMember).Op(">").Lit(int(*param.MaxItems))).Block(
-								jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusBadRequest"))),
+								gen.generateBadRequest(operation, gen.parameterError(param, jen.Lit("invalid-max"), jen.Lit(fmt.Sprintf("%s parameter '%s' allows at maximum %d items", param.In, param.Name, *param.MaxItems)))),
 							)
 						}
 					}
@@ -429,55 +423,117 @@ func (gen *goServerGenerator) generateHandler(operation *Operation, parametersBu
 					})
 				} else if param.Required {
 					group.Else().BlockFunc(func(stmts *jen.Group) {
-						stmts.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusBadRequest")))
+						stmts.Add(gen.generateBadRequest(operation, gen.parameterRequiredError(param)))
 					})
 				}
 			}
 
+			if operation.IsEventStream() {
+				stmts.Id("request").Dot("LastEventID").Op("=").Id("r").Dot("Header").Dot("Get").Call(jen.Id("lastEventIDHeader"))
+			}
+
+			if operation.IsConditional() {
+				getHeader := func(header string) *jen.Statement {
+					return jen.Id("r").Dot("Header").Dot("Get").Call(jen.Id(header))
+				}
+				if operation.IsSafe() {
+					stmts.Id("request").Dot("IfNoneMatch").Op("=").Add(getHeader("ifNoneMatchHeader"))
+					stmts.Id("request").Dot("IfModifiedSince").Op("=").Id("parseHTTPTime").Call(getHeader("ifModifiedSinceHeader"))
+				} else {
+					stmts.Id("request").Dot("IfMatch").Op("=").Add(getHeader("ifMatchHeader"))
+					stmts.Id("request").Dot("IfNoneMatch").Op("=").Add(getHeader("ifNoneMatchHeader"))
+					stmts.Id("request").Dot("IfUnmodifiedSince").Op("=").Id("parseHTTPTime").Call(getHeader("ifUnmodifiedSinceHeader"))
+				}
+			}
+
 			stmts.List(jen.Id("validationErrors"), jen.Id("err")).Op(":=").Id("server").Dot("Validator").Dot("ValidateRequest").Call(jen.Id("request"))
 			stmts.If(jen.Id("err").Op("!=").Nil()).Block(
 				jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"could not validate incoming request (error: %v)"), jen.Id("err"))),
 				jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusInternalServerError"))),
 			)
 
-			stmts.If(jen.Id("validationErrors").Op("!=").Nil()).BlockFunc(func(stmts *jen.Group) {
-				if response, ok := operation.Responses.StatusCodeResponses[http.StatusBadRequest]; ok {
+			stmts.If(jen.Id("validationErrors").Op("!=").Nil()).Block(
+				gen.generateBadRequest(operation, jen.Id("validationErrors")),
+			)
 
-					if response.Schema != nil && getDefinitionName(*response.Schema) == "ValidationErrors" {
-						// don't use Header().Set() or Header().Add(): https://github.com/golang/go/issues/5022
-						stmts.Id("c").Dot("Response").Dot("Header").Call().Index(jen.Id("contentTypeHeader")).Op("=").Index().String().Values(jen.Id("contentTypeApplicationJson"))
-						stmts.Id("c").Dot("Response").Dot("WriteHeader").Call(jen.Qual("net/http", "StatusBadRequest"))
-						stmts.Id("encodeErr").Op(":=").Qual("encoding/json", "NewEncoder").Call(jen.Id("c").Dot("Response")).Dot("Encode").Call(jen.Id("validationErrors"))
-						stmts.If(jen.Id("encodeErr").Op("!=").Nil()).Block(
-							jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"could not encode validation response (error: %v)"), jen.Id("encodeErr"))),
-							jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusInternalServerError"))),
+			if operation.IsConditional() && !operation.IsSafe() {
+				precondition := jen.Id("server").Dot(stringutil.UnTitle(operation.ID + "Precondition"))
+				stmts.If(precondition.Clone().Op("!=").Nil().Op("&&").Id("hasPreconditions").Call(jen.Id("r").Dot("Header"))).Block(
+					jen.List(jen.Id("etag"), jen.Id("lastModified"), jen.Id("err")).Op(":=").Add(precondition.Clone()).Call(jen.Id("r").Dot("Context").Call(), jen.Id("request")),
+					jen.If(jen.Id("err").Op("!=").Nil()).Block(
+						jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"could not evaluate preconditions (error: %v)"), jen.Id("err"))),
+						jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusInternalServerError"))),
+					),
+					jen.If(jen.Id("evaluatePreconditions").Call(jen.Id("r"), jen.Id("etag"), jen.Id("lastModified")).Op("!=").Lit(0)).Block(
+						jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusPreconditionFailed"))),
+					),
+				)
+			}
+
+			handlerArgs := []jen.Code{jen.Id("r").Dot("Context").Call(), jen.Id("request")}
+			if operation.IsEventStream() {
+				stmts.Id("events").Op(":=").Op("&").Id(strings.Title(operation.ID + "EventStream")).Values(jen.Id("stream").Op(":").Id("newEventStream").Call(jen.Id("w"), jen.Id("r")))
+				handlerArgs = append(handlerArgs, jen.Id("events"))
+			}
+
+			if operation.IsWebSocket() {
+				stmts.Id("conn").Op(":=").Op("&").Id(strings.Title(operation.ID + "ServerConn")).Values(jen.Id("socket").Op(":").Id("newWebSocket").Call(jen.Id("w"), jen.Id("r"), jen.Id("server").Dot("WebSockets"), jen.Id("server").Dot("Validator").Dot("ValidateMessage")))
+				handlerArgs = append(handlerArgs, jen.Id("conn"))
+			}
+
+			stmts.Id("response").Op(":=").Id("server").Dot(stringutil.UnTitle(operation.ID)+"Handler").Dot("customHandler").Call(handlerArgs...)
+
+			if operation.IsWebSocket() {
+				stmts.If(jen.Id("conn").Dot("socket").Dot("Started").Call()).BlockFunc(func(group *jen.Group) {
+					group.Comment("the response was sent by the upgrade, the connection is closed with the handler")
+					group.If(jen.Id("err").Op(":=").Id("conn").Dot("Close").Call(), jen.Id("err").Op("!=").Nil()).Block(
+						jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"could not close WebSocket (error: %v)"), jen.Id("err"))),
+					)
+					if generatePrometheus {
+						group.Id("server").Dot("prometheusHandler").Dot("HandleRequest").Call(
+							jen.Lit(makeMatcherForRoute(operation.Route)),
+							jen.Lit(strings.ToUpper(operation.Method)),
+							jen.Qual("net/http", "StatusSwitchingProtocols"),
+							jen.Qual("time", "Since").Call(jen.Id("start")),
 						)
-						stmts.Return(jen.Nil())
-					} else {
-						stmts.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusBadRequest")))
 					}
+					group.Return(jen.Nil())
+				})
+			}
 
-				} else {
-					stmts.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusBadRequest")))
-				}
-			})
+			if operation.IsEventStream() {
+				stmts.If(jen.Id("events").Dot("stream").Dot("Started").Call()).BlockFunc(func(group *jen.Group) {
+					group.Comment("the response was sent with the events")
+					if generatePrometheus {
+						group.Id("server").Dot("prometheusHandler").Dot("HandleRequest").Call(
+							jen.Lit(makeMatcherForRoute(operation.Route)),
+							jen.Lit(strings.ToUpper(operation.Method)),
+							jen.Qual("net/http", "StatusOK"),
+							jen.Qual("time", "Since").Call(jen.Id("start")),
+						)
+					}
+					group.Return(jen.Nil())
+				})
+			}
 
-			stmts.Id("response").Op(":=").Id("server").Dot(stringutil.UnTitle(operation.ID)+"Handler").Dot("customHandler").Call(jen.Id("c").Dot("Request").Dot("Context").Call(), jen.Id("request"))
 			stmts.If(jen.Id("response").Op("==").Nil()).Block(
 				jen.Id("server").Dot("ErrorLogger").Call(jen.Lit(logPrefix+"received a nil response object")),
 				jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusInternalServerError"))),
 			)
 
-			stmts.If(jen.Id("err").Op(":=").Id("response").Dot("write").Call(jen.Id("c").Dot("Response")), jen.Id("err").Op("!=").Nil()).Block(
+			if operation.IsConditional() && operation.IsSafe() {
+				gen.generateNotModified(operation, stmts)
+			}
+
+			stmts.If(jen.Id("err").Op(":=").Id("response").Dot("write").Call(jen.Id("w"), jen.Id("r"), jen.Id("negotiatedContentType")), jen.Id("err").Op("!=").Nil()).Block(
 				jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"could not send response (error: %v)"), jen.Id("err"))),
 				jen.Return(jen.Id("err")),
 			)
 
 			if generatePrometheus {
-				stmts.Id("routeDescription").Op(":=").Id("server").Dot(stringutil.UnTitle(operation.ID) + "Handler").Dot("routeDescription")
 				stmts.Id("server").Dot("prometheusHandler").Dot("HandleRequest").Call(
-					jen.Id("routeDescription").Dot("Path"),
-					jen.Id("routeDescription").Dot("Method"),
+					jen.Lit(makeMatcherForRoute(operation.Route)),
+					jen.Lit(strings.ToUpper(operation.Method)),
 					jen.Id("response").Dot("StatusCode").Call(),
 					jen.Qual("time", "Since").Call(jen.Id("start")),
 				)
@@ -487,3 +543,251 @@ func (gen *goServerGenerator) generateHandler(operation *Operation, parametersBu
 		stmts.Return(jen.Nil())
 	}).Line()
 }
+
+// generateNotModified replaces a success response by the 304 response if the conditional headers of the request
+// match the ETag or Last-Modified of the response
+func (gen *goServerGenerator) generateNotModified(operation *Operation, stmts *jen.Group) {
+
+	notModifiedName := strings.Title(fmt.Sprintf("%s%dResponse", operation.ID, http.StatusNotModified))
+
+	var responseNames []string
+	fileResponses := make(map[string]bool)
+	walkResponses(operation, func(statusCode int, response spec.Response) {
+		if statusCode != http.StatusNotModified && operation.HasValidators(statusCode) {
+			responseName := strings.Title(fmt.Sprintf("%s%dResponse", operation.ID, statusCode))
+			responseNames = append(responseNames, responseName)
+			fileResponses[responseName] = response.Schema != nil && response.Schema.Type.Contains("file")
+		}
+	})
+	if len(responseNames) == 0 {
+		return
+	}
+
+	stmts.Switch(jen.Id("conditional").Op(":=").Id("response").Assert(jen.Type())).BlockFunc(func(cases *jen.Group) {
+		for _, responseName := range responseNames {
+			// the file of a replaced response isn't written, it's closed instead
+			closeBody := jen.Null()
+			if fileResponses[responseName] {
+				closeBody = jen.If(jen.Id("conditional").Dot("Body").Op("!=").Nil()).Block(
+					jen.Id("conditional").Dot("Body").Dot("Close").Call(),
+				)
+			}
+			cases.Case(jen.Op("*").Id(responseName)).Block(
+				jen.If(jen.Id("conditional").Dot("ETag").Op("!=").Lit("").Op("||").Op("!").Id("conditional").Dot("LastModified").Dot("IsZero").Call()).Block(
+					jen.Switch(jen.Id("evaluatePreconditions").Call(jen.Id("r"), jen.Id("conditional").Dot("ETag"), jen.Id("conditional").Dot("LastModified"))).Block(
+						jen.Case(jen.Qual("net/http", "StatusNotModified")).Block(
+							closeBody,
+							jen.Id("response").Op("=").Op("&").Id(notModifiedName).Values(
+								jen.Id("ETag").Op(":").Id("conditional").Dot("ETag"),
+								jen.Id("LastModified").Op(":").Id("conditional").Dot("LastModified"),
+							),
+						),
+						jen.Case(jen.Qual("net/http", "StatusPreconditionFailed")).Block(
+							closeBody,
+							jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusPreconditionFailed"))),
+						),
+					),
+				),
+			)
+		}
+	})
+}
+
+// negotiableProduces returns the produced content types offered by the content negotiation. Serialized bodies
+// are only written in the content types of serializedProduces, the other produced content types are only offered
+// by operations whose responses are written as they are, i.e. files and event streams.
+func (gen *goServerGenerator) negotiableProduces(operation *Operation) []string {
+
+	if operation.IsEventStream() || operation.IsWebSocket() || hasFileResponse(operation) {
+		return operation.Produces
+	}
+
+	serialized := serializedProduces(operation, gen.itemsSchema(operation) != nil)
+	if len(serialized) == 0 {
+		return operation.Produces
+	}
+
+	var contentTypes []string
+	for _, contentType := range operation.Produces {
+		for _, serializedContentType := range serialized {
+			if contentType == serializedContentType {
+				contentTypes = append(contentTypes, contentType)
+				break
+			}
+		}
+	}
+	return contentTypes
+}
+
+// hasFileResponse checks if a response of the operation is a file
+func hasFileResponse(operation *Operation) bool {
+
+	found := false
+	walkResponses(operation, func(statusCode int, response spec.Response) {
+		if response.Schema != nil && response.Schema.Type.Contains("file") {
+			found = true
+		}
+	})
+	return found
+}
+
+// hasValidationErrorsResponse checks if the operation declares a 400 response of the type ValidationErrors
+func hasValidationErrorsResponse(operation *Operation) bool {
+
+	response, ok := operation.Responses.StatusCodeResponses[http.StatusBadRequest]
+	return ok && response.Schema != nil && getDefinitionName(*response.Schema) == "ValidationErrors"
+}
+
+// generateMultipartForm generates the extraction of the files and values of a multipart request. The files of
+// an upload stream are read by the handler, only the values which precede the files are extracted.
+func (gen *goServerGenerator) generateMultipartForm(operation *Operation, parametersBucket *ParametersBucket, logPrefix string, stmts *jen.Group) {
+
+	upload, err := operation.Upload()
+	if err != nil {
+		log.WithError(err).Error(fmt.Sprintf("error generating multipart form of '%s'", operation.ID))
+		upload = new(Upload)
+	}
+
+	stmts.Id("uploadLimits").Op(":=").Id("server").Dot("UploadLimits")
+	if upload.MaxFileSize > 0 {
+		stmts.Id("uploadLimits").Dot("MaxFileSize").Op("=").Lit(int(upload.MaxFileSize))
+	}
+	if upload.MaxTotalSize > 0 {
+		stmts.Id("uploadLimits").Dot("MaxTotalSize").Op("=").Lit(int(upload.MaxTotalSize))
+	}
+
+	contentTypes := jen.Nil()
+	if uploadContentTypes := operation.UploadContentTypes(); len(uploadContentTypes) != 0 {
+		contentTypes = jen.Index().String().ValuesFunc(func(values *jen.Group) {
+			for _, contentType := range uploadContentTypes {
+				values.Lit(contentType)
+			}
+		})
+	}
+
+	returnUploadError := func(err string) jen.Code {
+		return jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Id("uploadErrorStatus").Call(jen.Id(err))))
+	}
+
+	var formValues jen.Code
+	if operation.IsUploadStream() {
+
+		stmts.List(jen.Id("uploads"), jen.Id("err")).Op(":=").Id("newUploadReader").Call(jen.Id("r"), jen.Id("uploadLimits"), contentTypes)
+		stmts.If(jen.Id("err").Op("!=").Nil()).Block(
+			jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"could not parse multipart form of incoming request (error: %v)"), jen.Id("err"))),
+			jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusBadRequest"))),
+		)
+		values, assign := "values", ":="
+		if len(parametersBucket.FormData) == 0 {
+			values, assign = "_", "="
+		}
+		stmts.List(jen.Id(values), jen.Id("err")).Op(assign).Id("uploads").Dot("ReadValues").Call()
+		stmts.If(jen.Id("err").Op("!=").Nil()).Block(
+			jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"could not read multipart form of incoming request (error: %v)"), jen.Id("err"))),
+			returnUploadError("err"),
+		)
+		stmts.Id("formData").Op(":=").Op("&").Id("request").Dot("FormData")
+		stmts.Id("formData").Dot("Uploads").Op("=").Id("uploads")
+		formValues = jen.Id("values")
+
+	} else {
+
+		stmts.Id("limitUploads").Call(jen.Id("r"), jen.Id("uploadLimits"))
+
+		if len(parametersBucket.FormDataFiles) == 0 {
+			// form values are parsed while extracting uploads, so parse them explicitly if there are none
+			stmts.If(jen.Id("err").Op(":=").Id("r").Dot("ParseMultipartForm").Call(jen.Lit(1024)), jen.Id("err").Op("!=").Nil()).Block(
+				jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"could not parse multipart form of incoming request (error: %v)"), jen.Id("err"))),
+				returnUploadError("err"),
+			)
+		}
+
+		stmts.Id("formData").Op(":=").Op("&").Id("request").Dot("FormData")
+		for i, param := range parametersBucket.FormDataFiles {
+
+			files := "files" + strconv.Itoa(i)
+			extractErr := "extractErr" + strconv.Itoa(i)
+			field := jen.Id("formData").Dot(strings.Title(identifier.MakeIdentifier(param.Name)))
+
+			stmts.List(jen.Id(files), jen.Id(extractErr)).Op(":=").Id("extractUploads").Call(jen.Lit(param.Name), jen.Id("r"), jen.Id("uploadLimits"), contentTypes)
+			stmts.If(jen.Id(extractErr).Op("!=").Nil()).Block(
+				jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"could not extract upload from incoming request (error: %v)"), jen.Id(extractErr))),
+				returnUploadError(extractErr),
+			)
+
+			if param.Type == "array" {
+				if param.Required {
+					stmts.If(jen.Len(jen.Id(files)).Op("==").Lit(0)).Block(
+						gen.generateBadRequest(operation, gen.parameterRequiredError(param)),
+					)
+				}
+				stmts.Add(field).Op("=").Id(files)
+			} else if param.Required {
+				stmts.If(jen.Len(jen.Id(files)).Op("==").Lit(0)).Block(
+					gen.generateBadRequest(operation, gen.parameterRequiredError(param)),
+				)
+				stmts.Add(field).Op("=").Id(files).Index(jen.Lit(0))
+			} else {
+				stmts.If(jen.Len(jen.Id(files)).Op(">").Lit(0)).Block(
+					jen.Add(field).Op("=").Op("&").Id(files).Index(jen.Lit(0)),
+				)
+			}
+		}
+		formValues = jen.Id("r").Dot("Form")
+	}
+
+	for _, param := range parametersBucket.FormData {
+		group := stmts.If(jen.Len(jen.Add(formValues).Index(jen.Lit(param.Name))).Op(">").Lit(0)).Block(
+			jen.If(jen.Id("err").Op(":=").Id("fromString").Call(jen.Add(formValues).Index(jen.Lit(param.Name)).Index(jen.Lit(0)), jen.Op("&").Id("formData").Dot(strings.Title(identifier.MakeIdentifier(param.Name)))), jen.Id("err").Op("!=").Nil()).Block(
+				jen.Id("server").Dot("ErrorLogger").Call(jen.Qual("fmt", "Sprintf").Call(jen.Lit(logPrefix+"could not convert string to specific type (error: %v)"), jen.Id("err"))),
+				gen.generateBadRequest(operation, gen.parameterTypeError(param)),
+			),
+		)
+
+		if param.Required {
+			group.Else().BlockFunc(func(stmts *jen.Group) {
+				stmts.Add(gen.generateBadRequest(operation, gen.parameterRequiredError(param)))
+			})
+		}
+	}
+}
+
+// generateBadRequest responds with the validation errors if the operation declares them for
+// its 400 response, otherwise only problem details include them and the default response has no body.
+// The declared errors are written as XML if an XML content type was negotiated.
+func (gen *goServerGenerator) generateBadRequest(operation *Operation, validationErrors jen.Code) jen.Code {
+
+	if hasValidationErrorsResponse(operation) {
+		jsonError := jen.Return(jen.Id("newJsonHTTPError").Call(jen.Qual("net/http", "StatusBadRequest"), validationErrors))
+		xmlProduces := operation.XMLProduces()
+		if len(xmlProduces) == 0 {
+			return jsonError
+		}
+
+		body := identifier.MakeIdentifier(strings.Title(getDefinitionName(*operation.Responses.StatusCodeResponses[http.StatusBadRequest].Schema)))
+		xmlContentTypes := jen.Index().String().ValuesFunc(func(group *jen.Group) {
+			for _, contentType := range xmlProduces {
+				group.Lit(contentType)
+			}
+		})
+		return jen.If(jen.Id("contentTypeInList").Call(xmlContentTypes, jen.Id("negotiatedContentType"))).Block(
+			jen.Return(jen.Id("newXmlValidationHTTPError").Call(jen.Id("negotiatedContentType"), validationErrors, jen.New(jen.Id(body)))),
+		).Line().Add(jsonError)
+	}
+	return jen.Return(jen.Id("newValidationHTTPError").Call(validationErrors))
+}
+
+func (gen *goServerGenerator) parameterError(param *spec.Parameter, code, message jen.Code) jen.Code {
+
+	return jen.Id("NewParameterError").Call(jen.Lit(param.Name), jen.Lit(param.In), code, message)
+}
+
+func (gen *goServerGenerator) parameterTypeError(param *spec.Parameter) jen.Code {
+
+	return gen.parameterError(param, jen.Id("CodeInvalidType"), jen.Id("err").Dot("Error").Call())
+}
+
+func (gen *goServerGenerator) parameterRequiredError(param *spec.Parameter) jen.Code {
+
+	return gen.parameterError(param, jen.Id("CodeRequired"), jen.Lit(fmt.Sprintf("%s parameter '%s' is required", param.In, param.Name)))
+}
@@ -63,7 +63,7 @@ func (gen *goServicesGenerator) Generate(path, pckg, tag, serverPkg string) erro
 
 func (gen *goServicesGenerator) generateHandler(service string, operation *Operation, serverPkg string, file *jen.File) {
 
-	file.Func().Params(jen.Id("service").Op("*").Id(strings.Title(service)+"Service")).Id(strings.Title(operation.ID)).Params(jen.Id("ctx").Qual("context", "Context"), jen.Id("request").Op("*").Qual(serverPkg, strings.Title(operation.ID)+"Request")).Qual(serverPkg, strings.Title(operation.ID)+"Response").BlockFunc(func(stmts *jen.Group) {
+	file.Func().Params(jen.Id("service").Op("*").Id(strings.Title(service)+"Service")).Id(strings.Title(operation.ID)).Params(stubHandlerParams(operation, serverPkg)...).Qual(serverPkg, strings.Title(operation.ID)+"Response").BlockFunc(func(stmts *jen.Group) {
 		response, status, _ := operation.SuccessResponse()
 		if response == nil {
 			stmts.Return(jen.Nil())
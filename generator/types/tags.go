@@ -94,6 +94,40 @@ func generateIntegerRestriction(min, max *float64, exclusiveMinimum, exclusiveMa
 	return tags
 }
 
+func generateNumberRestriction(min, max *float64, exclusiveMinimum, exclusiveMaximun bool) []string {
+
+	tags := make([]string, 0)
+	if min != nil {
+		if exclusiveMinimum {
+			tags = append(tags, "gt="+strconv.FormatFloat(*min, 'f', -1, 64))
+		} else {
+			tags = append(tags, "min="+strconv.FormatFloat(*min, 'f', -1, 64))
+		}
+	}
+	if max != nil {
+		if exclusiveMaximun {
+			tags = append(tags, "lt="+strconv.FormatFloat(*max, 'f', -1, 64))
+		} else {
+			tags = append(tags, "max="+strconv.FormatFloat(*max, 'f', -1, 64))
+		}
+	}
+	return tags
+}
+
+// the values of the oneof validator are separated by spaces and can't be quoted
+func generateEnumRestriction(values []interface{}) ([]string, error) {
+
+	oneOf := make([]string, 0, len(values))
+	for _, value := range values {
+		v := fmt.Sprint(value)
+		if v == "" || strings.ContainsAny(v, " ,|") {
+			return nil, errors.Errorf("enum value '%s' can't be validated", v)
+		}
+		oneOf = append(oneOf, v)
+	}
+	return []string{"oneof=" + strings.Join(oneOf, " ")}, nil
+}
+
 var tags int32
 
 func generateRegexRestriction(field, pattern string) (*RegexValidator, error) {
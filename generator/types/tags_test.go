@@ -186,3 +186,84 @@ func TestIntegerRestrictionTag(t *testing.T) {
 		t.Logf("tag: %s", got)
 	}
 }
+
+func TestNumberRestrictionTag(t *testing.T) {
+
+	tests := []RestrictionCase{
+		{
+			Name: "Minimum and maximum",
+			makeSchema: func() *spec.Schema {
+				schema := &spec.Schema{}
+				min := float64(0.5)
+				schema.Minimum = &min
+				max := float64(1.5)
+				schema.Maximum = &max
+				return schema
+			},
+			want: `min=0.5,max=1.5`,
+		},
+		{
+			Name: "Minimum and maximum (exclusive)",
+			makeSchema: func() *spec.Schema {
+				schema := &spec.Schema{}
+				min := float64(0)
+				schema.Minimum = &min
+				schema.ExclusiveMinimum = true
+				max := float64(1.5)
+				schema.Maximum = &max
+				schema.ExclusiveMaximum = true
+				return schema
+			},
+			want: `gt=0,lt=1.5`,
+		},
+	}
+
+	for _, test := range tests {
+		schema := test.makeSchema()
+		got := generateNumberRestriction(schema.Minimum, schema.Maximum, schema.ExclusiveMinimum, schema.ExclusiveMaximum)
+		tag := generateValidationTag(true, got)
+		if tag != test.want {
+			t.Errorf("restriction is bad (want: %s got: %s)", test.want, tag)
+		}
+		t.Logf("tag: %s", got)
+	}
+}
+
+func TestEnumRestrictionTag(t *testing.T) {
+
+	tests := []struct {
+		Name    string
+		values  []interface{}
+		want    string
+		wantErr bool
+	}{
+		{
+			Name:   "strings",
+			values: []interface{}{"PKW", "VAN"},
+			want:   `oneof=PKW VAN`,
+		},
+		{
+			Name:   "numbers",
+			values: []interface{}{float64(1), float64(2.5)},
+			want:   `oneof=1 2.5`,
+		},
+		{
+			Name:    "space",
+			values:  []interface{}{"PKW", "SPRINTER VAN"},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := generateEnumRestriction(test.values)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tag := generateValidationTag(true, got)
+			if tag != test.want {
+				t.Errorf("restriction is bad (want: %s got: %s)", test.want, tag)
+			}
+		})
+	}
+}
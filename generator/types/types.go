@@ -27,6 +27,7 @@ const (
 	IntType     = "int"
 	Int32Type   = "int32"
 	ByteType    = "byte"
+	TimeType    = "time.Time"
 )
 
 func ConvertSimpleType(typ string, format string) string {
@@ -92,6 +93,8 @@ type Element struct {
 	Name       string
 	Type       *Type
 	Serialized string
+	// Parameter holds the name and location of a request parameter (e.g. "X-Auth,header")
+	Parameter string
 }
 
 type Type struct {
@@ -103,6 +106,8 @@ type Type struct {
 	Required   bool
 	Elements   []Element
 	Validator  *RegexValidator
+	// XMLName is the name of the root element of an object encoded as XML, the name of the type is used if it's empty
+	XMLName string
 }
 
 func New(composit Composit, name, typ string, required, nestable bool, tags ...string) *Type {
@@ -194,6 +199,33 @@ func NewEnum(name string, required bool, values []interface{}) (*Type, error) {
 	return typ, nil
 }
 
+// NewEnumParameter creates an enum of a request parameter (path, query, header or form data).
+// In contrast to enums of a body, which are restricted while decoding, parameters are
+// validated against the values of the enum.
+func NewEnumParameter(name string, required, array bool, values []interface{}) (*Type, error) {
+
+	enumType, err := NewEnum(name, required, values)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := generateEnumRestriction(values)
+	if err != nil {
+		log.WithError(err).Warn(fmt.Sprintf("values of enum '%s' are not validated", name))
+	}
+
+	if array {
+		arrayType := NewArray(name, required, enumType)
+		if len(tags) > 0 {
+			arrayType.Validation = generateValidationTag(required, append([]string{"dive"}, tags...))
+		}
+		return arrayType, nil
+	}
+
+	enumType.Validation = generateValidationTag(required, tags)
+	return enumType, nil
+}
+
 func NewFile(name string, required bool) *Type {
 
 	return New(File, name, "io.ReadCloser", required, false)
@@ -259,6 +291,8 @@ func FromSimpleSchema(name string, schema *spec.SimpleSchema, required bool, val
 			}
 		} else if typ == "integer" {
 			tags = generateIntegerRestriction(validations.Minimum, validations.Maximum, validations.ExclusiveMinimum, validations.ExclusiveMaximum)
+		} else if typ == "number" {
+			tags = generateNumberRestriction(validations.Minimum, validations.Maximum, validations.ExclusiveMinimum, validations.ExclusiveMaximum)
 		}
 	}
 
@@ -429,6 +463,12 @@ func (typ *Type) AddElement(name string, t *Type, serialized string) {
 	typ.Elements = append(typ.Elements, Element{Name: name, Type: t, Serialized: serialized})
 }
 
+// AddParameter adds an element for a request parameter, which is tagged with the name and location of the parameter
+func (typ *Type) AddParameter(name string, t *Type, parameterName, in string) {
+
+	typ.Elements = append(typ.Elements, Element{Name: name, Type: t, Parameter: parameterName + "," + in})
+}
+
 func (typ *Type) GetValidators() []*RegexValidator {
 
 	validators := []*RegexValidator{}
@@ -446,6 +486,32 @@ func (typ *Type) GetValidators() []*RegexValidator {
 	return validators
 }
 
+// Code returns the type reference of an element of this type
+func (typ *Type) Code() *jen.Statement {
+
+	code := jen.Empty()
+
+	if typ.Composit == Simple || typ.Composit == Enum {
+		if !typ.Required {
+			code.Op("*")
+		}
+	} else if typ.Composit == Array {
+		code.Index()
+	} else if typ.Composit == Map {
+		code.Map(jen.String())
+	}
+
+	if typ.Name != "" {
+		code.Id(typ.Name)
+	} else if typ.Type == TimeType {
+		code.Qual("time", "Time")
+	} else if typ.Type != "" {
+		code.Id(typ.Type)
+	}
+
+	return code
+}
+
 func (typ *Type) WriteTo(file *file.File) error {
 
 	if typ.Name == "" {
@@ -465,6 +531,10 @@ func (typ *Type) WriteTo(file *file.File) error {
 
 		var properties []jen.Code
 
+		if typ.XMLName != "" {
+			properties = append(properties, jen.Id("XMLName").Qual("encoding/xml", "Name").Tag(map[string]string{"xml": typ.XMLName, "json": "-", "bson": "-"}))
+		}
+
 		for _, element := range typ.Elements {
 
 			if element.Type == nil {
@@ -476,30 +546,18 @@ func (typ *Type) WriteTo(file *file.File) error {
 				return errors.Wrapf(err, "error writing type of element %s", element.Name)
 			}
 
-			code := jen.Id(element.Name)
-
-			if element.Type.Composit == Simple || element.Type.Composit == Enum {
-				if !element.Type.Required {
-					code.Op("*")
-				}
-			} else if element.Type.Composit == Array {
-				code.Index()
-			} else if element.Type.Composit == Map {
-				code.Map(jen.String())
-			}
-
-			if element.Type.Name != "" {
-				code.Id(element.Type.Name)
-			} else if element.Type.Type != "" {
-				code.Id(element.Type.Type)
-			}
-			if element.Type.Validation != "" || element.Serialized != "" {
+			code := jen.Id(element.Name).Add(element.Type.Code())
+			if element.Type.Validation != "" || element.Serialized != "" || element.Parameter != "" {
 
 				tags := map[string]string{}
 				if element.Type.Validation != "" {
 					tags["validate"] = element.Type.Validation
 				}
 
+				if element.Parameter != "" {
+					tags["param"] = element.Parameter
+				}
+
 				if element.Serialized != "" {
 					serialized := element.Serialized
 					if element.Type.Required {
@@ -509,6 +567,10 @@ func (typ *Type) WriteTo(file *file.File) error {
 					}
 
 					tags["xml"] = serialized
+					if element.Type.Composit == Map {
+						// maps are not supported by encoding/xml
+						tags["xml"] = "-"
+					}
 					tags["json"] = serialized
 					tags["bson"] = serialized
 				}
@@ -2,6 +2,7 @@ package generator
 
 import (
 	"fmt"
+	"net/http"
 	"sort"
 	"strings"
 
@@ -89,12 +90,18 @@ func (gen *goTypesGenerator) Generate(path, pckg string) (ValidatorMap, error) {
 			return err
 		}
 
-		if err := gen.generateRequest(strings.Title(operation.ID+"Request"), bucket, file); err != nil {
+		if err := gen.generateRequest(operation, bucket, file); err != nil {
 			return errors.Wrapf(err, "error generating request for '%s'", operation.ID)
 		}
 
 		gen.generateResponses(operation, file)
 
+		if operation.IsWebSocket() {
+			if err := gen.generateWebSocket(operation, file); err != nil {
+				return errors.Wrapf(err, "error generating WebSocket for '%s'", operation.ID)
+			}
+		}
+
 		return nil
 	}); err != nil {
 		return nil, err
@@ -192,6 +199,10 @@ func (gen *goTypesGenerator) generateDefinition(definitionName string, definitio
 		return errors.Wrapf(err, "error generating definition '%s'", definitionName)
 	}
 
+	if definition.XML != nil && typ.Composit == types.Object {
+		typ.XMLName = definition.XML.Name
+	}
+
 	gen.validators.AddAll(typ.GetValidators())
 
 	if definition.Description != "" {
@@ -224,7 +235,9 @@ func (gen *goTypesGenerator) FindDefinitionByName(name string) *spec.Schema {
 	return definition
 }
 
-func (gen *goTypesGenerator) generateRequest(requestName string, bucket *ParametersBucket, file *file.File) error {
+func (gen *goTypesGenerator) generateRequest(operation *Operation, bucket *ParametersBucket, file *file.File) error {
+
+	requestName := strings.Title(operation.ID + "Request")
 
 	if bucket.HasBody && bucket.HasFormData {
 		return errors.New("definition of both form data and body type is not allowed in a request")
@@ -236,15 +249,23 @@ func (gen *goTypesGenerator) generateRequest(requestName string, bucket *Paramet
 		formData := types.NewObject(requestName+"FormData", true)
 
 		for _, file := range bucket.FormDataFiles {
-			formData.AddElement(identifier.MakeIdentifier(strings.Title(file.Name)), types.New(types.Simple, "", "MimeFile", file.Required, false), "")
+			fileType := types.New(types.Simple, "", "MimeFile", file.Required, false)
+			if file.Type == "array" {
+				fileType = types.NewArray("", file.Required, types.New(types.Simple, "", "MimeFile", true, false))
+			}
+			formData.AddParameter(identifier.MakeIdentifier(strings.Title(file.Name)), fileType, file.Name, file.In)
+		}
+
+		if operation.IsUploadStream() {
+			// the files are read by the handler of the server, the fields of the files are only sent by the client
+			formData.AddElement("Uploads", types.New(types.Simple, "", "*UploadReader", true, false), "")
 		}
 
 		for _, field := range bucket.FormData {
-			if typ, err := types.FromSimpleSchema("", &field.SimpleSchema, field.Required, nil); err != nil {
+			if typ, err := gen.makeParameter(field); err != nil {
 				return errors.Wrap(err, "error generating form data")
 			} else {
-				gen.validators.AddAll(typ.GetValidators())
-				formData.AddElement(identifier.MakeIdentifier(strings.Title(field.Name)), typ, "")
+				formData.AddParameter(identifier.MakeIdentifier(strings.Title(field.Name)), typ, field.Name, field.In)
 			}
 		}
 
@@ -265,7 +286,7 @@ func (gen *goTypesGenerator) generateRequest(requestName string, bucket *Paramet
 		if err != nil {
 			return err
 		}
-		request.AddElement(identifier.MakeIdentifier(strings.Title(parameter.Name)), typ, "")
+		request.AddParameter(identifier.MakeIdentifier(strings.Title(parameter.Name)), typ, parameter.Name, parameter.In)
 	}
 
 	for _, parameter := range bucket.Security {
@@ -273,7 +294,7 @@ func (gen *goTypesGenerator) generateRequest(requestName string, bucket *Paramet
 
 		if parameter.Type == openapi.ApiKey.String() && parameter.In == openapi.Header.String() {
 			request.AddElement(parameterName, types.New(types.Simple, "", types.StringType, true, false), "")
-		} else if parameter.Type == openapi.Basic.String() {
+		} else if parameter.Type == openapi.Basic.String() || parameter.Type == openapi.OAuth2.String() {
 			if parameterName == "" {
 				parameterName = openapi.BasicAuthHeaderName
 			}
@@ -283,6 +304,29 @@ func (gen *goTypesGenerator) generateRequest(requestName string, bucket *Paramet
 		}
 	}
 
+	if operation.IsEventStream() {
+		// ID of the last received event to resume an event stream
+		request.AddElement("LastEventID", types.New(types.Simple, "", types.StringType, true, false), "")
+	}
+
+	if operation.IsConditional() {
+		// conditional headers, the ETag of a response is compared with If-Match and If-None-Match
+		if operation.IsSafe() {
+			request.AddElement("IfNoneMatch", types.New(types.Simple, "", types.StringType, true, false), "")
+			request.AddElement("IfModifiedSince", types.New(types.Simple, "", types.TimeType, true, false), "")
+		} else {
+			request.AddElement("IfMatch", types.New(types.Simple, "", types.StringType, true, false), "")
+			request.AddElement("IfNoneMatch", types.New(types.Simple, "", types.StringType, true, false), "")
+			request.AddElement("IfUnmodifiedSince", types.New(types.Simple, "", types.TimeType, true, false), "")
+		}
+	}
+
+	if operation.HasRanges() {
+		// range headers, a part of the file is only sent if the If-Range validator matches the file
+		request.AddElement("Range", types.New(types.Simple, "", types.StringType, true, false), "")
+		request.AddElement("IfRange", types.New(types.Simple, "", types.StringType, true, false), "")
+	}
+
 	err := request.WriteTo(file)
 	if err != nil {
 		return errors.Wrapf(err, "error writing request '%s'", requestName)
@@ -303,16 +347,7 @@ func (gen *goTypesGenerator) makeParameter(parameter *spec.Parameter) (*types.Ty
 
 	} else if len(parameter.Enum) > 0 {
 
-		enumType, err := types.NewEnum(strings.Title(parameter.Name), parameter.Required, parameter.Enum)
-		if err != nil {
-			return nil, err
-		}
-
-		if parameter.Type == "array" {
-			return types.NewArray(strings.Title(parameter.Name), parameter.Required, enumType), nil
-		} else {
-			return enumType, nil
-		}
+		return types.NewEnumParameter(strings.Title(identifier.MakeIdentifier(parameter.Name)), parameter.Required, parameter.Type == "array", parameter.Enum)
 
 	} else if parameter.Type == "file" {
 
@@ -340,17 +375,47 @@ func (gen *goTypesGenerator) generateResponses(operation *Operation, file *file.
 	file.Type().Id(interfaceName).Interface(
 		jen.Id("is"+interfaceName).Params(),
 		jen.Id("StatusCode").Params().Int(),
-		jen.Id("write").Params(jen.Id("response").Qual("net/http", "ResponseWriter")).Error(),
+		jen.Id("write").Params(jen.Id("response").Qual("net/http", "ResponseWriter"), jen.Id("request").Op("*").Qual("net/http", "Request"), jen.Id("contentType").String()).Error(),
 	).Line()
 
 	walkResponses(operation, func(statusCode int, response spec.Response) {
 
 		responseName := strings.Title(fmt.Sprintf("%s%dResponse", operation.ID, statusCode))
-		responseType, err := gen.makeResponse(responseName, response.Schema, response.Headers)
+		isEventStream := operation.IsEventStream() && statusCode == http.StatusOK
+
+		body := response.Schema
+		if isEventStream {
+			if err := gen.generateEventStream(operation, response.Schema, file); err != nil {
+				log.WithError(err).Error(fmt.Sprintf("error generating event stream for '%s'", operation.ID))
+				return
+			}
+			body = nil
+		}
+
+		responseType, err := gen.makeResponse(responseName, body, response.Headers)
 		if err != nil {
 			log.WithError(err).Error(fmt.Sprintf("error generating %d response for '%s'", statusCode, operation.ID))
 			return
 		}
+		if isEventStream {
+			responseType.AddElement("Events", types.New(types.Simple, "", "*"+strings.Title(operation.ID+"EventReader"), true, false), "")
+		}
+		itemsSchema := gen.itemsSchema(operation)
+		streamsItems := itemsSchema != nil && statusCode == http.StatusOK
+		if streamsItems {
+			if err := gen.generateItems(operation, itemsSchema, file); err != nil {
+				log.WithError(err).Error(fmt.Sprintf("error generating items for '%s'", operation.ID))
+				return
+			}
+			responseType.AddElement("Items", types.New(types.Simple, "", "*"+strings.Title(operation.ID+"Items"), true, false), "")
+		}
+		if operation.HasValidators(statusCode) {
+			responseType.AddElement("ETag", types.New(types.Simple, "", types.StringType, true, false), "")
+			responseType.AddElement("LastModified", types.New(types.Simple, "", types.TimeType, true, false), "")
+		}
+		if operation.IsWebSocket() && statusCode == http.StatusSwitchingProtocols {
+			responseType.AddElement("Conn", types.New(types.Simple, "", "*"+strings.Title(operation.ID+"ClientConn"), true, false), "")
+		}
 
 		if response.Description != "" {
 			file.Comment(response.Description)
@@ -367,22 +432,11 @@ func (gen *goTypesGenerator) generateResponses(operation *Operation, file *file.
 			jen.Return(jen.Lit(statusCode)),
 		).Line()
 
-		var (
-			writeFunc []jen.Code
-			headers   []string
-		)
-
-		for header := range response.Headers {
-			headers = append(headers, header)
+		if statusCode >= http.StatusBadRequest {
+			generateResponseError(operation, responseName, response.Schema != nil && !response.Schema.Type.Contains("file"), file)
 		}
-		sort.Strings(headers)
 
-		for _, header := range headers {
-			field := identifier.MakeIdentifier(strings.Title(header))
-			// don't use Header().Set() or Header().Add(): https://github.com/golang/go/issues/5022
-			writeHeader := jen.Id("response").Dot("Header").Call().Index(jen.Lit(header)).Op("=").Index().String().Values(jen.Id("toString").Call(jen.Id("r").Dot(field)))
-			writeFunc = append(writeFunc, writeHeader)
-		}
+		writeFunc := generateWriteHeaders(response.Headers)
 
 		// Behavior of content type
 		// Type
@@ -390,9 +444,30 @@ func (gen *goTypesGenerator) generateResponses(operation *Operation, file *file.
 		// Entity body
 		// 	https://tools.ietf.org/search/rfc2616#section-7.2.1
 
+		if operation.HasValidators(statusCode) {
+			writeFunc = append(writeFunc, jen.Id("setValidators").Call(jen.Id("response").Dot("Header").Call(), jen.Id("r").Dot("ETag"), jen.Id("r").Dot("LastModified")))
+		}
+
 		returnInternalServerError := jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusInternalServerError")))
 
-		if response.Schema != nil && response.Schema.Type.Contains("file") && operation.HasProduces(ContentTypesForFiles...) {
+		if streamsItems {
+			writeFunc = append(writeFunc, generateServeItems(operation, statusCode))
+		}
+
+		if isEventStream {
+
+			// events are sent by the handler, a response without events is an empty stream
+			setContentType := jen.Id("response").Dot("Header").Call().Index(jen.Id("contentTypeHeader")).Op("=").Index().String().Values(jen.Id("contentTypeTextEventStream"))
+			writeFunc = append(writeFunc, setContentType)
+
+			writeStatusCode := jen.Id("response").Dot("WriteHeader").Call(jen.Lit(statusCode))
+			writeFunc = append(writeFunc, writeStatusCode)
+
+		} else if response.Schema != nil && response.Schema.Type.Contains("file") && operation.HasProduces(ContentTypesForFiles...) {
+
+			if operation.HasRanges() && statusCode == http.StatusOK {
+				writeFunc = append(writeFunc, generateServeRanges(operation, returnInternalServerError))
+			}
 
 			writeFile := jen.If(jen.List(jen.Id("_"), jen.Id("err")).Op(":=").Qual("io", "Copy").Call(jen.Id("response"), jen.Id("r").Dot("Body")), jen.Id("err").Op("!=").Nil()).Block(
 				returnInternalServerError,
@@ -404,19 +479,9 @@ func (gen *goTypesGenerator) generateResponses(operation *Operation, file *file.
 			)
 			writeFunc = append(writeFunc, closeBody)
 
-		} else if response.Schema != nil && operation.HasProduce(ContentTypeApplicationJson) {
+		} else if bodyContentTypes := serializedProduces(operation, streamsItems); response.Schema != nil && !response.Schema.Type.Contains("file") && len(bodyContentTypes) != 0 {
 
-			writeJson := jen.If(jen.Id("err").Op(":=").Id("serveJson").Call(jen.Id("response"), jen.Lit(statusCode), jen.Id("r").Dot("Body")), jen.Id("err").Op("!=").Nil()).Block(
-				returnInternalServerError,
-			)
-			writeFunc = append(writeFunc, writeJson)
-
-		} else if response.Schema != nil && operation.HasProduce(ContentTypeApplicationHalJson) {
-
-			writeJson := jen.If(jen.Id("err").Op(":=").Id("serveHalJson").Call(jen.Id("response"), jen.Lit(statusCode), jen.Id("r").Dot("Body")), jen.Id("err").Op("!=").Nil()).Block(
-				returnInternalServerError,
-			)
-			writeFunc = append(writeFunc, writeJson)
+			writeFunc = append(writeFunc, generateServeBody(bodyContentTypes, jen.Lit(statusCode), returnInternalServerError))
 
 		} else {
 			// don't set a content type for an nil schema
@@ -429,10 +494,490 @@ func (gen *goTypesGenerator) generateResponses(operation *Operation, file *file.
 
 		writeFunc = append(writeFunc, jen.Return(jen.Nil()))
 
-		file.Func().Params(jen.Id("r").Op("*").Id(responseName)).Id("write").Params(jen.Id("response").Qual("net/http", "ResponseWriter")).Error().Block(
+		file.Func().Params(jen.Id("r").Op("*").Id(responseName)).Id("write").Params(jen.Id("response").Qual("net/http", "ResponseWriter"), jen.Id("request").Op("*").Qual("net/http", "Request"), jen.Id("contentType").String()).Error().Block(
 			writeFunc...,
 		).Line()
 	})
+
+	if _, ok := operation.Responses.StatusCodeResponses[http.StatusNotModified]; operation.IsConditional() && operation.IsSafe() && !ok {
+		generateNotModifiedResponse(operation, interfaceName, file)
+	}
+
+	if operation.HasRanges() {
+		generatePartialContentResponse(operation, interfaceName, file)
+	}
+
+	if operation.Responses.Default != nil {
+		gen.generateDefaultResponse(operation, interfaceName, *operation.Responses.Default, file)
+	}
+}
+
+// generateWriteHeaders generates the writing of the declared headers of a response
+func generateWriteHeaders(responseHeaders map[string]spec.Header) []jen.Code {
+
+	var (
+		writeFunc []jen.Code
+		headers   []string
+	)
+
+	for header := range responseHeaders {
+		headers = append(headers, header)
+	}
+	sort.Strings(headers)
+
+	for _, header := range headers {
+		field := identifier.MakeIdentifier(strings.Title(header))
+		// don't use Header().Set() or Header().Add(): https://github.com/golang/go/issues/5022
+		writeHeader := jen.Id("response").Dot("Header").Call().Index(jen.Lit(header)).Op("=").Index().String().Values(jen.Id("toString").Call(jen.Id("r").Dot(field)))
+		writeFunc = append(writeFunc, writeHeader)
+	}
+	return writeFunc
+}
+
+// generateDefaultResponse generates the default response of an operation, which is the response of all status
+// codes without response of their own. Its status code is set by the handler, file bodies aren't supported.
+func (gen *goTypesGenerator) generateDefaultResponse(operation *Operation, interfaceName string, response spec.Response, file *file.File) {
+
+	responseName := strings.Title(operation.ID + "DefaultResponse")
+
+	body := response.Schema
+	if body != nil && body.Type.Contains("file") {
+		log.Warn(fmt.Sprintf("file body of default response of '%s' isn't supported", operation.ID))
+		body = nil
+	}
+
+	responseType, err := gen.makeResponse(responseName, body, response.Headers)
+	if err != nil {
+		log.WithError(err).Error(fmt.Sprintf("error generating default response for '%s'", operation.ID))
+		return
+	}
+	responseType.AddElement("Status", types.New(types.Simple, "", types.IntType, true, false), "")
+
+	if response.Description != "" {
+		file.Comment(response.Description)
+	}
+	if err := responseType.WriteTo(file); err != nil {
+		log.WithError(err).Error(fmt.Sprintf("error writing default response for '%s'", operation.ID))
+		return
+	}
+
+	file.Func().Params(jen.Id("r").Op("*").Id(responseName)).Id("is" + interfaceName).Params().Block().Line()
+
+	file.Comment("StatusCode returns the status code of the response, it defaults to 500 if the status isn't set")
+	file.Func().Params(jen.Id("r").Op("*").Id(responseName)).Id("StatusCode").Params().Int().Block(
+		jen.If(jen.Id("r").Dot("Status").Op("==").Lit(0)).Block(
+			jen.Return(jen.Qual("net/http", "StatusInternalServerError")),
+		),
+		jen.Return(jen.Id("r").Dot("Status")),
+	).Line()
+
+	generateResponseError(operation, responseName, body != nil, file)
+
+	returnInternalServerError := jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusInternalServerError")))
+
+	writeFunc := generateWriteHeaders(response.Headers)
+	if bodyContentTypes := serializedProduces(operation, false); body != nil && len(bodyContentTypes) != 0 {
+		writeFunc = append(writeFunc, generateServeBody(bodyContentTypes, jen.Id("r").Dot("StatusCode").Call(), returnInternalServerError))
+	} else {
+		// don't set a content type for an nil schema
+		writeFunc = append(writeFunc, jen.Id("response").Dot("Header").Call().Index(jen.Id("contentTypeHeader")).Op("=").Index().String().Values())
+		writeFunc = append(writeFunc, jen.Id("response").Dot("WriteHeader").Call(jen.Id("r").Dot("StatusCode").Call()))
+	}
+	writeFunc = append(writeFunc, jen.Return(jen.Nil()))
+
+	file.Func().Params(jen.Id("r").Op("*").Id(responseName)).Id("write").Params(jen.Id("response").Qual("net/http", "ResponseWriter"), jen.Id("request").Op("*").Qual("net/http", "Request"), jen.Id("contentType").String()).Error().Block(
+		writeFunc...,
+	).Line()
+}
+
+// generateResponseError makes a response of the status codes 4xx and 5xx implement the ResponseError, the message
+// contains the decoded body
+func generateResponseError(operation *Operation, responseName string, hasBody bool, file *file.File) {
+
+	body := jen.Nil()
+	if hasBody {
+		body = jen.Id("r").Dot("Body")
+	}
+
+	file.Func().Params(jen.Id("r").Op("*").Id(responseName)).Id("OperationID").Params().String().Block(
+		jen.Return(jen.Lit(operation.ID)),
+	).Line()
+
+	file.Func().Params(jen.Id("r").Op("*").Id(responseName)).Id("Error").Params().String().Block(
+		jen.Return(jen.Id("responseErrorMessage").Call(jen.Id("r").Dot("OperationID").Call(), jen.Id("r").Dot("StatusCode").Call(), body)),
+	).Line()
+}
+
+// generateServeRanges generates the serving of a seekable file, which is able to answer range requests
+func generateServeRanges(operation *Operation, returnInternalServerError jen.Code) jen.Code {
+
+	lastModified := jen.Qual("time", "Time").Values()
+	if operation.HasValidators(http.StatusOK) {
+		lastModified = jen.Id("r").Dot("LastModified")
+	}
+
+	return jen.If(jen.List(jen.Id("content"), jen.Id("ok")).Op(":=").Id("r").Dot("Body").Assert(jen.Qual("io", "ReadSeeker")), jen.Id("ok")).Block(
+		jen.Id("serveRanges").Call(jen.Id("response"), jen.Id("request"), jen.Id("content"), lastModified),
+		jen.If(jen.Id("err").Op(":=").Id("r").Dot("Body").Dot("Close").Call(), jen.Id("err").Op("!=").Nil()).Block(
+			returnInternalServerError,
+		),
+		jen.Return(jen.Nil()),
+	)
+}
+
+// generatePartialContentResponse generates the 206 response of a download operation, which is received by the
+// client if a range of the file was requested
+func generatePartialContentResponse(operation *Operation, interfaceName string, file *file.File) {
+
+	responseName := strings.Title(fmt.Sprintf("%s%dResponse", operation.ID, http.StatusPartialContent))
+	hasValidators := operation.HasValidators(http.StatusPartialContent)
+
+	file.Commentf("%s is the response of a range request, a multipart/byteranges body contains several ranges", responseName)
+	file.AddType(responseName).StructFunc(func(fields *jen.Group) {
+		fields.Id("Body").Qual("io", "ReadCloser")
+		fields.Id("ContentType").String()
+		fields.Id("ContentRange").String()
+		if hasValidators {
+			fields.Id("ETag").String()
+			fields.Id("LastModified").Qual("time", "Time")
+		}
+	}).Line()
+
+	file.Func().Params(jen.Id("r").Op("*").Id(responseName)).Id("is" + interfaceName).Params().Block().Line()
+
+	file.Func().Params(jen.Id("r").Op("*").Id(responseName)).Id("StatusCode").Params().Int().Block(
+		jen.Return(jen.Lit(http.StatusPartialContent)),
+	).Line()
+
+	returnInternalServerError := jen.Return(jen.Id("NewHTTPStatusCodeError").Call(jen.Qual("net/http", "StatusInternalServerError")))
+
+	file.Func().Params(jen.Id("r").Op("*").Id(responseName)).Id("write").Params(jen.Id("response").Qual("net/http", "ResponseWriter"), jen.Id("request").Op("*").Qual("net/http", "Request"), jen.Id("contentType").String()).Error().BlockFunc(func(stmts *jen.Group) {
+		// don't use Header().Set() or Header().Add(): https://github.com/golang/go/issues/5022
+		stmts.Id("response").Dot("Header").Call().Index(jen.Id("contentTypeHeader")).Op("=").Index().String().Values(jen.Id("r").Dot("ContentType"))
+		stmts.Id("response").Dot("Header").Call().Index(jen.Id("contentRangeHeader")).Op("=").Index().String().Values(jen.Id("r").Dot("ContentRange"))
+		stmts.Id("response").Dot("Header").Call().Index(jen.Id("acceptRangesHeader")).Op("=").Index().String().Values(jen.Lit("bytes"))
+		if hasValidators {
+			stmts.Id("setValidators").Call(jen.Id("response").Dot("Header").Call(), jen.Id("r").Dot("ETag"), jen.Id("r").Dot("LastModified"))
+		}
+		stmts.Id("response").Dot("WriteHeader").Call(jen.Lit(http.StatusPartialContent))
+		stmts.If(jen.List(jen.Id("_"), jen.Id("err")).Op(":=").Qual("io", "Copy").Call(jen.Id("response"), jen.Id("r").Dot("Body")), jen.Id("err").Op("!=").Nil()).Block(
+			returnInternalServerError,
+		)
+		stmts.If(jen.Id("err").Op(":=").Id("r").Dot("Body").Dot("Close").Call(), jen.Id("err").Op("!=").Nil()).Block(
+			returnInternalServerError,
+		)
+		stmts.Return(jen.Nil())
+	}).Line()
+}
+
+// generateNotModifiedResponse generates the 304 response of a safe conditional operation which doesn't declare it,
+// the server responds with it if the resource wasn't modified
+func generateNotModifiedResponse(operation *Operation, interfaceName string, file *file.File) {
+
+	responseName := strings.Title(fmt.Sprintf("%s%dResponse", operation.ID, http.StatusNotModified))
+
+	file.Commentf("%s is the response of a conditional request if the resource was not modified", responseName)
+	file.AddType(responseName).Struct(
+		jen.Id("ETag").String(),
+		jen.Id("LastModified").Qual("time", "Time"),
+	).Line()
+
+	file.Func().Params(jen.Id("r").Op("*").Id(responseName)).Id("is" + interfaceName).Params().Block().Line()
+
+	file.Func().Params(jen.Id("r").Op("*").Id(responseName)).Id("StatusCode").Params().Int().Block(
+		jen.Return(jen.Lit(http.StatusNotModified)),
+	).Line()
+
+	file.Func().Params(jen.Id("r").Op("*").Id(responseName)).Id("write").Params(jen.Id("response").Qual("net/http", "ResponseWriter"), jen.Id("request").Op("*").Qual("net/http", "Request"), jen.Id("contentType").String()).Error().Block(
+		jen.Id("setValidators").Call(jen.Id("response").Dot("Header").Call(), jen.Id("r").Dot("ETag"), jen.Id("r").Dot("LastModified")),
+		jen.Id("response").Dot("Header").Call().Index(jen.Id("contentTypeHeader")).Op("=").Index().String().Values(),
+		jen.Id("response").Dot("WriteHeader").Call(jen.Lit(http.StatusNotModified)),
+		jen.Return(jen.Nil()),
+	).Line()
+}
+
+// generateEventStream generates the event of an event stream operation with the data of the success response and
+// the typed wrappers to send and read the events
+func (gen *goTypesGenerator) generateEventStream(operation *Operation, schema *spec.Schema, file *file.File) error {
+
+	eventName := strings.Title(operation.ID + "Event")
+	streamName := strings.Title(operation.ID + "EventStream")
+	readerName := strings.Title(operation.ID + "EventReader")
+
+	dataType, err := types.FromSchema("", schema, true, gen.FindDefinitionByName)
+	if err != nil {
+		return errors.Wrapf(err, "error generating event '%s'", eventName)
+	}
+	gen.validators.AddAll(dataType.GetValidators())
+
+	if err := dataType.WriteTo(file); err != nil {
+		return errors.Wrapf(err, "error writing event '%s'", eventName)
+	}
+
+	file.Commentf("%s is a server-sent event of the %s operation", eventName, operation.ID)
+	file.AddType(eventName).Struct(
+		jen.Id("Name").String(),
+		jen.Id("ID").String(),
+		jen.Id("Data").Add(dataType.Code()),
+		jen.Id("Retry").Qual("time", "Duration"),
+	).Line()
+
+	file.Commentf("%s sends the events of the %s operation, sending stops if the request is canceled", streamName, operation.ID)
+	file.AddType(streamName).Struct(
+		jen.Id("stream").Op("*").Id("eventStream"),
+	).Line()
+
+	file.Func().Params(jen.Id("s").Op("*").Id(streamName)).Id("Send").Params(jen.Id("event").Op("*").Id(eventName)).Error().Block(
+		jen.Return(jen.Id("s").Dot("stream").Dot("Send").Call(
+			jen.Id("event").Dot("Name"),
+			jen.Id("event").Dot("ID"),
+			jen.Id("event").Dot("Data"),
+			jen.Id("event").Dot("Retry"),
+		)),
+	).Line()
+
+	file.Commentf("%s reads the events of the %s operation, io.EOF is returned at the end of the stream", readerName, operation.ID)
+	file.AddType(readerName).Struct(
+		jen.Id("reader").Op("*").Id("eventReader"),
+	).Line()
+
+	file.Func().Params(jen.Id("r").Op("*").Id(readerName)).Id("Next").Params().Params(jen.Op("*").Id(eventName), jen.Error()).Block(
+		jen.List(jen.Id("event"), jen.Id("err")).Op(":=").Id("r").Dot("reader").Dot("Next").Call(),
+		jen.If(jen.Id("err").Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Id("err")),
+		),
+		jen.Line(),
+		jen.Id("decoded").Op(":=").Op("&").Id(eventName).Values(jen.Dict{
+			jen.Id("Name"):  jen.Id("event").Dot("Name"),
+			jen.Id("ID"):    jen.Id("event").Dot("ID"),
+			jen.Id("Retry"): jen.Id("event").Dot("Retry"),
+		}),
+		jen.If(jen.Id("err").Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Index().Byte().Parens(jen.Id("event").Dot("Data")), jen.Op("&").Id("decoded").Dot("Data")), jen.Id("err").Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Id("err")),
+		),
+		jen.Line(),
+		jen.Return(jen.Id("decoded"), jen.Nil()),
+	).Line()
+
+	file.Comment("LastEventID returns the ID of the last received event to resume the stream with the LastEventID of the request")
+	file.Func().Params(jen.Id("r").Op("*").Id(readerName)).Id("LastEventID").Params().String().Block(
+		jen.Return(jen.Id("r").Dot("reader").Dot("LastEventID").Call()),
+	).Line()
+
+	file.Func().Params(jen.Id("r").Op("*").Id(readerName)).Id("Close").Params().Error().Block(
+		jen.Return(jen.Id("r").Dot("reader").Dot("Close").Call()),
+	).Line()
+
+	return nil
+}
+
+// generateWebSocket generates the typed connections of a WebSocket operation, which exchange the inbound
+// and outbound messages on the server and the client
+func (gen *goTypesGenerator) generateWebSocket(operation *Operation, file *file.File) error {
+
+	inbound, outbound, err := operation.WebSocketMessages()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := operation.Responses.StatusCodeResponses[http.StatusSwitchingProtocols]; !ok {
+		log.Warn(fmt.Sprintf("WebSocket operation '%s' has no 101 response, the client can't return the connection", operation.ID))
+	}
+
+	inboundType, err := gen.makeMessage(inbound, file)
+	if err != nil {
+		return errors.Wrap(err, "error generating inbound message")
+	}
+
+	outboundType, err := gen.makeMessage(outbound, file)
+	if err != nil {
+		return errors.Wrap(err, "error generating outbound message")
+	}
+
+	serverConn := strings.Title(operation.ID + "ServerConn")
+	file.Commentf("%s is the WebSocket of the %s operation on the server, it's upgraded with the first message", serverConn, operation.ID)
+	gen.generateConn(serverConn, inboundType, outboundType, file)
+
+	clientConn := strings.Title(operation.ID + "ClientConn")
+	file.Commentf("%s is the WebSocket of the %s operation on the client", clientConn, operation.ID)
+	gen.generateConn(clientConn, outboundType, inboundType, file)
+
+	return nil
+}
+
+// makeMessage returns the type of a WebSocket message, messages without schema are passed as raw JSON
+func (gen *goTypesGenerator) makeMessage(schema *spec.Schema, file *file.File) (func() jen.Code, error) {
+
+	if schema == nil {
+		return func() jen.Code { return jen.Qual("encoding/json", "RawMessage") }, nil
+	}
+
+	typ, err := types.FromSchema("", schema, true, gen.FindDefinitionByName)
+	if err != nil {
+		return nil, err
+	}
+	gen.validators.AddAll(typ.GetValidators())
+
+	if err := typ.WriteTo(file); err != nil {
+		return nil, err
+	}
+
+	return func() jen.Code { return typ.Code() }, nil
+}
+
+func (gen *goTypesGenerator) generateConn(name string, receiveType, sendType func() jen.Code, file *file.File) {
+
+	file.AddType(name).Struct(
+		jen.Id("socket").Op("*").Id("webSocket"),
+	).Line()
+
+	file.Comment("Receive reads and validates the next message, io.EOF is returned if the peer closed the connection")
+	file.Func().Params(jen.Id("c").Op("*").Id(name)).Id("Receive").Params().Params(jen.Op("*").Add(receiveType()), jen.Error()).Block(
+		jen.Id("message").Op(":=").New(receiveType()),
+		jen.If(jen.Id("err").Op(":=").Id("c").Dot("socket").Dot("Read").Call(jen.Id("message")), jen.Id("err").Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Id("err")),
+		),
+		jen.Return(jen.Id("message"), jen.Nil()),
+	).Line()
+
+	file.Comment("Send validates and writes a message")
+	file.Func().Params(jen.Id("c").Op("*").Id(name)).Id("Send").Params(jen.Id("message").Op("*").Add(sendType())).Error().Block(
+		jen.Return(jen.Id("c").Dot("socket").Dot("Write").Call(jen.Id("message"))),
+	).Line()
+
+	file.Func().Params(jen.Id("c").Op("*").Id(name)).Id("Close").Params().Error().Block(
+		jen.Return(jen.Id("c").Dot("socket").Dot("Close").Call()),
+	).Line()
+}
+
+// generateItems generates the items of an operation which streams the array of its 200 response, the items
+// are produced by the handler on the server and decoded one by one on the client
+func (gen *goTypesGenerator) generateItems(operation *Operation, schema *spec.Schema, file *file.File) error {
+
+	itemsName := strings.Title(operation.ID + "Items")
+
+	itemType, err := types.FromSchema("", schema, true, gen.FindDefinitionByName)
+	if err != nil {
+		return errors.Wrapf(err, "error generating items '%s'", itemsName)
+	}
+	gen.validators.AddAll(itemType.GetValidators())
+
+	if err := itemType.WriteTo(file); err != nil {
+		return errors.Wrapf(err, "error writing items '%s'", itemsName)
+	}
+
+	next := jen.Func().Params().Params(jen.Op("*").Add(itemType.Code()), jen.Error())
+
+	file.Commentf("%s are the items of a streamed %s response, Next returns io.EOF after the last item", itemsName, operation.ID)
+	file.AddType(itemsName).Struct(
+		jen.Id("next").Add(next.Clone()),
+		jen.Id("closer").Func().Params().Error(),
+	).Line()
+
+	file.Commentf("New%s creates the items of a %s response which are produced by next, close is called with the end of the response and may be nil", itemsName, operation.ID)
+	file.Func().Id("New"+itemsName).Params(jen.Id("next").Add(next.Clone()), jen.Id("close").Func().Params().Error()).Op("*").Id(itemsName).Block(
+		jen.Return(jen.Op("&").Id(itemsName).Values(jen.Id("next").Op(":").Id("next"), jen.Id("closer").Op(":").Id("close"))),
+	).Line()
+
+	file.Func().Params(jen.Id("i").Op("*").Id(itemsName)).Id("Next").Params().Params(jen.Op("*").Add(itemType.Code()), jen.Error()).Block(
+		jen.Return(jen.Id("i").Dot("next").Call()),
+	).Line()
+
+	file.Func().Params(jen.Id("i").Op("*").Id(itemsName)).Id("Close").Params().Error().Block(
+		jen.If(jen.Id("i").Dot("closer").Op("==").Nil()).Block(
+			jen.Return(jen.Nil()),
+		),
+		jen.Return(jen.Id("i").Dot("closer").Call()),
+	).Line()
+
+	file.Func().Id("read"+itemsName).Params(jen.Id("body").Qual("io", "ReadCloser")).Op("*").Id(itemsName).Block(
+		jen.Id("reader").Op(":=").Id("newItemReader").Call(jen.Id("body")),
+		jen.Return(jen.Id("New"+itemsName).Call(
+			jen.Func().Params().Params(jen.Op("*").Add(itemType.Code()), jen.Error()).Block(
+				jen.Id("item").Op(":=").New(itemType.Code()),
+				jen.If(jen.Id("err").Op(":=").Id("reader").Dot("Next").Call(jen.Id("item")), jen.Id("err").Op("!=").Nil()).Block(
+					jen.Return(jen.Nil(), jen.Id("err")),
+				),
+				jen.Return(jen.Id("item"), jen.Nil()),
+			),
+			jen.Id("reader").Dot("Close"),
+		)),
+	).Line()
+
+	return nil
+}
+
+// generateServeItems streams the items of a response if they are set, items are written as JSON array unless
+// newline delimited JSON is negotiated. Errors of a started stream are returned as they are to break the body.
+func generateServeItems(operation *Operation, statusCode int) jen.Code {
+
+	return jen.If(jen.Id("r").Dot("Items").Op("!=").Nil()).BlockFunc(func(group *jen.Group) {
+		group.Defer().Id("r").Dot("Items").Dot("Close").Call()
+		group.Id("next").Op(":=").Func().Params().Params(jen.Interface(), jen.Error()).Block(
+			jen.Return(jen.Id("r").Dot("Items").Dot("Next").Call()),
+		)
+		if operation.HasProduce(ContentTypeApplicationJson) {
+			group.If(jen.Id("contentType").Op("!=").Id("contentTypeApplicationNdjson")).Block(
+				jen.Return(jen.Id("serveJsonArray").Call(jen.Id("response"), jen.Lit(statusCode), jen.Id("next"))),
+			)
+		}
+		group.Return(jen.Id("serveNdjson").Call(jen.Id("response"), jen.Lit(statusCode), jen.Id("next")))
+	})
+}
+
+// serializedProduces returns the produced content types of serialized bodies, the first one is
+// served if the negotiated content type is none of them. Newline delimited JSON is only served by
+// responses which stream items.
+func serializedProduces(operation *Operation, streamsItems bool) []string {
+
+	var contentTypes []string
+	if operation.HasProduce(ContentTypeApplicationJson) {
+		contentTypes = append(contentTypes, ContentTypeApplicationJson)
+	}
+	if operation.HasProduce(ContentTypeApplicationHalJson) {
+		contentTypes = append(contentTypes, ContentTypeApplicationHalJson)
+	}
+	if streamsItems && operation.HasProduce(ContentTypeApplicationNdjson) {
+		contentTypes = append(contentTypes, ContentTypeApplicationNdjson)
+	}
+	return append(contentTypes, operation.XMLProduces()...)
+}
+
+// generateServeBody serializes the body with the negotiated content type
+func generateServeBody(contentTypes []string, statusCode jen.Code, returnInternalServerError jen.Code) jen.Code {
+
+	serve := func(contentType string) jen.Code {
+
+		var call *jen.Statement
+		onError := returnInternalServerError
+		switch contentType {
+		case ContentTypeApplicationJson:
+			call = jen.Id("serveJson").Call(jen.Id("response"), statusCode, jen.Id("r").Dot("Body"))
+		case ContentTypeApplicationHalJson:
+			call = jen.Id("serveHalJson").Call(jen.Id("response"), statusCode, jen.Id("r").Dot("Body"))
+		case ContentTypeApplicationNdjson:
+			call = jen.Id("serveNdjson").Call(jen.Id("response"), statusCode, jen.Id("sliceItems").Call(jen.Id("r").Dot("Body")))
+			// the status code was already written, the stream error aborts the response
+			onError = jen.Return(jen.Id("err"))
+		default:
+			call = jen.Id("serveXml").Call(jen.Id("response"), jen.Lit(contentType), statusCode, jen.Id("r").Dot("Body"))
+		}
+
+		return jen.If(jen.Id("err").Op(":=").Add(call), jen.Id("err").Op("!=").Nil()).Block(
+			onError,
+		)
+	}
+
+	if len(contentTypes) == 1 {
+		return serve(contentTypes[0])
+	}
+
+	return jen.Switch(jen.Id("contentType")).BlockFunc(func(group *jen.Group) {
+		for _, contentType := range contentTypes[1:] {
+			group.Case(jen.Lit(contentType)).Block(serve(contentType))
+		}
+		group.Default().Block(serve(contentTypes[0]))
+	})
 }
 
 func (gen *goTypesGenerator) makeResponse(responseName string, body *spec.Schema, headers map[string]spec.Header) (*types.Type, error) {
@@ -7,6 +7,7 @@ require (
 	github.com/corpix/uarand v0.1.1 // indirect
 	github.com/dave/jennifer v1.4.0
 	github.com/fatih/astrewrite v0.0.0-20191207154002-9094e544fcef
+	github.com/go-chi/chi/v5 v5.0.12
 	github.com/go-openapi/analysis v0.19.7
 	github.com/go-openapi/errors v0.19.3
 	github.com/go-openapi/loads v0.19.4
@@ -20,6 +21,7 @@ require (
 	github.com/go-playground/validator v9.31.0+incompatible
 	github.com/gofrs/uuid v3.2.0+incompatible
 	github.com/golang/gddo v0.0.0-20191216155521-fbfc0f5e7810 // indirect
+	github.com/gorilla/websocket v1.4.2
 	github.com/icrowley/fake v0.0.0-20180203215853-4178557ae428
 	github.com/leodido/go-urn v1.2.0 // indirect
 	github.com/mailru/easyjson v0.7.1 // indirect
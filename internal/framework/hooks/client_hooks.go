@@ -4,10 +4,54 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httputil"
+	"time"
 )
 
+// ClientOperation identifies the operation of a client call
+type ClientOperation struct {
+	// ID is the operation ID of the OpenAPI definition
+	ID string
+	// Route is the route template of the operation, e.g. /partners/{partnerId}
+	Route  string
+	Method string
+}
+
+// HooksClient are called during the lifecycle of client calls, hooks which aren't set are skipped
 type HooksClient struct {
 	OnUnknownResponseCode func(response *http.Response, request *http.Request) string
+	// OnRequest is called before a request is sent, it can change the request
+	OnRequest func(operation ClientOperation, request *http.Request)
+	// OnResponse is called after a response is received, the duration is the time until the response headers
+	// were received
+	OnResponse func(operation ClientOperation, request *http.Request, response *http.Response, duration time.Duration)
+	// OnError is called if a request failed or its response couldn't be decoded, the response is nil if the
+	// request failed
+	OnError func(operation ClientOperation, request *http.Request, response *http.Response, err error)
+}
+
+// CallOnRequest calls the OnRequest hook if it's set
+func (h *HooksClient) CallOnRequest(operation ClientOperation, request *http.Request) {
+
+	if h.OnRequest != nil {
+		h.OnRequest(operation, request)
+	}
+}
+
+// CallOnResponse calls the OnResponse hook if it's set
+func (h *HooksClient) CallOnResponse(operation ClientOperation, request *http.Request, response *http.Response, start time.Time) {
+
+	if h.OnResponse != nil {
+		h.OnResponse(operation, request, response, time.Since(start))
+	}
+}
+
+// CallOnError calls the OnError hook if it's set and returns the error
+func (h *HooksClient) CallOnError(operation ClientOperation, request *http.Request, response *http.Response, err error) error {
+
+	if h.OnError != nil {
+		h.OnError(operation, request, response, err)
+	}
+	return err
 }
 
 func DevHook() HooksClient {
@@ -31,10 +75,10 @@ func DevHook() HooksClient {
 
 			message := fmt.Sprintf("unknown response status code %d", response.StatusCode)
 			if len(httpRequestDump) != 0 {
-				message = message + "\n HTTP Request: \n '" + string(httpResponseDumpMessage) + "' \n"
+				message = message + "\n HTTP Request: \n '" + string(httpRequestDumpMessage) + "' \n"
 			}
 			if len(httpResponseDump) != 0 {
-				message = message + "HTTP Response: \n '" + string(httpRequestDumpMessage) + "'"
+				message = message + "HTTP Response: \n '" + string(httpResponseDumpMessage) + "'"
 			}
 			return message
 		},
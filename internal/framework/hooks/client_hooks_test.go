@@ -0,0 +1,31 @@
+package hooks_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ExperienceOne/apikit/internal/framework/hooks"
+)
+
+func TestDevHook(t *testing.T) {
+
+	request := httptest.NewRequest(http.MethodGet, "/partners", nil)
+	response := &http.Response{
+		StatusCode: http.StatusTeapot,
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Body:       ioutil.NopCloser(strings.NewReader("teapot")),
+	}
+
+	message := hooks.DevHook().OnUnknownResponseCode(response, request)
+
+	if !strings.Contains(message, "HTTP Request: \n 'GET /partners") {
+		t.Errorf("request isn't labeled as request: %s", message)
+	}
+	if !strings.Contains(message, "HTTP Response: \n 'HTTP/1.1 418") {
+		t.Errorf("response isn't labeled as response: %s", message)
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -15,6 +17,36 @@ var (
 	TypeError = errors.New("unexpected type")
 )
 
+// DecodeError reports a value of a JSON document that couldn't be decoded into the target,
+// the value is referenced by a JSON pointer (RFC 6901)
+type DecodeError struct {
+	Pointer string
+	Err     error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("%v at '%s'", e.Err, e.Pointer)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+var pointerTokenEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// wrapDecodeError prefixes the pointer of a decode error with the reference token of its parent
+func wrapDecodeError(token string, err error) error {
+
+	token = "/" + pointerTokenEscaper.Replace(token)
+
+	if decodeErr, ok := err.(*DecodeError); ok {
+		decodeErr.Pointer = token + decodeErr.Pointer
+		return decodeErr
+	}
+
+	return &DecodeError{Pointer: token, Err: err}
+}
+
 func JSON(r io.Reader, v interface{}, required bool) (err error) {
 
 	defer func() {
@@ -53,7 +85,7 @@ func JSON(r io.Reader, v interface{}, required bool) (err error) {
 
 			var abstractSlice []interface{}
 			if err := decoder.Decode(&abstractSlice); err != nil {
-				return err
+				return typeError(err)
 			}
 
 			if abstractSlice == nil {
@@ -66,7 +98,7 @@ func JSON(r io.Reader, v interface{}, required bool) (err error) {
 
 			var abstractMap map[string]interface{}
 			if err := decoder.Decode(&abstractMap); err != nil {
-				return err
+				return typeError(err)
 			}
 
 			if abstractMap == nil {
@@ -89,11 +121,11 @@ func JSON(r io.Reader, v interface{}, required bool) (err error) {
 	} else {
 
 		if !required {
-			return decoder.Decode(v)
+			return typeError(decoder.Decode(v))
 		}
 
 		if err := decoder.Decode(&v); err != nil {
-			return err
+			return typeError(err)
 		}
 
 		if v == nil {
@@ -104,6 +136,22 @@ func JSON(r io.Reader, v interface{}, required bool) (err error) {
 	return nil
 }
 
+// typeError converts a type mismatch of the JSON decoder into a decode error of the document,
+// the dotted path of the mismatched field becomes the pointer of the error
+func typeError(err error) error {
+
+	if typeErr, ok := err.(*json.UnmarshalTypeError); ok {
+		pointer := ""
+		if typeErr.Field != "" {
+			for _, token := range strings.Split(typeErr.Field, ".") {
+				pointer += "/" + pointerTokenEscaper.Replace(token)
+			}
+		}
+		return &DecodeError{Pointer: pointer, Err: TypeError}
+	}
+	return err
+}
+
 func setValue(value, dest reflect.Value) {
 
 	for dest.Kind() == reflect.Ptr {
@@ -128,7 +176,7 @@ func slice2concrete(typ reflect.Type, s []interface{}) (reflect.Value, error) {
 	for i, value := range s {
 		concretValue, err := convert(value, typ.Elem())
 		if err != nil {
-			return reflect.Value{}, err
+			return reflect.Value{}, wrapDecodeError(strconv.Itoa(i), err)
 		}
 		setValue(concretValue, concretSlice.Index(i))
 	}
@@ -144,12 +192,12 @@ func map2concrete(typ reflect.Type, m map[string]interface{}) (reflect.Value, er
 
 		concreteKey, err := convert(key, typ.Key())
 		if err != nil {
-			return reflect.Value{}, err
+			return reflect.Value{}, wrapDecodeError(key, err)
 		}
 
 		concreteValue, err := convert(value, typ.Elem())
 		if err != nil {
-			return reflect.Value{}, err
+			return reflect.Value{}, wrapDecodeError(key, err)
 		}
 
 		setMapValue(concreteKey, concreteValue, concreteMap)
@@ -193,13 +241,13 @@ func map2object(typ reflect.Type, m map[string]interface{}) (reflect.Value, erro
 
 			concreteValue, err := convert(value, field.Type)
 			if err != nil {
-				return reflect.Value{}, err
+				return reflect.Value{}, wrapDecodeError(key, err)
 			}
 			setValue(concreteValue, object.Field(i))
 
 		} else if required {
 
-			return reflect.Value{}, NullError
+			return reflect.Value{}, wrapDecodeError(key, NullError)
 		}
 	}
 
@@ -250,6 +298,36 @@ func convert(data interface{}, typ reflect.Type) (reflect.Value, error) {
 
 	} else {
 
-		return reflect.ValueOf(data).Convert(typ), nil
+		value := reflect.ValueOf(data)
+		if !value.IsValid() {
+			return reflect.Value{}, NullError
+		}
+
+		if !convertible(value, typ) {
+			return reflect.Value{}, TypeError
+		}
+
+		return value.Convert(typ), nil
 	}
 }
+
+// convertible checks if a decoded JSON value (bool, float64 or string) matches the kind of the type
+func convertible(value reflect.Value, typ reflect.Type) bool {
+
+	switch typ.Kind() {
+	case reflect.Interface:
+		return value.Type().Implements(typ)
+	case reflect.Bool:
+		return value.Kind() == reflect.Bool
+	case reflect.String:
+		return value.Kind() == reflect.String
+	case reflect.Float32, reflect.Float64:
+		return value.Kind() == reflect.Float64
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Kind() == reflect.Float64 && value.Float() == math.Trunc(value.Float())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return value.Kind() == reflect.Float64 && value.Float() == math.Trunc(value.Float()) && value.Float() >= 0
+	}
+
+	return false
+}
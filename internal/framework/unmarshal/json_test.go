@@ -406,3 +406,119 @@ func TestJSON_panic(t *testing.T) {
 
 	t.Log(unmarshal.JSON(nil, nil, false))
 }
+
+func TestJSON_type_mismatch_pointer(t *testing.T) {
+
+	type item struct {
+		Title string `json:"title,required"`
+		Count int    `json:"count"`
+	}
+
+	var s struct {
+		Items []item `json:"items"`
+	}
+
+	tests := []struct {
+		name    string
+		json    string
+		pointer string
+		err     error
+	}{
+		{
+			name:    "string instead of integer",
+			json:    `{"items": [{"title": "a"}, {"title": "b", "count": "1"}]}`,
+			pointer: "/items/1/count",
+			err:     unmarshal.TypeError,
+		},
+		{
+			name:    "fraction instead of integer",
+			json:    `{"items": [{"title": "a", "count": 1.5}]}`,
+			pointer: "/items/0/count",
+			err:     unmarshal.TypeError,
+		},
+		{
+			name:    "missing required",
+			json:    `{"items": [{"count": 1}]}`,
+			pointer: "/items/0/title",
+			err:     unmarshal.NullError,
+		},
+		{
+			name:    "array instead of object",
+			json:    `[]`,
+			pointer: "",
+			err:     unmarshal.TypeError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			err := unmarshal.JSON(strings.NewReader(test.json), &s, true)
+
+			var decodeErr *unmarshal.DecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Fatalf("expected decode error, got: %v", err)
+			}
+
+			if decodeErr.Pointer != test.pointer {
+				t.Errorf("expected pointer '%s', got '%s'", test.pointer, decodeErr.Pointer)
+			}
+
+			if !errors.Is(err, test.err) {
+				t.Errorf("expected error '%v', got '%v'", test.err, err)
+			}
+		})
+	}
+}
+
+func TestJSON_type_mismatch_pointer_optional(t *testing.T) {
+
+	var s struct {
+		Partner struct {
+			Count int `json:"count"`
+			Rate  int `json:"rate/day"`
+		} `json:"partner"`
+	}
+
+	tests := []struct {
+		name    string
+		json    string
+		pointer string
+	}{
+		{
+			name:    "string instead of integer",
+			json:    `{"partner": {"count": "1"}}`,
+			pointer: "/partner/count",
+		},
+		{
+			name:    "escaped reference token",
+			json:    `{"partner": {"rate/day": true}}`,
+			pointer: "/partner/rate~1day",
+		},
+		{
+			name:    "array instead of object",
+			json:    `[]`,
+			pointer: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			err := unmarshal.JSON(strings.NewReader(test.json), &s, false)
+
+			var decodeErr *unmarshal.DecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Fatalf("expected decode error, got: %v", err)
+			}
+
+			if decodeErr.Pointer != test.pointer {
+				t.Errorf("expected pointer '%s', got '%s'", test.pointer, decodeErr.Pointer)
+			}
+
+			if !errors.Is(err, unmarshal.TypeError) {
+				t.Errorf("expected error '%v', got '%v'", unmarshal.TypeError, err)
+			}
+		})
+	}
+}
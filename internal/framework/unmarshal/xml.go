@@ -0,0 +1,28 @@
+package unmarshal
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+var SyntaxError = errors.New("invalid XML document")
+
+// XML decodes a XML document into v, an empty document is a null value. Errors of the
+// decoder are reported as SyntaxError.
+func XML(r io.Reader, v interface{}, required bool) error {
+
+	if err := xml.NewDecoder(r).Decode(v); err != nil {
+		if err == io.EOF {
+			if required {
+				return NullError
+			}
+			return nil
+		}
+		return fmt.Errorf("%w: %v", SyntaxError, err)
+	}
+
+	return nil
+}
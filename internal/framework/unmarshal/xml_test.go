@@ -0,0 +1,62 @@
+package unmarshal
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestXML(t *testing.T) {
+
+	type partner struct {
+		ID     string `xml:"id"`
+		Credit *int64 `xml:"credit,omitempty"`
+	}
+
+	tests := []struct {
+		name     string
+		document string
+		required bool
+		wantErr  error
+	}{
+		{
+			name:     "Valid",
+			document: "<Partner><id>abc</id><credit>3</credit></Partner>",
+			required: true,
+		},
+		{
+			name:     "EmptyRequired",
+			document: "",
+			required: true,
+			wantErr:  NullError,
+		},
+		{
+			name:     "EmptyOptional",
+			document: "",
+		},
+		{
+			name:     "TypeMismatch",
+			document: "<Partner><id>abc</id><credit>abc</credit></Partner>",
+			wantErr:  SyntaxError,
+		},
+		{
+			name:     "Malformed",
+			document: "<Partner><id>abc</id>",
+			wantErr:  SyntaxError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+
+			err := XML(strings.NewReader(tc.document), new(partner), tc.required)
+			if tc.wantErr == nil && err != nil {
+				t.Fatalf("XML() failed: %v", err)
+			}
+			if tc.wantErr != nil && !errors.Is(err, tc.wantErr) {
+				t.Fatalf("XML() = %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
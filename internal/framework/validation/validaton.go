@@ -2,8 +2,32 @@ package validation
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
 
+	"github.com/ExperienceOne/apikit/internal/framework/unmarshal"
 	"github.com/go-playground/validator"
+	"github.com/pkg/errors"
+)
+
+// locations of the values of a request
+const (
+	LocationPath     = "path"
+	LocationQuery    = "query"
+	LocationHeader   = "header"
+	LocationFormData = "formData"
+	LocationBody     = "body"
+)
+
+// codes of errors which are not reported by the validator
+const (
+	CodeRequired    = "required"
+	CodeInvalidType = "invalid-type"
+	CodeInvalidJSON = "invalid-json"
+	CodeInvalidXML  = "invalid-xml"
+	// CodeInvalidPattern is reported for strings that don't match the regular expression of a pattern validation
+	CodeInvalidPattern = "invalid-pattern"
 )
 
 type ValidationErrorsObject struct {
@@ -12,19 +36,92 @@ type ValidationErrorsObject struct {
 }
 
 type ValidationErrorObject struct {
-	Message string `json:"message"`
-	Field   string `json:"field"`
-	Code    string `json:"code"`
+	Message  string `json:"message"`
+	Field    string `json:"field"`
+	Code     string `json:"code"`
+	Param    string `json:"param,omitempty"`
+	Location string `json:"location,omitempty"`
+	Pointer  string `json:"pointer,omitempty"`
+}
+
+// Error lists the fields and codes of the validation errors, e.g. to report an invalid message of a WebSocket
+func (v *ValidationErrorsObject) Error() string {
+
+	fields := make([]string, len(v.Errors))
+	for i, err := range v.Errors {
+		fields[i] = fmt.Sprintf("%s (%s)", err.Field, err.Code)
+	}
+	return fmt.Sprintf("%s: %s", v.Message, strings.Join(fields, ", "))
 }
 
 func NewValidation() *Validator {
+
+	v := validator.New()
+	v.RegisterTagNameFunc(jsonTagName)
+
 	return &Validator{
-		validator.New(),
+		Validate: v,
+		patterns: make(map[string]string),
 	}
 }
 
 type Validator struct {
 	*validator.Validate
+	patterns map[string]string
+}
+
+// jsonTagName reports fields by their JSON name and request parameters by the name of the parameter,
+// other fields keep their Go name
+func jsonTagName(field reflect.StructField) string {
+
+	if name := strings.SplitN(field.Tag.Get("param"), ",", 2)[0]; name != "" {
+		return name
+	}
+
+	name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// parameterLocation follows the Go field names of a namespace through the type of the request and
+// returns the location of the request parameter that contains the field as well as its depth
+func parameterLocation(typ reflect.Type, fields []string) (string, int) {
+
+	for i, name := range fields {
+
+		for typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+
+		if typ.Kind() != reflect.Struct {
+			break
+		}
+
+		field, ok := typ.FieldByName(name)
+		if !ok {
+			break
+		}
+
+		if param := strings.SplitN(field.Tag.Get("param"), ",", 2); len(param) == 2 {
+			return param[1], i + 1
+		}
+
+		typ = field.Type
+	}
+
+	return "", 0
+}
+
+// RegisterPattern registers a validation tag which matches strings against the regular expression,
+// failed validations are reported with the code invalid-pattern and the expression as parameter
+func (v *Validator) RegisterPattern(tag string, regex *regexp.Regexp) error {
+
+	v.patterns[tag] = regex.String()
+	return v.RegisterValidation(tag, func(fl validator.FieldLevel) bool {
+		return regex.MatchString(fl.Field().String())
+	})
 }
 
 // validates an incoming request and returns nil, nil if request is valid
@@ -43,15 +140,27 @@ func (v *Validator) ValidateRequest(request interface{}) (*ValidationErrorsObjec
 		for _, err := range errors {
 
 			errorCode := fmt.Sprintf("invalid-%s", err.Tag())
+			param := err.Param()
 			if err.Tag() == "required" {
 				errorCode = err.Tag()
+			} else if pattern, ok := v.patterns[err.Tag()]; ok {
+				errorCode = CodeInvalidPattern
+				param = pattern
 			}
 
 			validationError := ValidationErrorObject{
 				Message: fmt.Sprint(err),
 				Field:   err.Field(),
 				Code:    errorCode,
+				Param:   param,
 			}
+
+			location, depth := parameterLocation(reflect.TypeOf(request), namespaceTokens(err.StructNamespace()))
+			validationError.Location = location
+			if location == LocationBody {
+				validationError.Pointer = jsonPointer(namespaceTokens(err.Namespace())[depth:])
+			}
+
 			validationErrors.Errors = append(validationErrors.Errors, validationError)
 		}
 
@@ -60,3 +169,125 @@ func (v *Validator) ValidateRequest(request interface{}) (*ValidationErrorsObjec
 
 	return nil, nil
 }
+
+// ValidateMessage validates a message of a WebSocket and returns the validation errors as error,
+// messages which are no structs aren't validated
+func (v *Validator) ValidateMessage(message interface{}) error {
+
+	typ := reflect.TypeOf(message)
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil
+	}
+
+	validationErrors, err := v.ValidateRequest(message)
+	if err != nil {
+		return err
+	}
+	if validationErrors != nil {
+		return validationErrors
+	}
+	return nil
+}
+
+// namespaceTokens splits the namespace of a validation error (e.g. Request.Body.items[3].title)
+// into the names and indices of the fields below the validated struct
+func namespaceTokens(namespace string) []string {
+
+	var tokens []string
+	var token strings.Builder
+	inIndex := false
+
+	flush := func() {
+		tokens = append(tokens, token.String())
+		token.Reset()
+	}
+
+	for _, c := range namespace {
+		switch {
+		case c == '.' && !inIndex:
+			flush()
+		case c == '[' && !inIndex:
+			flush()
+			inIndex = true
+		case c == ']' && inIndex:
+			inIndex = false
+		default:
+			token.WriteRune(c)
+		}
+	}
+	flush()
+
+	// the first token is the name of the validated struct, empty tokens follow closing brackets
+	var fields []string
+	for _, token := range tokens[1:] {
+		if token != "" {
+			fields = append(fields, token)
+		}
+	}
+	return fields
+}
+
+// jsonPointer joins reference tokens to a JSON pointer (RFC 6901)
+func jsonPointer(tokens []string) string {
+
+	var pointer strings.Builder
+	for _, token := range tokens {
+		pointer.WriteString("/")
+		pointer.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(token))
+	}
+	return pointer.String()
+}
+
+// NewParameterError returns the validation errors of a path, query, header or form data parameter
+// which is missing or couldn't be converted into the type of the parameter
+func NewParameterError(name, location, code, message string) *ValidationErrorsObject {
+
+	return &ValidationErrorsObject{
+		Message: "validation failed",
+		Errors: []ValidationErrorObject{
+			{
+				Message:  message,
+				Field:    name,
+				Code:     code,
+				Location: location,
+			},
+		},
+	}
+}
+
+// NewBodyError returns the validation errors of a body which couldn't be decoded,
+// values of the body that don't match their type are referenced by a JSON pointer
+func NewBodyError(name string, err error) *ValidationErrorsObject {
+
+	validationError := ValidationErrorObject{
+		Message:  fmt.Sprint(err),
+		Field:    name,
+		Code:     CodeInvalidJSON,
+		Location: LocationBody,
+	}
+
+	var decodeErr *unmarshal.DecodeError
+	if errors.As(err, &decodeErr) {
+		validationError.Pointer = decodeErr.Pointer
+		validationError.Code = CodeInvalidType
+		if i := strings.LastIndex(decodeErr.Pointer, "/"); i >= 0 {
+			validationError.Field = strings.NewReplacer("~1", "/", "~0", "~").Replace(decodeErr.Pointer[i+1:])
+		}
+	}
+
+	if errors.Is(err, unmarshal.NullError) {
+		validationError.Code = CodeRequired
+	}
+
+	if errors.Is(err, unmarshal.SyntaxError) {
+		validationError.Code = CodeInvalidXML
+	}
+
+	return &ValidationErrorsObject{
+		Message: "validation failed",
+		Errors:  []ValidationErrorObject{validationError},
+	}
+}
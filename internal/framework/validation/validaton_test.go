@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ExperienceOne/apikit/internal/framework/unmarshal"
 	"github.com/ExperienceOne/apikit/internal/framework/validation"
 	"github.com/stretchr/testify/require"
 
@@ -58,7 +59,7 @@ func TestValidator(t *testing.T) {
 				Message: "Validation of incoming request faild",
 				Errors: []validation.ValidationErrorObject{
 					{
-						Field: "Email",
+						Field: "email",
 						Code:  "invalid-email",
 					},
 				},
@@ -255,10 +256,151 @@ func TestValidatorChain(t *testing.T) {
 		t.Fatal("expected more errors")
 	}
 
-	if len(vErrs.Errors) != 1 || vErrs.Errors[0].Message != "Key: 'Rental.Color' Error:Field validation for 'Color' failed on the 'min' tag" {
+	if len(vErrs.Errors) != 1 || vErrs.Errors[0].Message != "Key: 'Rental.color' Error:Field validation for 'color' failed on the 'min' tag" {
 		t.Error("unexpected errors")
 		for _, err := range vErrs.Errors {
 			t.Log(err.Message)
 		}
 	}
 }
+
+func TestNewBodyError(t *testing.T) {
+
+	type Item struct {
+		Title string `json:"title,required"`
+	}
+
+	tests := []struct {
+		name     string
+		json     string
+		field    string
+		pointer  string
+		code     string
+		optional bool
+	}{
+		{
+			name:    "type mismatch",
+			json:    `{"items": [{"title": 1}]}`,
+			field:   "title",
+			pointer: "/items/0/title",
+			code:    validation.CodeInvalidType,
+		},
+		{
+			name:     "type mismatch of optional body",
+			json:     `{"owner": {"title": 1}}`,
+			field:    "title",
+			pointer:  "/owner/title",
+			code:     validation.CodeInvalidType,
+			optional: true,
+		},
+		{
+			name:    "missing required",
+			json:    `{"items": [{}]}`,
+			field:   "title",
+			pointer: "/items/0/title",
+			code:    validation.CodeRequired,
+		},
+		{
+			name:  "syntax",
+			json:  `{"items": `,
+			field: "body",
+			code:  validation.CodeInvalidJSON,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			var body struct {
+				Items []Item `json:"items"`
+				Owner Item   `json:"owner"`
+			}
+			err := unmarshal.JSON(strings.NewReader(test.json), &body, !test.optional)
+			require.Error(t, err)
+
+			validationErrors := validation.NewBodyError("body", err)
+			require.Len(t, validationErrors.Errors, 1)
+			require.Equal(t, test.field, validationErrors.Errors[0].Field)
+			require.Equal(t, test.pointer, validationErrors.Errors[0].Pointer)
+			require.Equal(t, test.code, validationErrors.Errors[0].Code)
+			require.Equal(t, validation.LocationBody, validationErrors.Errors[0].Location)
+		})
+	}
+}
+
+func TestNewBodyErrorXML(t *testing.T) {
+
+	var body struct {
+		Count int `xml:"count"`
+	}
+	err := unmarshal.XML(strings.NewReader("<body><count>one</count></body>"), &body, true)
+	require.Error(t, err)
+
+	validationErrors := validation.NewBodyError("body", err)
+	require.Len(t, validationErrors.Errors, 1)
+	require.Equal(t, "body", validationErrors.Errors[0].Field)
+	require.Equal(t, validation.CodeInvalidXML, validationErrors.Errors[0].Code)
+}
+
+func TestValidateRequestPointer(t *testing.T) {
+
+	type Item struct {
+		Title string `json:"title,required" validate:"min=3"`
+		Code  string `json:"code,required" validate:"regex1"`
+	}
+
+	type Body struct {
+		Items []Item `json:"items,omitempty" validate:"omitempty,gt=0,dive"`
+	}
+
+	type Request struct {
+		XLimit int64 `param:"X-Limit,header" validate:"max=10"`
+		Todo   Body  `param:"todo,body"`
+	}
+
+	v := validation.NewValidation()
+	require.NoError(t, v.RegisterPattern("regex1", regexp.MustCompile(`^[a-z]+$`)))
+
+	request := &Request{
+		XLimit: 11,
+		Todo: Body{
+			Items: []Item{
+				{Title: "title", Code: "code"},
+				{Title: "ti", Code: "CODE"},
+			},
+		},
+	}
+
+	vErrs, err := v.ValidateRequest(request)
+	require.NoError(t, err)
+	require.NotNil(t, vErrs)
+
+	want := []validation.ValidationErrorObject{
+		{Field: "X-Limit", Code: "invalid-max", Param: "10", Location: validation.LocationHeader},
+		{Field: "title", Code: "invalid-min", Param: "3", Location: validation.LocationBody, Pointer: "/items/1/title"},
+		{Field: "code", Code: validation.CodeInvalidPattern, Param: "^[a-z]+$", Location: validation.LocationBody, Pointer: "/items/1/code"},
+	}
+
+	require.Len(t, vErrs.Errors, len(want))
+	for i, got := range vErrs.Errors {
+		got.Message = ""
+		require.Equal(t, want[i], got)
+	}
+}
+
+func TestValidateMessage(t *testing.T) {
+
+	v := validation.NewValidation()
+
+	err := v.ValidateMessage(&Input3{Name: "ab"})
+	require.Error(t, err)
+
+	validationErrors, ok := err.(*validation.ValidationErrorsObject)
+	require.True(t, ok)
+	require.Len(t, validationErrors.Errors, 1)
+	require.Equal(t, "validation failed: Name (invalid-min)", err.Error())
+
+	require.NoError(t, v.ValidateMessage(&Input3{Name: "abc"}))
+	require.NoError(t, v.ValidateMessage([]string{"no struct"}))
+	require.NoError(t, v.ValidateMessage(nil))
+}
@@ -9,10 +9,21 @@ import (
 	"github.com/ExperienceOne/apikit/internal/framework/hooks"
 )
 
-// Opts contains hooks and an optional context object
+// Opts contains hooks and an optional context object, which is used by calls without context
 type Opts struct {
 	Hooks hooks.HooksClient
 	Ctx   context.Context
+	// Validate validates requests before they are sent, invalid requests fail with a RequestValidationError
+	Validate bool
+	// Credentials maps the names of security definitions to the providers of their credentials, which are sent if
+	// the security values of a request are empty
+	Credentials map[string]CredentialProvider
+	// ResponseErrors returns the documented responses of the status codes 4xx and 5xx as errors instead of responses,
+	// the errors can be checked with helpers like IsNotFound or errors.As
+	ResponseErrors bool
+	// StreamItems prefers newline delimited JSON for operations which stream the items of their response, the items
+	// are returned by Items instead of Body and must be closed
+	StreamItems bool
 }
 
 // HttpClientWrapper imposes common Client API conventions on a set of resource paths.
@@ -0,0 +1,173 @@
+package xclient
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// tokenRefreshDelta is the time before the expiry of a token in which it's refreshed
+const tokenRefreshDelta = 30 * time.Second
+
+// CredentialProvider provides the credential of a security definition, it's sent as value of the header of the
+// security definition
+type CredentialProvider interface {
+	Credential(ctx context.Context) (string, error)
+}
+
+// CredentialFunc transforms a function into a CredentialProvider
+type CredentialFunc func(ctx context.Context) (string, error)
+
+func (f CredentialFunc) Credential(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// APIKeyCredential provides a static API key
+func APIKeyCredential(key string) CredentialProvider {
+	return CredentialFunc(func(ctx context.Context) (string, error) {
+		return key, nil
+	})
+}
+
+// BasicAuthCredential provides the Authorization header value of HTTP basic authentication
+func BasicAuthCredential(user, password string) CredentialProvider {
+
+	credential := "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+password))
+	return CredentialFunc(func(ctx context.Context) (string, error) {
+		return credential, nil
+	})
+}
+
+// OAuth2Token is an access token, a zero expiry never expires
+type OAuth2Token struct {
+	AccessToken string
+	Expiry      time.Time
+}
+
+// OAuth2TokenSource fetches access tokens
+type OAuth2TokenSource interface {
+	Token(ctx context.Context) (*OAuth2Token, error)
+}
+
+// BearerTokenCredential provides the Authorization header value of a bearer token. The token of the source is
+// cached and refreshed shortly before it expires.
+func BearerTokenCredential(source OAuth2TokenSource) CredentialProvider {
+	return &bearerTokenCredential{source: source}
+}
+
+type bearerTokenCredential struct {
+	source OAuth2TokenSource
+	mutex  sync.Mutex
+	token  *OAuth2Token
+}
+
+func (c *bearerTokenCredential) Credential(ctx context.Context) (string, error) {
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.token == nil || (!c.token.Expiry.IsZero() && time.Now().Add(tokenRefreshDelta).After(c.token.Expiry)) {
+		token, err := c.source.Token(ctx)
+		if err != nil {
+			return "", errors.Wrap(err, "error fetching bearer token")
+		}
+		c.token = token
+	}
+	return "Bearer " + c.token.AccessToken, nil
+}
+
+// ClientCredentialsTokenSource fetches access tokens with the OAuth2 client credentials grant from the token
+// endpoint, a nil client uses the http.DefaultClient.
+func ClientCredentialsTokenSource(client *http.Client, tokenURL, clientID, clientSecret string, scopes ...string) OAuth2TokenSource {
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &clientCredentialsTokenSource{
+		client:       client,
+		tokenURL:     tokenURL,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		scopes:       scopes,
+	}
+}
+
+type clientCredentialsTokenSource struct {
+	client       *http.Client
+	tokenURL     string
+	clientID     string
+	clientSecret string
+	scopes       []string
+}
+
+func (s *clientCredentialsTokenSource) Token(ctx context.Context) (*OAuth2Token, error) {
+
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(s.scopes) > 0 {
+		form.Set("scope", strings.Join(s.scopes, " "))
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	request.SetBasicAuth(url.QueryEscape(s.clientID), url.QueryEscape(s.clientSecret))
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token endpoint responded with status code %d", response.StatusCode)
+	}
+
+	var body struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+		return nil, errors.Wrap(err, "error decoding token response")
+	}
+	if body.AccessToken == "" {
+		return nil, errors.New("token response without access token")
+	}
+	if body.TokenType != "" && !strings.EqualFold(body.TokenType, "bearer") {
+		return nil, fmt.Errorf("unsupported token type '%s'", body.TokenType)
+	}
+
+	token := &OAuth2Token{AccessToken: body.AccessToken}
+	if body.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(body.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// SetCredential sets the header of a security definition, the value of the request is sent if it's set,
+// otherwise the credential of the provider of the security definition
+func SetCredential(ctx context.Context, header http.Header, credentials map[string]CredentialProvider, definition, name, value string) error {
+
+	if provider, ok := credentials[definition]; ok && value == "" {
+		credential, err := provider.Credential(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "error providing credential of security definition '%s'", definition)
+		}
+		value = credential
+	}
+
+	// don't use Header().Set() or Header().Add(): https://github.com/golang/go/issues/5022
+	header[name] = []string{value}
+	return nil
+}
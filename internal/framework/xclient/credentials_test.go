@@ -0,0 +1,103 @@
+package xclient_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ExperienceOne/apikit/internal/framework/xclient"
+)
+
+func TestSetCredential(t *testing.T) {
+
+	credentials := map[string]xclient.CredentialProvider{
+		"ApiKey": xclient.APIKeyCredential("secret"),
+		"Basic":  xclient.BasicAuthCredential("user", "password"),
+	}
+
+	tests := []struct {
+		name       string
+		definition string
+		header     string
+		value      string
+		expected   string
+	}{
+		{name: "api key", definition: "ApiKey", header: "X-Api-Key", expected: "secret"},
+		{name: "basic auth", definition: "Basic", header: "Authorization", expected: "Basic dXNlcjpwYXNzd29yZA=="},
+		{name: "value of request", definition: "ApiKey", header: "X-Api-Key", value: "other", expected: "other"},
+		{name: "without provider", definition: "Session", header: "X-Session", value: "session", expected: "session"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			header := make(http.Header)
+			if err := xclient.SetCredential(context.Background(), header, credentials, test.definition, test.header, test.value); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if value := header.Get(test.header); value != test.expected {
+				t.Errorf("unexpected header value: got '%s', expected '%s'", value, test.expected)
+			}
+		})
+	}
+}
+
+func TestClientCredentials(t *testing.T) {
+
+	var requests int
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		requests++
+		clientID, clientSecret, _ := r.BasicAuth()
+		if r.PostFormValue("grant_type") != "client_credentials" || r.PostFormValue("scope") != "read write" || clientID != "client" || clientSecret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		// the token expires within the refresh delta, so it's fetched again by every call
+		json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "token", "token_type": "bearer", "expires_in": 10})
+	}))
+	defer tokenServer.Close()
+
+	source := xclient.ClientCredentialsTokenSource(nil, tokenServer.URL, "client", "secret", "read", "write")
+	provider := xclient.BearerTokenCredential(source)
+
+	for i := 0; i < 2; i++ {
+		credential, err := provider.Credential(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if credential != "Bearer token" {
+			t.Errorf("unexpected credential: %s", credential)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("expiring token wasn't refreshed: %d requests", requests)
+	}
+}
+
+type staticTokenSource struct {
+	requests int
+}
+
+func (s *staticTokenSource) Token(ctx context.Context) (*xclient.OAuth2Token, error) {
+	s.requests++
+	return &xclient.OAuth2Token{AccessToken: "token"}, nil
+}
+
+func TestBearerTokenCache(t *testing.T) {
+
+	source := new(staticTokenSource)
+	provider := xclient.BearerTokenCredential(source)
+
+	for i := 0; i < 3; i++ {
+		if _, err := provider.Credential(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if source.requests != 1 {
+		t.Errorf("token wasn't cached: %d requests", source.requests)
+	}
+}
@@ -0,0 +1,56 @@
+package xclient
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// maxUnexpectedBodySize limits the bytes of the body of an unexpected response which are copied into its error
+const maxUnexpectedBodySize int64 = 64 << 10
+
+// UnexpectedResponseError is returned for responses with a status code which isn't documented by the operation
+type UnexpectedResponseError struct {
+	StatusCode int
+	Header     http.Header
+	// Body is a copy of the body, it's truncated after 64 KiB
+	Body []byte
+	// Method and URL identify the request, the URL doesn't contain user information
+	Method string
+	URL    string
+}
+
+func (e *UnexpectedResponseError) Error() string {
+	return fmt.Sprintf("unknown response status code '%d' of %s %s", e.StatusCode, e.Method, e.URL)
+}
+
+// UnexpectedStatusCode returns the status code, so the status code checks of response errors also match unexpected
+// responses
+func (e *UnexpectedResponseError) UnexpectedStatusCode() int {
+	return e.StatusCode
+}
+
+// NewUnexpectedResponseError copies the status code, headers and the beginning of the body of an unexpected
+// response, the body isn't closed
+func NewUnexpectedResponseError(request *http.Request, response *http.Response) *UnexpectedResponseError {
+
+	err := &UnexpectedResponseError{
+		StatusCode: response.StatusCode,
+		Header:     response.Header,
+		Method:     request.Method,
+	}
+
+	if request.URL != nil {
+		url := *request.URL
+		url.User = nil
+		err.URL = url.String()
+	}
+
+	if response.Body != nil {
+		// a body which can't be read completely is returned as far as it was read
+		err.Body, _ = ioutil.ReadAll(io.LimitReader(response.Body, maxUnexpectedBodySize))
+	}
+
+	return err
+}
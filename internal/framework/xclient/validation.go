@@ -0,0 +1,43 @@
+package xclient
+
+import (
+	"github.com/ExperienceOne/apikit/internal/framework/validation"
+	"github.com/ExperienceOne/apikit/internal/framework/xhttp"
+)
+
+// RequestValidationError is returned by a client with request validation if a request is invalid, the request
+// isn't sent. It contains the validation errors of a 400 (Bad Request) response of the server.
+type RequestValidationError struct {
+	validation.ValidationErrorsObject
+}
+
+func (e *RequestValidationError) Error() string {
+	return "invalid request: " + e.ValidationErrorsObject.Error()
+}
+
+// ValidateClientRequest validates a request before it's sent, a client without validator doesn't validate requests
+func ValidateClientRequest(validator *validation.Validator, request interface{}) error {
+
+	if validator == nil {
+		return nil
+	}
+
+	validationErrors, err := validator.ValidateRequest(request)
+	if err != nil {
+		return err
+	}
+	if validationErrors != nil {
+		return &RequestValidationError{ValidationErrorsObject: *validationErrors}
+	}
+	return nil
+}
+
+// ClientMessageValidator returns the validator of the messages of a WebSocket, a client without validator doesn't
+// validate messages
+func ClientMessageValidator(validator *validation.Validator) xhttp.MessageValidator {
+
+	if validator == nil {
+		return nil
+	}
+	return validator.ValidateMessage
+}
@@ -0,0 +1,135 @@
+package xhttp
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MediaRange is a media range of an Accept header with its quality value
+type MediaRange struct {
+	Type    string
+	Subtype string
+	Q       float64
+}
+
+// matches checks if the media range includes the content type and returns the
+// specificity of the match (0: */*, 1: type/*, 2: type/subtype)
+func (m MediaRange) matches(contentType string) (int, bool) {
+
+	typ, subtype := splitMediaType(contentType)
+
+	switch {
+	case m.Type == "*" && m.Subtype == "*":
+		return 0, true
+	case m.Type == typ && m.Subtype == "*":
+		return 1, true
+	case m.Type == typ && m.Subtype == subtype:
+		return 2, true
+	}
+	return 0, false
+}
+
+func splitMediaType(mediaType string) (string, string) {
+
+	mediaType = ExtractContentType(mediaType)
+	i := strings.Index(mediaType, "/")
+	if i == -1 {
+		return mediaType, ""
+	}
+	return mediaType[:i], mediaType[i+1:]
+}
+
+// ParseAccept parses the media ranges of an Accept header (RFC 7231 section 5.3.2) ordered by
+// their quality values, media ranges with an invalid quality value are skipped
+func ParseAccept(header string) []MediaRange {
+
+	var mediaRanges []MediaRange
+
+	for _, element := range strings.Split(header, ",") {
+
+		params := strings.Split(element, ";")
+		typ, subtype := splitMediaType(params[0])
+		if typ == "" || subtype == "" {
+			continue
+		}
+
+		mediaRange := MediaRange{Type: typ, Subtype: subtype, Q: 1}
+		valid := true
+		for _, param := range params[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(strings.ToLower(param), "q=") {
+				continue
+			}
+			q, err := strconv.ParseFloat(param[2:], 64)
+			if err != nil || q < 0 || q > 1 {
+				valid = false
+				break
+			}
+			mediaRange.Q = q
+		}
+
+		if valid {
+			mediaRanges = append(mediaRanges, mediaRange)
+		}
+	}
+
+	sort.SliceStable(mediaRanges, func(i, j int) bool {
+		return mediaRanges[i].Q > mediaRanges[j].Q
+	})
+
+	return mediaRanges
+}
+
+// NegotiateContentType picks the content type of the offers that is preferred by the Accept header.
+// The quality value of an offer is taken from the most specific media range that includes it,
+// offers with the same quality value are chosen in their order. A missing Accept header accepts any
+// content type.
+func NegotiateContentType(header string, offers []string) (string, bool) {
+
+	if len(offers) == 0 {
+		return "", false
+	}
+
+	if strings.TrimSpace(header) == "" {
+		return offers[0], true
+	}
+
+	mediaRanges := ParseAccept(header)
+
+	var (
+		best  string
+		bestQ float64
+	)
+
+	for _, offer := range offers {
+
+		q, specificity := 0.0, -1
+		for _, mediaRange := range mediaRanges {
+			if s, ok := mediaRange.matches(offer); ok && s > specificity {
+				q, specificity = mediaRange.Q, s
+			}
+		}
+
+		if q > bestQ {
+			best, bestQ = offer, q
+		}
+	}
+
+	return best, bestQ > 0
+}
+
+type negotiatedContentTypeKey struct{}
+
+// WithNegotiatedContentType stores the negotiated content type of the response in the context
+func WithNegotiatedContentType(ctx context.Context, contentType string) context.Context {
+	return context.WithValue(ctx, negotiatedContentTypeKey{}, contentType)
+}
+
+// NegotiatedContentType returns the content type of the response negotiated with the Accept header of the request
+func NegotiatedContentType(ctx context.Context) string {
+
+	contentType, _ := ctx.Value(negotiatedContentTypeKey{}).(string)
+	return contentType
+}
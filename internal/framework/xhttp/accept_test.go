@@ -0,0 +1,109 @@
+package xhttp_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/ExperienceOne/apikit/internal/framework/xhttp"
+)
+
+func TestParseAccept(t *testing.T) {
+
+	got := xhttp.ParseAccept("text/*;q=0.3, text/html;q=0.7, text/html;level=1, */*;q=0.5, application/json;q=abc")
+	want := []xhttp.MediaRange{
+		{Type: "text", Subtype: "html", Q: 1},
+		{Type: "text", Subtype: "html", Q: 0.7},
+		{Type: "*", Subtype: "*", Q: 0.5},
+		{Type: "text", Subtype: "*", Q: 0.3},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected media ranges (actual: %v, expected: %v)", got, want)
+	}
+}
+
+func TestNegotiateContentType(t *testing.T) {
+
+	offers := []string{"application/json", "application/xml", "text/xml"}
+
+	tests := []struct {
+		name   string
+		accept string
+		want   string
+		ok     bool
+	}{
+		{
+			name: "no accept header",
+			want: "application/json",
+			ok:   true,
+		},
+		{
+			name:   "exact match",
+			accept: "application/xml",
+			want:   "application/xml",
+			ok:     true,
+		},
+		{
+			name:   "quality values",
+			accept: "application/json;q=0.5, text/xml",
+			want:   "text/xml",
+			ok:     true,
+		},
+		{
+			name:   "wildcard",
+			accept: "*/*",
+			want:   "application/json",
+			ok:     true,
+		},
+		{
+			name:   "type wildcard",
+			accept: "text/*, application/json;q=0.1",
+			want:   "text/xml",
+			ok:     true,
+		},
+		{
+			name:   "specific range overrides wildcard",
+			accept: "application/*, application/json;q=0",
+			want:   "application/xml",
+			ok:     true,
+		},
+		{
+			name:   "case insensitive",
+			accept: "Application/XML",
+			want:   "application/xml",
+			ok:     true,
+		},
+		{
+			name:   "not acceptable",
+			accept: "text/html",
+			ok:     false,
+		},
+		{
+			name:   "excluded",
+			accept: "application/json;q=0",
+			ok:     false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := xhttp.NegotiateContentType(test.accept, offers)
+			if got != test.want || ok != test.ok {
+				t.Errorf("unexpected content type (actual: %q %v, expected: %q %v)", got, ok, test.want, test.ok)
+			}
+		})
+	}
+}
+
+func TestNegotiatedContentType(t *testing.T) {
+
+	ctx := xhttp.WithNegotiatedContentType(context.Background(), "application/xml")
+	if contentType := xhttp.NegotiatedContentType(ctx); contentType != "application/xml" {
+		t.Errorf("unexpected content type (actual: %q, expected: application/xml)", contentType)
+	}
+
+	if contentType := xhttp.NegotiatedContentType(context.Background()); contentType != "" {
+		t.Errorf("unexpected content type (actual: %q, expected: empty)", contentType)
+	}
+}
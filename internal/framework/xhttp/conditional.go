@@ -0,0 +1,146 @@
+package xhttp
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	ETagHeader              string = "ETag"
+	LastModifiedHeader      string = "Last-Modified"
+	IfMatchHeader           string = "If-Match"
+	IfNoneMatchHeader       string = "If-None-Match"
+	IfModifiedSinceHeader   string = "If-Modified-Since"
+	IfUnmodifiedSinceHeader string = "If-Unmodified-Since"
+)
+
+// FormatETag quotes an entity tag unless it is quoted or weak already
+func FormatETag(etag string) string {
+
+	if etag == "" || strings.HasPrefix(etag, "\"") || strings.HasPrefix(etag, "W/\"") {
+		return etag
+	}
+	return "\"" + etag + "\""
+}
+
+// SetValidators sets the ETag and Last-Modified headers of a response, empty values are omitted
+func SetValidators(header http.Header, etag string, lastModified time.Time) {
+
+	if etag != "" {
+		header.Set(ETagHeader, FormatETag(etag))
+	}
+	if !lastModified.IsZero() {
+		header.Set(LastModifiedHeader, FormatHTTPTime(lastModified))
+	}
+}
+
+// FormatHTTPTime formats the date of a header, the zero time is formatted as empty string
+func FormatHTTPTime(t time.Time) string {
+
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(http.TimeFormat)
+}
+
+// ParseHTTPTime parses the date of a header, a missing or invalid date is returned as zero time
+func ParseHTTPTime(value string) time.Time {
+
+	if value == "" {
+		return time.Time{}
+	}
+	t, err := http.ParseTime(value)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// HasPreconditions checks if a request has conditional headers which may fail the request
+func HasPreconditions(header http.Header) bool {
+
+	return header.Get(IfMatchHeader) != "" || header.Get(IfNoneMatchHeader) != "" ||
+		header.Get(IfUnmodifiedSinceHeader) != "" || header.Get(IfModifiedSinceHeader) != ""
+}
+
+// EvaluatePreconditions evaluates the conditional headers of a request against the current representation of the
+// resource like described by RFC 7232 section 6. An empty entity tag and a zero time describe a resource without
+// current representation. The status code 304 (Not Modified) or 412 (Precondition Failed) is returned if the
+// request fails a precondition, 0 is returned otherwise.
+func EvaluatePreconditions(r *http.Request, etag string, lastModified time.Time) int {
+
+	etag = FormatETag(etag)
+	exists := etag != "" || !lastModified.IsZero()
+	lastModified = lastModified.Truncate(time.Second)
+	safe := r.Method == http.MethodGet || r.Method == http.MethodHead
+
+	if ifMatch := r.Header.Get(IfMatchHeader); ifMatch != "" {
+		if !matchETag(ifMatch, etag, exists, true) {
+			return http.StatusPreconditionFailed
+		}
+	} else if since := ParseHTTPTime(r.Header.Get(IfUnmodifiedSinceHeader)); !since.IsZero() && !lastModified.IsZero() {
+		if lastModified.After(since) {
+			return http.StatusPreconditionFailed
+		}
+	}
+
+	if ifNoneMatch := r.Header.Get(IfNoneMatchHeader); ifNoneMatch != "" {
+		if matchETag(ifNoneMatch, etag, exists, false) {
+			if safe {
+				return http.StatusNotModified
+			}
+			return http.StatusPreconditionFailed
+		}
+	} else if since := ParseHTTPTime(r.Header.Get(IfModifiedSinceHeader)); safe && !since.IsZero() && !lastModified.IsZero() {
+		if !lastModified.After(since) {
+			return http.StatusNotModified
+		}
+	}
+
+	return 0
+}
+
+// matchETag checks if the entity tag matches a list of entity tags, a strong comparison doesn't match weak tags
+func matchETag(list, etag string, exists, strong bool) bool {
+
+	if strings.TrimSpace(list) == "*" {
+		return exists
+	}
+	if etag == "" {
+		return false
+	}
+
+	for _, candidate := range splitETags(list) {
+		if strong {
+			if candidate == etag && !strings.HasPrefix(etag, "W/") {
+				return true
+			}
+		} else if strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// splitETags splits a list of entity tags, commas within quotes don't separate tags
+func splitETags(list string) []string {
+
+	var (
+		etags  []string
+		quoted bool
+		start  int
+	)
+	for i := 0; i < len(list); i++ {
+		switch list[i] {
+		case '"':
+			quoted = !quoted
+		case ',':
+			if !quoted {
+				etags = append(etags, strings.TrimSpace(list[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(etags, strings.TrimSpace(list[start:]))
+}
@@ -0,0 +1,68 @@
+package xhttp_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ExperienceOne/apikit/internal/framework/xhttp"
+)
+
+func TestEvaluatePreconditions(t *testing.T) {
+
+	lastModified := time.Date(2021, 11, 27, 10, 0, 0, 0, time.UTC)
+	before := lastModified.Add(-time.Hour).Format(http.TimeFormat)
+	after := lastModified.Add(time.Hour).Format(http.TimeFormat)
+
+	tests := []struct {
+		name         string
+		method       string
+		header       string
+		value        string
+		etag         string
+		lastModified time.Time
+		want         int
+	}{
+		{name: "no preconditions", method: http.MethodGet, etag: "v1", want: 0},
+		{name: "if-none-match matches", method: http.MethodGet, header: "If-None-Match", value: "\"v0\", \"v1\"", etag: "v1", want: http.StatusNotModified},
+		{name: "if-none-match matches weak", method: http.MethodGet, header: "If-None-Match", value: "W/\"v1\"", etag: "\"v1\"", want: http.StatusNotModified},
+		{name: "if-none-match differs", method: http.MethodGet, header: "If-None-Match", value: "\"v0\"", etag: "v1", want: 0},
+		{name: "if-none-match any on put", method: http.MethodPut, header: "If-None-Match", value: "*", etag: "v1", want: http.StatusPreconditionFailed},
+		{name: "if-none-match any on put of new resource", method: http.MethodPut, header: "If-None-Match", value: "*", want: 0},
+		{name: "if-match matches", method: http.MethodPut, header: "If-Match", value: "\"v1\"", etag: "v1", want: 0},
+		{name: "if-match differs", method: http.MethodPut, header: "If-Match", value: "\"v0\"", etag: "v1", want: http.StatusPreconditionFailed},
+		{name: "if-match weak", method: http.MethodPut, header: "If-Match", value: "W/\"v1\"", etag: "W/\"v1\"", want: http.StatusPreconditionFailed},
+		{name: "if-match any of missing resource", method: http.MethodDelete, header: "If-Match", value: "*", want: http.StatusPreconditionFailed},
+		{name: "if-modified-since not modified", method: http.MethodGet, header: "If-Modified-Since", value: after, lastModified: lastModified, want: http.StatusNotModified},
+		{name: "if-modified-since modified", method: http.MethodGet, header: "If-Modified-Since", value: before, lastModified: lastModified, want: 0},
+		{name: "if-modified-since invalid", method: http.MethodGet, header: "If-Modified-Since", value: "yesterday", lastModified: lastModified, want: 0},
+		{name: "if-unmodified-since modified", method: http.MethodPatch, header: "If-Unmodified-Since", value: before, lastModified: lastModified, want: http.StatusPreconditionFailed},
+		{name: "if-unmodified-since not modified", method: http.MethodPatch, header: "If-Unmodified-Since", value: after, lastModified: lastModified, want: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(test.method, "/", nil)
+			if test.header != "" {
+				r.Header.Set(test.header, test.value)
+			}
+			if got := xhttp.EvaluatePreconditions(r, test.etag, test.lastModified); got != test.want {
+				t.Errorf("unexpected status code (actual: %d, expected: %d)", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSetValidators(t *testing.T) {
+
+	header := make(http.Header)
+	xhttp.SetValidators(header, "v1", time.Date(2021, 11, 27, 11, 0, 0, 0, time.FixedZone("CET", 3600)))
+
+	if etag := header.Get("ETag"); etag != "\"v1\"" {
+		t.Errorf("unexpected entity tag: %s", etag)
+	}
+	if lastModified := header.Get("Last-Modified"); lastModified != "Sat, 27 Nov 2021 10:00:00 GMT" {
+		t.Errorf("unexpected last modified date: %s", lastModified)
+	}
+}
@@ -7,4 +7,7 @@ const (
 	ContentTypeTextPlain                 string = "text/plain"
 	ContentTypeMultipartFormData         string = "multipart/form-data"
 	ContentTypeApplicationFormUrlencoded string = "application/x-www-form-urlencoded"
+	ContentTypeApplicationXml            string = "application/xml"
+	ContentTypeTextXml                   string = "text/xml"
+	ContentTypeApplicationNdjson         string = "application/x-ndjson"
 )
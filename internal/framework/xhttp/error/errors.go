@@ -1,7 +1,9 @@
 package error
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 
 	"github.com/pkg/errors"
 )
@@ -42,16 +44,112 @@ func (err *ErrUnknownResponse) Error() string {
 	return fmt.Sprintf("unknown response status code '%d'", err.code)
 }
 
-func NewErrOnUnknownResponseCode(message string) *ErrOnUnknownResponseCode {
+func NewErrOnUnknownResponseCode(message string, statusCode int) *ErrOnUnknownResponseCode {
 	return &ErrOnUnknownResponseCode{
-		Message: message,
+		Message:    message,
+		statusCode: statusCode,
 	}
 }
 
 type ErrOnUnknownResponseCode struct {
-	Message string
+	Message    string
+	statusCode int
 }
 
 func (err *ErrOnUnknownResponseCode) Error() string {
 	return fmt.Sprintf(err.Message)
 }
+
+func (err *ErrOnUnknownResponseCode) UnexpectedStatusCode() int {
+	return err.statusCode
+}
+
+// ResponseError is implemented by the responses of the status codes 4xx and 5xx of an operation, so they can be
+// returned and handled as errors
+type ResponseError interface {
+	error
+	StatusCode() int
+	OperationID() string
+}
+
+// ResponseErrorMessage formats the message of a response error, the body is appended as JSON. A response without
+// body passes nil.
+func ResponseErrorMessage(operationID string, statusCode int, body interface{}) string {
+
+	message := fmt.Sprintf("%s responded with status code %d (%s)", operationID, statusCode, http.StatusText(statusCode))
+	if body == nil {
+		return message
+	}
+	if content, err := json.Marshal(body); err == nil {
+		return message + ": " + string(content)
+	}
+	return message + fmt.Sprintf(": %+v", body)
+}
+
+// unexpectedResponseError is implemented by the error of a response with a status code which isn't documented by
+// the operation
+type unexpectedResponseError interface {
+	error
+	UnexpectedStatusCode() int
+}
+
+// ResponseStatusCode returns the status code of a response error or of an unexpected response in the chain of an
+// error
+func ResponseStatusCode(err error) (int, bool) {
+
+	var responseErr ResponseError
+	if errors.As(err, &responseErr) {
+		return responseErr.StatusCode(), true
+	}
+	var unexpectedErr unexpectedResponseError
+	if errors.As(err, &unexpectedErr) {
+		return unexpectedErr.UnexpectedStatusCode(), true
+	}
+	return 0, false
+}
+
+// IsBadRequest checks if an error is a response with status code 400
+func IsBadRequest(err error) bool {
+	return hasStatusCode(err, http.StatusBadRequest)
+}
+
+// IsUnauthorized checks if an error is a response with status code 401
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+// IsForbidden checks if an error is a response with status code 403
+func IsForbidden(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
+}
+
+// IsNotFound checks if an error is a response with status code 404
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConflict checks if an error is a response with status code 409
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsPreconditionFailed checks if an error is a response with status code 412
+func IsPreconditionFailed(err error) bool {
+	return hasStatusCode(err, http.StatusPreconditionFailed)
+}
+
+// IsTooManyRequests checks if an error is a response with status code 429
+func IsTooManyRequests(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+// IsServerError checks if an error is a response with a status code 5xx
+func IsServerError(err error) bool {
+	statusCode, ok := ResponseStatusCode(err)
+	return ok && statusCode >= http.StatusInternalServerError
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	code, ok := ResponseStatusCode(err)
+	return ok && code == statusCode
+}
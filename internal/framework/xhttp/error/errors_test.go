@@ -1,6 +1,8 @@
 package error_test
 
 import (
+	"errors"
+	"fmt"
 	httperror "github.com/ExperienceOne/apikit/internal/framework/xhttp/error"
 	"github.com/stretchr/testify/assert"
 	"net/http"
@@ -31,3 +33,49 @@ func TestNotSupportedContentType(t *testing.T) {
 		})
 	}
 }
+
+type notFoundResponse struct{}
+
+func (r *notFoundResponse) Error() string {
+	return httperror.ResponseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *notFoundResponse) StatusCode() int {
+	return http.StatusNotFound
+}
+
+func (r *notFoundResponse) OperationID() string {
+	return "GetPartner"
+}
+
+func TestResponseError(t *testing.T) {
+
+	err := fmt.Errorf("error fetching partner: %w", &notFoundResponse{})
+
+	assert.Equal(t, "GetPartner responded with status code 404 (Not Found)", (&notFoundResponse{}).Error())
+	assert.True(t, httperror.IsNotFound(err))
+	assert.False(t, httperror.IsBadRequest(err))
+	assert.False(t, httperror.IsServerError(err))
+	assert.False(t, httperror.IsNotFound(errors.New("not found")))
+
+	statusCode, ok := httperror.ResponseStatusCode(err)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusNotFound, statusCode)
+
+	var responseErr httperror.ResponseError
+	assert.True(t, errors.As(err, &responseErr))
+	assert.Equal(t, "GetPartner", responseErr.OperationID())
+
+	assert.Equal(t, "CreatePartner responded with status code 400 (Bad Request): [\"name\"]", httperror.ResponseErrorMessage("CreatePartner", http.StatusBadRequest, []string{"name"}))
+}
+
+func TestErrOnUnknownResponseCode(t *testing.T) {
+
+	err := fmt.Errorf("error fetching partner: %w", httperror.NewErrOnUnknownResponseCode("unknown response status code 502", http.StatusBadGateway))
+
+	statusCode, ok := httperror.ResponseStatusCode(err)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusBadGateway, statusCode)
+	assert.True(t, httperror.IsServerError(err))
+	assert.False(t, httperror.IsNotFound(err))
+}
@@ -0,0 +1,164 @@
+package xhttp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	ContentTypeTextEventStream string = "text/event-stream"
+	LastEventIDHeader          string = "Last-Event-ID"
+)
+
+var ErrStreamingNotSupported = errors.New("response writer does not support streaming")
+
+// ServerSentEvent is an event of an event stream (text/event-stream)
+type ServerSentEvent struct {
+	Name  string
+	ID    string
+	Data  string
+	Retry time.Duration
+}
+
+// EventStream writes server-sent events to a response, the response is started with the first event
+type EventStream struct {
+	w       http.ResponseWriter
+	r       *http.Request
+	started bool
+}
+
+func NewEventStream(w http.ResponseWriter, r *http.Request) *EventStream {
+	return &EventStream{w: w, r: r}
+}
+
+// Send writes an event with JSON encoded data and flushes it to the client. Sending stops
+// with the error of the request context if the request is canceled.
+func (s *EventStream) Send(name, id string, data interface{}, retry time.Duration) error {
+
+	if err := s.r.Context().Err(); err != nil {
+		return err
+	}
+
+	flusher, ok := s.w.(http.Flusher)
+	if !ok {
+		return ErrStreamingNotSupported
+	}
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if !s.started {
+		// don't use Header().Set() or Header().Add(): https://github.com/golang/go/issues/5022
+		s.w.Header()["Content-Type"] = []string{ContentTypeTextEventStream}
+		s.w.Header()["Cache-Control"] = []string{"no-cache"}
+		s.w.WriteHeader(http.StatusOK)
+		s.started = true
+	}
+
+	var event strings.Builder
+	if name != "" {
+		fmt.Fprintf(&event, "event: %s\n", stripLineBreaks(name))
+	}
+	if id != "" {
+		fmt.Fprintf(&event, "id: %s\n", stripLineBreaks(id))
+	}
+	if retry > 0 {
+		fmt.Fprintf(&event, "retry: %d\n", retry.Milliseconds())
+	}
+	fmt.Fprintf(&event, "data: %s\n\n", encoded)
+
+	if _, err := io.WriteString(s.w, event.String()); err != nil {
+		return err
+	}
+	flusher.Flush()
+
+	return nil
+}
+
+// Started reports if an event was sent
+func (s *EventStream) Started() bool {
+	return s.started
+}
+
+func stripLineBreaks(s string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(s)
+}
+
+// EventReader reads server-sent events from a response body
+type EventReader struct {
+	body        io.ReadCloser
+	reader      *bufio.Reader
+	lastEventID string
+}
+
+func NewEventReader(body io.ReadCloser) *EventReader {
+	return &EventReader{body: body, reader: bufio.NewReader(body)}
+}
+
+// Next returns the next event of the stream, io.EOF is returned at the end of the stream
+func (r *EventReader) Next() (*ServerSentEvent, error) {
+
+	event := new(ServerSentEvent)
+	var data []string
+
+	for {
+		line, err := r.reader.ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			return nil, err
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			if len(data) == 0 {
+				event = new(ServerSentEvent)
+				continue
+			}
+			event.ID = r.lastEventID
+			event.Data = strings.Join(data, "\n")
+			return event, nil
+		}
+
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		field, value := line, ""
+		if i := strings.Index(line, ":"); i >= 0 {
+			field, value = line[:i], strings.TrimPrefix(line[i+1:], " ")
+		}
+
+		switch field {
+		case "event":
+			event.Name = value
+		case "data":
+			data = append(data, value)
+		case "id":
+			if !strings.Contains(value, "\x00") {
+				r.lastEventID = value
+			}
+		case "retry":
+			if retry, err := strconv.ParseInt(value, 10, 64); err == nil {
+				event.Retry = time.Duration(retry) * time.Millisecond
+			}
+		}
+	}
+}
+
+// LastEventID returns the ID of the last event, it is sent as Last-Event-ID header to resume the stream
+func (r *EventReader) LastEventID() string {
+	return r.lastEventID
+}
+
+func (r *EventReader) Close() error {
+	return r.body.Close()
+}
@@ -0,0 +1,90 @@
+package xhttp_test
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ExperienceOne/apikit/internal/framework/xhttp"
+)
+
+func TestEventStream(t *testing.T) {
+
+	recorder := httptest.NewRecorder()
+	stream := xhttp.NewEventStream(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if stream.Started() {
+		t.Fatal("stream is started before the first event")
+	}
+
+	if err := stream.Send("todo", "1", map[string]int{"id": 1}, 0); err != nil {
+		t.Fatalf("error sending event: %v", err)
+	}
+	if err := stream.Send("", "2\n", "done", 3*time.Second); err != nil {
+		t.Fatalf("error sending event: %v", err)
+	}
+
+	if !stream.Started() || !recorder.Flushed {
+		t.Fatal("stream is not started and flushed")
+	}
+	if contentType := recorder.Header().Get("Content-Type"); contentType != xhttp.ContentTypeTextEventStream {
+		t.Errorf("unexpected content type (actual: %q, expected: %q)", contentType, xhttp.ContentTypeTextEventStream)
+	}
+
+	want := "event: todo\nid: 1\ndata: {\"id\":1}\n\nid: 2\nretry: 3000\ndata: \"done\"\n\n"
+	if body := recorder.Body.String(); body != want {
+		t.Errorf("unexpected body (actual: %q, expected: %q)", body, want)
+	}
+}
+
+func TestEventStreamCanceled(t *testing.T) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	recorder := httptest.NewRecorder()
+	stream := xhttp.NewEventStream(recorder, httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx))
+
+	if err := stream.Send("todo", "", 1, 0); err != context.Canceled {
+		t.Fatalf("unexpected error (actual: %v, expected: %v)", err, context.Canceled)
+	}
+	if stream.Started() {
+		t.Fatal("canceled stream is started")
+	}
+}
+
+func TestEventReader(t *testing.T) {
+
+	body := ": comment\n\nevent: todo\nid: 1\ndata: {\"id\":\ndata: 1}\n\nretry: 500\r\ndata:plain\r\n\nid: 2\n\ndata: incomplete"
+	reader := xhttp.NewEventReader(ioutil.NopCloser(strings.NewReader(body)))
+
+	want := []xhttp.ServerSentEvent{
+		{Name: "todo", ID: "1", Data: "{\"id\":\n1}"},
+		{ID: "1", Data: "plain", Retry: 500 * time.Millisecond},
+	}
+
+	for _, event := range want {
+		got, err := reader.Next()
+		if err != nil {
+			t.Fatalf("error reading event: %v", err)
+		}
+		if *got != event {
+			t.Errorf("unexpected event (actual: %#v, expected: %#v)", *got, event)
+		}
+	}
+
+	if _, err := reader.Next(); err != io.EOF {
+		t.Fatalf("unexpected error at the end of the stream (actual: %v, expected: %v)", err, io.EOF)
+	}
+	if id := reader.LastEventID(); id != "2" {
+		t.Errorf("unexpected last event ID (actual: %q, expected: 2)", id)
+	}
+	if err := reader.Close(); err != nil {
+		t.Errorf("error closing reader: %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package json
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+
+	"github.com/ExperienceOne/apikit/internal/framework/xhttperror"
+)
+
+// ItemProducer returns the next item of a streamed response, io.EOF is returned after the last item
+type ItemProducer func() (interface{}, error)
+
+// ServeNdjson writes the items as newline delimited JSON, every item is flushed to the client. Errors are returned
+// as StreamError, because the status code was already written.
+func ServeNdjson(w http.ResponseWriter, status int, next ItemProducer) error {
+
+	// don't use Header().Set() or Header().Add(): https://github.com/golang/go/issues/5022
+	w.Header()["Content-Type"] = []string{"application/x-ndjson"}
+	w.WriteHeader(status)
+
+	encoder := json.NewEncoder(w)
+	err := streamItems(w, next, func(item interface{}, first bool) error {
+		return encoder.Encode(item)
+	})
+	if err != nil {
+		return &xhttperror.StreamError{Err: err}
+	}
+	return nil
+}
+
+// ServeJsonArray writes the items as JSON array, every item is flushed to the client. Errors are returned as
+// StreamError, because the status code was already written.
+func ServeJsonArray(w http.ResponseWriter, status int, next ItemProducer) error {
+
+	// don't use Header().Set() or Header().Add(): https://github.com/golang/go/issues/5022
+	w.Header()["Content-Type"] = []string{"application/json"}
+	w.WriteHeader(status)
+
+	if _, err := io.WriteString(w, "["); err != nil {
+		return &xhttperror.StreamError{Err: err}
+	}
+
+	err := streamItems(w, next, func(item interface{}, first bool) error {
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		data, err := json.Marshal(item)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	})
+	if err != nil {
+		return &xhttperror.StreamError{Err: err}
+	}
+
+	if _, err := io.WriteString(w, "]\n"); err != nil {
+		return &xhttperror.StreamError{Err: err}
+	}
+	return nil
+}
+
+func streamItems(w http.ResponseWriter, next ItemProducer, write func(item interface{}, first bool) error) error {
+
+	flusher, _ := w.(http.Flusher)
+
+	for first := true; ; first = false {
+		item, err := next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if err := write(item, first); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
+// SliceItems produces the elements of a slice, any other value is produced as single item
+func SliceItems(v interface{}) ItemProducer {
+
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		value = reflect.ValueOf([]interface{}{v})
+	}
+
+	i := 0
+	return func() (interface{}, error) {
+		if i >= value.Len() {
+			return nil, io.EOF
+		}
+		i++
+		return value.Index(i - 1).Interface(), nil
+	}
+}
+
+// ItemReader decodes the items of a newline delimited JSON response one by one
+type ItemReader struct {
+	body    io.ReadCloser
+	decoder *json.Decoder
+}
+
+func NewItemReader(body io.ReadCloser) *ItemReader {
+	return &ItemReader{body: body, decoder: json.NewDecoder(body)}
+}
+
+// Next decodes the next item into v, io.EOF is returned after the last item
+func (r *ItemReader) Next(v interface{}) error {
+	return r.decoder.Decode(v)
+}
+
+func (r *ItemReader) Close() error {
+	return r.body.Close()
+}
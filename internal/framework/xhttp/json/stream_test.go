@@ -0,0 +1,121 @@
+package json_test
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ExperienceOne/apikit/internal/framework/xhttp/json"
+	"github.com/ExperienceOne/apikit/internal/framework/xhttperror"
+)
+
+type item struct {
+	ID int `json:"id"`
+}
+
+func TestServeNdjson(t *testing.T) {
+
+	recorder := httptest.NewRecorder()
+	if err := json.ServeNdjson(recorder, 200, json.SliceItems([]item{{ID: 1}, {ID: 2}})); err != nil {
+		t.Fatalf("error serving items: %v", err)
+	}
+
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "application/x-ndjson" {
+		t.Errorf("unexpected content type: %s", contentType)
+	}
+	if body := recorder.Body.String(); body != "{\"id\":1}\n{\"id\":2}\n" {
+		t.Errorf("unexpected body: %q", body)
+	}
+	if !recorder.Flushed {
+		t.Error("items are not flushed")
+	}
+}
+
+func TestServeJsonArray(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		items interface{}
+		want  string
+	}{
+		{name: "items", items: []item{{ID: 1}, {ID: 2}}, want: "[{\"id\":1},{\"id\":2}]\n"},
+		{name: "no items", items: []item{}, want: "[]\n"},
+		{name: "single value", items: item{ID: 3}, want: "[{\"id\":3}]\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			if err := json.ServeJsonArray(recorder, 200, json.SliceItems(test.items)); err != nil {
+				t.Fatalf("error serving items: %v", err)
+			}
+			if body := recorder.Body.String(); body != test.want {
+				t.Errorf("unexpected body (actual: %q, expected: %q)", body, test.want)
+			}
+		})
+	}
+}
+
+func TestServeNdjsonError(t *testing.T) {
+
+	errProducer := errors.New("producer failed")
+	produced := false
+	next := func() (interface{}, error) {
+		if produced {
+			return nil, errProducer
+		}
+		produced = true
+		return item{ID: 1}, nil
+	}
+
+	recorder := httptest.NewRecorder()
+	err := json.ServeNdjson(recorder, 200, next)
+	var streamErr *xhttperror.StreamError
+	if !errors.As(err, &streamErr) || !errors.Is(err, errProducer) {
+		t.Fatalf("unexpected error (actual: %v, expected: stream error of %v)", err, errProducer)
+	}
+	if body := recorder.Body.String(); body != "{\"id\":1}\n" {
+		t.Errorf("unexpected body: %q", body)
+	}
+}
+
+func TestServeJsonArrayError(t *testing.T) {
+
+	errProducer := errors.New("producer failed")
+	next := func() (interface{}, error) {
+		return nil, errProducer
+	}
+
+	recorder := httptest.NewRecorder()
+	err := json.ServeJsonArray(recorder, 200, next)
+	var streamErr *xhttperror.StreamError
+	if !errors.As(err, &streamErr) || !errors.Is(err, errProducer) {
+		t.Fatalf("unexpected error (actual: %v, expected: stream error of %v)", err, errProducer)
+	}
+	if body := recorder.Body.String(); body != "[" {
+		t.Errorf("unexpected body: %q", body)
+	}
+}
+
+func TestItemReader(t *testing.T) {
+
+	reader := json.NewItemReader(ioutil.NopCloser(strings.NewReader("{\"id\":1}\n{\"id\":2}\n")))
+	defer reader.Close()
+
+	for _, want := range []int{1, 2} {
+		got := new(item)
+		if err := reader.Next(got); err != nil {
+			t.Fatalf("error reading item: %v", err)
+		}
+		if got.ID != want {
+			t.Errorf("unexpected item (actual: %d, expected: %d)", got.ID, want)
+		}
+	}
+
+	if err := reader.Next(new(item)); err != io.EOF {
+		t.Errorf("unexpected error at the end of the stream (actual: %v, expected: %v)", err, io.EOF)
+	}
+}
@@ -0,0 +1,22 @@
+package xhttp
+
+import (
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	RangeHeader        string = "Range"
+	IfRangeHeader      string = "If-Range"
+	ContentRangeHeader string = "Content-Range"
+	AcceptRangesHeader string = "Accept-Ranges"
+)
+
+// ServeRanges serves a seekable file and honors the Range and If-Range headers of the request. Single ranges
+// are answered with 206 (Partial Content), multiple ranges as multipart/byteranges and unsatisfiable ranges
+// with 416 (Range Not Satisfiable). The ETag of If-Range is compared with the ETag header of the response.
+func ServeRanges(w http.ResponseWriter, r *http.Request, content io.ReadSeeker, lastModified time.Time) {
+
+	http.ServeContent(w, r, "", lastModified, content)
+}
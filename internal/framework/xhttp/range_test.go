@@ -0,0 +1,58 @@
+package xhttp_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ExperienceOne/apikit/internal/framework/xhttp"
+)
+
+func TestServeRanges(t *testing.T) {
+
+	lastModified := time.Date(2021, 11, 27, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		header       map[string]string
+		status       int
+		contentRange string
+		body         string
+	}{
+		{name: "whole file", status: http.StatusOK, body: "0123456789"},
+		{name: "single range", header: map[string]string{"Range": "bytes=2-4"}, status: http.StatusPartialContent, contentRange: "bytes 2-4/10", body: "234"},
+		{name: "resume", header: map[string]string{"Range": "bytes=7-"}, status: http.StatusPartialContent, contentRange: "bytes 7-9/10", body: "789"},
+		{name: "unsatisfiable range", header: map[string]string{"Range": "bytes=20-"}, status: http.StatusRequestedRangeNotSatisfiable, contentRange: "bytes */10"},
+		{name: "if-range matches", header: map[string]string{"Range": "bytes=7-", "If-Range": "\"v1\""}, status: http.StatusPartialContent, contentRange: "bytes 7-9/10", body: "789"},
+		{name: "if-range changed", header: map[string]string{"Range": "bytes=7-", "If-Range": "\"v0\""}, status: http.StatusOK, body: "0123456789"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			for name, value := range test.header {
+				r.Header.Set(name, value)
+			}
+			recorder := httptest.NewRecorder()
+			xhttp.SetValidators(recorder.Header(), "v1", lastModified)
+			xhttp.ServeRanges(recorder, r, strings.NewReader("0123456789"), lastModified)
+
+			if recorder.Code != test.status {
+				t.Fatalf("unexpected status code (actual: %d, expected: %d)", recorder.Code, test.status)
+			}
+			if acceptRanges := recorder.Header().Get("Accept-Ranges"); test.status != http.StatusRequestedRangeNotSatisfiable && acceptRanges != "bytes" {
+				t.Errorf("unexpected accepted ranges: %s", acceptRanges)
+			}
+			if contentRange := recorder.Header().Get("Content-Range"); contentRange != test.contentRange {
+				t.Errorf("unexpected content range (actual: %s, expected: %s)", contentRange, test.contentRange)
+			}
+			if body, _ := ioutil.ReadAll(recorder.Body); test.body != "" && string(body) != test.body {
+				t.Errorf("unexpected body (actual: %s, expected: %s)", body, test.body)
+			}
+		})
+	}
+}
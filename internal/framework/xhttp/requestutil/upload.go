@@ -0,0 +1,273 @@
+package requestutil
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// maxUploadValuesSize limits the size of the form values which are read before the files of a streamed upload
+const maxUploadValuesSize int64 = 10 << 20
+
+var (
+	// ErrUploadTooLarge is returned if an uploaded file or the whole upload exceeds its limit
+	ErrUploadTooLarge = errors.New("upload too large")
+	// ErrUnsupportedUploadContentType is returned if the content type of an uploaded file isn't consumed by the operation
+	ErrUnsupportedUploadContentType = errors.New("unsupported content type of upload")
+)
+
+// UploadLimits limits the size of uploads, a zero limit doesn't limit the size.
+type UploadLimits struct {
+	// MaxFileSize is the maximum size of an uploaded file
+	MaxFileSize int64
+	// MaxTotalSize is the maximum size of the whole multipart request
+	MaxTotalSize int64
+}
+
+// LimitUploads limits the size of the multipart body of a request, reading more fails with ErrUploadTooLarge
+func LimitUploads(r *http.Request, limits UploadLimits) {
+
+	if limits.MaxTotalSize > 0 && r.Body != nil {
+		r.Body = &limitedBody{limitedReader: limitedReader{reader: r.Body, remaining: limits.MaxTotalSize}, closer: r.Body}
+	}
+}
+
+// ExtractUploads extracts all files of a field from a multipart request, a missing field is returned as empty list.
+// Files which exceed the file limit or whose content types aren't in the list of content types are rejected, an
+// empty list accepts all content types.
+func ExtractUploads(fileID string, r *http.Request, limits UploadLimits, contentTypes []string) ([]MimeFile, error) {
+
+	// uploaded files should be stored to disk instead of heap
+	// so we give the reader only one KB of buffer memory
+	if err := r.ParseMultipartForm(1024); err != nil {
+		if errors.Is(err, ErrUploadTooLarge) {
+			return nil, ErrUploadTooLarge
+		}
+		return nil, err
+	}
+
+	headers := r.MultipartForm.File[fileID]
+	files := make([]MimeFile, 0, len(headers))
+	for _, header := range headers {
+		if limits.MaxFileSize > 0 && header.Size > limits.MaxFileSize {
+			return nil, errors.Wrapf(ErrUploadTooLarge, "file '%s' of field '%s'", header.Filename, fileID)
+		}
+		if !acceptsUpload(contentTypes, header.Header.Get("Content-Type")) {
+			return nil, errors.Wrapf(ErrUnsupportedUploadContentType, "file '%s' of field '%s'", header.Filename, fileID)
+		}
+		file, err := header.Open()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, MimeFile{Header: header, Content: file})
+	}
+	return files, nil
+}
+
+// UploadErrorStatus returns the status code of a failed upload
+func UploadErrorStatus(err error) int {
+
+	switch {
+	case errors.Is(err, ErrUploadTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, ErrUnsupportedUploadContentType):
+		return http.StatusUnsupportedMediaType
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+// WriteUpload writes a file as part of a multipart request, the file name and content type of its header are
+// used if they are set
+func WriteUpload(writer *multipart.Writer, fieldName string, file *MimeFile) error {
+
+	fileName, contentType := fieldName, "application/octet-stream"
+	if file.Header != nil {
+		if file.Header.Filename != "" {
+			fileName = file.Header.Filename
+		}
+		if value := file.Header.Header.Get("Content-Type"); value != "" {
+			contentType = value
+		}
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, quoteEscaper.Replace(fieldName), quoteEscaper.Replace(fileName)))
+	header.Set("Content-Type", contentType)
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(part, file.Content)
+	return err
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// UploadReader reads the parts of a multipart request one after another without buffering the files. Reading a
+// file fails with ErrUploadTooLarge if the file or the whole request exceeds its limit.
+type UploadReader struct {
+	reader       *multipart.Reader
+	limits       UploadLimits
+	contentTypes []string
+	pending      *multipart.Part
+}
+
+// UploadPart is a part of a multipart request, its content is read from the request while reading the part.
+type UploadPart struct {
+	FieldName   string
+	FileName    string
+	ContentType string
+	Header      textproto.MIMEHeader
+	reader      io.Reader
+}
+
+func (p *UploadPart) Read(b []byte) (int, error) {
+	return p.reader.Read(b)
+}
+
+// NewUploadReader creates a reader of the parts of a multipart request, the content types of files are checked
+// like by ExtractUploads
+func NewUploadReader(r *http.Request, limits UploadLimits, contentTypes []string) (*UploadReader, error) {
+
+	LimitUploads(r, limits)
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+	return &UploadReader{reader: reader, limits: limits, contentTypes: contentTypes}, nil
+}
+
+// ReadValues reads the form values which precede the first file of the request, the file is returned by the
+// next call of Next. Values which follow a file aren't read, they are returned as parts by Next.
+func (u *UploadReader) ReadValues() (url.Values, error) {
+
+	values := make(url.Values)
+	remaining := maxUploadValuesSize
+
+	for {
+		part, err := u.reader.NextPart()
+		if err == io.EOF {
+			return values, nil
+		}
+		if err != nil {
+			return nil, u.error(err)
+		}
+		if part.FileName() != "" {
+			u.pending = part
+			return values, nil
+		}
+
+		var value bytes.Buffer
+		n, err := io.CopyN(&value, part, remaining+1)
+		if err != nil && err != io.EOF {
+			return nil, u.error(err)
+		}
+		remaining -= n
+		if remaining < 0 {
+			return nil, errors.Wrap(ErrUploadTooLarge, "form values")
+		}
+		values.Add(part.FormName(), value.String())
+	}
+}
+
+// Next returns the next part of the request, io.EOF is returned after the last part. The rest of the previous
+// part is skipped.
+func (u *UploadReader) Next() (*UploadPart, error) {
+
+	part := u.pending
+	u.pending = nil
+	if part == nil {
+		var err error
+		if part, err = u.reader.NextPart(); err != nil {
+			return nil, u.error(err)
+		}
+	}
+
+	contentType := part.Header.Get("Content-Type")
+	if part.FileName() != "" && !acceptsUpload(u.contentTypes, contentType) {
+		return nil, errors.Wrapf(ErrUnsupportedUploadContentType, "file '%s' of field '%s'", part.FileName(), part.FormName())
+	}
+
+	var reader io.Reader = part
+	if part.FileName() != "" && u.limits.MaxFileSize > 0 {
+		reader = &limitedReader{reader: part, remaining: u.limits.MaxFileSize}
+	}
+
+	return &UploadPart{
+		FieldName:   part.FormName(),
+		FileName:    part.FileName(),
+		ContentType: contentType,
+		Header:      part.Header,
+		reader:      reader,
+	}, nil
+}
+
+// error returns the cause of a failed read of the multipart reader, io.EOF is returned as it is
+func (u *UploadReader) error(err error) error {
+
+	if errors.Is(err, ErrUploadTooLarge) {
+		return ErrUploadTooLarge
+	}
+	return err
+}
+
+// acceptsUpload checks if the content type of a file is in the list of content types, an empty list accepts all
+func acceptsUpload(contentTypes []string, contentType string) bool {
+
+	if len(contentTypes) == 0 {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = "application/octet-stream"
+	}
+	for _, accepted := range contentTypes {
+		if strings.EqualFold(accepted, mediaType) {
+			return true
+		}
+	}
+	return false
+}
+
+// limitedBody is a limited request body
+type limitedBody struct {
+	limitedReader
+	closer io.Closer
+}
+
+func (b *limitedBody) Close() error {
+	return b.closer.Close()
+}
+
+// limitedReader fails with ErrUploadTooLarge if more than the remaining bytes are read
+type limitedReader struct {
+	reader    io.Reader
+	remaining int64
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+
+	if r.remaining < 0 {
+		return 0, ErrUploadTooLarge
+	}
+	if int64(len(p)) > r.remaining+1 {
+		p = p[:r.remaining+1]
+	}
+	n, err := r.reader.Read(p)
+	r.remaining -= int64(n)
+	if r.remaining < 0 {
+		return n + int(r.remaining), ErrUploadTooLarge
+	}
+	return n, err
+}
@@ -0,0 +1,139 @@
+package requestutil_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/ExperienceOne/apikit/internal/framework/xhttp/requestutil"
+	"github.com/pkg/errors"
+)
+
+func newMultipartRequest(t *testing.T, write func(writer *multipart.Writer)) *http.Request {
+
+	buff := new(bytes.Buffer)
+	writer := multipart.NewWriter(buff)
+	write(writer)
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "http://www.example.de", buff)
+	req.Header.Set("content-type", writer.FormDataContentType())
+	return req
+}
+
+func writeFile(t *testing.T, writer *multipart.Writer, field, name, contentType, content string) {
+
+	header := &multipart.FileHeader{Filename: name, Header: textproto.MIMEHeader{"Content-Type": []string{contentType}}}
+	file := &requestutil.MimeFile{Header: header, Content: ioutil.NopCloser(strings.NewReader(content))}
+	if err := requestutil.WriteUpload(writer, field, file); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExtractUploads(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		limits       requestutil.UploadLimits
+		contentTypes []string
+		files        int
+		err          error
+	}{
+		{name: "several files", files: 2},
+		{name: "accepted content types", contentTypes: []string{"image/png", "application/pdf"}, files: 2},
+		{name: "unsupported content type", contentTypes: []string{"image/png"}, err: requestutil.ErrUnsupportedUploadContentType},
+		{name: "file too large", limits: requestutil.UploadLimits{MaxFileSize: 4}, err: requestutil.ErrUploadTooLarge},
+		{name: "upload too large", limits: requestutil.UploadLimits{MaxTotalSize: 64}, err: requestutil.ErrUploadTooLarge},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			req := newMultipartRequest(t, func(writer *multipart.Writer) {
+				writeFile(t, writer, "documents", "logo.png", "image/png", "PNG")
+				writeFile(t, writer, "documents", "contract.pdf", "application/pdf", "%PDF-1.4")
+			})
+			requestutil.LimitUploads(req, test.limits)
+
+			files, err := requestutil.ExtractUploads("documents", req, test.limits, test.contentTypes)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("unexpected error (expected: %v, actual: %v)", test.err, err)
+			}
+			if len(files) != test.files {
+				t.Fatalf("unexpected number of files (expected: %d, actual: %d)", test.files, len(files))
+			}
+			for _, file := range files {
+				file.Content.Close()
+			}
+		})
+	}
+}
+
+func TestUploadReader(t *testing.T) {
+
+	req := newMultipartRequest(t, func(writer *multipart.Writer) {
+		writer.WriteField("note", "Hello world")
+		writeFile(t, writer, "files", "a.txt", "text/plain", "Hello")
+		writeFile(t, writer, "files", "b.txt", "text/plain", strings.Repeat("x", 16))
+	})
+
+	uploads, err := requestutil.NewUploadReader(req, requestutil.UploadLimits{MaxFileSize: 8}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := uploads.ReadValues()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if note := values.Get("note"); note != "Hello world" {
+		t.Fatalf("unexpected value of note: %s", note)
+	}
+
+	part, err := uploads.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadAll(part)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if part.FieldName != "files" || part.FileName != "a.txt" || part.ContentType != "text/plain" || string(data) != "Hello" {
+		t.Fatalf("unexpected part %s/%s (%s): %s", part.FieldName, part.FileName, part.ContentType, data)
+	}
+
+	part, err = uploads.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ioutil.ReadAll(part); err != requestutil.ErrUploadTooLarge {
+		t.Fatalf("file exceeding the limit is read: %v", err)
+	}
+
+	if _, err := uploads.Next(); err != io.EOF {
+		t.Fatalf("unexpected error after last part: %v", err)
+	}
+}
+
+func TestUploadReaderContentTypes(t *testing.T) {
+
+	req := newMultipartRequest(t, func(writer *multipart.Writer) {
+		writeFile(t, writer, "files", "a.txt", "text/plain", "Hello")
+	})
+
+	uploads, err := requestutil.NewUploadReader(req, requestutil.UploadLimits{}, []string{"image/png"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uploads.Next(); !errors.Is(err, requestutil.ErrUnsupportedUploadContentType) {
+		t.Fatalf("unsupported content type is accepted: %v", err)
+	}
+}
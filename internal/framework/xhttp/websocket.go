@@ -0,0 +1,157 @@
+package xhttp
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// MessageValidator validates a message of a WebSocket, the returned error describes why the message is invalid
+type MessageValidator func(message interface{}) error
+
+const webSocketCloseTimeout = time.Second
+
+// WebSocketOpts configures the upgrade of the WebSockets of a server
+type WebSocketOpts struct {
+	// CheckOrigin accepts the origin of a handshake, by default handshakes whose origin differs from the host are
+	// rejected with 403 Forbidden
+	CheckOrigin func(r *http.Request) bool
+	// ReadBufferSize and WriteBufferSize are the sizes of the I/O buffers in bytes, the buffers of the HTTP server
+	// are used if they're zero
+	ReadBufferSize  int
+	WriteBufferSize int
+	// HandshakeTimeout limits the duration of the handshake, it isn't limited if it's zero
+	HandshakeTimeout time.Duration
+}
+
+// WebSocket exchanges JSON encoded messages, on the server it's upgraded with the first read or write
+type WebSocket struct {
+	w        http.ResponseWriter
+	r        *http.Request
+	opts     WebSocketOpts
+	conn     *websocket.Conn
+	started  bool
+	validate MessageValidator
+}
+
+// NewWebSocket creates the WebSocket of a request, it's upgraded with the options and messages are validated by
+// the validator if it's not nil
+func NewWebSocket(w http.ResponseWriter, r *http.Request, opts WebSocketOpts, validate MessageValidator) *WebSocket {
+	return &WebSocket{w: w, r: r, opts: opts, validate: validate}
+}
+
+// DialWebSocket opens a WebSocket with the URL, headers and context of the request, the cookie jar and the
+// timeout of the client are used for the handshake. The handshake isn't sent by the transport of the client, only
+// the TLS configuration, the proxy and the dialer of a *http.Transport are used, wrapping RoundTrippers are skipped.
+func DialWebSocket(client *http.Client, request *http.Request, validate MessageValidator) (*WebSocket, *http.Response, error) {
+
+	var transport http.RoundTripper = http.DefaultTransport
+	dialer := &websocket.Dialer{Proxy: http.ProxyFromEnvironment}
+	if client != nil {
+		dialer.HandshakeTimeout = client.Timeout
+		dialer.Jar = client.Jar
+		if client.Transport != nil {
+			transport = client.Transport
+		}
+	}
+
+	if t, ok := transport.(*http.Transport); ok {
+		dialer.TLSClientConfig = t.TLSClientConfig.Clone()
+		dialer.Proxy = t.Proxy
+		dialer.NetDialContext = t.DialContext
+	}
+
+	u := *request.URL
+	u.Scheme = strings.Replace(u.Scheme, "http", "ws", 1)
+
+	conn, response, err := dialer.DialContext(request.Context(), u.String(), request.Header)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return &WebSocket{conn: conn, started: true, validate: validate}, response, nil
+}
+
+// Upgrade switches the protocol of the request, the upgrade responds with an error if the request is no WebSocket handshake
+func (s *WebSocket) Upgrade() error {
+
+	if s.started {
+		if s.conn == nil {
+			return websocket.ErrBadHandshake
+		}
+		return nil
+	}
+	s.started = true
+
+	upgrader := &websocket.Upgrader{
+		CheckOrigin:      s.opts.CheckOrigin,
+		ReadBufferSize:   s.opts.ReadBufferSize,
+		WriteBufferSize:  s.opts.WriteBufferSize,
+		HandshakeTimeout: s.opts.HandshakeTimeout,
+	}
+	conn, err := upgrader.Upgrade(s.w, s.r, nil)
+	if err != nil {
+		return err
+	}
+	s.conn = conn
+
+	return nil
+}
+
+// Started reports if the response was sent by the upgrade
+func (s *WebSocket) Started() bool {
+	return s.started
+}
+
+// Read reads and validates the next message, io.EOF is returned if the peer closed the WebSocket
+func (s *WebSocket) Read(message interface{}) error {
+
+	if err := s.Upgrade(); err != nil {
+		return err
+	}
+
+	if err := s.conn.ReadJSON(message); err != nil {
+		if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+			return io.EOF
+		}
+		return err
+	}
+
+	if s.validate != nil {
+		return s.validate(message)
+	}
+	return nil
+}
+
+// Write validates and writes a message
+func (s *WebSocket) Write(message interface{}) error {
+
+	if s.validate != nil {
+		if err := s.validate(message); err != nil {
+			return err
+		}
+	}
+
+	if err := s.Upgrade(); err != nil {
+		return err
+	}
+	return s.conn.WriteJSON(message)
+}
+
+// Close closes the WebSocket after sending a close message to the peer
+func (s *WebSocket) Close() error {
+
+	if s.conn == nil {
+		return nil
+	}
+
+	message := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	if err := s.conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(webSocketCloseTimeout)); err != nil && err != websocket.ErrCloseSent {
+		s.conn.Close()
+		return err
+	}
+	return s.conn.Close()
+}
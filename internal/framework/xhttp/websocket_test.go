@@ -0,0 +1,217 @@
+package xhttp_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ExperienceOne/apikit/internal/framework/xhttp"
+)
+
+type message struct {
+	Text string `json:"text"`
+}
+
+var errEmptyMessage = errors.New("empty message")
+
+func validateMessage(m interface{}) error {
+	if m.(*message).Text == "" {
+		return errEmptyMessage
+	}
+	return nil
+}
+
+func TestWebSocket(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		socket := xhttp.NewWebSocket(w, r, xhttp.WebSocketOpts{}, validateMessage)
+		defer socket.Close()
+
+		for {
+			m := new(message)
+			if err := socket.Read(m); err != nil {
+				return
+			}
+			m.Text += "!"
+			if err := socket.Write(m); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	socket, response, err := xhttp.DialWebSocket(server.Client(), request, validateMessage)
+	if err != nil {
+		t.Fatalf("error dialing WebSocket: %v", err)
+	}
+	if response.StatusCode != http.StatusSwitchingProtocols {
+		t.Errorf("unexpected status code (actual: %d, expected: %d)", response.StatusCode, http.StatusSwitchingProtocols)
+	}
+
+	if err := socket.Write(&message{Text: "hello"}); err != nil {
+		t.Fatalf("error writing message: %v", err)
+	}
+	got := new(message)
+	if err := socket.Read(got); err != nil {
+		t.Fatalf("error reading message: %v", err)
+	}
+	if got.Text != "hello!" {
+		t.Errorf("unexpected message (actual: %q, expected: %q)", got.Text, "hello!")
+	}
+
+	if err := socket.Write(&message{}); err != errEmptyMessage {
+		t.Errorf("unexpected error writing invalid message (actual: %v, expected: %v)", err, errEmptyMessage)
+	}
+
+	if err := socket.Close(); err != nil {
+		t.Errorf("error closing WebSocket: %v", err)
+	}
+}
+
+func TestWebSocketClosedByPeer(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		socket := xhttp.NewWebSocket(w, r, xhttp.WebSocketOpts{}, nil)
+		if err := socket.Upgrade(); err == nil {
+			socket.Close()
+		}
+	}))
+	defer server.Close()
+
+	request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	socket, _, err := xhttp.DialWebSocket(server.Client(), request, nil)
+	if err != nil {
+		t.Fatalf("error dialing WebSocket: %v", err)
+	}
+	defer socket.Close()
+
+	if err := socket.Read(new(message)); err != io.EOF {
+		t.Errorf("unexpected error (actual: %v, expected: %v)", err, io.EOF)
+	}
+}
+
+func TestWebSocketCheckOrigin(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		opts   xhttp.WebSocketOpts
+		status int
+	}{
+		{
+			name:   "cross-origin rejected by default",
+			status: http.StatusForbidden,
+		},
+		{
+			name: "accepted origin",
+			opts: xhttp.WebSocketOpts{
+				CheckOrigin: func(r *http.Request) bool {
+					return r.Header.Get("Origin") == "https://partners.example.com"
+				},
+				ReadBufferSize:  256,
+				WriteBufferSize: 256,
+			},
+			status: http.StatusSwitchingProtocols,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+				socket := xhttp.NewWebSocket(w, r, test.opts, nil)
+				defer socket.Close()
+
+				socket.Write(&message{Text: "hello"})
+			}))
+			defer server.Close()
+
+			request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			request.Header.Set("Origin", "https://partners.example.com")
+
+			socket, response, err := xhttp.DialWebSocket(server.Client(), request, nil)
+			if response == nil {
+				t.Fatalf("error dialing WebSocket: %v", err)
+			}
+			if response.StatusCode != test.status {
+				t.Fatalf("unexpected status code (actual: %d, expected: %d)", response.StatusCode, test.status)
+			}
+			if socket == nil {
+				return
+			}
+			defer socket.Close()
+
+			m := new(message)
+			if err := socket.Read(m); err != nil || m.Text != "hello" {
+				t.Errorf("unexpected message: %v, %v", m, err)
+			}
+		})
+	}
+}
+
+func TestWebSocketNoHandshake(t *testing.T) {
+
+	recorder := httptest.NewRecorder()
+	socket := xhttp.NewWebSocket(recorder, httptest.NewRequest(http.MethodGet, "/", nil), xhttp.WebSocketOpts{}, nil)
+
+	if err := socket.Write(&message{Text: "hello"}); err == nil {
+		t.Fatal("expected an error writing to a request without handshake")
+	}
+	if !socket.Started() {
+		t.Error("response is not started by the failed upgrade")
+	}
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("unexpected status code (actual: %d, expected: %d)", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func TestWebSocketTransport(t *testing.T) {
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		socket := xhttp.NewWebSocket(w, r, xhttp.WebSocketOpts{}, nil)
+		defer socket.Close()
+
+		if err := socket.Write(&message{Text: r.Header.Get("Authorization")}); err != nil {
+			return
+		}
+	}))
+	defer server.Close()
+
+	request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Set("Authorization", "Bearer token")
+
+	// the client of the test server trusts its certificate by the TLS configuration of its transport
+	socket, _, err := xhttp.DialWebSocket(server.Client(), request, nil)
+	if err != nil {
+		t.Fatalf("error dialing WebSocket: %v", err)
+	}
+	defer socket.Close()
+
+	got := new(message)
+	if err := socket.Read(got); err != nil {
+		t.Fatalf("error reading message: %v", err)
+	}
+	if got.Text != "Bearer token" {
+		t.Errorf("unexpected header of the handshake (actual: %q, expected: %q)", got.Text, "Bearer token")
+	}
+}
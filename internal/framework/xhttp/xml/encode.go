@@ -0,0 +1,21 @@
+package xml
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+)
+
+func ServeXml(w http.ResponseWriter, contentType string, status int, v interface{}) error {
+
+	// don't use Header().Set() or Header().Add(): https://github.com/golang/go/issues/5022
+	w.Header()["Content-Type"] = []string{contentType}
+	w.WriteHeader(status)
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	if err := xml.NewEncoder(w).Encode(v); err != nil {
+		return err
+	}
+	return nil
+}
@@ -1,8 +1,11 @@
 package xhttperror
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+
+	"github.com/ExperienceOne/apikit/internal/framework/validation"
 )
 
 // HTTPError represents an HTTP error with HTTP status code and error message
@@ -50,3 +53,77 @@ func (e *HttpJsonError) StatusCode() int {
 func (e *HttpJsonError) Error() string {
 	return fmt.Sprintf("%s: %v", http.StatusText(e.statusCode), e.Message)
 }
+
+// HttpXmlError is the XML counterpart of HttpJsonError, the message is written as XML document of the content type
+type HttpXmlError struct {
+	statusCode  int
+	ContentType string
+	Message     interface{}
+	// Errors are the validation errors of the message, problem details include them
+	Errors *validation.ValidationErrorsObject
+}
+
+// NewXmlValidationHTTPError creates the error of an invalid request whose operation negotiated XML and declares the
+// validation errors for its 400 response. The errors are copied into body, a value of the generated type of the
+// declared errors, so the XML document matches the schema including the name of its root element.
+func NewXmlValidationHTTPError(contentType string, errors *validation.ValidationErrorsObject, body interface{}) XHTTPError {
+
+	// the JSON encoding of the errors matches the schema, its names are matched case-insensitively by the generated type
+	data, err := json.Marshal(errors)
+	if err == nil {
+		err = json.Unmarshal(data, body)
+	}
+	if err != nil {
+		return NewJsonHTTPError(http.StatusBadRequest, errors)
+	}
+	return &HttpXmlError{statusCode: http.StatusBadRequest, ContentType: contentType, Message: body, Errors: errors}
+}
+
+// StatusCode returns the HTTP status code.
+func (e *HttpXmlError) StatusCode() int {
+	return e.statusCode
+}
+
+func (e *HttpXmlError) Error() string {
+	if e.Errors != nil {
+		return fmt.Sprintf("%s: %s", http.StatusText(e.statusCode), e.Errors.Message)
+	}
+	return fmt.Sprintf("%s: %v", http.StatusText(e.statusCode), e.Message)
+}
+
+// HttpValidationError is the error of an invalid request whose operation doesn't declare the validation errors for
+// its 400 response. The default error renderer writes the status code only, problem details include the errors.
+type HttpValidationError struct {
+	Errors *validation.ValidationErrorsObject
+}
+
+// NewValidationHTTPError creates a new HttpValidationError instance.
+func NewValidationHTTPError(errors *validation.ValidationErrorsObject) XHTTPError {
+	return &HttpValidationError{Errors: errors}
+}
+
+// StatusCode returns the HTTP status code.
+func (e *HttpValidationError) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+func (e *HttpValidationError) Error() string {
+	if e.Errors == nil {
+		return http.StatusText(http.StatusBadRequest)
+	}
+	return fmt.Sprintf("%s: %s", http.StatusText(http.StatusBadRequest), e.Errors.Message)
+}
+
+// StreamError is returned if a streamed response fails after its status code was written. The response can't be
+// replaced by an error response anymore, so the server aborts it instead of rendering the error.
+type StreamError struct {
+	Err error
+}
+
+func (e *StreamError) Error() string {
+	return fmt.Sprintf("error streaming response: %v", e.Err)
+}
+
+func (e *StreamError) Unwrap() error {
+	return e.Err
+}
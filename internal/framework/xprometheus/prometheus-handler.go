@@ -61,3 +61,52 @@ func (h *PrometheusHandler) HandleRequest(path, method string, status int, durat
 	h.counter.WithLabelValues(path, method, strconv.Itoa(status)).Inc()
 	h.histogram.WithLabelValues(path).Observe(duration.Seconds())
 }
+
+// CircuitBreakerHandler exports the states of the circuits of a circuit breaker
+type CircuitBreakerHandler struct {
+	state   *prometheus.GaugeVec
+	changes *prometheus.CounterVec
+}
+
+func NewCircuitBreakerHandler(namespace *string) *CircuitBreakerHandler {
+
+	stateName := "api_circuit_breaker_state"
+	changesName := "api_circuit_breaker_state_changes_total"
+
+	if namespace != nil {
+		stateName = fmt.Sprintf("%s_%s", *namespace, stateName)
+		changesName = fmt.Sprintf("%s_%s", *namespace, changesName)
+	}
+
+	state := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: stateName,
+		Help: "Current state of the circuits, the gauge of the current state is 1.",
+	}, []string{"circuit", "state"})
+
+	changes := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: changesName,
+		Help: "Total number of state changes of the circuits.",
+	}, []string{"circuit", "from", "to"})
+
+	if err := prometheus.Register(state); err != nil {
+		logrus.WithError(err).Warn("failed to register prometheus gauge")
+	}
+
+	if err := prometheus.Register(changes); err != nil {
+		logrus.WithError(err).Warn("failed to register prometheus counter")
+	}
+
+	return &CircuitBreakerHandler{
+		state:   state,
+		changes: changes,
+	}
+}
+
+// HandleStateChange records the state change of a circuit, it's passed as OnStateChange callback of
+// roundtripper.CircuitBreaker
+func (h *CircuitBreakerHandler) HandleStateChange(circuit, from, to string) {
+
+	h.state.WithLabelValues(circuit, from).Set(0)
+	h.state.WithLabelValues(circuit, to).Set(1)
+	h.changes.WithLabelValues(circuit, from, to).Inc()
+}
@@ -0,0 +1,93 @@
+package xserver
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ExperienceOne/apikit/internal/framework/xhttperror"
+	"github.com/pkg/errors"
+)
+
+// ErrorRenderer writes the response for an error returned by a handler or for a panic of the middleware
+// and handlers. In case of a panic recovered holds the recovered value and err describes the panic.
+type ErrorRenderer func(w http.ResponseWriter, r *http.Request, err error, recovered interface{})
+
+// ErrorReporter is called with every error before it is rendered, e.g. to report it to an external sink.
+type ErrorReporter func(r *http.Request, err error, recovered interface{})
+
+// PanicError is the error of a recovered panic
+type PanicError struct {
+	Recovered interface{}
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("recovered from panic: %v", e.Recovered)
+}
+
+// renderError reports the error and writes it with the error renderer of the server
+func (server *Server) renderError(w http.ResponseWriter, r *http.Request, err error, recovered interface{}) {
+
+	if server.errorReporter != nil {
+		server.errorReporter(r, err, recovered)
+	}
+
+	// the status code and a part of the body of a streamed response are already sent, an error response would
+	// corrupt the stream, so the connection is aborted to tell the client that the response is incomplete
+	var streamErr *xhttperror.StreamError
+	if errors.As(err, &streamErr) {
+		server.ErrorLogger(fmt.Sprintf("aborted streamed response: %v", streamErr.Err))
+		panic(http.ErrAbortHandler)
+	}
+
+	switch {
+	case server.errorRenderer != nil:
+		server.errorRenderer(w, r, err, recovered)
+	case server.problemDetails:
+		server.writeProblem(w, r, err)
+	default:
+		server.writeError(w, r, err)
+	}
+}
+
+// writeError is the default error renderer. JSON and XML errors are written as JSON and XML, errors with a status code
+// as text and all other errors as internal server error without details.
+func (server *Server) writeError(w http.ResponseWriter, r *http.Request, err error) {
+
+	switch errType := err.(type) {
+	case *xhttperror.HttpJsonError:
+		w.Header()["Content-Type"] = []string{"application/json"}
+		w.WriteHeader(errType.StatusCode())
+		if e := json.NewEncoder(w).Encode(errType.Message); e != nil {
+			server.ErrorLogger(fmt.Sprintf("failed to write error message: %v", errType.Message))
+		}
+	case *xhttperror.HttpXmlError:
+		w.Header()["Content-Type"] = []string{errType.ContentType}
+		w.WriteHeader(errType.StatusCode())
+		if _, e := io.WriteString(w, xml.Header); e != nil {
+			server.ErrorLogger(fmt.Sprintf("failed to write error message: %v", errType.Message))
+		} else if e := xml.NewEncoder(w).Encode(errType.Message); e != nil {
+			server.ErrorLogger(fmt.Sprintf("failed to write error message: %v", errType.Message))
+		}
+	case *xhttperror.HttpCodeError, *xhttperror.HttpValidationError:
+		w.Header()["Content-Type"] = []string{""}
+		w.WriteHeader(errType.(xhttperror.XHTTPError).StatusCode())
+	case *PanicError:
+		w.WriteHeader(http.StatusInternalServerError)
+	case interface{ StatusCode() int }:
+		w.Header()["Content-Type"] = []string{"text/plain; charset=utf-8"}
+		w.WriteHeader(errType.StatusCode())
+		if _, e := w.Write([]byte(err.Error())); e != nil {
+			server.ErrorLogger(fmt.Sprintf("failed to write error message: %v", err.Error()))
+		}
+	default:
+		server.ErrorLogger(fmt.Sprintf("unexpected error: %v", err))
+		w.Header()["Content-Type"] = []string{"text/plain; charset=utf-8"}
+		w.WriteHeader(http.StatusInternalServerError)
+		if _, e := w.Write([]byte(http.StatusText(http.StatusInternalServerError))); e != nil {
+			server.ErrorLogger(fmt.Sprintf("failed to write error message: %v", err.Error()))
+		}
+	}
+}
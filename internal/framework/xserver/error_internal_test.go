@@ -0,0 +1,238 @@
+package xserver
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ExperienceOne/apikit/internal/framework/validation"
+	"github.com/ExperienceOne/apikit/internal/framework/xhttperror"
+)
+
+type teapotError struct{}
+
+func (teapotError) Error() string   { return "short and stout" }
+func (teapotError) StatusCode() int { return http.StatusTeapot }
+
+type xmlValidationErrors struct {
+	XMLName xml.Name `xml:"errors"`
+	Message string   `xml:"message"`
+}
+
+func errorRoutes() []RouteDescription {
+
+	handler := func(err error) HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) error {
+			return err
+		}
+	}
+
+	return []RouteDescription{
+		{Method: http.MethodGet, Path: "/json", Handler: handler(xhttperror.NewJsonHTTPError(http.StatusBadRequest, "bad"))},
+		{Method: http.MethodGet, Path: "/xml", Handler: handler(xhttperror.NewXmlValidationHTTPError("text/xml", validation.NewParameterError("id", validation.LocationPath, validation.CodeRequired, "path parameter 'id' is required"), new(xmlValidationErrors)))},
+		{Method: http.MethodGet, Path: "/code", Handler: handler(xhttperror.NewHTTPStatusCodeError(http.StatusNotFound))},
+		{Method: http.MethodGet, Path: "/validation", Handler: handler(xhttperror.NewValidationHTTPError(validation.NewParameterError("id", validation.LocationPath, validation.CodeRequired, "path parameter 'id' is required")))},
+		{Method: http.MethodGet, Path: "/status", Handler: handler(teapotError{})},
+		{Method: http.MethodGet, Path: "/unknown", Handler: handler(errors.New("secret"))},
+		{
+			Method: http.MethodGet,
+			Path:   "/panic",
+			Handler: func(w http.ResponseWriter, r *http.Request) error {
+				panic("panic")
+			},
+		},
+	}
+}
+
+func TestDefaultErrorRenderer(t *testing.T) {
+
+	router, err := NewServer(&ServerOpts{}).makeRouter(errorRoutes())
+	if err != nil {
+		t.Fatalf("makeRouter() failed: %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		path            string
+		wantStatus      int
+		wantContentType string
+		wantBody        string
+	}{
+		{
+			name:            "JsonError",
+			path:            "/json",
+			wantStatus:      http.StatusBadRequest,
+			wantContentType: "application/json",
+			wantBody:        "\"bad\"\n",
+		},
+		{
+			name:            "XmlError",
+			path:            "/xml",
+			wantStatus:      http.StatusBadRequest,
+			wantContentType: "text/xml",
+			wantBody:        xml.Header + "<errors><message>validation failed</message></errors>",
+		},
+		{
+			name:       "CodeError",
+			path:       "/code",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "ValidationError",
+			path:       "/validation",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:            "StatusCodeError",
+			path:            "/status",
+			wantStatus:      http.StatusTeapot,
+			wantContentType: "text/plain; charset=utf-8",
+			wantBody:        "short and stout",
+		},
+		{
+			name:            "UnknownError",
+			path:            "/unknown",
+			wantStatus:      http.StatusInternalServerError,
+			wantContentType: "text/plain; charset=utf-8",
+			wantBody:        http.StatusText(http.StatusInternalServerError),
+		},
+		{
+			name:       "Panic",
+			path:       "/panic",
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, tc.path, nil))
+
+			if recorder.Code != tc.wantStatus {
+				t.Fatalf("status code = %d, want %d", recorder.Code, tc.wantStatus)
+			}
+			if contentType := recorder.Header().Get("Content-Type"); contentType != tc.wantContentType {
+				t.Fatalf("content type = %q, want %q", contentType, tc.wantContentType)
+			}
+			if body := recorder.Body.String(); body != tc.wantBody {
+				t.Fatalf("body = %q, want %q", body, tc.wantBody)
+			}
+		})
+	}
+}
+
+func TestErrorRenderer(t *testing.T) {
+
+	var reported []error
+	var recovered interface{}
+
+	server := NewServer(&ServerOpts{
+		ErrorRenderer: func(w http.ResponseWriter, r *http.Request, err error, rec interface{}) {
+			recovered = rec
+			w.Header().Set("X-Error", err.Error())
+			w.WriteHeader(http.StatusServiceUnavailable)
+		},
+		ErrorReporter: func(r *http.Request, err error, rec interface{}) {
+			reported = append(reported, err)
+		},
+	})
+
+	router, err := server.makeRouter(errorRoutes())
+	if err != nil {
+		t.Fatalf("makeRouter() failed: %v", err)
+	}
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/unknown", nil))
+
+	if recorder.Code != http.StatusServiceUnavailable || recorder.Header().Get("X-Error") != "secret" {
+		t.Fatalf("error is not rendered by the error renderer: %d %v", recorder.Code, recorder.Header())
+	}
+	if recovered != nil {
+		t.Fatalf("recovered = %v, want nil", recovered)
+	}
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/panic", nil))
+
+	if recorder.Code != http.StatusServiceUnavailable {
+		t.Fatalf("panic is not rendered by the error renderer: %d", recorder.Code)
+	}
+	if recovered != "panic" {
+		t.Fatalf("recovered = %v, want panic", recovered)
+	}
+
+	if len(reported) != 2 {
+		t.Fatalf("reported errors = %v, want 2 errors", reported)
+	}
+	if _, ok := reported[1].(*PanicError); !ok {
+		t.Fatalf("reported error = %T, want *PanicError", reported[1])
+	}
+}
+
+func TestStreamErrorAbortsResponse(t *testing.T) {
+
+	var reported []error
+	var logged []interface{}
+
+	server := NewServer(&ServerOpts{
+		ErrorHandler: func(v ...interface{}) {
+			logged = append(logged, v...)
+		},
+		ErrorReporter: func(r *http.Request, err error, rec interface{}) {
+			reported = append(reported, err)
+		},
+	})
+
+	router, err := server.makeRouter([]RouteDescription{
+		{
+			Method: http.MethodGet,
+			Path:   "/stream",
+			Handler: func(w http.ResponseWriter, r *http.Request) error {
+				w.Header().Set("Content-Type", "application/x-ndjson")
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintln(w, `{"id":1}`)
+				w.(http.Flusher).Flush()
+				return &xhttperror.StreamError{Err: errors.New("producer failed")}
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("makeRouter() failed: %v", err)
+	}
+
+	ts := httptest.NewUnstartedServer(router)
+	ts.Config.ErrorLog = log.New(ioutil.Discard, "", 0)
+	ts.Start()
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/stream")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status code = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err == nil {
+		t.Fatalf("response isn't aborted, body = %q", body)
+	}
+	if string(body) != "{\"id\":1}\n" {
+		t.Fatalf("body = %q, want the streamed item only", body)
+	}
+
+	if len(reported) != 1 {
+		t.Fatalf("reported errors = %v, want 1 error", reported)
+	}
+	if len(logged) != 1 {
+		t.Fatalf("logged = %v, want the aborted response", logged)
+	}
+}
@@ -0,0 +1,110 @@
+package xserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/ExperienceOne/apikit/internal/framework/validation"
+	"github.com/ExperienceOne/apikit/internal/framework/xhttperror"
+)
+
+const (
+	contentTypeApplicationProblemJson = "application/problem+json"
+	requestIdHeader                   = "X-Request-ID"
+)
+
+// Problem is the body of an error response in the format of RFC 7807 (application/problem+json)
+type Problem struct {
+	Type     string
+	Title    string
+	Status   int
+	Detail   string
+	Instance string
+	// Extensions are additional members of the problem, members of the RFC can't be overwritten
+	Extensions map[string]interface{}
+}
+
+// ProblemExtension is called with every problem before it is written and can add custom members
+type ProblemExtension func(r *http.Request, err error, problem *Problem)
+
+func (p *Problem) MarshalJSON() ([]byte, error) {
+
+	members := make(map[string]interface{}, len(p.Extensions)+5)
+	for key, value := range p.Extensions {
+		members[key] = value
+	}
+
+	members["type"] = p.Type
+	members["title"] = p.Title
+	members["status"] = p.Status
+	if p.Detail != "" {
+		members["detail"] = p.Detail
+	}
+	if p.Instance != "" {
+		members["instance"] = p.Instance
+	}
+
+	return json.Marshal(members)
+}
+
+// NewProblem describes the error of a request as problem. The status code is taken from errors
+// implementing StatusCode() int, other errors are reported as internal server errors without details.
+// The request ID (X-Request-ID header) identifies the occurrence of the problem.
+func NewProblem(r *http.Request, err error) *Problem {
+
+	problem := &Problem{
+		Type:       "about:blank",
+		Status:     http.StatusInternalServerError,
+		Instance:   r.Header.Get(requestIdHeader),
+		Extensions: make(map[string]interface{}),
+	}
+
+	if statusErr, ok := err.(interface{ StatusCode() int }); ok {
+		problem.Status = statusErr.StatusCode()
+		problem.Detail = err.Error()
+	}
+
+	problem.Title = http.StatusText(problem.Status)
+	if problem.Detail == problem.Title {
+		problem.Detail = ""
+	}
+
+	if validationErr, ok := err.(*xhttperror.HttpValidationError); ok && validationErr.Errors != nil {
+		problem.Detail = validationErr.Errors.Message
+		problem.Extensions["errors"] = validationErr.Errors.Errors
+	}
+
+	if xmlErr, ok := err.(*xhttperror.HttpXmlError); ok && xmlErr.Errors != nil {
+		problem.Detail = xmlErr.Errors.Message
+		problem.Extensions["errors"] = xmlErr.Errors.Errors
+	}
+
+	if jsonErr, ok := err.(*xhttperror.HttpJsonError); ok {
+		problem.Detail = ""
+		switch message := jsonErr.Message.(type) {
+		case *validation.ValidationErrorsObject:
+			problem.Detail = message.Message
+			problem.Extensions["errors"] = message.Errors
+		case string:
+			problem.Detail = message
+		default:
+			problem.Extensions["message"] = message
+		}
+	}
+
+	return problem
+}
+
+func (server *Server) writeProblem(w http.ResponseWriter, r *http.Request, err error) {
+
+	problem := NewProblem(r, err)
+	if server.problemExtension != nil {
+		server.problemExtension(r, err, problem)
+	}
+
+	w.Header()["Content-Type"] = []string{contentTypeApplicationProblemJson}
+	w.WriteHeader(problem.Status)
+	if e := json.NewEncoder(w).Encode(problem); e != nil {
+		server.ErrorLogger("failed to write problem: " + e.Error())
+	}
+}
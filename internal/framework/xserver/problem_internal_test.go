@@ -0,0 +1,173 @@
+package xserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ExperienceOne/apikit/internal/framework/validation"
+	"github.com/ExperienceOne/apikit/internal/framework/xhttperror"
+)
+
+type unsupportedContentType struct{}
+
+func (unsupportedContentType) Error() string   { return "error unsupported media type (text/plain)" }
+func (unsupportedContentType) StatusCode() int { return http.StatusUnsupportedMediaType }
+
+func TestProblemDetails(t *testing.T) {
+
+	server := NewServer(&ServerOpts{
+		ProblemDetails: true,
+		ProblemExtension: func(r *http.Request, err error, problem *Problem) {
+			problem.Extensions["path"] = r.URL.Path
+		},
+	})
+
+	routes := []RouteDescription{
+		{
+			Method: http.MethodGet,
+			Path:   "/validation",
+			Handler: func(w http.ResponseWriter, r *http.Request) error {
+				return xhttperror.NewJsonHTTPError(http.StatusBadRequest, validation.NewParameterError("id", validation.LocationPath, validation.CodeRequired, "path parameter 'id' is required"))
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/xml-validation",
+			Handler: func(w http.ResponseWriter, r *http.Request) error {
+				return xhttperror.NewXmlValidationHTTPError("application/xml", validation.NewParameterError("id", validation.LocationPath, validation.CodeRequired, "path parameter 'id' is required"), new(xmlValidationErrors))
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/undeclared-validation",
+			Handler: func(w http.ResponseWriter, r *http.Request) error {
+				return xhttperror.NewValidationHTTPError(validation.NewParameterError("limit", validation.LocationQuery, validation.CodeInvalidType, "invalid syntax"))
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/unsupported",
+			Handler: func(w http.ResponseWriter, r *http.Request) error {
+				return unsupportedContentType{}
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/missing",
+			Handler: func(w http.ResponseWriter, r *http.Request) error {
+				return xhttperror.NewHTTPStatusCodeError(http.StatusNotFound)
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/panic",
+			Handler: func(w http.ResponseWriter, r *http.Request) error {
+				panic("panic")
+			},
+		},
+	}
+
+	router, err := server.makeRouter(routes)
+	if err != nil {
+		t.Fatalf("makeRouter() failed: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		path        string
+		wantStatus  int
+		wantDetail  string
+		wantMembers []string
+	}{
+		{
+			name:        "Validation",
+			path:        "/validation",
+			wantStatus:  http.StatusBadRequest,
+			wantDetail:  "validation failed",
+			wantMembers: []string{"errors"},
+		},
+		{
+			name:        "XmlValidation",
+			path:        "/xml-validation",
+			wantStatus:  http.StatusBadRequest,
+			wantDetail:  "validation failed",
+			wantMembers: []string{"errors"},
+		},
+		{
+			name:        "UndeclaredValidation",
+			path:        "/undeclared-validation",
+			wantStatus:  http.StatusBadRequest,
+			wantDetail:  "validation failed",
+			wantMembers: []string{"errors"},
+		},
+		{
+			name:       "UnsupportedContentType",
+			path:       "/unsupported",
+			wantStatus: http.StatusUnsupportedMediaType,
+			wantDetail: "error unsupported media type (text/plain)",
+		},
+		{
+			name:       "MissingHandler",
+			path:       "/missing",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "NoRoute",
+			path:       "/unknown",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Panic",
+			path:       "/panic",
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+
+			request := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			request.Header.Set("X-Request-ID", "42")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, request)
+
+			if recorder.Code != tc.wantStatus {
+				t.Fatalf("status code = %d, want %d", recorder.Code, tc.wantStatus)
+			}
+			if contentType := recorder.Header().Get("Content-Type"); contentType != "application/problem+json" {
+				t.Fatalf("content type = %q, want application/problem+json", contentType)
+			}
+
+			var problem map[string]interface{}
+			if err := json.NewDecoder(recorder.Body).Decode(&problem); err != nil {
+				t.Fatalf("could not decode problem: %v", err)
+			}
+
+			want := map[string]interface{}{
+				"type":     "about:blank",
+				"title":    http.StatusText(tc.wantStatus),
+				"status":   float64(tc.wantStatus),
+				"instance": "42",
+				"path":     tc.path,
+			}
+			if tc.wantDetail != "" {
+				want["detail"] = tc.wantDetail
+			}
+			for key, value := range want {
+				if problem[key] != value {
+					t.Errorf("problem[%q] = %v, want %v", key, problem[key], value)
+				}
+			}
+			for _, key := range tc.wantMembers {
+				if _, ok := problem[key]; !ok {
+					t.Errorf("problem has no member %q", key)
+				}
+			}
+			if len(problem) != len(want)+len(tc.wantMembers) {
+				t.Errorf("problem = %v, want members %v and %v", problem, want, tc.wantMembers)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package xserver
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"github.com/go-ozzo/ozzo-routing"
+)
+
+// Router is an adapter for the HTTP router that dispatches incoming requests
+// to the handlers of a server. Paths are passed in the OpenAPI template
+// syntax (e.g. /users/{userId}) and translated by the adapter.
+type Router interface {
+	http.Handler
+	// Handle registers the handler for the given HTTP method and path
+	Handle(method, path string, handler http.Handler)
+	// PathParam returns the value of a path parameter of the matched route
+	PathParam(r *http.Request, name string) string
+	// HandleNotFound registers the handler for requests which match no route
+	HandleNotFound(handler http.Handler)
+	// HandleMethodNotAllowed registers the handler for requests whose path matches a route of another
+	// method. The Allow header is set before the handler is called.
+	HandleMethodNotAllowed(handler http.Handler)
+}
+
+type ozzoContextKey struct{}
+
+var ozzoPathItem = regexp.MustCompile(`\{([^}]*)\}`)
+
+// OzzoRouter adapts an ozzo-routing router to a Router.
+type OzzoRouter struct {
+	*routing.Router
+	notFound         http.Handler
+	methodNotAllowed http.Handler
+}
+
+// NewOzzoRouter creates a new Router based on ozzo-routing which matches
+// routes against the escaped path of a request.
+func NewOzzoRouter() *OzzoRouter {
+
+	router := routing.New()
+	router.UseEscapedPath = true
+
+	return &OzzoRouter{Router: router, notFound: http.NotFoundHandler(), methodNotAllowed: http.HandlerFunc(methodNotAllowed)}
+}
+
+func (router *OzzoRouter) Handle(method, path string, handler http.Handler) {
+
+	router.To(method, ozzoPathItem.ReplaceAllString(path, "<$1>"), func(c *routing.Context) error {
+		ctx := context.WithValue(c.Request.Context(), ozzoContextKey{}, c)
+		handler.ServeHTTP(c.Response, c.Request.WithContext(ctx))
+		c.Abort()
+		return nil
+	})
+}
+
+func (router *OzzoRouter) PathParam(r *http.Request, name string) string {
+
+	c, ok := r.Context().Value(ozzoContextKey{}).(*routing.Context)
+	if !ok {
+		return ""
+	}
+	return c.Param(name)
+}
+
+func (router *OzzoRouter) HandleNotFound(handler http.Handler) {
+
+	router.notFound = handler
+	router.Router.NotFound(router.handleRoutingError)
+}
+
+func (router *OzzoRouter) HandleMethodNotAllowed(handler http.Handler) {
+
+	router.methodNotAllowed = handler
+	router.Router.NotFound(router.handleRoutingError)
+}
+
+// handleRoutingError responds to requests which match no route. The allowed methods of the path are detected by
+// the handler of ozzo-routing, whose response is replaced by the registered handlers. Requests with the method
+// OPTIONS are answered with the allowed methods.
+func (router *OzzoRouter) handleRoutingError(c *routing.Context) error {
+
+	response := c.Response
+	recorder := &statusRecorder{header: make(http.Header)}
+	c.Response = recorder
+	err := routing.MethodNotAllowedHandler(c)
+	c.Response = response
+	if err != nil {
+		return err
+	}
+
+	allow := recorder.header.Get("Allow")
+	if allow != "" {
+		response.Header().Set("Allow", allow)
+	}
+
+	switch {
+	case recorder.status == http.StatusMethodNotAllowed:
+		router.methodNotAllowed.ServeHTTP(response, c.Request)
+	case allow == "":
+		router.notFound.ServeHTTP(response, c.Request)
+	}
+
+	c.Abort()
+	return nil
+}
+
+func methodNotAllowed(w http.ResponseWriter, r *http.Request) {
+
+	http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+}
+
+// statusRecorder records the status code and the header of a response and discards its body
+type statusRecorder struct {
+	header http.Header
+	status int
+}
+
+func (r *statusRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return len(b), nil
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
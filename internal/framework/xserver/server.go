@@ -3,15 +3,16 @@ package xserver
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 	"time"
 
-	"encoding/json"
 	"runtime/debug"
 
+	"github.com/ExperienceOne/apikit/internal/framework/xhttp"
+	"github.com/ExperienceOne/apikit/internal/framework/xhttp/requestutil"
 	"github.com/ExperienceOne/apikit/internal/framework/xhttperror"
-	"github.com/go-ozzo/ozzo-routing"
 	"github.com/go-ozzo/ozzo-routing/fault"
 )
 
@@ -29,18 +30,36 @@ type (
 		Timeouts
 		ErrorHandler ErrorHandler
 		Middleware   []Middleware
-		OnStart      func(router *routing.Router)
-		Prefix       string
+		// Router dispatches incoming requests, if not set an ozzo-routing based router is used
+		Router  Router
+		OnStart func(router Router)
+		Prefix  string
+		// ProblemDetails writes errors of the framework (unsupported content types, bad requests,
+		// missing handlers, panics, ...) as application/problem+json (RFC 7807)
+		ProblemDetails bool
+		// ProblemExtension adds custom members to problems
+		ProblemExtension ProblemExtension
+		// ErrorRenderer writes the responses of errors and panics instead of the default renderer
+		ErrorRenderer ErrorRenderer
+		// ErrorReporter is called with every error and panic before it is rendered
+		ErrorReporter ErrorReporter
+		// UploadLimits limits the size of multipart uploads, the limits of an operation take precedence
+		UploadLimits requestutil.UploadLimits
+		// WebSockets configures the upgrade of WebSockets, e.g. the accepted origins
+		WebSockets xhttp.WebSocketOpts
 	}
 
+	// Middleware wraps the handler of a route like a standard net/http middleware.
 	Middleware struct {
-		Handler routing.Handler
-		After   bool
+		Handler func(next http.Handler) http.Handler
 	}
 
+	// HandlerFunc handles a request, a returned error is written to the response by the server.
+	HandlerFunc func(w http.ResponseWriter, r *http.Request) error
+
 	RouteDescription struct {
 		Path       string
-		Handler    routing.Handler
+		Handler    HandlerFunc
 		Middleware []Middleware
 		Method     string
 	}
@@ -48,26 +67,35 @@ type (
 	Server struct {
 		Timeouts
 		ErrorLogger func(v ...interface{})
-		OnStart     func(router *routing.Router)
+		OnStart     func(router Router)
 		server      *http.Server
-		Router      *routing.Router
-		after       []routing.Handler
-		before      []routing.Handler
+		Router      Router
+		middleware  []Middleware
 		SwaggerSpec string
 		Prefix      string
+		// UploadLimits limits the size of multipart uploads, the limits of an operation take precedence
+		UploadLimits requestutil.UploadLimits
+		// WebSockets configures the upgrade of WebSockets, e.g. the accepted origins
+		WebSockets xhttp.WebSocketOpts
+
+		problemDetails   bool
+		problemExtension ProblemExtension
+		errorRenderer    ErrorRenderer
+		errorReporter    ErrorReporter
 	}
 )
 
 type ErrorHandler func(v ...interface{})
 
 // NewServer initializes a new Server instance with a middleware handler.
-// middleware represents middleware handler that will be executed before  or after the actual
+// middleware represents middleware handler that will be executed before the actual
 // handler of each server endpoint.
 func NewServer(opts *ServerOpts) *Server {
 
 	if opts == nil {
 		return &Server{
 			ErrorLogger: func(v ...interface{}) {},
+			Router:      NewOzzoRouter(),
 		}
 	}
 
@@ -77,9 +105,14 @@ func NewServer(opts *ServerOpts) *Server {
 
 	server := &Server{
 		ErrorLogger: opts.ErrorHandler,
+		Router:      opts.Router,
 		Prefix:      "",
 	}
 
+	if server.Router == nil {
+		server.Router = NewOzzoRouter()
+	}
+
 	if opts.OnStart != nil {
 		server.OnStart = opts.OnStart
 	}
@@ -89,21 +122,16 @@ func NewServer(opts *ServerOpts) *Server {
 	}
 
 	if len(opts.Middleware) != 0 {
-		before := make([]routing.Handler, 0)
-		after := make([]routing.Handler, 0)
-
-		for _, m := range opts.Middleware {
-			if m.After {
-				after = append(after, m.Handler)
-			} else {
-				before = append(before, m.Handler)
-			}
-		}
-
-		server.after = after
-		server.before = before
+		server.middleware = opts.Middleware
 	}
 
+	server.problemDetails = opts.ProblemDetails
+	server.problemExtension = opts.ProblemExtension
+	server.errorRenderer = opts.ErrorRenderer
+	server.errorReporter = opts.ErrorReporter
+	server.UploadLimits = opts.UploadLimits
+	server.WebSockets = opts.WebSockets
+
 	server.ReadTimeout = opts.ReadTimeout
 	server.ReadHeaderTimeout = opts.ReadHeaderTimeout
 	server.WriteTimeout = opts.WriteTimeout
@@ -112,65 +140,66 @@ func NewServer(opts *ServerOpts) *Server {
 	return server
 }
 
-func (server *Server) makeRouter(routes []RouteDescription) (*routing.Router, error) {
+// PathParam returns the value of a path parameter of the route matched by the router.
+func (server *Server) PathParam(r *http.Request, name string) string {
+
+	return server.Router.PathParam(r, name)
+}
+
+func (server *Server) makeRouter(routes []RouteDescription) (Router, error) {
 
-	router := routing.New()
-	router.UseEscapedPath = true
+	router := server.Router
 
 	logError := func(format string, a ...interface{}) {
 		msg := fmt.Sprintf(format, a...)
 		server.ErrorLogger(msg)
 	}
 
-	var beforeStack []routing.Handler
-	beforeStack = append(beforeStack, errorHandler(logError))
-	if server.before != nil {
-		beforeStack = append(beforeStack, server.before...)
-	}
-
 	prefix := server.Prefix
 
 	if prefix == "/" {
 		prefix = ""
 	}
 
-	rg := router.Group(prefix)
+	spec := func(w http.ResponseWriter, r *http.Request) error {
+		_, err := io.WriteString(w, server.SwaggerSpec)
+		return err
+	}
+	router.Handle(http.MethodGet, prefix+"/spec", server.makeHandler(spec, nil, logError))
 
-	rg.Use(beforeStack...)
+	// routing errors are rendered like the errors of the handlers
+	router.HandleNotFound(server.makeHandler(routingError(http.StatusNotFound), nil, logError))
+	router.HandleMethodNotAllowed(server.makeHandler(routingError(http.StatusMethodNotAllowed), nil, logError))
 
-	var afterStack []routing.Handler
-	if server.after != nil {
-		afterStack = append(afterStack, server.after...)
+	for _, route := range routes {
+		router.Handle(route.Method, prefix+route.Path, server.makeHandler(route.Handler, route.Middleware, logError))
 	}
 
-	rg.Get("/spec", func(c *routing.Context) error {
-		return c.Write(server.SwaggerSpec)
-	})
+	return router, nil
+}
 
-	for _, route := range routes {
+// routingError returns a handler which fails with the status code of a request which matches no route
+func routingError(status int) HandlerFunc {
 
-		var before, after []routing.Handler
+	return func(w http.ResponseWriter, r *http.Request) error {
+		return xhttperror.NewHTTPStatusCodeError(status)
+	}
+}
 
-		if route.Middleware != nil {
-			for _, m := range route.Middleware {
-				if m.After {
-					after = append(after, m.Handler)
-				} else {
-					before = append(before, m.Handler)
-				}
-			}
-		}
+// makeHandler chains the global middleware, the middleware of a route and the handler itself
+func (server *Server) makeHandler(handler HandlerFunc, middleware []Middleware, logf fault.LogFunc) http.Handler {
 
-		var handler []routing.Handler
-		handler = append(handler, before...)
-		handler = append(handler, route.Handler)
-		handler = append(handler, after...)
-		handler = append(handler, afterStack...)
+	var h http.Handler = server.errorHandler(handler)
 
-		rg.To(route.Method, route.Path, handler...)
+	for i := len(middleware) - 1; i >= 0; i-- {
+		h = middleware[i].Handler(h)
 	}
 
-	return router, nil
+	for i := len(server.middleware) - 1; i >= 0; i-- {
+		h = server.middleware[i].Handler(h)
+	}
+
+	return server.recoverHandler(h, logf)
 }
 
 func (server *Server) Start(port int, routes []RouteDescription) error {
@@ -182,7 +211,6 @@ func (server *Server) Start(port int, routes []RouteDescription) error {
 	if server.OnStart != nil {
 		server.OnStart(router)
 	}
-	server.Router = router
 
 	httpServer := &http.Server{
 		ReadTimeout:       server.ReadTimeout,
@@ -200,52 +228,40 @@ func (server *Server) Start(port int, routes []RouteDescription) error {
 func (server *Server) Stop() error {
 
 	if server.server != nil {
-		deadline, _ := context.WithTimeout(context.TODO(), 30*time.Second)
+		deadline, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
+		defer cancel()
 		return server.server.Shutdown(deadline)
 	}
 
 	return nil
 }
 
-//errorHandler overwrites global error handling of router
-func errorHandler(logf fault.LogFunc) func(c *routing.Context) (err error) {
-	return func(c *routing.Context) (err error) {
+// recoverHandler recovers from panics of the middleware and handlers
+func (server *Server) recoverHandler(next http.Handler, logf fault.LogFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		defer func() {
-
 			if e := recover(); e != nil {
-
+				// aborted responses are handled by the http.Server
+				if e == http.ErrAbortHandler {
+					panic(e)
+				}
 				if logf != nil {
 					logf("recovered from panic: %v", string(debug.Stack()))
 				}
-				c.Response.WriteHeader(http.StatusInternalServerError)
-				err = nil
-				c.Abort()
-
-			} else if err != nil {
-
-				switch errType := err.(type) {
-				case *xhttperror.HttpJsonError:
-					c.Response.Header()["Content-Type"] = []string{"application/json"}
-					c.Response.WriteHeader(errType.StatusCode())
-					if e := json.NewEncoder(c.Response).Encode(errType.Message); e != nil && logf != nil {
-						logf("failed to write error message: %v", errType.Message)
-					}
-				case *xhttperror.HttpCodeError:
-					c.Response.Header()["Content-Type"] = []string{""}
-					c.Response.WriteHeader(errType.StatusCode())
-				case routing.HTTPError:
-					c.Response.Header()["Content-Type"] = []string{"text/plain; charset=utf-8"}
-					c.Response.WriteHeader(errType.StatusCode())
-					if _, e := c.Response.Write([]byte(errType.Error())); e != nil {
-						logf("failed to write error message: %v", errType.Error())
-					}
-				}
-
-				err = nil
-				c.Abort() // call Abort() to stop further middleware processing
+				server.renderError(w, r, &PanicError{Recovered: e}, e)
 			}
 		}()
 
-		return c.Next()
-	}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// errorHandler writes the error returned by a handler to the response
+func (server *Server) errorHandler(handler HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		if err := handler(w, r); err != nil {
+			server.renderError(w, r, err, nil)
+		}
+	})
 }
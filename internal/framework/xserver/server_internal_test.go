@@ -1,69 +1,153 @@
 package xserver
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
-	"github.com/go-ozzo/ozzo-routing"
+	"github.com/ExperienceOne/apikit/internal/framework/xhttperror"
 )
 
 func TestNewServer(t *testing.T) {
 
+	noop := func(next http.Handler) http.Handler { return next }
+
 	tests := []struct {
-		name       string
-		args       []Middleware
-		wantBefore bool
-		wantAfter  bool
+		name           string
+		args           []Middleware
+		wantMiddleware int
 	}{
 		{
 			name: "NoMiddleware",
 			args: nil,
 		},
 		{
-			name: "OnlyBeforeMiddleware",
+			name: "OneMiddleware",
 			args: []Middleware{
-				{
-					Handler: func(c *routing.Context) error { return nil },
-					After:   false,
-				},
+				{Handler: noop},
 			},
-			wantBefore: true,
+			wantMiddleware: 1,
 		},
 		{
-			name: "OnlyAfterMiddleware",
+			name: "TwoMiddleware",
 			args: []Middleware{
-				{
-					Handler: func(c *routing.Context) error { return nil },
-					After:   true,
-				},
+				{Handler: noop},
+				{Handler: noop},
 			},
-			wantAfter: true,
+			wantMiddleware: 2,
 		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+
+			got := NewServer(&ServerOpts{Middleware: tc.args})
+			if len(got.middleware) != tc.wantMiddleware {
+				t.Fatalf("xserver.NewServer().middleware = %v, want %v", got.middleware, tc.wantMiddleware)
+			}
+			if got.Router == nil {
+				t.Fatal("xserver.NewServer().Router is nil, want default router")
+			}
+		})
+	}
+}
+
+func TestMakeRouter(t *testing.T) {
+
+	var order []string
+	middleware := func(name string) Middleware {
+		return Middleware{
+			Handler: func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					order = append(order, name)
+					next.ServeHTTP(w, r)
+				})
+			},
+		}
+	}
+
+	server := NewServer(&ServerOpts{
+		Middleware: []Middleware{middleware("global")},
+		Prefix:     "/api",
+	})
+
+	routes := []RouteDescription{
 		{
-			name: "BeforeAndAfterMiddleware",
-			args: []Middleware{
-				{
-					Handler: func(c *routing.Context) error { return nil },
-					After:   false,
-				},
-				{
-					Handler: func(c *routing.Context) error { return nil },
-					After:   true,
-				},
+			Method: http.MethodGet,
+			Path:   "/users/{userId}",
+			Handler: func(w http.ResponseWriter, r *http.Request) error {
+				order = append(order, "handler")
+				if server.PathParam(r, "userId") != "42" {
+					return xhttperror.NewHTTPStatusCodeError(http.StatusNotFound)
+				}
+				w.WriteHeader(http.StatusNoContent)
+				return nil
 			},
-			wantAfter:  true,
-			wantBefore: true,
+			Middleware: []Middleware{middleware("route")},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/panic",
+			Handler: func(w http.ResponseWriter, r *http.Request) error {
+				panic("panic")
+			},
+		},
+	}
+
+	router, err := server.makeRouter(routes)
+	if err != nil {
+		t.Fatalf("makeRouter() failed: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		wantOrder  []string
+	}{
+		{
+			name:       "PathParam",
+			path:       "/api/users/42",
+			wantStatus: http.StatusNoContent,
+			wantOrder:  []string{"global", "route", "handler"},
+		},
+		{
+			name:       "HandlerError",
+			path:       "/api/users/43",
+			wantStatus: http.StatusNotFound,
+			wantOrder:  []string{"global", "route", "handler"},
+		},
+		{
+			name:       "Panic",
+			path:       "/api/panic",
+			wantStatus: http.StatusInternalServerError,
+			wantOrder:  []string{"global"},
+		},
+		{
+			name:       "Spec",
+			path:       "/api/spec",
+			wantStatus: http.StatusOK,
+			wantOrder:  []string{"global"},
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 
-			got := NewServer(&ServerOpts{Middleware: tc.args})
-			if (len(got.after) != 0) != tc.wantAfter {
-				t.Fatalf("xserver.NewServer().after = %v, want %v", got.after, tc.wantAfter)
+			order = nil
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, tc.path, nil))
+
+			if recorder.Code != tc.wantStatus {
+				t.Fatalf("status code = %d, want %d", recorder.Code, tc.wantStatus)
+			}
+			if len(order) != len(tc.wantOrder) {
+				t.Fatalf("call order = %v, want %v", order, tc.wantOrder)
 			}
-			if (len(got.before) != 0) != tc.wantBefore {
-				t.Fatalf("xserver.NewServer().before = %v, want %v", got.before, tc.wantAfter)
+			for i := range order {
+				if order[i] != tc.wantOrder[i] {
+					t.Fatalf("call order = %v, want %v", order, tc.wantOrder)
+				}
 			}
 		})
 	}
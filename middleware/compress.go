@@ -0,0 +1,378 @@
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ExperienceOne/apikit/internal/framework/xserver"
+	"github.com/pkg/errors"
+)
+
+const (
+	EncodingGzip    string = "gzip"
+	EncodingDeflate string = "deflate"
+
+	acceptEncodingHeader  string = "Accept-Encoding"
+	contentEncodingHeader string = "Content-Encoding"
+
+	defaultCompressMinSize int = 1024
+)
+
+// DefaultCompressContentTypes are the content types compressed by default, a type ending with /* matches all its subtypes
+var DefaultCompressContentTypes = []string{
+	"application/json",
+	"application/hal+json",
+	"application/problem+json",
+	"application/x-ndjson",
+	"application/xml",
+	"application/javascript",
+	"text/*",
+}
+
+// CompressOptions configures the compression of responses.
+type CompressOptions struct {
+	// MinSize is the minimum size of a compressed body, smaller bodies are sent as they are (default: 1024 bytes)
+	MinSize int
+	// ContentTypes are the compressed content types (default: DefaultCompressContentTypes)
+	ContentTypes []string
+	// Level is the compression level of gzip and deflate, the zero value and invalid levels use the default level
+	Level int
+}
+
+// Compress creates a middleware which compresses responses with the gzip or deflate encoding negotiated by
+// the Accept-Encoding header of the request.
+func Compress(opts CompressOptions) xserver.Middleware {
+	return xserver.Middleware{
+		Handler: CompressHandler(opts),
+	}
+}
+
+// CompressHandler creates a middleware handler which compresses responses.
+func CompressHandler(opts CompressOptions) func(next http.Handler) http.Handler {
+
+	if opts.MinSize <= 0 {
+		opts.MinSize = defaultCompressMinSize
+	}
+	if opts.ContentTypes == nil {
+		opts.ContentTypes = DefaultCompressContentTypes
+	}
+	// invalid levels would fail the creation of the writers
+	if opts.Level == 0 || opts.Level < gzip.HuffmanOnly || opts.Level > gzip.BestCompression {
+		opts.Level = gzip.DefaultCompression
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+			encoding := negotiateEncoding(r.Header.Get(acceptEncodingHeader))
+			if encoding == "" || r.Method == http.MethodHead {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			cw := &compressResponseWriter{ResponseWriter: w, opts: &opts, encoding: encoding, status: http.StatusOK}
+			defer func() {
+				// an unstarted response is dropped on a panic, so the panic can be rendered as error response
+				if e := recover(); e != nil {
+					cw.buffer.Reset()
+					panic(e)
+				}
+				cw.Close()
+			}()
+
+			next.ServeHTTP(cw, r)
+		})
+	}
+}
+
+// negotiateEncoding returns the preferred encoding of an Accept-Encoding header, gzip wins a tie
+func negotiateEncoding(header string) string {
+
+	qualities := make(map[string]float64)
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		coding := strings.ToLower(strings.TrimSpace(fields[0]))
+		if coding == "" {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if value, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = value
+				}
+			}
+		}
+		qualities[coding] = q
+	}
+
+	best, bestQ := "", 0.0
+	for _, encoding := range []string{EncodingGzip, EncodingDeflate} {
+		q, ok := qualities[encoding]
+		if !ok {
+			q = qualities["*"]
+		}
+		if q > bestQ {
+			best, bestQ = encoding, q
+		}
+	}
+	return best
+}
+
+// compressWriter is implemented by the gzip and zlib writers
+type compressWriter interface {
+	io.WriteCloser
+	Flush() error
+}
+
+// compressResponseWriter buffers the beginning of the body until it is known if the response is compressed
+type compressResponseWriter struct {
+	http.ResponseWriter
+	opts     *CompressOptions
+	encoding string
+	status   int
+	buffer   bytes.Buffer
+	decided  bool
+	writer   compressWriter
+}
+
+func (w *compressResponseWriter) WriteHeader(status int) {
+
+	if w.decided {
+		w.ResponseWriter.WriteHeader(status)
+		return
+	}
+	w.status = status
+	if w.bodyless() {
+		w.decide(false)
+	}
+}
+
+func (w *compressResponseWriter) Write(p []byte) (int, error) {
+
+	if !w.decided {
+		if w.buffer.Len()+len(p) < w.opts.MinSize {
+			return w.buffer.Write(p)
+		}
+		if err := w.decide(true); err != nil {
+			return 0, err
+		}
+	}
+
+	if w.writer != nil {
+		return w.writer.Write(p)
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+// Flush starts the response, a streamed response is compressed regardless of its size
+func (w *compressResponseWriter) Flush() {
+
+	if !w.decided {
+		if err := w.decide(true); err != nil {
+			return
+		}
+	}
+	if w.writer != nil {
+		if err := w.writer.Flush(); err != nil {
+			return
+		}
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack takes over the connection of the wrapped writer, the response is neither buffered nor compressed afterwards
+func (w *compressResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+	w.decided = true
+	return hijacker.Hijack()
+}
+
+// Close writes a buffered body which is too small to be compressed and completes a compressed body
+func (w *compressResponseWriter) Close() error {
+
+	if !w.decided {
+		return w.decide(false)
+	}
+	if w.writer != nil {
+		return w.writer.Close()
+	}
+	return nil
+}
+
+// decide starts the response and writes the buffered beginning of the body
+func (w *compressResponseWriter) decide(compress bool) error {
+
+	w.decided = true
+
+	header := w.Header()
+	// ranges refer to the bytes of the unencoded representation
+	partial := w.status == http.StatusPartialContent || header.Get("Content-Range") != ""
+	compressible := header.Get(contentEncodingHeader) == "" && !w.bodyless() && !partial && w.compressible(header.Get("Content-Type"))
+	if compressible {
+		// the representation depends on the encoding even if this one is too small to be compressed
+		header.Add("Vary", acceptEncodingHeader)
+	}
+	if compress && compressible {
+		// the response is sent uncompressed if the writer can't be created
+		if writer, err := w.newWriter(); err == nil {
+			header.Set(contentEncodingHeader, w.encoding)
+			header.Del("Content-Length")
+			// the encoded body isn't byte-for-byte identical to the representation of a strong entity tag
+			if etag := header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+				header.Set("ETag", "W/"+etag)
+			}
+			w.writer = writer
+		}
+	}
+
+	w.ResponseWriter.WriteHeader(w.status)
+
+	if w.buffer.Len() == 0 {
+		return nil
+	}
+	var err error
+	if w.writer != nil {
+		_, err = w.writer.Write(w.buffer.Bytes())
+	} else {
+		_, err = w.ResponseWriter.Write(w.buffer.Bytes())
+	}
+	w.buffer.Reset()
+	return err
+}
+
+// newWriter creates the writer of the negotiated encoding
+func (w *compressResponseWriter) newWriter() (compressWriter, error) {
+
+	if w.encoding == EncodingGzip {
+		return gzip.NewWriterLevel(w.ResponseWriter, w.opts.Level)
+	}
+	return zlib.NewWriterLevel(w.ResponseWriter, w.opts.Level)
+}
+
+func (w *compressResponseWriter) bodyless() bool {
+
+	return w.status < http.StatusOK || w.status == http.StatusNoContent || w.status == http.StatusNotModified
+}
+
+func (w *compressResponseWriter) compressible(contentType string) bool {
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	for _, allowed := range w.opts.ContentTypes {
+		allowed = strings.ToLower(allowed)
+		if allowed == mediaType || (strings.HasSuffix(allowed, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(allowed, "*"))) {
+			return true
+		}
+	}
+	return false
+}
+
+// DecompressOptions configures the decompression of requests.
+type DecompressOptions struct {
+	// MaxSize limits the size of a decompressed body, reading more fails (default: no limit)
+	MaxSize int64
+}
+
+// ErrDecompressedBodyTooLarge is returned by the body of a decompressed request which exceeds the maximum size
+var ErrDecompressedBodyTooLarge = errors.New("decompressed request body too large")
+
+// Decompress creates a middleware which decompresses gzip or deflate encoded request bodies. Requests with
+// another encoding are rejected with the status code 415 (Unsupported Media Type).
+func Decompress(opts DecompressOptions) xserver.Middleware {
+	return xserver.Middleware{
+		Handler: DecompressHandler(opts),
+	}
+}
+
+// DecompressHandler creates a middleware handler which decompresses request bodies.
+func DecompressHandler(opts DecompressOptions) func(next http.Handler) http.Handler {
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+			encoding := strings.ToLower(strings.TrimSpace(r.Header.Get(contentEncodingHeader)))
+			if encoding == "" || encoding == "identity" || r.Body == nil || r.Body == http.NoBody {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			var (
+				reader io.ReadCloser
+				err    error
+			)
+			switch encoding {
+			case EncodingGzip:
+				reader, err = gzip.NewReader(r.Body)
+			case EncodingDeflate:
+				reader, err = zlib.NewReader(r.Body)
+			default:
+				w.Header().Set(acceptEncodingHeader, EncodingGzip+", "+EncodingDeflate)
+				http.Error(w, "unsupported content encoding: "+encoding, http.StatusUnsupportedMediaType)
+				return
+			}
+			if err != nil {
+				http.Error(w, errors.Wrap(err, "failed to decompress request body").Error(), http.StatusBadRequest)
+				return
+			}
+
+			body := &decompressedBody{reader: reader, body: r.Body, limit: opts.MaxSize}
+
+			r.Header.Del(contentEncodingHeader)
+			r.Header.Del("Content-Length")
+			r.ContentLength = -1
+			r.Body = body
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// decompressedBody reads the decompressed request body and closes the compressed one
+type decompressedBody struct {
+	reader io.ReadCloser
+	body   io.ReadCloser
+	limit  int64
+	read   int64
+}
+
+func (b *decompressedBody) Read(p []byte) (int, error) {
+
+	if b.limit > 0 && b.read > b.limit {
+		return 0, ErrDecompressedBodyTooLarge
+	}
+
+	n, err := b.reader.Read(p)
+	b.read += int64(n)
+	if b.limit > 0 && b.read > b.limit {
+		return n - int(b.read-b.limit), ErrDecompressedBodyTooLarge
+	}
+	return n, err
+}
+
+func (b *decompressedBody) Close() error {
+
+	err := b.reader.Close()
+	if bodyErr := b.body.Close(); err == nil {
+		err = bodyErr
+	}
+	return err
+}
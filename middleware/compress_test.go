@@ -0,0 +1,283 @@
+package middleware_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ExperienceOne/apikit/middleware"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompress(t *testing.T) {
+
+	large := strings.Repeat("{\"id\":1}", 256)
+
+	tests := []struct {
+		name           string
+		acceptEncoding string
+		contentType    string
+		body           string
+		encoding       string
+		vary           bool
+	}{
+		{name: "gzip", acceptEncoding: "gzip, deflate", contentType: "application/json", body: large, encoding: "gzip", vary: true},
+		{name: "deflate", acceptEncoding: "gzip;q=0.5, deflate", contentType: "application/json", body: large, encoding: "deflate", vary: true},
+		{name: "wildcard", acceptEncoding: "*", contentType: "text/plain; charset=utf-8", body: large, encoding: "gzip", vary: true},
+		{name: "not accepted", acceptEncoding: "br", contentType: "application/json", body: large},
+		{name: "gzip refused", acceptEncoding: "gzip;q=0", contentType: "application/json", body: large},
+		{name: "small body", acceptEncoding: "gzip", contentType: "application/json", body: "{}", vary: true},
+		{name: "content type not allowed", acceptEncoding: "gzip", contentType: "image/png", body: large},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			handler := middleware.Compress(middleware.CompressOptions{}).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", test.contentType)
+				w.WriteHeader(http.StatusCreated)
+				for i := 0; i < len(test.body); i += 100 {
+					end := i + 100
+					if end > len(test.body) {
+						end = len(test.body)
+					}
+					w.Write([]byte(test.body[i:end]))
+				}
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Accept-Encoding", test.acceptEncoding)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			require.Equal(t, http.StatusCreated, recorder.Code)
+			require.Equal(t, test.encoding, recorder.Header().Get("Content-Encoding"))
+			if test.vary {
+				require.Equal(t, "Accept-Encoding", recorder.Header().Get("Vary"))
+			} else {
+				require.Empty(t, recorder.Header().Get("Vary"))
+			}
+
+			var body io.Reader = recorder.Body
+			switch test.encoding {
+			case "gzip":
+				reader, err := gzip.NewReader(body)
+				require.NoError(t, err)
+				body = reader
+			case "deflate":
+				reader, err := zlib.NewReader(body)
+				require.NoError(t, err)
+				body = reader
+			}
+			data, err := ioutil.ReadAll(body)
+			require.NoError(t, err)
+			require.Equal(t, test.body, string(data))
+		})
+	}
+}
+
+func TestCompressFlush(t *testing.T) {
+
+	handler := middleware.Compress(middleware.CompressOptions{}).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Write([]byte("{\"id\":1}\n"))
+		w.(http.Flusher).Flush()
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	require.True(t, recorder.Flushed, "response is not flushed")
+	require.Equal(t, "gzip", recorder.Header().Get("Content-Encoding"))
+
+	reader, err := gzip.NewReader(recorder.Body)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, "{\"id\":1}\n", string(data))
+}
+
+func TestCompressInvalidLevel(t *testing.T) {
+
+	body := strings.Repeat("{\"id\":1}", 256)
+
+	for _, encoding := range []string{"gzip", "deflate"} {
+		t.Run(encoding, func(t *testing.T) {
+
+			handler := middleware.Compress(middleware.CompressOptions{Level: 10}).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(body))
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Accept-Encoding", encoding)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			// the invalid level is replaced by the default level
+			require.Equal(t, http.StatusOK, recorder.Code)
+			require.Equal(t, encoding, recorder.Header().Get("Content-Encoding"))
+
+			var reader io.Reader
+			var err error
+			if encoding == "gzip" {
+				reader, err = gzip.NewReader(recorder.Body)
+			} else {
+				reader, err = zlib.NewReader(recorder.Body)
+			}
+			require.NoError(t, err)
+			data, err := ioutil.ReadAll(reader)
+			require.NoError(t, err)
+			require.Equal(t, body, string(data))
+		})
+	}
+}
+
+func TestCompressNoContent(t *testing.T) {
+
+	handler := middleware.Compress(middleware.CompressOptions{}).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	req := httptest.NewRequest(http.MethodDelete, "/", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusNoContent, recorder.Code)
+	require.Empty(t, recorder.Header().Get("Content-Encoding"))
+	require.Zero(t, recorder.Body.Len())
+}
+
+func TestDecompress(t *testing.T) {
+
+	compress := func(encoding, data string) io.Reader {
+		var buf bytes.Buffer
+		var writer io.WriteCloser
+		if encoding == "gzip" {
+			writer = gzip.NewWriter(&buf)
+		} else {
+			writer = zlib.NewWriter(&buf)
+		}
+		writer.Write([]byte(data))
+		writer.Close()
+		return &buf
+	}
+
+	tests := []struct {
+		name            string
+		contentEncoding string
+		body            io.Reader
+		maxSize         int64
+		status          int
+		want            string
+	}{
+		{name: "gzip", contentEncoding: "gzip", body: compress("gzip", "hello"), status: http.StatusOK, want: "hello"},
+		{name: "deflate", contentEncoding: "deflate", body: compress("deflate", "hello"), status: http.StatusOK, want: "hello"},
+		{name: "identity", body: strings.NewReader("hello"), status: http.StatusOK, want: "hello"},
+		{name: "unsupported encoding", contentEncoding: "br", body: strings.NewReader("hello"), status: http.StatusUnsupportedMediaType},
+		{name: "corrupted body", contentEncoding: "gzip", body: strings.NewReader("hello"), status: http.StatusBadRequest},
+		{name: "max size", contentEncoding: "gzip", body: compress("gzip", "hello"), maxSize: 5, status: http.StatusOK, want: "hello"},
+		{name: "too large", contentEncoding: "gzip", body: compress("gzip", "hello"), maxSize: 4, status: http.StatusRequestEntityTooLarge},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			handler := middleware.Decompress(middleware.DecompressOptions{MaxSize: test.maxSize}).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Empty(t, r.Header.Get("Content-Encoding"))
+				data, err := ioutil.ReadAll(r.Body)
+				if err == middleware.ErrDecompressedBodyTooLarge {
+					w.WriteHeader(http.StatusRequestEntityTooLarge)
+					return
+				}
+				require.NoError(t, err)
+				w.Write(data)
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/", test.body)
+			req.Header.Set("Content-Encoding", test.contentEncoding)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			require.Equal(t, test.status, recorder.Code)
+			if test.status == http.StatusOK {
+				require.Equal(t, test.want, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestCompressPanic(t *testing.T) {
+
+	handler := middleware.Compress(middleware.CompressOptions{}).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("{\"a\":1}"))
+		panic("panic")
+	}))
+
+	// the recovering middleware renders the panic, the buffered response isn't written
+	recovering := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if e := recover(); e != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		}()
+		handler.ServeHTTP(w, r)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	recorder := httptest.NewRecorder()
+	recovering.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusInternalServerError, recorder.Code)
+	require.Zero(t, recorder.Body.Len())
+}
+
+func TestCompressPartialContent(t *testing.T) {
+
+	body := strings.Repeat("x", 2048)
+
+	handler := middleware.Compress(middleware.CompressOptions{}).Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Header().Set("ETag", "\"v1\"")
+		if r.Header.Get("Range") != "" {
+			w.Header().Set("Content-Range", "bytes 0-2047/4096")
+			w.WriteHeader(http.StatusPartialContent)
+		}
+		w.Write([]byte(body))
+	}))
+
+	// ranges are sent unencoded with the strong entity tag
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	req.Header.Set("Range", "bytes=0-2047")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusPartialContent, recorder.Code)
+	require.Empty(t, recorder.Header().Get("Content-Encoding"))
+	require.Equal(t, "bytes 0-2047/4096", recorder.Header().Get("Content-Range"))
+	require.Equal(t, "\"v1\"", recorder.Header().Get("ETag"))
+	require.Equal(t, body, recorder.Body.String())
+
+	// the entity tag of a compressed representation is weak
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "gzip", recorder.Header().Get("Content-Encoding"))
+	require.Equal(t, "W/\"v1\"", recorder.Header().Get("ETag"))
+}
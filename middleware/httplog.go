@@ -1,14 +1,15 @@
 package middleware
 
 import (
+	"bufio"
 	"bytes"
 	"io/ioutil"
+	"net"
 	"net/http"
 
 	"time"
 
 	"github.com/ExperienceOne/apikit/internal/framework/xserver"
-	"github.com/go-ozzo/ozzo-routing"
 	"github.com/pkg/errors"
 )
 
@@ -25,48 +26,47 @@ type LogFunc func(r *http.Request, w *LogResponseWriter, elapsed time.Duration)
 
 // LogHandler creates a middleware handler for logging HTTP requests
 // and responses
-func LogHandler(log LogFunc) routing.Handler {
+func LogHandler(log LogFunc) func(next http.Handler) http.Handler {
 
-	return func(c *routing.Context) error {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
-		start := time.Now()
+			start := time.Now()
 
-		if err := storeReqInCtx(c); err != nil {
-			return errors.Wrap(err, "failed to store request in context")
-		}
+			data, err := storeReqBody(r)
+			if err != nil {
+				http.Error(w, errors.Wrap(err, "failed to store request body").Error(), http.StatusInternalServerError)
+				return
+			}
 
-		rw := NewLogResponseWriter(c.Response)
-		c.Response = rw
+			rw := NewLogResponseWriter(w)
 
-		err := c.Next()
+			next.ServeHTTP(rw, r)
 
-		if data := c.Get("requestData"); data != nil {
-			c.Request.Body = ioutil.NopCloser(data.(*bytes.Buffer))
-		}
+			if data != nil {
+				r.Body = ioutil.NopCloser(data)
+			}
 
-		log(c.Request, rw, time.Since(start))
-
-		return err
+			log(r, rw, time.Since(start))
+		})
 	}
 }
 
-func storeReqInCtx(c *routing.Context) error {
+func storeReqBody(r *http.Request) (*bytes.Buffer, error) {
 
-	if c.Request.ContentLength <= 0 {
-		return nil
+	if r.ContentLength <= 0 {
+		return nil, nil
 	}
 
-	buf, err := ioutil.ReadAll(c.Request.Body)
+	buf, err := ioutil.ReadAll(r.Body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	c.Request.Body = ioutil.NopCloser(bytes.NewBuffer(buf))
+	r.Body = ioutil.NopCloser(bytes.NewBuffer(buf))
 
 	// TODO: maybe we should add a kind of restriction for big data blobs.
-	c.Set("requestData", bytes.NewBuffer(buf))
-
-	return nil
+	return bytes.NewBuffer(buf), nil
 }
 
 type LogResponseStatusWriter struct {
@@ -85,6 +85,24 @@ func (r *LogResponseStatusWriter) WriteHeader(status int) {
 	r.ResponseWriter.WriteHeader(status)
 }
 
+// Flush sends buffered data to the client if the wrapped writer supports it, e.g. for event streams
+func (r *LogResponseStatusWriter) Flush() {
+
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack takes over the connection of the wrapped writer if it supports it, e.g. for WebSockets
+func (r *LogResponseStatusWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+
+	hijacker, ok := r.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+	return hijacker.Hijack()
+}
+
 // LogResponseWriter represents a http.ResponseWriter with additional
 // functionality to store response related data for a later use.
 type LogResponseWriter struct {
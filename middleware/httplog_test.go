@@ -30,7 +30,6 @@ func TestLogHandler(t *testing.T) {
 	}
 
 	router := routing.New()
-	router.Use(middleware.LogHandler(logFunc))
 	router.Get("/get", func(c *routing.Context) error {
 		c.Response.WriteHeader(200)
 		return c.Write("passing the test")
@@ -44,7 +43,7 @@ func TestLogHandler(t *testing.T) {
 		return c.Write("passing the test")
 	})
 
-	ts := httptest.NewServer(router)
+	ts := httptest.NewServer(middleware.LogHandler(logFunc)(router))
 	defer ts.Close()
 
 	_, err := http.Get(ts.URL + "/get")
@@ -68,3 +67,26 @@ func TestLogHandler(t *testing.T) {
 	assert.Contains(t, buf.String(), `request: [POST /urlencoded test=test-string]`)
 	assert.Contains(t, buf.String(), "response: [200 passing the test]")
 }
+
+func TestLogResponseWriterFlush(t *testing.T) {
+
+	recorder := httptest.NewRecorder()
+	var w http.ResponseWriter = middleware.NewLogResponseWriter(recorder)
+
+	flusher, ok := w.(http.Flusher)
+	require.True(t, ok, "log response writer is not a flusher")
+
+	flusher.Flush()
+	assert.True(t, recorder.Flushed)
+}
+
+func TestLogResponseWriterHijack(t *testing.T) {
+
+	var w http.ResponseWriter = middleware.NewLogResponseWriter(httptest.NewRecorder())
+
+	hijacker, ok := w.(http.Hijacker)
+	require.True(t, ok, "log response writer is not a hijacker")
+
+	_, _, err := hijacker.Hijack()
+	assert.Error(t, err, "recorder must not be hijacked")
+}
@@ -38,15 +38,14 @@ func TestLogger(t *testing.T) {
 			setup: func() (string, func()) {
 
 				router := routing.New()
-				router.Use(middleware.Log(middleware.NewLogger(DateTimeFormat, []string{}, func(entity middleware.LogEntry, values ...interface{}) {
-					log.WithField("entry", entity).Info("request handled")
-				})).Handler)
 				router.Get("/endpoint", func(c *routing.Context) error {
 					c.Response.WriteHeader(http.StatusOK)
 					return c.Write([]byte(`{"number":6.13,"strings":["a","b"]}`))
 				})
 
-				ts := httptest.NewServer(router)
+				ts := httptest.NewServer(middleware.Log(middleware.NewLogger(DateTimeFormat, []string{}, func(entity middleware.LogEntry, values ...interface{}) {
+					log.WithField("entry", entity).Info("request handled")
+				})).Handler(router))
 				return ts.URL, ts.Close
 			},
 			httpMethod:      http.MethodGet,
@@ -61,15 +60,14 @@ func TestLogger(t *testing.T) {
 			setup: func() (string, func()) {
 
 				router := routing.New()
-				router.Use(middleware.Log(middleware.NewLogger(DateTimeFormat, []string{}, func(entity middleware.LogEntry, values ...interface{}) {
-					log.WithField("entry", entity).Info("request handled")
-				})).Handler)
 				router.Get(`/endpoint/something/<id:\d+><test>`, func(c *routing.Context) error {
 					c.Response.WriteHeader(http.StatusOK)
 					return c.Write([]byte(`{"number":6.13,"strings":["a","b"]}`))
 				})
 
-				ts := httptest.NewServer(router)
+				ts := httptest.NewServer(middleware.Log(middleware.NewLogger(DateTimeFormat, []string{}, func(entity middleware.LogEntry, values ...interface{}) {
+					log.WithField("entry", entity).Info("request handled")
+				})).Handler(router))
 				return ts.URL, ts.Close
 			},
 			httpMethod:      http.MethodGet,
@@ -84,15 +82,14 @@ func TestLogger(t *testing.T) {
 			setup: func() (string, func()) {
 
 				router := routing.New()
-				router.Use(middleware.Log(middleware.NewLogger(DateTimeFormat, []string{}, func(entity middleware.LogEntry, values ...interface{}) {
-					log.WithField("entry", entity).Info("request handled")
-				})).Handler)
 				router.Post("/endpoint", func(c *routing.Context) error {
 					c.Response.WriteHeader(http.StatusOK)
 					return c.Write([]byte(`{"number":6.13,"strings":["a","b"]}`))
 				})
 
-				ts := httptest.NewServer(router)
+				ts := httptest.NewServer(middleware.Log(middleware.NewLogger(DateTimeFormat, []string{}, func(entity middleware.LogEntry, values ...interface{}) {
+					log.WithField("entry", entity).Info("request handled")
+				})).Handler(router))
 				return ts.URL, ts.Close
 			},
 			httpMethod:      http.MethodPost,
@@ -107,15 +104,14 @@ func TestLogger(t *testing.T) {
 			setup: func() (string, func()) {
 
 				router := routing.New()
-				router.Use(middleware.Log(middleware.NewLogger(DateTimeFormat, []string{}, func(entity middleware.LogEntry, values ...interface{}) {
-					log.WithField("entry", entity).Info("request handled")
-				})).Handler)
 				router.Post("/endpoint", func(c *routing.Context) error {
 					c.Response.WriteHeader(http.StatusOK)
 					return c.Write([]byte(`{"number":6.13,"strings":["a","b"]}`))
 				})
 
-				ts := httptest.NewServer(router)
+				ts := httptest.NewServer(middleware.Log(middleware.NewLogger(DateTimeFormat, []string{}, func(entity middleware.LogEntry, values ...interface{}) {
+					log.WithField("entry", entity).Info("request handled")
+				})).Handler(router))
 				return ts.URL, ts.Close
 			},
 			httpMethod:      http.MethodPost,
@@ -130,15 +126,14 @@ func TestLogger(t *testing.T) {
 			setup: func() (string, func()) {
 
 				router := routing.New()
-				router.Use(middleware.Log(middleware.NewLogger(DateTimeFormat, []string{"/documents"}, func(entity middleware.LogEntry, values ...interface{}) {
-					log.WithField("entry", entity).Info("request handled")
-				})).Handler)
 				router.Post("/documents", func(c *routing.Context) error {
 					c.Response.WriteHeader(http.StatusOK)
 					return c.Write([]byte(`{"number":6.13,"strings":["a","b"]}`))
 				})
 
-				ts := httptest.NewServer(router)
+				ts := httptest.NewServer(middleware.Log(middleware.NewLogger(DateTimeFormat, []string{"/documents"}, func(entity middleware.LogEntry, values ...interface{}) {
+					log.WithField("entry", entity).Info("request handled")
+				})).Handler(router))
 				return ts.URL, ts.Close
 			},
 			httpMethod:      http.MethodPost,
@@ -202,15 +197,14 @@ func TestMaskingLogger(t *testing.T) {
 			setup: func(fieldsToMask []string) (string, func()) {
 
 				router := routing.New()
-				router.Use(middleware.Log(middleware.NewMaskingLogger(DateTimeFormat, fieldsToMask, nil, []string{}, func(logEntry middleware.LogEntry, values ...interface{}) {
-					log.WithField("entry", logEntry).Info("request handled")
-				})).Handler)
 				router.Post("/endpoint", func(c *routing.Context) error {
 					c.Response.WriteHeader(http.StatusOK)
 					return c.Write([]byte(`{"number":6.13,"strings":["a","b"],"name":"tester","location":{"name":"street"}}`))
 				})
 
-				ts := httptest.NewServer(router)
+				ts := httptest.NewServer(middleware.Log(middleware.NewMaskingLogger(DateTimeFormat, fieldsToMask, nil, []string{}, func(logEntry middleware.LogEntry, values ...interface{}) {
+					log.WithField("entry", logEntry).Info("request handled")
+				})).Handler(router))
 				return ts.URL, ts.Close
 			},
 			httpMethod:      http.MethodPost,
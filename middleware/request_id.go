@@ -4,20 +4,20 @@ import (
 	"context"
 	"github.com/ExperienceOne/apikit/internal/framework/xserver"
 	"github.com/ExperienceOne/apikit/pkg/requestid"
-	"github.com/go-ozzo/ozzo-routing"
 	"net/http"
 )
 
 // RequestID creates a middleware which creates a request ID and store the ID in the request context.
+// A generated ID is also set as X-Request-ID header of the request, e.g. to identify problem details.
 func RequestID() xserver.Middleware {
 
 	return xserver.Middleware{
-		Handler: func(c *routing.Context) error {
+		Handler: func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
-			ctx := contextWithRequestID(c.Request.Context(), c.Request)
-			c.Request = c.Request.WithContext(ctx)
-
-			return c.Next()
+				ctx := contextWithRequestID(r.Context(), r)
+				next.ServeHTTP(w, r.WithContext(ctx))
+			})
 		},
 	}
 }
@@ -27,6 +27,7 @@ func contextWithRequestID(ctx context.Context, req *http.Request) context.Contex
 	reqID := req.Header.Get(requestid.RequestIdHttpHeader)
 	if reqID == "" {
 		reqID = requestid.Generate()
+		req.Header.Set(requestid.RequestIdHttpHeader, reqID)
 	}
 
 	return context.WithValue(ctx, requestid.RequestIdKey, reqID)
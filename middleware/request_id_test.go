@@ -19,7 +19,6 @@ func TestRequestID(t *testing.T) {
 	var buf bytes.Buffer
 
 	router := routing.New()
-	router.Use(middleware.RequestID().Handler)
 	router.Get("/", func(c *routing.Context) error {
 
 		reqID := requestid.Get(c.Request.Context())
@@ -28,7 +27,7 @@ func TestRequestID(t *testing.T) {
 		return c.Write("done")
 	})
 
-	ts := httptest.NewServer(router)
+	ts := httptest.NewServer(middleware.RequestID().Handler(router))
 	defer ts.Close()
 
 	_, err := http.Get(ts.URL + "/")
@@ -0,0 +1,422 @@
+package roundtripper
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	CacheControlHeader string = "Cache-Control"
+	CacheStatusHeader  string = "X-Cache"
+)
+
+// CacheStatus tells how a request was answered by the Cache RoundTripper
+type CacheStatus string
+
+const (
+	// CacheHit is a fresh response of the cache, the request wasn't sent
+	CacheHit CacheStatus = "HIT"
+	// CacheMiss is a response of the server, which is stored if it's cacheable
+	CacheMiss CacheStatus = "MISS"
+	// CacheRevalidated is a stale response of the cache, which was confirmed by the server with 304 Not Modified
+	CacheRevalidated CacheStatus = "REVALIDATED"
+)
+
+// cacheableStatusCodes are the status codes which are cacheable by default,
+// see https://tools.ietf.org/html/rfc7231#section-6.1
+var cacheableStatusCodes = map[int]bool{
+	http.StatusOK:                   true,
+	http.StatusNonAuthoritativeInfo: true,
+	http.StatusNoContent:            true,
+	http.StatusMultipleChoices:      true,
+	http.StatusMovedPermanently:     true,
+	http.StatusNotFound:             true,
+	http.StatusMethodNotAllowed:     true,
+	http.StatusGone:                 true,
+	http.StatusRequestURITooLong:    true,
+	http.StatusNotImplemented:       true,
+}
+
+// CacheEntry is a response stored by the Cache RoundTripper
+type CacheEntry struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	// Vary contains the request headers named by the Vary header of the response, the stored response is only used
+	// for requests with the same values
+	Vary http.Header
+	// RequestTime and ResponseTime are the times the request was sent and the response was received
+	RequestTime  time.Time
+	ResponseTime time.Time
+}
+
+// Size returns the approximate number of bytes of an entry
+func (e *CacheEntry) Size() int64 {
+
+	size := int64(len(e.Body))
+	for _, header := range []http.Header{e.Header, e.Vary} {
+		for key, values := range header {
+			for _, value := range values {
+				size += int64(len(key) + len(value))
+			}
+		}
+	}
+	return size
+}
+
+// CacheStorage stores the entries of the Cache RoundTripper, it has to be safe for concurrent use
+type CacheStorage interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+	Delete(key string)
+}
+
+// CacheOpts configures the Cache RoundTripper, zero values are replaced by the defaults.
+type CacheOpts struct {
+	// Storage stores the responses, defaults to a LRUCacheStorage of 10 MiB
+	Storage CacheStorage
+	// MaxBodySize limits the bodies of responses which are stored, larger responses are passed through, defaults
+	// to 1 MiB
+	MaxBodySize int64
+	// OnResult is called with the cache status of every cacheable request, e.g. to export metrics
+	OnResult func(req *http.Request, status CacheStatus)
+}
+
+// Cache creates a RoundTripper which implements a private HTTP cache (https://tools.ietf.org/html/rfc7234). Fresh
+// responses are answered from the cache, stale responses with an ETag or Last-Modified are revalidated with a
+// conditional request. Only GET requests are answered from the cache, requests with conditional or range headers
+// are passed through. Responses to successful requests of other methods invalidate the stored response of the URL.
+// The cache status is set as X-Cache header of the responses.
+func Cache(opts CacheOpts) RoundTripper {
+
+	if opts.Storage == nil {
+		opts.Storage = NewLRUCacheStorage(0)
+	}
+	if opts.MaxBodySize <= 0 {
+		opts.MaxBodySize = 1 << 20
+	}
+
+	return func(next http.RoundTripper) http.RoundTripper {
+		return Func(func(req *http.Request) (*http.Response, error) {
+
+			key := cacheKey(req)
+
+			if req.Method != http.MethodGet {
+				resp, err := next.RoundTrip(req)
+				if err == nil && req.Method != http.MethodHead && req.Method != http.MethodOptions && resp.StatusCode < http.StatusBadRequest {
+					opts.Storage.Delete(key)
+				}
+				return resp, err
+			}
+
+			requestDirectives := parseCacheControl(req.Header)
+			if _, noStore := requestDirectives["no-store"]; noStore || hasConditionalHeaders(req) {
+				return next.RoundTrip(req)
+			}
+
+			entry, ok := opts.Storage.Get(key)
+			if ok && !entry.matches(req) {
+				entry, ok = nil, false
+			}
+
+			if ok && entry.isFresh(requestDirectives, time.Now()) {
+				return opts.result(req, entry.response(req, time.Now()), CacheHit), nil
+			}
+
+			sentReq := req
+			if ok && entry.hasValidators() {
+				sentReq = cloneRequest(req)
+				if etag := entry.Header.Get("ETag"); etag != "" {
+					sentReq.Header.Set("If-None-Match", etag)
+				}
+				if lastModified := entry.Header.Get("Last-Modified"); lastModified != "" {
+					sentReq.Header.Set("If-Modified-Since", lastModified)
+				}
+			}
+
+			requestTime := time.Now()
+			resp, err := next.RoundTrip(sentReq)
+			if err != nil {
+				return nil, err
+			}
+			responseTime := time.Now()
+
+			if ok && sentReq != req && resp.StatusCode == http.StatusNotModified {
+				discardResponse(resp)
+				entry = entry.revalidate(resp.Header, requestTime, responseTime)
+				opts.Storage.Set(key, entry)
+				return opts.result(req, entry.response(req, responseTime), CacheRevalidated), nil
+			}
+
+			if !isStorable(requestDirectives, resp) {
+				if ok {
+					opts.Storage.Delete(key)
+				}
+				return opts.result(req, resp, CacheMiss), nil
+			}
+
+			body, complete, err := readBody(resp, opts.MaxBodySize)
+			if err != nil {
+				return nil, err
+			}
+			if complete {
+				opts.Storage.Set(key, &CacheEntry{
+					StatusCode:   resp.StatusCode,
+					Header:       resp.Header.Clone(),
+					Body:         body,
+					Vary:         varyHeader(req, resp.Header),
+					RequestTime:  requestTime,
+					ResponseTime: responseTime,
+				})
+			}
+			return opts.result(req, resp, CacheMiss), nil
+		})
+	}
+}
+
+// result sets the cache status of a response and reports it to the callback of the options
+func (opts CacheOpts) result(req *http.Request, resp *http.Response, status CacheStatus) *http.Response {
+
+	resp.Header.Set(CacheStatusHeader, string(status))
+	if opts.OnResult != nil {
+		opts.OnResult(req, status)
+	}
+	return resp
+}
+
+// cacheKey identifies the stored response of a request, the variants of a Vary header share the key
+func cacheKey(req *http.Request) string {
+	return req.URL.String()
+}
+
+// hasConditionalHeaders checks if the caller handles the validation of the response itself
+func hasConditionalHeaders(req *http.Request) bool {
+
+	for _, header := range []string{"If-None-Match", "If-Modified-Since", "If-Match", "If-Unmodified-Since", "If-Range", "Range"} {
+		if req.Header.Get(header) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// isStorable checks if a response may be stored by a private cache and if it's useful to store it, responses
+// without freshness and validators are stale immediately
+func isStorable(requestDirectives map[string]string, resp *http.Response) bool {
+
+	if !cacheableStatusCodes[resp.StatusCode] || resp.Header.Get("Vary") == "*" {
+		return false
+	}
+
+	directives := parseCacheControl(resp.Header)
+	if _, noStore := directives["no-store"]; noStore {
+		return false
+	}
+	if _, noStore := requestDirectives["no-store"]; noStore {
+		return false
+	}
+
+	_, hasMaxAge := directives["max-age"]
+	return hasMaxAge || resp.Header.Get("Expires") != "" || resp.Header.Get("ETag") != "" || resp.Header.Get("Last-Modified") != ""
+}
+
+// readBody reads the body of a response up to the limit. The body of the response is replaced, so the caller can
+// still read the whole body if it exceeds the limit.
+func readBody(resp *http.Response, limit int64) ([]byte, bool, error) {
+
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return nil, true, nil
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		resp.Body.Close()
+		return nil, false, err
+	}
+
+	if int64(len(body)) > limit {
+		resp.Body = &prefixedBody{Reader: io.MultiReader(bytes.NewReader(body), resp.Body), Closer: resp.Body}
+		return nil, false, nil
+	}
+
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, true, nil
+}
+
+// prefixedBody is a body whose beginning was already read
+type prefixedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// varyHeader returns the request headers named by the Vary header of a response
+func varyHeader(req *http.Request, header http.Header) http.Header {
+
+	vary := make(http.Header)
+	for _, values := range header["Vary"] {
+		for _, name := range strings.Split(values, ",") {
+			if name = http.CanonicalHeaderKey(strings.TrimSpace(name)); name != "" {
+				vary[name] = req.Header[name]
+			}
+		}
+	}
+	return vary
+}
+
+// matches checks if the stored response was requested with the same values of the headers named by its Vary header
+func (e *CacheEntry) matches(req *http.Request) bool {
+
+	for name, values := range e.Vary {
+		if strings.Join(values, ",") != strings.Join(req.Header[name], ",") {
+			return false
+		}
+	}
+	return true
+}
+
+// hasValidators checks if the stored response can be revalidated
+func (e *CacheEntry) hasValidators() bool {
+	return e.Header.Get("ETag") != "" || e.Header.Get("Last-Modified") != ""
+}
+
+// isFresh checks if the stored response can be used without revalidation
+func (e *CacheEntry) isFresh(requestDirectives map[string]string, now time.Time) bool {
+
+	if _, noCache := requestDirectives["no-cache"]; noCache {
+		return false
+	}
+
+	age := e.age(now)
+	if maxAge, ok := parseSeconds(requestDirectives, "max-age"); ok && age > maxAge {
+		return false
+	}
+	return age < e.freshnessLifetime()
+}
+
+// freshnessLifetime returns the time the response is fresh after it was created,
+// see https://tools.ietf.org/html/rfc7234#section-4.2.1
+func (e *CacheEntry) freshnessLifetime() time.Duration {
+
+	directives := parseCacheControl(e.Header)
+	if _, noCache := directives["no-cache"]; noCache {
+		return 0
+	}
+	if maxAge, ok := parseSeconds(directives, "max-age"); ok {
+		return maxAge
+	}
+
+	if value := e.Header.Get("Expires"); value != "" {
+		// invalid dates represent a time in the past
+		expires, err := http.ParseTime(value)
+		if err != nil {
+			return 0
+		}
+		return expires.Sub(e.date())
+	}
+	return 0
+}
+
+// age returns the time since the response was created by the server,
+// see https://tools.ietf.org/html/rfc7234#section-4.2.3
+func (e *CacheEntry) age(now time.Time) time.Duration {
+
+	apparentAge := e.ResponseTime.Sub(e.date())
+	if apparentAge < 0 {
+		apparentAge = 0
+	}
+
+	correctedAge := e.ResponseTime.Sub(e.RequestTime)
+	if seconds, err := strconv.Atoi(e.Header.Get("Age")); err == nil && seconds > 0 {
+		correctedAge += time.Duration(seconds) * time.Second
+	}
+	if apparentAge > correctedAge {
+		correctedAge = apparentAge
+	}
+
+	return correctedAge + now.Sub(e.ResponseTime)
+}
+
+// date returns the time the response was created by the server
+func (e *CacheEntry) date() time.Time {
+
+	if date, err := http.ParseTime(e.Header.Get("Date")); err == nil {
+		return date
+	}
+	return e.ResponseTime
+}
+
+// revalidate returns a copy of the entry updated with the headers of a 304 Not Modified response
+func (e *CacheEntry) revalidate(header http.Header, requestTime, responseTime time.Time) *CacheEntry {
+
+	revalidated := *e
+	revalidated.Header = e.Header.Clone()
+	for name, values := range header {
+		if name != "Content-Length" {
+			revalidated.Header[name] = values
+		}
+	}
+	revalidated.RequestTime = requestTime
+	revalidated.ResponseTime = responseTime
+	return &revalidated
+}
+
+// response creates a response of the stored entry
+func (e *CacheEntry) response(req *http.Request, now time.Time) *http.Response {
+
+	header := e.Header.Clone()
+	header.Set("Age", strconv.Itoa(int(e.age(now)/time.Second)))
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)),
+		StatusCode:    e.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// parseCacheControl parses the directives of the Cache-Control headers, the names are lower case and quoted values
+// are unquoted
+func parseCacheControl(header http.Header) map[string]string {
+
+	directives := make(map[string]string)
+	for _, values := range header[CacheControlHeader] {
+		for _, directive := range strings.Split(values, ",") {
+			directive = strings.TrimSpace(directive)
+			if directive == "" {
+				continue
+			}
+			name, value := directive, ""
+			if i := strings.Index(directive, "="); i >= 0 {
+				name, value = directive[:i], strings.Trim(strings.TrimSpace(directive[i+1:]), `"`)
+			}
+			directives[strings.ToLower(strings.TrimSpace(name))] = value
+		}
+	}
+	return directives
+}
+
+// parseSeconds parses a directive with a number of seconds
+func parseSeconds(directives map[string]string, name string) (time.Duration, bool) {
+
+	value, ok := directives[name]
+	if !ok {
+		return 0, false
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
@@ -0,0 +1,99 @@
+package roundtripper
+
+import (
+	"container/list"
+	"sync"
+)
+
+// DefaultCacheSize is the size of a LRUCacheStorage without explicit size
+const DefaultCacheSize int64 = 10 << 20
+
+// LRUCacheStorage is an in-memory CacheStorage of a bounded size, the least recently used entries are removed if
+// the size is exceeded
+type LRUCacheStorage struct {
+	maxSize int64
+	mutex   sync.Mutex
+	size    int64
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+type lruItem struct {
+	key   string
+	entry *CacheEntry
+	size  int64
+}
+
+// NewLRUCacheStorage creates a LRUCacheStorage which holds up to maxSize bytes, a size of zero uses the
+// DefaultCacheSize
+func NewLRUCacheStorage(maxSize int64) *LRUCacheStorage {
+
+	if maxSize <= 0 {
+		maxSize = DefaultCacheSize
+	}
+	return &LRUCacheStorage{
+		maxSize: maxSize,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+func (s *LRUCacheStorage) Get(key string) (*CacheEntry, bool) {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	element, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	s.order.MoveToFront(element)
+	return element.Value.(*lruItem).entry, true
+}
+
+// Set stores an entry, entries larger than the size of the storage aren't stored
+func (s *LRUCacheStorage) Set(key string, entry *CacheEntry) {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.remove(key)
+
+	size := entry.Size() + int64(len(key))
+	if size > s.maxSize {
+		return
+	}
+
+	s.entries[key] = s.order.PushFront(&lruItem{key: key, entry: entry, size: size})
+	s.size += size
+
+	for s.size > s.maxSize {
+		s.remove(s.order.Back().Value.(*lruItem).key)
+	}
+}
+
+func (s *LRUCacheStorage) Delete(key string) {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.remove(key)
+}
+
+// Size returns the number of bytes of the stored entries
+func (s *LRUCacheStorage) Size() int64 {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.size
+}
+
+func (s *LRUCacheStorage) remove(key string) {
+
+	if element, ok := s.entries[key]; ok {
+		s.order.Remove(element)
+		delete(s.entries, key)
+		s.size -= element.Value.(*lruItem).size
+	}
+}
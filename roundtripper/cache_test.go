@@ -0,0 +1,256 @@
+package roundtripper
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// cachedGet sends a GET request and returns the cache status and body of the response
+func cachedGet(t *testing.T, httpClient *http.Client, url string, header http.Header) (string, string) {
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	for name, values := range header {
+		req.Header[name] = values
+	}
+
+	resp, err := httpClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.Header.Get(CacheStatusHeader), string(body)
+}
+
+func TestCacheFreshness(t *testing.T) {
+
+	var requests int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Cache-Control", "private, max-age=60")
+		w.Write([]byte("partners"))
+	}))
+	defer ts.Close()
+
+	var results []CacheStatus
+	httpClient := Use(new(http.Client), Cache(CacheOpts{
+		OnResult: func(req *http.Request, status CacheStatus) {
+			results = append(results, status)
+		},
+	}))
+
+	status, body := cachedGet(t, httpClient, ts.URL, nil)
+	require.Equal(t, string(CacheMiss), status)
+	require.Equal(t, "partners", body)
+
+	status, body = cachedGet(t, httpClient, ts.URL, nil)
+	require.Equal(t, string(CacheHit), status)
+	require.Equal(t, "partners", body)
+	require.Equal(t, 1, requests)
+
+	// the request can ask for a revalidation
+	status, _ = cachedGet(t, httpClient, ts.URL, http.Header{"Cache-Control": {"no-cache"}})
+	require.Equal(t, string(CacheMiss), status)
+	require.Equal(t, 2, requests)
+	require.Equal(t, []CacheStatus{CacheMiss, CacheHit, CacheMiss}, results)
+}
+
+func TestCacheRevalidation(t *testing.T) {
+
+	var conditionalRequests int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			conditionalRequests++
+			w.Header().Set("X-Revision", "2")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("X-Revision", "1")
+		w.Write([]byte("partner"))
+	}))
+	defer ts.Close()
+
+	httpClient := Use(new(http.Client), Cache(CacheOpts{}))
+
+	status, _ := cachedGet(t, httpClient, ts.URL, nil)
+	require.Equal(t, string(CacheMiss), status)
+
+	resp, err := httpClient.Get(ts.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	require.Equal(t, string(CacheRevalidated), resp.Header.Get(CacheStatusHeader))
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "partner", string(body))
+	require.Equal(t, "2", resp.Header.Get("X-Revision"))
+	require.Equal(t, 1, conditionalRequests)
+
+	// conditional requests of the caller are passed through
+	req, err := http.NewRequest(http.MethodGet, ts.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set("If-None-Match", `"v1"`)
+	resp, err = httpClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusNotModified, resp.StatusCode)
+	require.Empty(t, resp.Header.Get(CacheStatusHeader))
+}
+
+func TestCacheVary(t *testing.T) {
+
+	var requests int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Cache-Control", "max-age=60")
+		w.Header().Set("Vary", "Accept-Language")
+		w.Write([]byte(r.Header.Get("Accept-Language")))
+	}))
+	defer ts.Close()
+
+	httpClient := Use(new(http.Client), Cache(CacheOpts{}))
+
+	_, body := cachedGet(t, httpClient, ts.URL, http.Header{"Accept-Language": {"de"}})
+	require.Equal(t, "de", body)
+
+	status, body := cachedGet(t, httpClient, ts.URL, http.Header{"Accept-Language": {"de"}})
+	require.Equal(t, string(CacheHit), status)
+	require.Equal(t, "de", body)
+
+	status, body = cachedGet(t, httpClient, ts.URL, http.Header{"Accept-Language": {"en"}})
+	require.Equal(t, string(CacheMiss), status)
+	require.Equal(t, "en", body)
+	require.Equal(t, 2, requests)
+}
+
+func TestCacheNoStore(t *testing.T) {
+
+	var requests int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/no-store" {
+			w.Header().Set("Cache-Control", "no-store, max-age=60")
+		} else {
+			w.Header().Set("Cache-Control", "max-age=60")
+		}
+	}))
+	defer ts.Close()
+
+	httpClient := Use(new(http.Client), Cache(CacheOpts{}))
+
+	cachedGet(t, httpClient, ts.URL+"/no-store", nil)
+	status, _ := cachedGet(t, httpClient, ts.URL+"/no-store", nil)
+	require.Equal(t, string(CacheMiss), status)
+
+	cachedGet(t, httpClient, ts.URL+"/request", http.Header{"Cache-Control": {"no-store"}})
+	cachedGet(t, httpClient, ts.URL+"/request", http.Header{"Cache-Control": {"no-store"}})
+	require.Equal(t, 4, requests)
+}
+
+func TestCacheInvalidation(t *testing.T) {
+
+	var requests int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Cache-Control", "max-age=60")
+	}))
+	defer ts.Close()
+
+	httpClient := Use(new(http.Client), Cache(CacheOpts{}))
+
+	cachedGet(t, httpClient, ts.URL, nil)
+
+	req, err := http.NewRequest(http.MethodPut, ts.URL, strings.NewReader("partner"))
+	require.NoError(t, err)
+	resp, err := httpClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	status, _ := cachedGet(t, httpClient, ts.URL, nil)
+	require.Equal(t, string(CacheMiss), status)
+	require.Equal(t, 3, requests)
+}
+
+func TestCacheMaxBodySize(t *testing.T) {
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "max-age=60")
+		w.Write([]byte(strings.Repeat("x", 100)))
+	}))
+	defer ts.Close()
+
+	httpClient := Use(new(http.Client), Cache(CacheOpts{MaxBodySize: 10}))
+
+	_, body := cachedGet(t, httpClient, ts.URL, nil)
+	require.Len(t, body, 100)
+
+	status, body := cachedGet(t, httpClient, ts.URL, nil)
+	require.Equal(t, string(CacheMiss), status)
+	require.Len(t, body, 100)
+}
+
+func TestCacheEntryAge(t *testing.T) {
+
+	now := time.Now()
+	entry := &CacheEntry{
+		Header: http.Header{
+			"Date":          {now.Add(-10 * time.Second).UTC().Format(http.TimeFormat)},
+			"Age":           {"20"},
+			"Cache-Control": {"max-age=60"},
+		},
+		RequestTime:  now.Add(-time.Second),
+		ResponseTime: now,
+	}
+
+	require.Equal(t, 21*time.Second, entry.age(now))
+	require.True(t, entry.isFresh(nil, now.Add(30*time.Second)))
+	require.False(t, entry.isFresh(nil, now.Add(40*time.Second)))
+	require.False(t, entry.isFresh(map[string]string{"max-age": "10"}, now))
+
+	entry.Header = http.Header{"Expires": {now.Add(time.Minute).UTC().Format(http.TimeFormat)}, "Date": {now.UTC().Format(http.TimeFormat)}}
+	require.True(t, entry.freshnessLifetime() > 59*time.Second)
+
+	entry.Header.Set("Expires", "0")
+	require.Equal(t, time.Duration(0), entry.freshnessLifetime())
+}
+
+func TestLRUCacheStorage(t *testing.T) {
+
+	storage := NewLRUCacheStorage(30)
+
+	storage.Set("a", &CacheEntry{Body: []byte("0123456789")})
+	storage.Set("b", &CacheEntry{Body: []byte("0123456789")})
+	_, ok := storage.Get("a")
+	require.True(t, ok)
+
+	// the least recently used entry is removed
+	storage.Set("c", &CacheEntry{Body: []byte("0123456789")})
+	_, ok = storage.Get("b")
+	require.False(t, ok)
+	_, ok = storage.Get("a")
+	require.True(t, ok)
+	require.Equal(t, int64(22), storage.Size())
+
+	// entries larger than the storage aren't stored
+	storage.Set("d", &CacheEntry{Body: make([]byte, 100)})
+	_, ok = storage.Get("d")
+	require.False(t, ok)
+
+	storage.Delete("a")
+	require.Equal(t, int64(11), storage.Size())
+}
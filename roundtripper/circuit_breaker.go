@@ -0,0 +1,241 @@
+package roundtripper
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of a circuit of the CircuitBreaker RoundTripper. It's an alias of string, so the
+// HandleStateChange method of the generated CircuitBreakerHandler can be passed as OnStateChange callback.
+type CircuitState = string
+
+const (
+	// CircuitClosed lets all requests pass and counts their failures
+	CircuitClosed CircuitState = "closed"
+	// CircuitOpen rejects all requests until the cool-down has passed
+	CircuitOpen CircuitState = "open"
+	// CircuitHalfOpen lets a limited number of trial requests pass, which close the circuit if they succeed
+	CircuitHalfOpen CircuitState = "half-open"
+)
+
+// ErrCircuitOpen is the cause of all CircuitOpenErrors
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitOpenError is returned for requests which are rejected by an open circuit without being sent
+type CircuitOpenError struct {
+	// Key is the host of the request and its route, if the circuits are separated by route
+	Key string
+	// State is the state of the circuit, trial requests exceeding the limit are rejected by half-open circuits
+	State CircuitState
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker of '%s' is %s", e.Key, e.State)
+}
+
+// Is makes a CircuitOpenError match ErrCircuitOpen
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// CircuitBreakerOpts configures the CircuitBreaker RoundTripper, zero values are replaced by the defaults.
+type CircuitBreakerOpts struct {
+	// FailureRate is the rate of failed requests which opens a closed circuit, defaults to 0.5
+	FailureRate float64
+	// MinRequests is the number of requests of a window before the failure rate is checked, defaults to 10
+	MinRequests int
+	// Window is the period in which the requests of a closed circuit are counted, defaults to 1m
+	Window time.Duration
+	// CoolDown is the period in which an open circuit rejects requests before it becomes half-open, defaults to 30s
+	CoolDown time.Duration
+	// HalfOpenRequests is the number of trial requests of a half-open circuit, defaults to 1
+	HalfOpenRequests int
+	// Route returns the route template of a request, e.g. "/partners/{partnerId}". Requests of different routes of
+	// a host use different circuits if it's set, otherwise all requests of a host share one circuit.
+	Route func(req *http.Request) string
+	// IsFailure decides if a request failed, defaults to network errors and status codes 5xx
+	IsFailure func(resp *http.Response, err error) bool
+	// OnStateChange is called if a circuit changes its state, e.g. to export metrics. It's called in the order of the
+	// state changes while the circuits are locked, so it must not block.
+	OnStateChange func(key string, from, to CircuitState)
+}
+
+// CircuitBreaker creates a RoundTripper which fails fast with a CircuitOpenError if too many requests of a host
+// failed. Each host has a circuit, which is opened if the failure rate of its requests reaches the threshold. An
+// open circuit rejects all requests until the cool-down has passed, then it's half-open and lets trial requests
+// pass. It's closed again if all trials succeed and opened again if one of them fails.
+func CircuitBreaker(opts CircuitBreakerOpts) RoundTripper {
+
+	if opts.FailureRate <= 0 {
+		opts.FailureRate = 0.5
+	}
+	if opts.MinRequests <= 0 {
+		opts.MinRequests = 10
+	}
+	if opts.Window <= 0 {
+		opts.Window = time.Minute
+	}
+	if opts.CoolDown <= 0 {
+		opts.CoolDown = 30 * time.Second
+	}
+	if opts.HalfOpenRequests <= 0 {
+		opts.HalfOpenRequests = 1
+	}
+	if opts.IsFailure == nil {
+		opts.IsFailure = isServerFailure
+	}
+
+	breaker := &circuitBreaker{opts: opts, circuits: make(map[string]*circuit)}
+
+	return func(next http.RoundTripper) http.RoundTripper {
+		return Func(func(req *http.Request) (*http.Response, error) {
+
+			key := req.URL.Host
+			if opts.Route != nil {
+				key += opts.Route(req)
+			}
+
+			generation, err := breaker.allow(key)
+			if err != nil {
+				return nil, err
+			}
+
+			resp, err := next.RoundTrip(req)
+
+			// requests canceled by the caller don't tell anything about the host
+			if req.Context().Err() != nil {
+				breaker.release(key, generation)
+			} else {
+				breaker.record(key, generation, opts.IsFailure(resp, err))
+			}
+
+			return resp, err
+		})
+	}
+}
+
+// isServerFailure reports network errors and the status codes 5xx as failures
+func isServerFailure(resp *http.Response, err error) bool {
+	return err != nil || resp.StatusCode >= http.StatusInternalServerError
+}
+
+type circuitBreaker struct {
+	opts     CircuitBreakerOpts
+	mutex    sync.Mutex
+	circuits map[string]*circuit
+}
+
+// circuit counts the requests of a key, the generation is changed by every state change so results of requests
+// which were sent in a former state are ignored
+type circuit struct {
+	state      CircuitState
+	generation uint64
+	since      time.Time
+	requests   int
+	failures   int
+	trials     int
+	succeeded  int
+}
+
+// allow checks if a request may be sent and returns the generation of the circuit
+func (b *circuitBreaker) allow(key string) (uint64, error) {
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	now := time.Now()
+	c, ok := b.circuits[key]
+	if !ok {
+		c = &circuit{state: CircuitClosed, since: now}
+		b.circuits[key] = c
+	}
+
+	from := c.state
+	switch c.state {
+	case CircuitClosed:
+		if now.Sub(c.since) >= b.opts.Window {
+			c.since, c.requests, c.failures = now, 0, 0
+		}
+	case CircuitOpen:
+		if now.Sub(c.since) < b.opts.CoolDown {
+			return 0, &CircuitOpenError{Key: key, State: CircuitOpen}
+		}
+		c.change(CircuitHalfOpen, now)
+	}
+
+	b.stateChanged(key, from, c.state)
+
+	if c.state == CircuitHalfOpen {
+		if c.trials >= b.opts.HalfOpenRequests {
+			return 0, &CircuitOpenError{Key: key, State: CircuitHalfOpen}
+		}
+		c.trials++
+	}
+
+	return c.generation, nil
+}
+
+// record counts the result of a request sent in the given generation of the circuit
+func (b *circuitBreaker) record(key string, generation uint64, failed bool) {
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	now := time.Now()
+	c := b.circuits[key]
+	if c.generation != generation {
+		return
+	}
+
+	from := c.state
+	switch c.state {
+	case CircuitClosed:
+		c.requests++
+		if failed {
+			c.failures++
+		}
+		if c.requests >= b.opts.MinRequests && float64(c.failures) >= b.opts.FailureRate*float64(c.requests) {
+			c.change(CircuitOpen, now)
+		}
+	case CircuitHalfOpen:
+		if failed {
+			c.change(CircuitOpen, now)
+		} else if c.succeeded++; c.succeeded >= b.opts.HalfOpenRequests {
+			c.change(CircuitClosed, now)
+		}
+	}
+
+	b.stateChanged(key, from, c.state)
+}
+
+// release frees the trial of a request without result, so a half-open circuit can send another trial request
+func (b *circuitBreaker) release(key string, generation uint64) {
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if c := b.circuits[key]; c.generation == generation && c.state == CircuitHalfOpen {
+		c.trials--
+	}
+}
+
+// stateChanged calls the callback of the options, it's called while holding the lock to keep the order of the state
+// changes
+func (b *circuitBreaker) stateChanged(key string, from, to CircuitState) {
+
+	if from != to && b.opts.OnStateChange != nil {
+		b.opts.OnStateChange(key, from, to)
+	}
+}
+
+// change changes the state of a circuit and resets its counters
+func (c *circuit) change(state CircuitState, now time.Time) {
+
+	c.state = state
+	c.generation++
+	c.since = now
+	c.requests, c.failures, c.trials, c.succeeded = 0, 0, 0, 0
+}
@@ -0,0 +1,190 @@
+package roundtripper
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ExperienceOne/apikit/internal/framework/xprometheus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+
+	var status int32 = http.StatusServiceUnavailable
+	var requests int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(int(atomic.LoadInt32(&status)))
+	}))
+	defer ts.Close()
+
+	var changes []CircuitState
+	httpClient := Use(new(http.Client), CircuitBreaker(CircuitBreakerOpts{
+		MinRequests: 2,
+		CoolDown:    50 * time.Millisecond,
+		OnStateChange: func(key string, from, to CircuitState) {
+			changes = append(changes, to)
+		},
+	}))
+
+	get := func() (*http.Response, error) {
+		resp, err := httpClient.Get(ts.URL)
+		if err == nil {
+			resp.Body.Close()
+		}
+		return resp, err
+	}
+
+	// two failures open the circuit
+	for i := 0; i < 2; i++ {
+		resp, err := get()
+		require.NoError(t, err)
+		require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	}
+	require.Equal(t, []CircuitState{CircuitOpen}, changes)
+
+	// the open circuit fails fast
+	_, err := get()
+	require.True(t, errors.Is(err, ErrCircuitOpen))
+	var openErr *CircuitOpenError
+	require.True(t, errors.As(err, &openErr))
+	require.Equal(t, CircuitOpen, openErr.State)
+	require.Equal(t, int32(2), atomic.LoadInt32(&requests))
+
+	// a failed trial opens the circuit again
+	time.Sleep(60 * time.Millisecond)
+	resp, err := get()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	require.Equal(t, []CircuitState{CircuitOpen, CircuitHalfOpen, CircuitOpen}, changes)
+
+	// a successful trial closes the circuit
+	atomic.StoreInt32(&status, http.StatusOK)
+	time.Sleep(60 * time.Millisecond)
+	resp, err = get()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, []CircuitState{CircuitOpen, CircuitHalfOpen, CircuitOpen, CircuitHalfOpen, CircuitClosed}, changes)
+
+	resp, err = get()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestCircuitBreakerFailureRate(t *testing.T) {
+
+	var requests int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// every third request fails
+		if atomic.AddInt32(&requests, 1)%3 == 0 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer ts.Close()
+
+	httpClient := Use(new(http.Client), CircuitBreaker(CircuitBreakerOpts{MinRequests: 3}))
+
+	for i := 0; i < 12; i++ {
+		resp, err := httpClient.Get(ts.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	require.Equal(t, int32(12), atomic.LoadInt32(&requests))
+}
+
+func TestCircuitBreakerRoute(t *testing.T) {
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/failing" {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer ts.Close()
+
+	httpClient := Use(new(http.Client), CircuitBreaker(CircuitBreakerOpts{
+		MinRequests: 1,
+		Route: func(req *http.Request) string {
+			return req.URL.Path
+		},
+	}))
+
+	resp, err := httpClient.Get(ts.URL + "/failing")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	_, err = httpClient.Get(ts.URL + "/failing")
+	require.True(t, errors.Is(err, ErrCircuitOpen))
+
+	resp, err = httpClient.Get(ts.URL + "/working")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestCircuitBreakerRetry(t *testing.T) {
+
+	var requests int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	breaker := CircuitBreaker(CircuitBreakerOpts{MinRequests: 2})
+	httpClient := Use(new(http.Client), breaker, Retry(RetryOpts{MaxAttempts: 5, MinBackoff: time.Millisecond}))
+
+	// the retries stop once the circuit is open
+	_, err := httpClient.Get(ts.URL)
+	require.True(t, errors.Is(err, ErrCircuitOpen))
+	require.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestCircuitBreakerStateChangeOrder(t *testing.T) {
+
+	namespace := "order_test"
+	metrics := xprometheus.NewCircuitBreakerHandler(&namespace)
+
+	// the circuit changes between open and half-open while the requests fail in parallel
+	var changes [][2]CircuitState
+	transport := CircuitBreaker(CircuitBreakerOpts{
+		MinRequests: 1,
+		CoolDown:    time.Nanosecond,
+		OnStateChange: func(key string, from, to CircuitState) {
+			metrics.HandleStateChange(key, from, to)
+			changes = append(changes, [2]CircuitState{from, to})
+		},
+	})(Func(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("host unavailable")
+	}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				req := httptest.NewRequest(http.MethodGet, "http://example.com/partners", nil)
+				_, _ = transport.RoundTrip(req)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// every change starts in the state the previous change ended in
+	require.True(t, len(changes) > 2)
+	require.Equal(t, CircuitClosed, changes[0][0])
+	for i := 1; i < len(changes); i++ {
+		require.Equal(t, changes[i-1][1], changes[i][0])
+	}
+
+	// the handler can be passed as callback
+	_ = CircuitBreakerOpts{OnStateChange: metrics.HandleStateChange}
+}
@@ -0,0 +1,140 @@
+package roundtripper
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+const (
+	EncodingGzip    string = "gzip"
+	EncodingDeflate string = "deflate"
+)
+
+// Compress creates a RoundTripper which asks for gzip or deflate encoded responses and decompresses them.
+// Request bodies are compressed with the given encoding, an empty encoding sends them uncompressed. Only
+// compress requests for servers which are known to decompress them.
+func Compress(encoding string) RoundTripper {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return Func(func(req *http.Request) (*http.Response, error) {
+
+			if encoding != "" && encoding != EncodingGzip && encoding != EncodingDeflate {
+				return nil, fmt.Errorf("unsupported content encoding: %s", encoding)
+			}
+
+			req, err := compressRequest(req, encoding)
+			if err != nil {
+				return nil, err
+			}
+
+			// responses are only decompressed if they were asked for, e.g. not for a custom Accept-Encoding
+			decompress := req.Header.Get("Accept-Encoding") == ""
+			if decompress {
+				req = cloneRequest(req)
+				req.Header.Set("Accept-Encoding", EncodingGzip+", "+EncodingDeflate)
+			}
+
+			resp, err := next.RoundTrip(req)
+			if err != nil || !decompress {
+				return resp, err
+			}
+
+			contentEncoding := strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding")))
+			if contentEncoding != EncodingGzip && contentEncoding != EncodingDeflate {
+				return resp, nil
+			}
+
+			resp.Body = &decompressingBody{body: resp.Body, encoding: contentEncoding}
+			resp.Header.Del("Content-Encoding")
+			resp.Header.Del("Content-Length")
+			resp.ContentLength = -1
+			resp.Uncompressed = true
+
+			return resp, nil
+		})
+	}
+}
+
+// compressRequest returns a copy of the request with a compressed body, the body must not be encoded already
+func compressRequest(req *http.Request, encoding string) (*http.Request, error) {
+
+	if encoding == "" || req.Body == nil || req.Body == http.NoBody || req.Header.Get("Content-Encoding") != "" {
+		return req, nil
+	}
+
+	data, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	var writer io.WriteCloser
+	if encoding == EncodingGzip {
+		writer = gzip.NewWriter(&buf)
+	} else {
+		writer = zlib.NewWriter(&buf)
+	}
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	compressed := buf.Bytes()
+	req = cloneRequest(req)
+	req.Header.Set("Content-Encoding", encoding)
+	req.Header.Del("Content-Length")
+	req.ContentLength = int64(len(compressed))
+	req.Body = ioutil.NopCloser(bytes.NewReader(compressed))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(compressed)), nil
+	}
+
+	return req, nil
+}
+
+// cloneRequest returns a copy of the request with its own headers, which can be changed by a RoundTripper
+func cloneRequest(req *http.Request) *http.Request {
+
+	clone := req.WithContext(req.Context())
+	clone.Header = req.Header.Clone()
+	return clone
+}
+
+// decompressingBody decompresses a response body with its first read, so empty bodies aren't read at all
+type decompressingBody struct {
+	body     io.ReadCloser
+	encoding string
+	reader   io.ReadCloser
+}
+
+func (b *decompressingBody) Read(p []byte) (int, error) {
+
+	if b.reader == nil {
+		var err error
+		if b.encoding == EncodingGzip {
+			b.reader, err = gzip.NewReader(b.body)
+		} else {
+			b.reader, err = zlib.NewReader(b.body)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	return b.reader.Read(p)
+}
+
+func (b *decompressingBody) Close() error {
+
+	if b.reader != nil {
+		b.reader.Close()
+	}
+	return b.body.Close()
+}
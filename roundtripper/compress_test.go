@@ -0,0 +1,71 @@
+package roundtripper
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompress(t *testing.T) {
+
+	var requestBody, contentEncoding, acceptEncoding string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		contentEncoding = r.Header.Get("Content-Encoding")
+		acceptEncoding = r.Header.Get("Accept-Encoding")
+
+		reader, err := gzip.NewReader(r.Body)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		requestBody = string(data)
+
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		writer.Write([]byte("pong"))
+		writer.Close()
+
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write(buf.Bytes())
+	}))
+	defer ts.Close()
+
+	httpClient := Use(new(http.Client), Compress(EncodingGzip))
+
+	resp, err := httpClient.Post(ts.URL, "text/plain", strings.NewReader("ping"))
+	require.NoError(t, err, "failed to send post request")
+	defer resp.Body.Close()
+
+	require.Equal(t, "gzip", contentEncoding)
+	require.Equal(t, "gzip, deflate", acceptEncoding)
+	require.Equal(t, "ping", requestBody)
+
+	data, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, "pong", string(data))
+	require.Empty(t, resp.Header.Get("Content-Encoding"))
+	require.True(t, resp.Uncompressed)
+}
+
+func TestCompressEmptyResponse(t *testing.T) {
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	httpClient := Use(new(http.Client), Compress(""))
+
+	resp, err := httpClient.Get(ts.URL)
+	require.NoError(t, err, "failed to send get request")
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	require.NoError(t, resp.Body.Close())
+}
@@ -0,0 +1,281 @@
+package roundtripper
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	RateLimitRemainingHeader string = "X-RateLimit-Remaining"
+	RateLimitResetHeader     string = "X-RateLimit-Reset"
+)
+
+// ErrRateLimited is returned for requests whose wait for the rate limit would exceed the deadline of their context
+var ErrRateLimited = errors.New("rate limit wait exceeds the deadline of the request")
+
+// RateLimitOpts configures the RateLimit RoundTripper, zero values are replaced by the defaults.
+type RateLimitOpts struct {
+	// Rate is the number of requests per second of a host, defaults to 10
+	Rate float64
+	// Burst is the number of requests of a host which can be sent at once, defaults to 1
+	Burst int
+	// MaxInFlight limits the concurrent requests of a host, a request is in flight until its response body is
+	// closed. Zero doesn't limit the concurrent requests.
+	MaxInFlight int
+	// Route returns the route template of a request, e.g. "/partners/{partnerId}". Requests of different routes of
+	// a host have separate limits if it's set, otherwise all requests of a host share the limits.
+	Route func(req *http.Request) string
+	// OnWait is called with the time every request waited for the limits, e.g. to export metrics
+	OnWait func(req *http.Request, wait time.Duration)
+}
+
+// RateLimit creates a RoundTripper which limits the requests of each host with a token bucket and optionally the
+// number of concurrent requests. Requests block until they may be sent, a request whose wait would exceed the
+// deadline of its context fails with ErrRateLimited without waiting. The limit adapts to the quota of the server:
+// an exhausted X-RateLimit-Remaining pauses the requests until X-RateLimit-Reset, a remaining quota lowers the rate
+// so it lasts until the reset, and a Retry-After header of a 429 or 503 response pauses the requests as requested.
+func RateLimit(opts RateLimitOpts) RoundTripper {
+
+	if opts.Rate <= 0 {
+		opts.Rate = 10
+	}
+	if opts.Burst <= 0 {
+		opts.Burst = 1
+	}
+
+	limiter := &rateLimiter{opts: opts, buckets: make(map[string]*bucket)}
+
+	return func(next http.RoundTripper) http.RoundTripper {
+		return Func(func(req *http.Request) (*http.Response, error) {
+
+			key := req.URL.Host
+			if opts.Route != nil {
+				key += opts.Route(req)
+			}
+
+			start := time.Now()
+			b := limiter.bucket(key)
+
+			if err := limiter.wait(req.Context(), b, start); err != nil {
+				return nil, err
+			}
+
+			release, err := b.acquire(req.Context())
+			if err != nil {
+				return nil, err
+			}
+
+			if opts.OnWait != nil {
+				opts.OnWait(req, time.Since(start))
+			}
+
+			resp, err := next.RoundTrip(req)
+			if err != nil {
+				release()
+				return nil, err
+			}
+
+			limiter.adapt(b, resp, time.Now())
+
+			if resp.Body == nil {
+				release()
+			} else {
+				resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+			}
+			return resp, nil
+		})
+	}
+}
+
+type rateLimiter struct {
+	opts    RateLimitOpts
+	mutex   sync.Mutex
+	buckets map[string]*bucket
+}
+
+// bucket holds the tokens of a key, the last refill is in the future while the requests are paused. The rate is
+// lowered until adaptedUntil if the server reported a smaller quota.
+type bucket struct {
+	tokens       float64
+	last         time.Time
+	rate         float64
+	adaptedUntil time.Time
+	inFlight     chan struct{}
+}
+
+// bucket returns the bucket of a key, it's created with full tokens
+func (l *rateLimiter) bucket(key string) *bucket {
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(l.opts.Burst), last: time.Now(), rate: l.opts.Rate}
+		if l.opts.MaxInFlight > 0 {
+			b.inFlight = make(chan struct{}, l.opts.MaxInFlight)
+		}
+		l.buckets[key] = b
+	}
+	return b
+}
+
+// wait takes a token of the bucket and waits until it's available
+func (l *rateLimiter) wait(ctx context.Context, b *bucket, now time.Time) error {
+
+	wait := l.reserve(b, now)
+	if wait <= 0 {
+		return nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && deadline.Before(now.Add(wait)) {
+		l.cancel(b)
+		return ErrRateLimited
+	}
+
+	timer := time.NewTimer(wait)
+	select {
+	case <-ctx.Done():
+		timer.Stop()
+		l.cancel(b)
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve takes a token of the bucket and returns the time until it's available
+func (l *rateLimiter) reserve(b *bucket, now time.Time) time.Duration {
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if !b.adaptedUntil.IsZero() && !now.Before(b.adaptedUntil) {
+		b.rate, b.adaptedUntil = l.opts.Rate, time.Time{}
+	}
+
+	if now.After(b.last) {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if burst := float64(l.opts.Burst); b.tokens > burst {
+			b.tokens = burst
+		}
+		b.last = now
+	}
+
+	b.tokens--
+	wait := b.last.Sub(now)
+	if b.tokens < 0 {
+		wait += time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	return wait
+}
+
+// cancel returns the token of a request which isn't sent
+func (l *rateLimiter) cancel(b *bucket) {
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	b.tokens++
+}
+
+// adapt changes the limits of a bucket by the rate limit headers of a response
+func (l *rateLimiter) adapt(b *bucket, resp *http.Response, now time.Time) {
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get(RetryAfterHeader), now); ok {
+			l.pause(b, now.Add(retryAfter))
+			return
+		}
+	}
+
+	remaining, err := strconv.Atoi(resp.Header.Get(RateLimitRemainingHeader))
+	if err != nil || remaining < 0 {
+		return
+	}
+	untilReset, ok := parseRateLimitReset(resp.Header.Get(RateLimitResetHeader), now)
+	if !ok || untilReset <= 0 {
+		return
+	}
+
+	if remaining == 0 {
+		l.pause(b, now.Add(untilReset))
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if rate := float64(remaining) / untilReset.Seconds(); rate < l.opts.Rate {
+		b.rate, b.adaptedUntil = rate, now.Add(untilReset)
+	}
+}
+
+// pause stops the requests of a bucket until the given time, then one request may be sent
+func (l *rateLimiter) pause(b *bucket, until time.Time) {
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if until.After(b.last) {
+		b.tokens, b.last = 1, until
+	}
+}
+
+// acquire waits for a free slot of the concurrent requests and returns the function which frees it
+func (b *bucket) acquire(ctx context.Context) (func(), error) {
+
+	if b.inFlight == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case b.inFlight <- struct{}{}:
+		var once sync.Once
+		return func() {
+			once.Do(func() { <-b.inFlight })
+		}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// parseRateLimitReset parses the value of a X-RateLimit-Reset header, which is either a number of seconds, a unix
+// timestamp or a HTTP date
+func parseRateLimitReset(value string, now time.Time) (time.Duration, bool) {
+
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return parseRetryAfter(value, now)
+	}
+
+	// numbers of seconds are much smaller than the timestamps of the last decades
+	if seconds > 1e9 {
+		if wait := time.Unix(seconds, 0).Sub(now); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	if seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// releasingBody frees the slot of a request when its response body is closed
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
@@ -0,0 +1,165 @@
+package roundtripper
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit(t *testing.T) {
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	var mutex sync.Mutex
+	var waits []time.Duration
+	httpClient := Use(new(http.Client), RateLimit(RateLimitOpts{
+		Rate: 20,
+		OnWait: func(req *http.Request, wait time.Duration) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			waits = append(waits, wait)
+		},
+	}))
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		resp, err := httpClient.Get(ts.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	require.True(t, time.Since(start) >= 90*time.Millisecond, "requests weren't limited: %v", time.Since(start))
+	require.Len(t, waits, 3)
+	require.True(t, waits[0] < 10*time.Millisecond)
+	require.True(t, waits[2] >= 40*time.Millisecond)
+}
+
+func TestRateLimitDeadline(t *testing.T) {
+
+	var requests int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+	}))
+	defer ts.Close()
+
+	httpClient := Use(new(http.Client), RateLimit(RateLimitOpts{Rate: 1}))
+
+	resp, err := httpClient.Get(ts.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, nil)
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = httpClient.Do(req)
+	require.True(t, errors.Is(err, ErrRateLimited))
+	require.True(t, time.Since(start) < 40*time.Millisecond, "request waited for the rate limit")
+	require.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestRateLimitMaxInFlight(t *testing.T) {
+
+	var inFlight, maxInFlight int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			highest := atomic.LoadInt32(&maxInFlight)
+			if current <= highest || atomic.CompareAndSwapInt32(&maxInFlight, highest, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer ts.Close()
+
+	httpClient := Use(new(http.Client), RateLimit(RateLimitOpts{Rate: 1000, Burst: 10, MaxInFlight: 2}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := httpClient.Get(ts.URL)
+			require.NoError(t, err)
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(2), atomic.LoadInt32(&maxInFlight))
+}
+
+func TestRateLimitAdapt(t *testing.T) {
+
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		status   int
+		header   map[string]string
+		expected time.Duration
+	}{
+		{
+			name:     "retry after",
+			status:   http.StatusTooManyRequests,
+			header:   map[string]string{RetryAfterHeader: "3"},
+			expected: 3 * time.Second,
+		},
+		{
+			name:     "exhausted quota",
+			status:   http.StatusOK,
+			header:   map[string]string{RateLimitRemainingHeader: "0", RateLimitResetHeader: "5"},
+			expected: 5 * time.Second,
+		},
+		{
+			name:     "exhausted quota with timestamp",
+			status:   http.StatusOK,
+			header:   map[string]string{RateLimitRemainingHeader: "0", RateLimitResetHeader: strconv.FormatInt(now.Add(time.Minute).Unix(), 10)},
+			expected: time.Unix(now.Add(time.Minute).Unix(), 0).Sub(now),
+		},
+		{
+			name:     "remaining quota",
+			status:   http.StatusOK,
+			header:   map[string]string{RateLimitRemainingHeader: "2", RateLimitResetHeader: "10"},
+			expected: 5 * time.Second,
+		},
+		{
+			name:     "without headers",
+			status:   http.StatusOK,
+			header:   map[string]string{},
+			expected: 100 * time.Millisecond,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			limiter := &rateLimiter{opts: RateLimitOpts{Rate: 10, Burst: 1}, buckets: make(map[string]*bucket)}
+			b := &bucket{tokens: 1, last: now, rate: 10}
+
+			header := make(http.Header)
+			for name, value := range test.header {
+				header.Set(name, value)
+			}
+
+			require.Equal(t, time.Duration(0), limiter.reserve(b, now))
+			limiter.adapt(b, &http.Response{StatusCode: test.status, Header: header}, now)
+			require.Equal(t, test.expected, limiter.reserve(b, now))
+		})
+	}
+}
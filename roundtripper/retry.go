@@ -0,0 +1,192 @@
+package roundtripper
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	IdempotencyKeyHeader string = "Idempotency-Key"
+	RetryAfterHeader     string = "Retry-After"
+)
+
+// maxDrainSize limits the bytes of a response body which are read before the response is discarded for a retry,
+// so the connection can be reused
+const maxDrainSize int64 = 4 << 10
+
+// RetryOpts configures the Retry RoundTripper, zero values are replaced by the defaults.
+type RetryOpts struct {
+	// MaxAttempts is the maximum number of attempts of a request including the first one, defaults to 3
+	MaxAttempts int
+	// MinBackoff is the backoff before the first retry, it's doubled with every further retry, defaults to 100ms
+	MinBackoff time.Duration
+	// MaxBackoff limits the backoff between two attempts, defaults to 10s. A server asking for a longer wait via
+	// Retry-After gets its response returned instead of a retry.
+	MaxBackoff time.Duration
+	// Retryable decides if a response or error is retried, defaults to RetryableResponse
+	Retryable func(resp *http.Response, err error) bool
+}
+
+// RetryableResponse reports network errors and the status codes 429 (Too Many Requests), 502 (Bad Gateway), 503
+// (Service Unavailable) and 504 (Gateway Timeout) as retryable. Requests rejected by an open circuit aren't retried.
+func RetryableResponse(resp *http.Response, err error) bool {
+
+	if err != nil {
+		return !errors.Is(err, ErrCircuitOpen)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// Retry creates a RoundTripper which retries failed requests with an exponential backoff and jitter. The Retry-After
+// header of a response overrides the backoff. Only idempotent requests are retried, these are GET, HEAD, PUT and
+// DELETE requests and POST requests with an Idempotency-Key header. Request bodies are rewound via GetBody,
+// requests with a body but without GetBody are sent only once.
+func Retry(opts RetryOpts) RoundTripper {
+
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 3
+	}
+	if opts.MinBackoff <= 0 {
+		opts.MinBackoff = 100 * time.Millisecond
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = 10 * time.Second
+	}
+	if opts.Retryable == nil {
+		opts.Retryable = RetryableResponse
+	}
+
+	return func(next http.RoundTripper) http.RoundTripper {
+		return Func(func(req *http.Request) (*http.Response, error) {
+
+			if !isIdempotent(req) || !isRewindable(req) {
+				return next.RoundTrip(req)
+			}
+
+			attemptReq := req
+			for attempt := 1; ; attempt++ {
+
+				resp, err := next.RoundTrip(attemptReq)
+				if attempt >= opts.MaxAttempts || req.Context().Err() != nil || !opts.Retryable(resp, err) {
+					return resp, err
+				}
+
+				wait := backoff(opts.MinBackoff, opts.MaxBackoff, attempt)
+				if resp != nil {
+					if retryAfter, ok := parseRetryAfter(resp.Header.Get(RetryAfterHeader), time.Now()); ok {
+						if retryAfter > opts.MaxBackoff {
+							return resp, nil
+						}
+						wait = retryAfter
+					}
+					discardResponse(resp)
+				}
+
+				timer := time.NewTimer(wait)
+				select {
+				case <-req.Context().Done():
+					timer.Stop()
+					return nil, req.Context().Err()
+				case <-timer.C:
+				}
+
+				attemptReq, err = rewindRequest(req)
+				if err != nil {
+					return nil, err
+				}
+			}
+		})
+	}
+}
+
+// isIdempotent checks if a request can be sent more than once
+func isIdempotent(req *http.Request) bool {
+
+	switch req.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		return req.Header.Get(IdempotencyKeyHeader) != ""
+	default:
+		return false
+	}
+}
+
+// isRewindable checks if the body of a request can be sent again
+func isRewindable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// rewindRequest returns a copy of the request with a new body for another attempt
+func rewindRequest(req *http.Request) (*http.Request, error) {
+
+	clone := cloneRequest(req)
+	if req.Body == nil || req.Body == http.NoBody {
+		return clone, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	clone.Body = body
+	return clone, nil
+}
+
+// backoff returns the exponential backoff after an attempt with a random jitter of up to half of the backoff
+func backoff(minBackoff, maxBackoff time.Duration, attempt int) time.Duration {
+
+	wait := minBackoff
+	for i := 1; i < attempt && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxBackoff {
+		wait = maxBackoff
+	}
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or a HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if wait := date.Sub(now); wait > 0 {
+		return wait, true
+	}
+	return 0, true
+}
+
+// discardResponse drains and closes the body of a response which isn't returned to the caller
+func discardResponse(resp *http.Response) {
+
+	if resp.Body == nil {
+		return
+	}
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxDrainSize))
+	resp.Body.Close()
+}
@@ -0,0 +1,171 @@
+package roundtripper
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetry(t *testing.T) {
+
+	var attempts int
+	var bodies []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		attempts++
+		data, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		bodies = append(bodies, string(data))
+
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("pong"))
+	}))
+	defer ts.Close()
+
+	httpClient := Use(new(http.Client), Retry(RetryOpts{MinBackoff: time.Millisecond}))
+
+	req, err := http.NewRequest(http.MethodPut, ts.URL, strings.NewReader("ping"))
+	require.NoError(t, err)
+
+	resp, err := httpClient.Do(req)
+	require.NoError(t, err, "failed to send put request")
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, 3, attempts)
+	require.Equal(t, []string{"ping", "ping", "ping"}, bodies)
+}
+
+func TestRetryMaxAttempts(t *testing.T) {
+
+	var attempts int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer ts.Close()
+
+	httpClient := Use(new(http.Client), Retry(RetryOpts{MaxAttempts: 2, MinBackoff: time.Millisecond}))
+
+	resp, err := httpClient.Get(ts.URL)
+	require.NoError(t, err, "failed to send get request")
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	require.Equal(t, 2, attempts)
+}
+
+func TestRetryIdempotency(t *testing.T) {
+
+	tests := []struct {
+		name           string
+		method         string
+		idempotencyKey string
+		attempts       int
+	}{
+		{name: "delete", method: http.MethodDelete, attempts: 2},
+		{name: "post", method: http.MethodPost, attempts: 1},
+		{name: "post with idempotency key", method: http.MethodPost, idempotencyKey: "key", attempts: 2},
+		{name: "patch", method: http.MethodPatch, attempts: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			var attempts int
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				w.WriteHeader(http.StatusTooManyRequests)
+			}))
+			defer ts.Close()
+
+			httpClient := Use(new(http.Client), Retry(RetryOpts{MaxAttempts: 2, MinBackoff: time.Millisecond}))
+
+			req, err := http.NewRequest(test.method, ts.URL, strings.NewReader("ping"))
+			require.NoError(t, err)
+			if test.idempotencyKey != "" {
+				req.Header.Set(IdempotencyKeyHeader, test.idempotencyKey)
+			}
+
+			resp, err := httpClient.Do(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			require.Equal(t, test.attempts, attempts)
+		})
+	}
+}
+
+func TestRetryNetworkError(t *testing.T) {
+
+	var attempts int
+
+	transport := Func(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		if attempts == 1 {
+			return nil, errors.New("connection reset")
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+	})
+
+	httpClient := Use(&http.Client{Transport: transport}, Retry(RetryOpts{MinBackoff: time.Millisecond}))
+
+	resp, err := httpClient.Get("http://localhost")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, 2, attempts)
+}
+
+func TestRetryAfter(t *testing.T) {
+
+	var attempts int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set(RetryAfterHeader, "120")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	// the server asks for a longer wait than the maximum backoff, so its response is returned
+	httpClient := Use(new(http.Client), Retry(RetryOpts{MinBackoff: time.Millisecond, MaxBackoff: time.Second}))
+
+	resp, err := httpClient.Get(ts.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	require.Equal(t, 1, attempts)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	wait, ok := parseRetryAfter("3", now)
+	require.True(t, ok)
+	require.Equal(t, 3*time.Second, wait)
+
+	wait, ok = parseRetryAfter(now.Add(time.Minute).Format(http.TimeFormat), now)
+	require.True(t, ok)
+	require.Equal(t, time.Minute, wait)
+
+	wait, ok = parseRetryAfter(now.Add(-time.Minute).Format(http.TimeFormat), now)
+	require.True(t, ok)
+	require.Equal(t, time.Duration(0), wait)
+
+	_, ok = parseRetryAfter("soon", now)
+	require.False(t, ok)
+}
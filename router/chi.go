@@ -0,0 +1,75 @@
+package router
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ExperienceOne/apikit/internal/framework/xserver"
+	"github.com/go-chi/chi/v5"
+)
+
+type chiRouter struct {
+	chi.Router
+}
+
+// Chi creates a router adapter for a chi router. If router is nil a new
+// chi router is created.
+func Chi(router chi.Router) xserver.Router {
+
+	if router == nil {
+		router = chi.NewRouter()
+	}
+
+	return &chiRouter{Router: router}
+}
+
+func (router *chiRouter) Handle(method, path string, handler http.Handler) {
+
+	router.Router.Method(method, path, handler)
+}
+
+func (router *chiRouter) PathParam(r *http.Request, name string) string {
+
+	value := chi.URLParam(r, name)
+
+	// chi matches against the raw path if the path contains escaped characters
+	if r.URL.RawPath != "" {
+		if unescaped, err := url.PathUnescape(value); err == nil {
+			return unescaped
+		}
+	}
+
+	return value
+}
+
+func (router *chiRouter) HandleNotFound(handler http.Handler) {
+
+	router.Router.NotFound(handler.ServeHTTP)
+}
+
+func (router *chiRouter) HandleMethodNotAllowed(handler http.Handler) {
+
+	router.Router.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Allow", strings.Join(router.allowedMethods(r), ", "))
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// allowedMethods returns the methods of the routes which match the path of a request, chi passes them to its
+// default handler only
+func (router *chiRouter) allowedMethods(r *http.Request) []string {
+
+	path := r.URL.Path
+	if r.URL.RawPath != "" {
+		path = r.URL.RawPath
+	}
+
+	var methods []string
+	for _, method := range []string{http.MethodConnect, http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPatch, http.MethodPost, http.MethodPut, http.MethodTrace} {
+		if router.Router.Match(chi.NewRouteContext(), method, path) {
+			methods = append(methods, method)
+		}
+	}
+	return methods
+}
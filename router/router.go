@@ -0,0 +1,14 @@
+// Package router provides adapters which let generated servers dispatch
+// requests with different HTTP routers.
+package router
+
+import (
+	"github.com/ExperienceOne/apikit/internal/framework/xserver"
+)
+
+// Ozzo creates a router adapter based on ozzo-routing, which is the default
+// router of generated servers.
+func Ozzo() xserver.Router {
+
+	return xserver.NewOzzoRouter()
+}
@@ -0,0 +1,102 @@
+package router_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ExperienceOne/apikit/internal/framework/xserver"
+	"github.com/ExperienceOne/apikit/router"
+)
+
+func testRouter(t *testing.T, r xserver.Router) {
+
+	r.Handle(http.MethodGet, "/users/{userId}/items/{itemId}", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(r.PathParam(req, "userId") + ":" + r.PathParam(req, "itemId")))
+	}))
+	r.Handle(http.MethodPost, "/users", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+	r.HandleNotFound(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("custom not found"))
+	}))
+	r.HandleMethodNotAllowed(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		w.Write([]byte("custom method not allowed"))
+	}))
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		wantStatus int
+		wantBody   string
+		wantAllow  string
+	}{
+		{
+			name:       "PathParams",
+			method:     http.MethodGet,
+			path:       "/users/42/items/7",
+			wantStatus: http.StatusOK,
+			wantBody:   "42:7",
+		},
+		{
+			name:       "EscapedPathParam",
+			method:     http.MethodGet,
+			path:       "/users/a%2Fb/items/7",
+			wantStatus: http.StatusOK,
+			wantBody:   "a/b:7",
+		},
+		{
+			name:       "Method",
+			method:     http.MethodPost,
+			path:       "/users",
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "NotFound",
+			method:     http.MethodGet,
+			path:       "/unknown",
+			wantStatus: http.StatusNotFound,
+			wantBody:   "custom not found",
+		},
+		{
+			name:       "MethodNotAllowed",
+			method:     http.MethodDelete,
+			path:       "/users",
+			wantStatus: http.StatusMethodNotAllowed,
+			wantBody:   "custom method not allowed",
+			wantAllow:  http.MethodPost,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, httptest.NewRequest(tc.method, tc.path, nil))
+
+			if recorder.Code != tc.wantStatus {
+				t.Fatalf("status code = %d, want %d", recorder.Code, tc.wantStatus)
+			}
+			if tc.wantBody != "" && recorder.Body.String() != tc.wantBody {
+				t.Fatalf("body = %q, want %q", recorder.Body.String(), tc.wantBody)
+			}
+			if allow := recorder.Header().Get("Allow"); tc.wantAllow != "" && !strings.Contains(allow, tc.wantAllow) {
+				t.Fatalf("allow = %q, want %q", allow, tc.wantAllow)
+			}
+		})
+	}
+}
+
+func TestOzzo(t *testing.T) {
+
+	testRouter(t, router.Ozzo())
+}
+
+func TestChi(t *testing.T) {
+
+	testRouter(t, router.Chi(nil))
+}
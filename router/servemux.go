@@ -0,0 +1,102 @@
+//go:build go1.22
+// +build go1.22
+
+package router
+
+import (
+	"net/http"
+
+	"github.com/ExperienceOne/apikit/internal/framework/xserver"
+)
+
+type serveMuxRouter struct {
+	*http.ServeMux
+	notFound         http.Handler
+	methodNotAllowed http.Handler
+}
+
+// ServeMux creates a router adapter for a http.ServeMux. If mux is nil a new
+// http.ServeMux is created. The routes are registered as method-qualified
+// patterns with wildcards, which requires the Go 1.22 pattern semantics
+// (a go directive of 1.22 or later, or GODEBUG=httpmuxgo121=0).
+func ServeMux(mux *http.ServeMux) xserver.Router {
+
+	if mux == nil {
+		mux = http.NewServeMux()
+	}
+
+	return &serveMuxRouter{ServeMux: mux}
+}
+
+func (router *serveMuxRouter) Handle(method, path string, handler http.Handler) {
+
+	router.ServeMux.Handle(method+" "+path, handler)
+}
+
+func (router *serveMuxRouter) PathParam(r *http.Request, name string) string {
+
+	return r.PathValue(name)
+}
+
+func (router *serveMuxRouter) HandleNotFound(handler http.Handler) {
+
+	router.notFound = handler
+}
+
+func (router *serveMuxRouter) HandleMethodNotAllowed(handler http.Handler) {
+
+	router.methodNotAllowed = handler
+}
+
+// ServeHTTP dispatches the request with the http.ServeMux. A http.ServeMux has no hooks for its routing errors,
+// so requests which match no pattern are answered by the registered handlers instead. The response of the
+// http.ServeMux is recorded to tell apart the paths of other methods, its Allow header is kept.
+func (router *serveMuxRouter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if router.notFound == nil && router.methodNotAllowed == nil {
+		router.ServeMux.ServeHTTP(w, r)
+		return
+	}
+
+	handler, pattern := router.ServeMux.Handler(r)
+	if pattern != "" {
+		router.ServeMux.ServeHTTP(w, r)
+		return
+	}
+
+	recorder := &statusRecorder{header: make(http.Header)}
+	handler.ServeHTTP(recorder, r)
+
+	switch {
+	case recorder.status == http.StatusMethodNotAllowed && router.methodNotAllowed != nil:
+		w.Header().Set("Allow", recorder.header.Get("Allow"))
+		router.methodNotAllowed.ServeHTTP(w, r)
+	case recorder.status == http.StatusNotFound && router.notFound != nil:
+		router.notFound.ServeHTTP(w, r)
+	default:
+		handler.ServeHTTP(w, r)
+	}
+}
+
+// statusRecorder records the status code and the header of a response and discards its body
+type statusRecorder struct {
+	header http.Header
+	status int
+}
+
+func (r *statusRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return len(b), nil
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
@@ -0,0 +1,17 @@
+//go:build go1.22
+// +build go1.22
+
+//go:debug httpmuxgo121=0
+
+package router_test
+
+import (
+	"testing"
+
+	"github.com/ExperienceOne/apikit/router"
+)
+
+func TestServeMux(t *testing.T) {
+
+	testRouter(t, router.ServeMux(nil))
+}
@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"encoding/xml"
 	"io"
 	"mime/multipart"
 	"net/http"
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
 )
 
 type VisAdminClient interface {
@@ -32,6 +34,7 @@ type VisAdminClient interface {
 	GetUserMethod
 	CreateOrUpdateUserMethod
 	GetBookingMethod
+	GetBookingYamlMethod
 	GetBookingsMethod
 	ListModelsMethod
 	GetClassesMethod
@@ -45,141 +48,472 @@ type VisAdminClient interface {
 	DownloadFileMethod
 	FindByTagsMethod
 	GenericFileDownloadMethod
+	ValidateParametersMethod
+	ListPartnersMethod
+	CreatePartnerMethod
+	WatchPartnersMethod
+	GetPartnerMethod
+	UpdatePartnerMethod
+	ImportPartnerArchiveMethod
+	ChatWithPartnerMethod
+	DownloadPartnerContractMethod
+	UploadPartnerDocumentsMethod
+	GetPartnerProfileMethod
+	GetPartnerStatementMethod
 	GetRentalMethod
 	GetShoesMethod
 	PostUploadMethod
 }
 type GetClientsMethod interface {
-	GetClients(request *GetClientsRequest) (GetClientsResponse, error)
+	GetClients(ctx context.Context, request *GetClientsRequest) (GetClientsResponse, error)
 }
 type DeleteClientMethod interface {
-	DeleteClient(request *DeleteClientRequest) (DeleteClientResponse, error)
+	DeleteClient(ctx context.Context, request *DeleteClientRequest) (DeleteClientResponse, error)
 }
 type GetClientMethod interface {
-	GetClient(request *GetClientRequest) (GetClientResponse, error)
+	GetClient(ctx context.Context, request *GetClientRequest) (GetClientResponse, error)
 }
 type CreateOrUpdateClientMethod interface {
-	CreateOrUpdateClient(request *CreateOrUpdateClientRequest) (CreateOrUpdateClientResponse, error)
+	CreateOrUpdateClient(ctx context.Context, request *CreateOrUpdateClientRequest) (CreateOrUpdateClientResponse, error)
 }
 type GetViewsSetsMethod interface {
-	GetViewsSets(request *GetViewsSetsRequest) (GetViewsSetsResponse, error)
+	GetViewsSets(ctx context.Context, request *GetViewsSetsRequest) (GetViewsSetsResponse, error)
 }
 type DeleteViewsSetMethod interface {
-	DeleteViewsSet(request *DeleteViewsSetRequest) (DeleteViewsSetResponse, error)
+	DeleteViewsSet(ctx context.Context, request *DeleteViewsSetRequest) (DeleteViewsSetResponse, error)
 }
 type GetViewsSetMethod interface {
-	GetViewsSet(request *GetViewsSetRequest) (GetViewsSetResponse, error)
+	GetViewsSet(ctx context.Context, request *GetViewsSetRequest) (GetViewsSetResponse, error)
 }
 type ActivateViewsSetMethod interface {
-	ActivateViewsSet(request *ActivateViewsSetRequest) (ActivateViewsSetResponse, error)
+	ActivateViewsSet(ctx context.Context, request *ActivateViewsSetRequest) (ActivateViewsSetResponse, error)
 }
 type CreateOrUpdateViewsSetMethod interface {
-	CreateOrUpdateViewsSet(request *CreateOrUpdateViewsSetRequest) (CreateOrUpdateViewsSetResponse, error)
+	CreateOrUpdateViewsSet(ctx context.Context, request *CreateOrUpdateViewsSetRequest) (CreateOrUpdateViewsSetResponse, error)
 }
 type ShowVehicleInViewMethod interface {
-	ShowVehicleInView(request *ShowVehicleInViewRequest) (ShowVehicleInViewResponse, error)
+	ShowVehicleInView(ctx context.Context, request *ShowVehicleInViewRequest) (ShowVehicleInViewResponse, error)
 }
 type GetPermissionsMethod interface {
-	GetPermissions(request *GetPermissionsRequest) (GetPermissionsResponse, error)
+	GetPermissions(ctx context.Context, request *GetPermissionsRequest) (GetPermissionsResponse, error)
 }
 type DestroySessionMethod interface {
-	DestroySession(request *DestroySessionRequest) (DestroySessionResponse, error)
+	DestroySession(ctx context.Context, request *DestroySessionRequest) (DestroySessionResponse, error)
 }
 type GetUserInfoMethod interface {
-	GetUserInfo(request *GetUserInfoRequest) (GetUserInfoResponse, error)
+	GetUserInfo(ctx context.Context, request *GetUserInfoRequest) (GetUserInfoResponse, error)
 }
 type CreateSessionMethod interface {
-	CreateSession(request *CreateSessionRequest) (CreateSessionResponse, error)
+	CreateSession(ctx context.Context, request *CreateSessionRequest) (CreateSessionResponse, error)
 }
 type GetUsersMethod interface {
-	GetUsers(request *GetUsersRequest) (GetUsersResponse, error)
+	GetUsers(ctx context.Context, request *GetUsersRequest) (GetUsersResponse, error)
 }
 type DeleteUserMethod interface {
-	DeleteUser(request *DeleteUserRequest) (DeleteUserResponse, error)
+	DeleteUser(ctx context.Context, request *DeleteUserRequest) (DeleteUserResponse, error)
 }
 type GetUserMethod interface {
-	GetUser(request *GetUserRequest) (GetUserResponse, error)
+	GetUser(ctx context.Context, request *GetUserRequest) (GetUserResponse, error)
 }
 type CreateOrUpdateUserMethod interface {
-	CreateOrUpdateUser(request *CreateOrUpdateUserRequest) (CreateOrUpdateUserResponse, error)
+	CreateOrUpdateUser(ctx context.Context, request *CreateOrUpdateUserRequest) (CreateOrUpdateUserResponse, error)
 }
 type GetBookingMethod interface {
-	GetBooking(request *GetBookingRequest) (GetBookingResponse, error)
+	GetBooking(ctx context.Context, request *GetBookingRequest) (GetBookingResponse, error)
+}
+type GetBookingYamlMethod interface {
+	GetBookingYaml(ctx context.Context, request *GetBookingYamlRequest) (GetBookingYamlResponse, error)
 }
 type GetBookingsMethod interface {
-	GetBookings(request *GetBookingsRequest) (GetBookingsResponse, error)
+	GetBookings(ctx context.Context, request *GetBookingsRequest) (GetBookingsResponse, error)
 }
 type ListModelsMethod interface {
-	ListModels(request *ListModelsRequest) (ListModelsResponse, error)
+	ListModels(ctx context.Context, request *ListModelsRequest) (ListModelsResponse, error)
 }
 type GetClassesMethod interface {
-	GetClasses(request *GetClassesRequest) (GetClassesResponse, error)
+	GetClasses(ctx context.Context, request *GetClassesRequest) (GetClassesResponse, error)
 }
 type CodeMethod interface {
-	Code(request *CodeRequest) (CodeResponse, error)
+	Code(ctx context.Context, request *CodeRequest) (CodeResponse, error)
 }
 type DeleteCustomerSessionMethod interface {
-	DeleteCustomerSession(request *DeleteCustomerSessionRequest) (DeleteCustomerSessionResponse, error)
+	DeleteCustomerSession(ctx context.Context, request *DeleteCustomerSessionRequest) (DeleteCustomerSessionResponse, error)
 }
 type CreateCustomerSessionMethod interface {
-	CreateCustomerSession(request *CreateCustomerSessionRequest) (CreateCustomerSessionResponse, error)
+	CreateCustomerSession(ctx context.Context, request *CreateCustomerSessionRequest) (CreateCustomerSessionResponse, error)
 }
 type DownloadNestedFileMethod interface {
-	DownloadNestedFile(request *DownloadNestedFileRequest) (DownloadNestedFileResponse, error)
+	DownloadNestedFile(ctx context.Context, request *DownloadNestedFileRequest) (DownloadNestedFileResponse, error)
 }
 type DownloadImageMethod interface {
-	DownloadImage(request *DownloadImageRequest) (DownloadImageResponse, error)
+	DownloadImage(ctx context.Context, request *DownloadImageRequest) (DownloadImageResponse, error)
 }
 type ListElementsMethod interface {
-	ListElements(request *ListElementsRequest) (ListElementsResponse, error)
+	ListElements(ctx context.Context, request *ListElementsRequest) (ListElementsResponse, error)
 }
 type FileUploadMethod interface {
-	FileUpload(request *FileUploadRequest) (FileUploadResponse, error)
+	FileUpload(ctx context.Context, request *FileUploadRequest) (FileUploadResponse, error)
 }
 type DownloadFileMethod interface {
-	DownloadFile(request *DownloadFileRequest) (DownloadFileResponse, error)
+	DownloadFile(ctx context.Context, request *DownloadFileRequest) (DownloadFileResponse, error)
 }
 type FindByTagsMethod interface {
-	FindByTags(request *FindByTagsRequest) (FindByTagsResponse, error)
+	FindByTags(ctx context.Context, request *FindByTagsRequest) (FindByTagsResponse, error)
 }
 type GenericFileDownloadMethod interface {
-	GenericFileDownload(request *GenericFileDownloadRequest) (GenericFileDownloadResponse, error)
+	GenericFileDownload(ctx context.Context, request *GenericFileDownloadRequest) (GenericFileDownloadResponse, error)
+}
+type ValidateParametersMethod interface {
+	ValidateParameters(ctx context.Context, request *ValidateParametersRequest) (ValidateParametersResponse, error)
+}
+type ListPartnersMethod interface {
+	ListPartners(ctx context.Context, request *ListPartnersRequest) (ListPartnersResponse, error)
+}
+type CreatePartnerMethod interface {
+	CreatePartner(ctx context.Context, request *CreatePartnerRequest) (CreatePartnerResponse, error)
+}
+type WatchPartnersMethod interface {
+	WatchPartners(ctx context.Context, request *WatchPartnersRequest) (WatchPartnersResponse, error)
+}
+type GetPartnerMethod interface {
+	GetPartner(ctx context.Context, request *GetPartnerRequest) (GetPartnerResponse, error)
+}
+type UpdatePartnerMethod interface {
+	UpdatePartner(ctx context.Context, request *UpdatePartnerRequest) (UpdatePartnerResponse, error)
+}
+type ImportPartnerArchiveMethod interface {
+	ImportPartnerArchive(ctx context.Context, request *ImportPartnerArchiveRequest) (ImportPartnerArchiveResponse, error)
+}
+type ChatWithPartnerMethod interface {
+	ChatWithPartner(ctx context.Context, request *ChatWithPartnerRequest) (ChatWithPartnerResponse, error)
+}
+type DownloadPartnerContractMethod interface {
+	DownloadPartnerContract(ctx context.Context, request *DownloadPartnerContractRequest) (DownloadPartnerContractResponse, error)
+}
+type UploadPartnerDocumentsMethod interface {
+	UploadPartnerDocuments(ctx context.Context, request *UploadPartnerDocumentsRequest) (UploadPartnerDocumentsResponse, error)
+}
+type GetPartnerProfileMethod interface {
+	GetPartnerProfile(ctx context.Context, request *GetPartnerProfileRequest) (GetPartnerProfileResponse, error)
+}
+type GetPartnerStatementMethod interface {
+	GetPartnerStatement(ctx context.Context, request *GetPartnerStatementRequest) (GetPartnerStatementResponse, error)
 }
 type GetRentalMethod interface {
-	GetRental(request *GetRentalRequest) (GetRentalResponse, error)
+	GetRental(ctx context.Context, request *GetRentalRequest) (GetRentalResponse, error)
 }
 type GetShoesMethod interface {
-	GetShoes(request *GetShoesRequest) (GetShoesResponse, error)
+	GetShoes(ctx context.Context, request *GetShoesRequest) (GetShoesResponse, error)
 }
 type PostUploadMethod interface {
-	PostUpload(request *PostUploadRequest) (PostUploadResponse, error)
+	PostUpload(ctx context.Context, request *PostUploadRequest) (PostUploadResponse, error)
 }
 
 func NewVisAdminClient(httpClient *http.Client, baseUrl string, options Opts) VisAdminClient {
-	return &visAdminClient{httpClient: newHttpClientWrapper(httpClient, baseUrl), baseURL: baseUrl, hooks: options.Hooks, ctx: options.Ctx, xmlMatcher: regexp.MustCompile("^application\\/(.+)xml$")}
+	ctx := options.Ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	client := &visAdminClient{httpClient: newHttpClientWrapper(httpClient, baseUrl), baseURL: baseUrl, hooks: options.Hooks, ctx: ctx, credentials: options.Credentials, responseErrors: options.ResponseErrors, streamItems: options.StreamItems, xmlMatcher: regexp.MustCompile("^(application|text)\\/(.+\\+)?xml$")}
+	if options.Validate {
+		client.validator = NewValidation()
+		client.registerValidators()
+	}
+	return client
+}
+
+// VisAdminClientWithoutContext is the client interface of former versions without context parameters, use VisAdminClient instead
+type VisAdminClientWithoutContext interface {
+	GetClients(request *GetClientsRequest) (GetClientsResponse, error)
+	DeleteClient(request *DeleteClientRequest) (DeleteClientResponse, error)
+	GetClient(request *GetClientRequest) (GetClientResponse, error)
+	CreateOrUpdateClient(request *CreateOrUpdateClientRequest) (CreateOrUpdateClientResponse, error)
+	GetViewsSets(request *GetViewsSetsRequest) (GetViewsSetsResponse, error)
+	DeleteViewsSet(request *DeleteViewsSetRequest) (DeleteViewsSetResponse, error)
+	GetViewsSet(request *GetViewsSetRequest) (GetViewsSetResponse, error)
+	ActivateViewsSet(request *ActivateViewsSetRequest) (ActivateViewsSetResponse, error)
+	CreateOrUpdateViewsSet(request *CreateOrUpdateViewsSetRequest) (CreateOrUpdateViewsSetResponse, error)
+	ShowVehicleInView(request *ShowVehicleInViewRequest) (ShowVehicleInViewResponse, error)
+	GetPermissions(request *GetPermissionsRequest) (GetPermissionsResponse, error)
+	DestroySession(request *DestroySessionRequest) (DestroySessionResponse, error)
+	GetUserInfo(request *GetUserInfoRequest) (GetUserInfoResponse, error)
+	CreateSession(request *CreateSessionRequest) (CreateSessionResponse, error)
+	GetUsers(request *GetUsersRequest) (GetUsersResponse, error)
+	DeleteUser(request *DeleteUserRequest) (DeleteUserResponse, error)
+	GetUser(request *GetUserRequest) (GetUserResponse, error)
+	CreateOrUpdateUser(request *CreateOrUpdateUserRequest) (CreateOrUpdateUserResponse, error)
+	GetBooking(request *GetBookingRequest) (GetBookingResponse, error)
+	GetBookingYaml(request *GetBookingYamlRequest) (GetBookingYamlResponse, error)
+	GetBookings(request *GetBookingsRequest) (GetBookingsResponse, error)
+	ListModels(request *ListModelsRequest) (ListModelsResponse, error)
+	GetClasses(request *GetClassesRequest) (GetClassesResponse, error)
+	Code(request *CodeRequest) (CodeResponse, error)
+	DeleteCustomerSession(request *DeleteCustomerSessionRequest) (DeleteCustomerSessionResponse, error)
+	CreateCustomerSession(request *CreateCustomerSessionRequest) (CreateCustomerSessionResponse, error)
+	DownloadNestedFile(request *DownloadNestedFileRequest) (DownloadNestedFileResponse, error)
+	DownloadImage(request *DownloadImageRequest) (DownloadImageResponse, error)
+	ListElements(request *ListElementsRequest) (ListElementsResponse, error)
+	FileUpload(request *FileUploadRequest) (FileUploadResponse, error)
+	DownloadFile(request *DownloadFileRequest) (DownloadFileResponse, error)
+	FindByTags(request *FindByTagsRequest) (FindByTagsResponse, error)
+	GenericFileDownload(request *GenericFileDownloadRequest) (GenericFileDownloadResponse, error)
+	ValidateParameters(request *ValidateParametersRequest) (ValidateParametersResponse, error)
+	ListPartners(request *ListPartnersRequest) (ListPartnersResponse, error)
+	CreatePartner(request *CreatePartnerRequest) (CreatePartnerResponse, error)
+	WatchPartners(request *WatchPartnersRequest) (WatchPartnersResponse, error)
+	GetPartner(request *GetPartnerRequest) (GetPartnerResponse, error)
+	UpdatePartner(request *UpdatePartnerRequest) (UpdatePartnerResponse, error)
+	ImportPartnerArchive(request *ImportPartnerArchiveRequest) (ImportPartnerArchiveResponse, error)
+	ChatWithPartner(request *ChatWithPartnerRequest) (ChatWithPartnerResponse, error)
+	DownloadPartnerContract(request *DownloadPartnerContractRequest) (DownloadPartnerContractResponse, error)
+	UploadPartnerDocuments(request *UploadPartnerDocumentsRequest) (UploadPartnerDocumentsResponse, error)
+	GetPartnerProfile(request *GetPartnerProfileRequest) (GetPartnerProfileResponse, error)
+	GetPartnerStatement(request *GetPartnerStatementRequest) (GetPartnerStatementResponse, error)
+	GetRental(request *GetRentalRequest) (GetRentalResponse, error)
+	GetShoes(request *GetShoesRequest) (GetShoesResponse, error)
+	PostUpload(request *PostUploadRequest) (PostUploadResponse, error)
+}
+
+// NewVisAdminClientWithoutContext creates a client for existing callers of the methods without context parameter, the calls use the context of the options
+func NewVisAdminClientWithoutContext(httpClient *http.Client, baseUrl string, options Opts) VisAdminClientWithoutContext {
+	return &visAdminClientWithoutContext{client: NewVisAdminClient(httpClient, baseUrl, options), ctx: options.Ctx}
+}
+
+type visAdminClientWithoutContext struct {
+	client VisAdminClient
+	ctx    context.Context
+}
+
+func (client *visAdminClientWithoutContext) GetClients(request *GetClientsRequest) (GetClientsResponse, error) {
+	return client.client.GetClients(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) DeleteClient(request *DeleteClientRequest) (DeleteClientResponse, error) {
+	return client.client.DeleteClient(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) GetClient(request *GetClientRequest) (GetClientResponse, error) {
+	return client.client.GetClient(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) CreateOrUpdateClient(request *CreateOrUpdateClientRequest) (CreateOrUpdateClientResponse, error) {
+	return client.client.CreateOrUpdateClient(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) GetViewsSets(request *GetViewsSetsRequest) (GetViewsSetsResponse, error) {
+	return client.client.GetViewsSets(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) DeleteViewsSet(request *DeleteViewsSetRequest) (DeleteViewsSetResponse, error) {
+	return client.client.DeleteViewsSet(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) GetViewsSet(request *GetViewsSetRequest) (GetViewsSetResponse, error) {
+	return client.client.GetViewsSet(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) ActivateViewsSet(request *ActivateViewsSetRequest) (ActivateViewsSetResponse, error) {
+	return client.client.ActivateViewsSet(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) CreateOrUpdateViewsSet(request *CreateOrUpdateViewsSetRequest) (CreateOrUpdateViewsSetResponse, error) {
+	return client.client.CreateOrUpdateViewsSet(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) ShowVehicleInView(request *ShowVehicleInViewRequest) (ShowVehicleInViewResponse, error) {
+	return client.client.ShowVehicleInView(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) GetPermissions(request *GetPermissionsRequest) (GetPermissionsResponse, error) {
+	return client.client.GetPermissions(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) DestroySession(request *DestroySessionRequest) (DestroySessionResponse, error) {
+	return client.client.DestroySession(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) GetUserInfo(request *GetUserInfoRequest) (GetUserInfoResponse, error) {
+	return client.client.GetUserInfo(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) CreateSession(request *CreateSessionRequest) (CreateSessionResponse, error) {
+	return client.client.CreateSession(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) GetUsers(request *GetUsersRequest) (GetUsersResponse, error) {
+	return client.client.GetUsers(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) DeleteUser(request *DeleteUserRequest) (DeleteUserResponse, error) {
+	return client.client.DeleteUser(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) GetUser(request *GetUserRequest) (GetUserResponse, error) {
+	return client.client.GetUser(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) CreateOrUpdateUser(request *CreateOrUpdateUserRequest) (CreateOrUpdateUserResponse, error) {
+	return client.client.CreateOrUpdateUser(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) GetBooking(request *GetBookingRequest) (GetBookingResponse, error) {
+	return client.client.GetBooking(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) GetBookingYaml(request *GetBookingYamlRequest) (GetBookingYamlResponse, error) {
+	return client.client.GetBookingYaml(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) GetBookings(request *GetBookingsRequest) (GetBookingsResponse, error) {
+	return client.client.GetBookings(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) ListModels(request *ListModelsRequest) (ListModelsResponse, error) {
+	return client.client.ListModels(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) GetClasses(request *GetClassesRequest) (GetClassesResponse, error) {
+	return client.client.GetClasses(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) Code(request *CodeRequest) (CodeResponse, error) {
+	return client.client.Code(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) DeleteCustomerSession(request *DeleteCustomerSessionRequest) (DeleteCustomerSessionResponse, error) {
+	return client.client.DeleteCustomerSession(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) CreateCustomerSession(request *CreateCustomerSessionRequest) (CreateCustomerSessionResponse, error) {
+	return client.client.CreateCustomerSession(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) DownloadNestedFile(request *DownloadNestedFileRequest) (DownloadNestedFileResponse, error) {
+	return client.client.DownloadNestedFile(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) DownloadImage(request *DownloadImageRequest) (DownloadImageResponse, error) {
+	return client.client.DownloadImage(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) ListElements(request *ListElementsRequest) (ListElementsResponse, error) {
+	return client.client.ListElements(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) FileUpload(request *FileUploadRequest) (FileUploadResponse, error) {
+	return client.client.FileUpload(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) DownloadFile(request *DownloadFileRequest) (DownloadFileResponse, error) {
+	return client.client.DownloadFile(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) FindByTags(request *FindByTagsRequest) (FindByTagsResponse, error) {
+	return client.client.FindByTags(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) GenericFileDownload(request *GenericFileDownloadRequest) (GenericFileDownloadResponse, error) {
+	return client.client.GenericFileDownload(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) ValidateParameters(request *ValidateParametersRequest) (ValidateParametersResponse, error) {
+	return client.client.ValidateParameters(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) ListPartners(request *ListPartnersRequest) (ListPartnersResponse, error) {
+	return client.client.ListPartners(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) CreatePartner(request *CreatePartnerRequest) (CreatePartnerResponse, error) {
+	return client.client.CreatePartner(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) WatchPartners(request *WatchPartnersRequest) (WatchPartnersResponse, error) {
+	return client.client.WatchPartners(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) GetPartner(request *GetPartnerRequest) (GetPartnerResponse, error) {
+	return client.client.GetPartner(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) UpdatePartner(request *UpdatePartnerRequest) (UpdatePartnerResponse, error) {
+	return client.client.UpdatePartner(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) ImportPartnerArchive(request *ImportPartnerArchiveRequest) (ImportPartnerArchiveResponse, error) {
+	return client.client.ImportPartnerArchive(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) ChatWithPartner(request *ChatWithPartnerRequest) (ChatWithPartnerResponse, error) {
+	return client.client.ChatWithPartner(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) DownloadPartnerContract(request *DownloadPartnerContractRequest) (DownloadPartnerContractResponse, error) {
+	return client.client.DownloadPartnerContract(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) UploadPartnerDocuments(request *UploadPartnerDocumentsRequest) (UploadPartnerDocumentsResponse, error) {
+	return client.client.UploadPartnerDocuments(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) GetPartnerProfile(request *GetPartnerProfileRequest) (GetPartnerProfileResponse, error) {
+	return client.client.GetPartnerProfile(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) GetPartnerStatement(request *GetPartnerStatementRequest) (GetPartnerStatementResponse, error) {
+	return client.client.GetPartnerStatement(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) GetRental(request *GetRentalRequest) (GetRentalResponse, error) {
+	return client.client.GetRental(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) GetShoes(request *GetShoesRequest) (GetShoesResponse, error) {
+	return client.client.GetShoes(client.ctx, request)
+}
+
+func (client *visAdminClientWithoutContext) PostUpload(request *PostUploadRequest) (PostUploadResponse, error) {
+	return client.client.PostUpload(client.ctx, request)
 }
 
 type visAdminClient struct {
-	baseURL    string
-	hooks      HooksClient
-	ctx        context.Context
-	httpClient *httpClientWrapper
-	xmlMatcher *regexp.Regexp
+	baseURL        string
+	hooks          HooksClient
+	ctx            context.Context
+	httpClient     *httpClientWrapper
+	xmlMatcher     *regexp.Regexp
+	validator      *Validator
+	credentials    map[string]CredentialProvider
+	responseErrors bool
+	streamItems    bool
 }
 
-func (client *visAdminClient) GetClients(request *GetClientsRequest) (GetClientsResponse, error) {
+func (client *visAdminClient) GetClients(ctx context.Context, request *GetClientsRequest) (GetClientsResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/api/client"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
 	httpRequest.Header["X-Auth"] = []string{toString(request.XAuth)}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -188,22 +522,30 @@ func (client *visAdminClient) GetClients(request *GetClientsRequest) (GetClients
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "GetClients", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(GetClients200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(GetClients200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -213,6 +555,8 @@ func (client *visAdminClient) GetClients(request *GetClientsRequest) (GetClients
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(GetClients204Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -222,32 +566,56 @@ func (client *visAdminClient) GetClients(request *GetClientsRequest) (GetClients
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(GetClients403Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(GetClients304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) DeleteClient(request *DeleteClientRequest) (DeleteClientResponse, error) {
+func (client *visAdminClient) DeleteClient(ctx context.Context, request *DeleteClientRequest) (DeleteClientResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/api/client/{clientId}"
 	method := "DELETE"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	endpoint = strings.Replace(endpoint, "{clientId}", url.QueryEscape(toString(request.ClientId)), 1)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
 	httpRequest.Header["X-Auth"] = []string{toString(request.XAuth)}
+	if request.IfMatch != "" {
+		httpRequest.Header[ifMatchHeader] = []string{request.IfMatch}
+	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfUnmodifiedSince.IsZero() {
+		httpRequest.Header[ifUnmodifiedSinceHeader] = []string{formatHTTPTime(request.IfUnmodifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -256,15 +624,21 @@ func (client *visAdminClient) DeleteClient(request *DeleteClientRequest) (Delete
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "DeleteClient", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(DeleteClient200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -274,6 +648,9 @@ func (client *visAdminClient) DeleteClient(request *DeleteClientRequest) (Delete
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(DeleteClient403Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -283,6 +660,9 @@ func (client *visAdminClient) DeleteClient(request *DeleteClientRequest) (Delete
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(DeleteClient404Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -290,25 +670,37 @@ func (client *visAdminClient) DeleteClient(request *DeleteClientRequest) (Delete
 
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) GetClient(request *GetClientRequest) (GetClientResponse, error) {
+func (client *visAdminClient) GetClient(ctx context.Context, request *GetClientRequest) (GetClientResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/api/client/{clientId}"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	endpoint = strings.Replace(endpoint, "{clientId}", url.QueryEscape(toString(request.ClientId)), 1)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
 	httpRequest.Header["X-Auth"] = []string{toString(request.XAuth)}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -317,22 +709,30 @@ func (client *visAdminClient) GetClient(request *GetClientRequest) (GetClientRes
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "GetClient", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(GetClient200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(GetClient200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -342,6 +742,9 @@ func (client *visAdminClient) GetClient(request *GetClientRequest) (GetClientRes
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(GetClient403Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -351,38 +754,62 @@ func (client *visAdminClient) GetClient(request *GetClientRequest) (GetClientRes
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(GetClient404Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(GetClient304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) CreateOrUpdateClient(request *CreateOrUpdateClientRequest) (CreateOrUpdateClientResponse, error) {
+func (client *visAdminClient) CreateOrUpdateClient(ctx context.Context, request *CreateOrUpdateClientRequest) (CreateOrUpdateClientResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/api/client/{clientId}"
 	method := "PUT"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	endpoint = strings.Replace(endpoint, "{clientId}", url.QueryEscape(toString(request.ClientId)), 1)
 	jsonData := new(bytes.Buffer)
 	encodeErr := json.NewEncoder(jsonData).Encode(&request.Body)
 	if encodeErr != nil {
 		return nil, encodeErr
 	}
-	httpRequest, reqErr := http.NewRequest(method, endpoint, jsonData)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, jsonData)
 	if reqErr != nil {
 		return nil, reqErr
 	}
 	httpRequest.Header[contentTypeHeader] = []string{contentTypeApplicationJson}
 	httpRequest.Header["X-Auth"] = []string{toString(request.XAuth)}
+	if request.IfMatch != "" {
+		httpRequest.Header[ifMatchHeader] = []string{request.IfMatch}
+	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfUnmodifiedSince.IsZero() {
+		httpRequest.Header[ifUnmodifiedSinceHeader] = []string{formatHTTPTime(request.IfUnmodifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -391,15 +818,21 @@ func (client *visAdminClient) CreateOrUpdateClient(request *CreateOrUpdateClient
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "CreateOrUpdateClient", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateOrUpdateClient200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -409,6 +842,8 @@ func (client *visAdminClient) CreateOrUpdateClient(request *CreateOrUpdateClient
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateOrUpdateClient201Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -418,6 +853,9 @@ func (client *visAdminClient) CreateOrUpdateClient(request *CreateOrUpdateClient
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateOrUpdateClient400Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -427,6 +865,9 @@ func (client *visAdminClient) CreateOrUpdateClient(request *CreateOrUpdateClient
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateOrUpdateClient403Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -436,6 +877,9 @@ func (client *visAdminClient) CreateOrUpdateClient(request *CreateOrUpdateClient
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateOrUpdateClient405Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -443,25 +887,37 @@ func (client *visAdminClient) CreateOrUpdateClient(request *CreateOrUpdateClient
 
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) GetViewsSets(request *GetViewsSetsRequest) (GetViewsSetsResponse, error) {
+func (client *visAdminClient) GetViewsSets(ctx context.Context, request *GetViewsSetsRequest) (GetViewsSetsResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/api/client/{clientId}/views"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	endpoint = strings.Replace(endpoint, "{clientId}", url.QueryEscape(toString(request.ClientId)), 1)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
 	httpRequest.Header["X-Auth"] = []string{toString(request.XAuth)}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -470,22 +926,30 @@ func (client *visAdminClient) GetViewsSets(request *GetViewsSetsRequest) (GetVie
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "GetViewsSets", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(GetViewsSets200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(GetViewsSets200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -495,33 +959,57 @@ func (client *visAdminClient) GetViewsSets(request *GetViewsSetsRequest) (GetVie
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(GetViewsSets403Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(GetViewsSets304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) DeleteViewsSet(request *DeleteViewsSetRequest) (DeleteViewsSetResponse, error) {
+func (client *visAdminClient) DeleteViewsSet(ctx context.Context, request *DeleteViewsSetRequest) (DeleteViewsSetResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/api/client/{clientId}/views/{viewsId}"
 	method := "DELETE"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	endpoint = strings.Replace(endpoint, "{clientId}", url.QueryEscape(toString(request.ClientId)), 1)
 	endpoint = strings.Replace(endpoint, "{viewsId}", url.QueryEscape(toString(request.ViewsId)), 1)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
 	httpRequest.Header["X-Auth"] = []string{toString(request.XAuth)}
+	if request.IfMatch != "" {
+		httpRequest.Header[ifMatchHeader] = []string{request.IfMatch}
+	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfUnmodifiedSince.IsZero() {
+		httpRequest.Header[ifUnmodifiedSinceHeader] = []string{formatHTTPTime(request.IfUnmodifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -530,15 +1018,21 @@ func (client *visAdminClient) DeleteViewsSet(request *DeleteViewsSetRequest) (De
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "DeleteViewsSet", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(DeleteViewsSet200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -548,6 +1042,9 @@ func (client *visAdminClient) DeleteViewsSet(request *DeleteViewsSetRequest) (De
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(DeleteViewsSet403Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -557,6 +1054,9 @@ func (client *visAdminClient) DeleteViewsSet(request *DeleteViewsSetRequest) (De
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(DeleteViewsSet404Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -564,19 +1064,25 @@ func (client *visAdminClient) DeleteViewsSet(request *DeleteViewsSetRequest) (De
 
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) GetViewsSet(request *GetViewsSetRequest) (GetViewsSetResponse, error) {
+func (client *visAdminClient) GetViewsSet(ctx context.Context, request *GetViewsSetRequest) (GetViewsSetResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/api/client/{clientId}/views/{viewsId}"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	endpoint = strings.Replace(endpoint, "{clientId}", url.QueryEscape(toString(request.ClientId)), 1)
 	endpoint = strings.Replace(endpoint, "{viewsId}", url.QueryEscape(toString(request.ViewsId)), 1)
 	query := make(url.Values)
@@ -585,11 +1091,17 @@ func (client *visAdminClient) GetViewsSet(request *GetViewsSetRequest) (GetViews
 	if encodedQuery != "" {
 		endpoint += "?" + encodedQuery
 	}
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
 	httpRequest.Header["X-Auth"] = []string{toString(request.XAuth)}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -598,22 +1110,30 @@ func (client *visAdminClient) GetViewsSet(request *GetViewsSetRequest) (GetViews
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "GetViewsSet", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(GetViewsSet200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(GetViewsSet200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -623,6 +1143,9 @@ func (client *visAdminClient) GetViewsSet(request *GetViewsSetRequest) (GetViews
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(GetViewsSet403Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -632,30 +1155,45 @@ func (client *visAdminClient) GetViewsSet(request *GetViewsSetRequest) (GetViews
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(GetViewsSet404Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(GetViewsSet304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
 // Make this viewset the active one for the client.
-func (client *visAdminClient) ActivateViewsSet(request *ActivateViewsSetRequest) (ActivateViewsSetResponse, error) {
+func (client *visAdminClient) ActivateViewsSet(ctx context.Context, request *ActivateViewsSetRequest) (ActivateViewsSetResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/api/client/{clientId}/views/{viewsId}"
 	method := "POST"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	endpoint = strings.Replace(endpoint, "{clientId}", url.QueryEscape(toString(request.ClientId)), 1)
 	endpoint = strings.Replace(endpoint, "{viewsId}", url.QueryEscape(toString(request.ViewsId)), 1)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
@@ -668,11 +1206,15 @@ func (client *visAdminClient) ActivateViewsSet(request *ActivateViewsSetRequest)
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "ActivateViewsSet", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
@@ -686,6 +1228,9 @@ func (client *visAdminClient) ActivateViewsSet(request *ActivateViewsSetRequest)
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(ActivateViewsSet403Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -695,6 +1240,9 @@ func (client *visAdminClient) ActivateViewsSet(request *ActivateViewsSetRequest)
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(ActivateViewsSet404Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -702,19 +1250,25 @@ func (client *visAdminClient) ActivateViewsSet(request *ActivateViewsSetRequest)
 
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) CreateOrUpdateViewsSet(request *CreateOrUpdateViewsSetRequest) (CreateOrUpdateViewsSetResponse, error) {
+func (client *visAdminClient) CreateOrUpdateViewsSet(ctx context.Context, request *CreateOrUpdateViewsSetRequest) (CreateOrUpdateViewsSetResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/api/client/{clientId}/views/{viewsId}"
 	method := "PUT"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	endpoint = strings.Replace(endpoint, "{clientId}", url.QueryEscape(toString(request.ClientId)), 1)
 	endpoint = strings.Replace(endpoint, "{viewsId}", url.QueryEscape(toString(request.ViewsId)), 1)
 	jsonData := new(bytes.Buffer)
@@ -722,12 +1276,21 @@ func (client *visAdminClient) CreateOrUpdateViewsSet(request *CreateOrUpdateView
 	if encodeErr != nil {
 		return nil, encodeErr
 	}
-	httpRequest, reqErr := http.NewRequest(method, endpoint, jsonData)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, jsonData)
 	if reqErr != nil {
 		return nil, reqErr
 	}
 	httpRequest.Header[contentTypeHeader] = []string{contentTypeApplicationJson}
 	httpRequest.Header["X-Auth"] = []string{toString(request.XAuth)}
+	if request.IfMatch != "" {
+		httpRequest.Header[ifMatchHeader] = []string{request.IfMatch}
+	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfUnmodifiedSince.IsZero() {
+		httpRequest.Header[ifUnmodifiedSinceHeader] = []string{formatHTTPTime(request.IfUnmodifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -736,15 +1299,21 @@ func (client *visAdminClient) CreateOrUpdateViewsSet(request *CreateOrUpdateView
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "CreateOrUpdateViewsSet", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateOrUpdateViewsSet200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -754,6 +1323,8 @@ func (client *visAdminClient) CreateOrUpdateViewsSet(request *CreateOrUpdateView
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateOrUpdateViewsSet201Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -763,6 +1334,9 @@ func (client *visAdminClient) CreateOrUpdateViewsSet(request *CreateOrUpdateView
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateOrUpdateViewsSet400Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -772,6 +1346,9 @@ func (client *visAdminClient) CreateOrUpdateViewsSet(request *CreateOrUpdateView
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateOrUpdateViewsSet403Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -781,6 +1358,9 @@ func (client *visAdminClient) CreateOrUpdateViewsSet(request *CreateOrUpdateView
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateOrUpdateViewsSet405Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -788,29 +1368,41 @@ func (client *visAdminClient) CreateOrUpdateViewsSet(request *CreateOrUpdateView
 
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) ShowVehicleInView(request *ShowVehicleInViewRequest) (ShowVehicleInViewResponse, error) {
+func (client *visAdminClient) ShowVehicleInView(ctx context.Context, request *ShowVehicleInViewRequest) (ShowVehicleInViewResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/api/client/{clientId}/views/{viewsId}/{view}/{breakpoint}/{spec}"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	endpoint = strings.Replace(endpoint, "{clientId}", url.QueryEscape(toString(request.ClientId)), 1)
 	endpoint = strings.Replace(endpoint, "{viewsId}", url.QueryEscape(toString(request.ViewsId)), 1)
 	endpoint = strings.Replace(endpoint, "{view}", url.QueryEscape(toString(request.View)), 1)
 	endpoint = strings.Replace(endpoint, "{breakpoint}", url.QueryEscape(toString(request.Breakpoint)), 1)
 	endpoint = strings.Replace(endpoint, "{spec}", url.QueryEscape(toString(request.Spec)), 1)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
 	httpRequest.Header["X-Auth"] = []string{toString(request.XAuth)}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -819,15 +1411,21 @@ func (client *visAdminClient) ShowVehicleInView(request *ShowVehicleInViewReques
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "ShowVehicleInView", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(ShowVehicleInView200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -837,6 +1435,9 @@ func (client *visAdminClient) ShowVehicleInView(request *ShowVehicleInViewReques
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(ShowVehicleInView403Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -846,35 +1447,56 @@ func (client *visAdminClient) ShowVehicleInView(request *ShowVehicleInViewReques
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(ShowVehicleInView404Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(ShowVehicleInView304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
 /*
 Get the list of permissions
 a user can grant to other users.
 */
-func (client *visAdminClient) GetPermissions(request *GetPermissionsRequest) (GetPermissionsResponse, error) {
+func (client *visAdminClient) GetPermissions(ctx context.Context, request *GetPermissionsRequest) (GetPermissionsResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/api/permission"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
 	httpRequest.Header["X-Auth"] = []string{toString(request.XAuth)}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -883,22 +1505,30 @@ func (client *visAdminClient) GetPermissions(request *GetPermissionsRequest) (Ge
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "GetPermissions", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(GetPermissions200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(GetPermissions200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -908,31 +1538,55 @@ func (client *visAdminClient) GetPermissions(request *GetPermissionsRequest) (Ge
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(GetPermissions403Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(GetPermissions304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) DestroySession(request *DestroySessionRequest) (DestroySessionResponse, error) {
+func (client *visAdminClient) DestroySession(ctx context.Context, request *DestroySessionRequest) (DestroySessionResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/api/session"
 	method := "DELETE"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
 	httpRequest.Header["X-Auth"] = []string{toString(request.XAuth)}
+	if request.IfMatch != "" {
+		httpRequest.Header[ifMatchHeader] = []string{request.IfMatch}
+	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfUnmodifiedSince.IsZero() {
+		httpRequest.Header[ifUnmodifiedSinceHeader] = []string{formatHTTPTime(request.IfUnmodifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -941,15 +1595,21 @@ func (client *visAdminClient) DestroySession(request *DestroySessionRequest) (De
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "DestroySession", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(DestroySession200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -959,6 +1619,9 @@ func (client *visAdminClient) DestroySession(request *DestroySessionRequest) (De
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(DestroySession404Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -966,20 +1629,26 @@ func (client *visAdminClient) DestroySession(request *DestroySessionRequest) (De
 
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) GetUserInfo(request *GetUserInfoRequest) (GetUserInfoResponse, error) {
+func (client *visAdminClient) GetUserInfo(ctx context.Context, request *GetUserInfoRequest) (GetUserInfoResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/api/session"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
@@ -987,6 +1656,12 @@ func (client *visAdminClient) GetUserInfo(request *GetUserInfoRequest) (GetUserI
 	if request.SubID != nil {
 		httpRequest.Header["subID"] = []string{toString(request.SubID)}
 	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -995,22 +1670,30 @@ func (client *visAdminClient) GetUserInfo(request *GetUserInfoRequest) (GetUserI
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "GetUserInfo", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(GetUserInfo200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(GetUserInfo200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1022,11 +1705,17 @@ func (client *visAdminClient) GetUserInfo(request *GetUserInfoRequest) (GetUserI
 			response := new(GetUserInfo400Response)
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			if client.responseErrors {
+				return nil, response
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(GetUserInfo400Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1036,32 +1725,47 @@ func (client *visAdminClient) GetUserInfo(request *GetUserInfoRequest) (GetUserI
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(GetUserInfo403Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(GetUserInfo304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) CreateSession(request *CreateSessionRequest) (CreateSessionResponse, error) {
+func (client *visAdminClient) CreateSession(ctx context.Context, request *CreateSessionRequest) (CreateSessionResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/api/session"
 	method := "POST"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	jsonData := new(bytes.Buffer)
 	encodeErr := json.NewEncoder(jsonData).Encode(&request.Body)
 	if encodeErr != nil {
 		return nil, encodeErr
 	}
-	httpRequest, reqErr := http.NewRequest(method, endpoint, jsonData)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, jsonData)
 	if reqErr != nil {
 		return nil, reqErr
 	}
@@ -1074,17 +1778,21 @@ func (client *visAdminClient) CreateSession(request *CreateSessionRequest) (Crea
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "CreateSession", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateSession200Response)
 			if err := fromString(httpResponse.Header.Get("X-Auth"), &response.XAuth); err != nil {
-				return nil, err
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, err)
 			}
 			return response, nil
 		}
@@ -1097,11 +1805,17 @@ func (client *visAdminClient) CreateSession(request *CreateSessionRequest) (Crea
 			response := new(CreateSession400Response)
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			if client.responseErrors {
+				return nil, response
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(CreateSession400Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1111,6 +1825,9 @@ func (client *visAdminClient) CreateSession(request *CreateSessionRequest) (Crea
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateSession401Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1118,24 +1835,36 @@ func (client *visAdminClient) CreateSession(request *CreateSessionRequest) (Crea
 
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) GetUsers(request *GetUsersRequest) (GetUsersResponse, error) {
+func (client *visAdminClient) GetUsers(ctx context.Context, request *GetUsersRequest) (GetUsersResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/api/user"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
 	httpRequest.Header["X-Auth"] = []string{toString(request.XAuth)}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -1144,22 +1873,30 @@ func (client *visAdminClient) GetUsers(request *GetUsersRequest) (GetUsersRespon
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "GetUsers", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(GetUsers200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(GetUsers200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1169,26 +1906,41 @@ func (client *visAdminClient) GetUsers(request *GetUsersRequest) (GetUsersRespon
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(GetUsers403Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(GetUsers304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) DeleteUser(request *DeleteUserRequest) (DeleteUserResponse, error) {
+func (client *visAdminClient) DeleteUser(ctx context.Context, request *DeleteUserRequest) (DeleteUserResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/api/user/{userId}"
 	method := "DELETE"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	endpoint = strings.Replace(endpoint, "{userId}", url.QueryEscape(toString(request.UserId)), 1)
 	query := make(url.Values)
 	if request.AllKeys != nil {
@@ -1198,11 +1950,20 @@ func (client *visAdminClient) DeleteUser(request *DeleteUserRequest) (DeleteUser
 	if encodedQuery != "" {
 		endpoint += "?" + encodedQuery
 	}
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
 	httpRequest.Header["X-Auth"] = []string{toString(request.XAuth)}
+	if request.IfMatch != "" {
+		httpRequest.Header[ifMatchHeader] = []string{request.IfMatch}
+	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfUnmodifiedSince.IsZero() {
+		httpRequest.Header[ifUnmodifiedSinceHeader] = []string{formatHTTPTime(request.IfUnmodifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -1211,15 +1972,21 @@ func (client *visAdminClient) DeleteUser(request *DeleteUserRequest) (DeleteUser
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "DeleteUser", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(DeleteUser200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1229,6 +1996,9 @@ func (client *visAdminClient) DeleteUser(request *DeleteUserRequest) (DeleteUser
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(DeleteUser403Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1238,6 +2008,9 @@ func (client *visAdminClient) DeleteUser(request *DeleteUserRequest) (DeleteUser
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(DeleteUser404Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1245,19 +2018,25 @@ func (client *visAdminClient) DeleteUser(request *DeleteUserRequest) (DeleteUser
 
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) GetUser(request *GetUserRequest) (GetUserResponse, error) {
+func (client *visAdminClient) GetUser(ctx context.Context, request *GetUserRequest) (GetUserResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/api/user/{userId}"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	endpoint = strings.Replace(endpoint, "{userId}", url.QueryEscape(toString(request.UserId)), 1)
 	query := make(url.Values)
 	if request.AllKeys != nil {
@@ -1267,11 +2046,17 @@ func (client *visAdminClient) GetUser(request *GetUserRequest) (GetUserResponse,
 	if encodedQuery != "" {
 		endpoint += "?" + encodedQuery
 	}
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
 	httpRequest.Header["X-Auth"] = []string{toString(request.XAuth)}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -1280,22 +2065,30 @@ func (client *visAdminClient) GetUser(request *GetUserRequest) (GetUserResponse,
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "GetUser", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(GetUser200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(GetUser200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1305,6 +2098,9 @@ func (client *visAdminClient) GetUser(request *GetUserRequest) (GetUserResponse,
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(GetUser403Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1314,26 +2110,41 @@ func (client *visAdminClient) GetUser(request *GetUserRequest) (GetUserResponse,
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(GetUser404Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(GetUser304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) CreateOrUpdateUser(request *CreateOrUpdateUserRequest) (CreateOrUpdateUserResponse, error) {
+func (client *visAdminClient) CreateOrUpdateUser(ctx context.Context, request *CreateOrUpdateUserRequest) (CreateOrUpdateUserResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/api/user/{userId}"
 	method := "PUT"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	endpoint = strings.Replace(endpoint, "{userId}", url.QueryEscape(toString(request.UserId)), 1)
 	query := make(url.Values)
 	if request.AllKeys != nil {
@@ -1348,12 +2159,21 @@ func (client *visAdminClient) CreateOrUpdateUser(request *CreateOrUpdateUserRequ
 	if encodeErr != nil {
 		return nil, encodeErr
 	}
-	httpRequest, reqErr := http.NewRequest(method, endpoint, jsonData)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, jsonData)
 	if reqErr != nil {
 		return nil, reqErr
 	}
 	httpRequest.Header[contentTypeHeader] = []string{contentTypeApplicationJson}
 	httpRequest.Header["X-Auth"] = []string{toString(request.XAuth)}
+	if request.IfMatch != "" {
+		httpRequest.Header[ifMatchHeader] = []string{request.IfMatch}
+	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfUnmodifiedSince.IsZero() {
+		httpRequest.Header[ifUnmodifiedSinceHeader] = []string{formatHTTPTime(request.IfUnmodifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -1362,15 +2182,21 @@ func (client *visAdminClient) CreateOrUpdateUser(request *CreateOrUpdateUserRequ
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "CreateOrUpdateUser", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateOrUpdateUser200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1380,6 +2206,8 @@ func (client *visAdminClient) CreateOrUpdateUser(request *CreateOrUpdateUserRequ
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateOrUpdateUser201Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1389,6 +2217,9 @@ func (client *visAdminClient) CreateOrUpdateUser(request *CreateOrUpdateUserRequ
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateOrUpdateUser400Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1398,6 +2229,9 @@ func (client *visAdminClient) CreateOrUpdateUser(request *CreateOrUpdateUserRequ
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateOrUpdateUser403Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1407,6 +2241,9 @@ func (client *visAdminClient) CreateOrUpdateUser(request *CreateOrUpdateUserRequ
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateOrUpdateUser405Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1414,25 +2251,157 @@ func (client *visAdminClient) CreateOrUpdateUser(request *CreateOrUpdateUserRequ
 
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
 // Get booking of session owner
-func (client *visAdminClient) GetBooking(request *GetBookingRequest) (GetBookingResponse, error) {
+func (client *visAdminClient) GetBooking(ctx context.Context, request *GetBookingRequest) (GetBookingResponse, error) {
+	if request == nil {
+		return nil, newRequestObjectIsNilError
+	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
+	path := "/booking"
+	method := "GET"
+	endpoint := client.baseURL + path
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
+	if reqErr != nil {
+		return nil, reqErr
+	}
+	if err := setCredential(ctx, httpRequest.Header, client.credentials, "X-Session-ID", "X-Session-ID", request.XSessionID); err != nil {
+		return nil, err
+	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
+	// set all headers from client context
+	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
+	if err != nil {
+		return nil, err
+	}
+	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
+		httpRequest.Header["Accept"] = []string{"application/json"}
+	}
+	operation := ClientOperation{ID: "GetBooking", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
+	httpResponse, err := client.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
+	}
+	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
+	if httpResponse.StatusCode == http.StatusOK {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
+			response := new(GetBooking200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
+			if decodeErr != nil {
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			return response, nil
+		} else if contentTypeOfResponse == "" {
+			response := new(GetBooking200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if httpResponse.StatusCode == http.StatusBadRequest {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == "" {
+			response := new(GetBooking400Response)
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if httpResponse.StatusCode == http.StatusUnauthorized {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == "" {
+			response := new(GetBooking401Response)
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if httpResponse.StatusCode == http.StatusNotFound {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == "" {
+			response := new(GetBooking404Response)
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if httpResponse.StatusCode == http.StatusInternalServerError {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == "" {
+			response := new(GetBooking500Response)
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(GetBooking304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
+	if client.hooks.OnUnknownResponseCode != nil {
+		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
+	}
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
+}
+
+// Get booking of session owner, the consumed content type isn't supported
+func (client *visAdminClient) GetBookingYaml(ctx context.Context, request *GetBookingYamlRequest) (GetBookingYamlResponse, error) {
 	return nil, newNotSupportedContentType(415, "no supported content type")
 }
 
 // Get bookings of session owner
-func (client *visAdminClient) GetBookings(request *GetBookingsRequest) (GetBookingsResponse, error) {
+func (client *visAdminClient) GetBookings(ctx context.Context, request *GetBookingsRequest) (GetBookingsResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/bookings"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	query := make(url.Values)
 	if request.Ids != nil {
 		query.Add("ids", toString(request.Ids))
@@ -1441,14 +2410,22 @@ func (client *visAdminClient) GetBookings(request *GetBookingsRequest) (GetBooki
 	if encodedQuery != "" {
 		endpoint += "?" + encodedQuery
 	}
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
-	httpRequest.Header["X-Session-ID"] = []string{request.XSessionID}
+	if err := setCredential(ctx, httpRequest.Header, client.credentials, "X-Session-ID", "X-Session-ID", request.XSessionID); err != nil {
+		return nil, err
+	}
 	if request.Date != nil {
 		httpRequest.Header["date"] = []string{toString(request.Date)}
 	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -1457,22 +2434,30 @@ func (client *visAdminClient) GetBookings(request *GetBookingsRequest) (GetBooki
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "GetBookings", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(GetBookings200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(GetBookings200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1482,6 +2467,9 @@ func (client *visAdminClient) GetBookings(request *GetBookingsRequest) (GetBooki
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(GetBookings400Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1491,6 +2479,9 @@ func (client *visAdminClient) GetBookings(request *GetBookingsRequest) (GetBooki
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(GetBookings401Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1500,6 +2491,9 @@ func (client *visAdminClient) GetBookings(request *GetBookingsRequest) (GetBooki
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(GetBookings404Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1509,26 +2503,41 @@ func (client *visAdminClient) GetBookings(request *GetBookingsRequest) (GetBooki
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(GetBookings500Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(GetBookings304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) ListModels(request *ListModelsRequest) (ListModelsResponse, error) {
+func (client *visAdminClient) ListModels(ctx context.Context, request *ListModelsRequest) (ListModelsResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/brands/{brandId}/models"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	endpoint = strings.Replace(endpoint, "{brandId}", url.QueryEscape(toString(request.BrandId)), 1)
 	query := make(url.Values)
 	if request.DriveConcept != nil {
@@ -1550,10 +2559,16 @@ func (client *visAdminClient) ListModels(request *ListModelsRequest) (ListModels
 	if encodedQuery != "" {
 		endpoint += "?" + encodedQuery
 	}
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -1562,42 +2577,62 @@ func (client *visAdminClient) ListModels(request *ListModelsRequest) (ListModels
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "ListModels", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(ListModels200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(ListModels200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(ListModels304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) GetClasses(request *GetClassesRequest) (GetClassesResponse, error) {
+func (client *visAdminClient) GetClasses(ctx context.Context, request *GetClassesRequest) (GetClassesResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/classes/{productGroup}"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	endpoint = strings.Replace(endpoint, "{productGroup}", url.QueryEscape(toString(request.ProductGroup)), 1)
 	query := make(url.Values)
 	if request.ComponentTypes != nil {
@@ -1607,10 +2642,16 @@ func (client *visAdminClient) GetClasses(request *GetClassesRequest) (GetClasses
 	if encodedQuery != "" {
 		endpoint += "?" + encodedQuery
 	}
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -1619,22 +2660,30 @@ func (client *visAdminClient) GetClasses(request *GetClassesRequest) (GetClasses
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "GetClasses", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(GetClasses200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(GetClasses200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1646,31 +2695,49 @@ func (client *visAdminClient) GetClasses(request *GetClassesRequest) (GetClasses
 			response := new(GetClasses400Response)
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			if client.responseErrors {
+				return nil, response
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(GetClasses400Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(GetClasses304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) Code(request *CodeRequest) (CodeResponse, error) {
+func (client *visAdminClient) Code(ctx context.Context, request *CodeRequest) (CodeResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/code"
 	method := "POST"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	query := make(url.Values)
 	query.Add("session", toString(request.Session))
 	encodedQuery := query.Encode()
@@ -1687,7 +2754,7 @@ func (client *visAdminClient) Code(request *CodeRequest) (CodeResponse, error) {
 	queryInBody.Add("code", toString(request.Code))
 	encodedQueryInBody := queryInBody.Encode()
 	formData := bytes.NewBufferString(encodedQueryInBody)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, formData)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, formData)
 	if reqErr != nil {
 		return nil, reqErr
 	}
@@ -1700,18 +2767,22 @@ func (client *visAdminClient) Code(request *CodeRequest) (CodeResponse, error) {
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "Code", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(Code200Response)
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
@@ -1725,6 +2796,9 @@ func (client *visAdminClient) Code(request *CodeRequest) (CodeResponse, error) {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(Code400Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1734,6 +2808,9 @@ func (client *visAdminClient) Code(request *CodeRequest) (CodeResponse, error) {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(Code401Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1743,6 +2820,9 @@ func (client *visAdminClient) Code(request *CodeRequest) (CodeResponse, error) {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(Code404Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1752,6 +2832,9 @@ func (client *visAdminClient) Code(request *CodeRequest) (CodeResponse, error) {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(Code500Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1759,30 +2842,47 @@ func (client *visAdminClient) Code(request *CodeRequest) (CodeResponse, error) {
 
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
 /*
 Deletes the user session matching the *X-Auth* header.
 */
-func (client *visAdminClient) DeleteCustomerSession(request *DeleteCustomerSessionRequest) (DeleteCustomerSessionResponse, error) {
+func (client *visAdminClient) DeleteCustomerSession(ctx context.Context, request *DeleteCustomerSessionRequest) (DeleteCustomerSessionResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/customer/session"
 	method := "DELETE"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
-	httpRequest.Header["X-Session-ID"] = []string{request.XSessionID}
+	if err := setCredential(ctx, httpRequest.Header, client.credentials, "X-Session-ID", "X-Session-ID", request.XSessionID); err != nil {
+		return nil, err
+	}
 	if request.XRequestID != nil {
 		httpRequest.Header["X-Request-ID"] = []string{toString(request.XRequestID)}
 	}
+	if request.IfMatch != "" {
+		httpRequest.Header[ifMatchHeader] = []string{request.IfMatch}
+	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfUnmodifiedSince.IsZero() {
+		httpRequest.Header[ifUnmodifiedSinceHeader] = []string{formatHTTPTime(request.IfUnmodifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -1791,15 +2891,21 @@ func (client *visAdminClient) DeleteCustomerSession(request *DeleteCustomerSessi
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "DeleteCustomerSession", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusNoContent {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(DeleteCustomerSession204Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1809,6 +2915,9 @@ func (client *visAdminClient) DeleteCustomerSession(request *DeleteCustomerSessi
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(DeleteCustomerSession401Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1818,6 +2927,9 @@ func (client *visAdminClient) DeleteCustomerSession(request *DeleteCustomerSessi
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(DeleteCustomerSession500Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1825,22 +2937,28 @@ func (client *visAdminClient) DeleteCustomerSession(request *DeleteCustomerSessi
 
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
 /*
 Creates a customer session for a given OpenID authentication token.
 */
-func (client *visAdminClient) CreateCustomerSession(request *CreateCustomerSessionRequest) (CreateCustomerSessionResponse, error) {
+func (client *visAdminClient) CreateCustomerSession(ctx context.Context, request *CreateCustomerSessionRequest) (CreateCustomerSessionResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/customer/session"
 	method := "POST"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	queryInBody := make(url.Values)
 	queryInBody.Add("code", toString(request.Code))
 	if request.Locale != nil {
@@ -1848,7 +2966,7 @@ func (client *visAdminClient) CreateCustomerSession(request *CreateCustomerSessi
 	}
 	encodedQueryInBody := queryInBody.Encode()
 	formData := bytes.NewBufferString(encodedQueryInBody)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, formData)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, formData)
 	if reqErr != nil {
 		return nil, reqErr
 	}
@@ -1864,18 +2982,22 @@ func (client *visAdminClient) CreateCustomerSession(request *CreateCustomerSessi
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "CreateCustomerSession", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusCreated {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(CreateCustomerSession201Response)
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
@@ -1889,6 +3011,9 @@ func (client *visAdminClient) CreateCustomerSession(request *CreateCustomerSessi
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateCustomerSession401Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1898,6 +3023,9 @@ func (client *visAdminClient) CreateCustomerSession(request *CreateCustomerSessi
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateCustomerSession403Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1909,11 +3037,17 @@ func (client *visAdminClient) CreateCustomerSession(request *CreateCustomerSessi
 			response := new(CreateCustomerSession422Response)
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			if client.responseErrors {
+				return nil, response
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(CreateCustomerSession422Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1923,6 +3057,9 @@ func (client *visAdminClient) CreateCustomerSession(request *CreateCustomerSessi
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(CreateCustomerSession500Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1930,26 +3067,38 @@ func (client *visAdminClient) CreateCustomerSession(request *CreateCustomerSessi
 
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
 /*
 Downloads a file that is a property within a nested structure in the response body
 */
-func (client *visAdminClient) DownloadNestedFile(request *DownloadNestedFileRequest) (DownloadNestedFileResponse, error) {
+func (client *visAdminClient) DownloadNestedFile(ctx context.Context, request *DownloadNestedFileRequest) (DownloadNestedFileResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/download/nested/file"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -1958,48 +3107,80 @@ func (client *visAdminClient) DownloadNestedFile(request *DownloadNestedFileRequ
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "DownloadNestedFile", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(DownloadNestedFile200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(DownloadNestedFile200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(DownloadNestedFile304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
 // Retrieve a image
-func (client *visAdminClient) DownloadImage(request *DownloadImageRequest) (DownloadImageResponse, error) {
+func (client *visAdminClient) DownloadImage(ctx context.Context, request *DownloadImageRequest) (DownloadImageResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/download/{image}"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	endpoint = strings.Replace(endpoint, "{image}", url.QueryEscape(toString(request.Image)), 1)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
+	if request.Range != "" {
+		httpRequest.Header[rangeHeader] = []string{request.Range}
+	}
+	if request.IfRange != "" {
+		httpRequest.Header[ifRangeHeader] = []string{request.IfRange}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -2008,17 +3189,32 @@ func (client *visAdminClient) DownloadImage(request *DownloadImageRequest) (Down
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"image/png"}
 	}
+	operation := ClientOperation{ID: "DownloadImage", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
+	}
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
+	if httpResponse.StatusCode == http.StatusPartialContent {
+		response := new(DownloadImage206Response)
+		response.ContentType = httpResponse.Header.Get(contentTypeHeader)
+		response.ContentRange = httpResponse.Header.Get(contentRangeHeader)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		response.Body = httpResponse.Body
+		return response, nil
 	}
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeInList(contentTypesForFiles, contentTypeOfResponse) {
 			response := new(DownloadImage200Response)
 			if err := fromString(httpResponse.Header.Get("Content-Type"), &response.ContentType); err != nil {
-				return nil, err
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, err)
 			}
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			response.Body = httpResponse.Body
 			return response, nil
 		}
@@ -2030,26 +3226,41 @@ func (client *visAdminClient) DownloadImage(request *DownloadImageRequest) (Down
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(DownloadImage500Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(DownloadImage304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) ListElements(request *ListElementsRequest) (ListElementsResponse, error) {
+func (client *visAdminClient) ListElements(ctx context.Context, request *ListElementsRequest) (ListElementsResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/elements"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	query := make(url.Values)
 	if request.Page != nil {
 		query.Add("_page", toString(request.Page))
@@ -2061,10 +3272,16 @@ func (client *visAdminClient) ListElements(request *ListElementsRequest) (ListEl
 	if encodedQuery != "" {
 		endpoint += "?" + encodedQuery
 	}
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -2073,28 +3290,36 @@ func (client *visAdminClient) ListElements(request *ListElementsRequest) (ListEl
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "ListElements", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(ListElements200Response)
 			if err := fromString(httpResponse.Header.Get("X-Total-Count"), &response.XTotalCount); err != nil {
-				return nil, err
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, err)
 			}
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(ListElements200Response)
 			if err := fromString(httpResponse.Header.Get("X-Total-Count"), &response.XTotalCount); err != nil {
-				return nil, err
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, err)
 			}
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -2104,43 +3329,56 @@ func (client *visAdminClient) ListElements(request *ListElementsRequest) (ListEl
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(ListElements500Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(ListElements304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) FileUpload(request *FileUploadRequest) (FileUploadResponse, error) {
+func (client *visAdminClient) FileUpload(ctx context.Context, request *FileUploadRequest) (FileUploadResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/file-upload"
 	method := "POST"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
-	formData := new(bytes.Buffer)
-	bodyWriter := multipart.NewWriter(formData)
-	if request.FormData.File != nil {
-		fileWriter0, writerErr0 := bodyWriter.CreateFormFile("file", "file")
-		if writerErr0 != nil {
-			bodyWriter.Close()
-			return nil, writerErr0
-		}
-		_, copyFileErr0 := io.Copy(fileWriter0, request.FormData.File.Content)
-		if copyFileErr0 != nil {
-			bodyWriter.Close()
-			return nil, copyFileErr0
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	writeForm := func(bodyWriter *multipart.Writer) error {
+		if request.FormData.File != nil {
+			if err := writeUpload(bodyWriter, "file", request.FormData.File); err != nil {
+				return err
+			}
 		}
+		return bodyWriter.Close()
+	}
+	formData := new(bytes.Buffer)
+	bodyWriter := multipart.NewWriter(formData)
+	if err := writeForm(bodyWriter); err != nil {
+		return nil, err
 	}
 	contentType := bodyWriter.FormDataContentType()
-	bodyWriter.Close()
-	httpRequest, reqErr := http.NewRequest(method, endpoint, formData)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, formData)
 	if reqErr != nil {
 		return nil, reqErr
 	}
@@ -2153,11 +3391,15 @@ func (client *visAdminClient) FileUpload(request *FileUploadRequest) (FileUpload
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "FileUpload", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusNoContent {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
@@ -2171,6 +3413,9 @@ func (client *visAdminClient) FileUpload(request *FileUploadRequest) (FileUpload
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(FileUpload500Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -2178,35 +3423,47 @@ func (client *visAdminClient) FileUpload(request *FileUploadRequest) (FileUpload
 
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
 // Retrieve a file
-func (client *visAdminClient) DownloadFile(request *DownloadFileRequest) (DownloadFileResponse, error) {
-	return nil, newNotSupportedContentType(415, "no supported content type")
+func (client *visAdminClient) DownloadFile(ctx context.Context, request *DownloadFileRequest) (DownloadFileResponse, error) {
+	return nil, newNotSupportedContentType(415, "application/json")
 }
 
 // Multiple tags can be provided with comma separated strings. Use tag1, tag2, tag3 for testing.
-func (client *visAdminClient) FindByTags(request *FindByTagsRequest) (FindByTagsResponse, error) {
+func (client *visAdminClient) FindByTags(ctx context.Context, request *FindByTagsRequest) (FindByTagsResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/findByTags"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	query := make(url.Values)
 	query.Add("tags", toString(request.Tags))
 	encodedQuery := query.Encode()
 	if encodedQuery != "" {
 		endpoint += "?" + encodedQuery
 	}
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -2215,22 +3472,30 @@ func (client *visAdminClient) FindByTags(request *FindByTagsRequest) (FindByTags
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "FindByTags", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(FindByTags200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(FindByTags200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -2240,32 +3505,59 @@ func (client *visAdminClient) FindByTags(request *FindByTagsRequest) (FindByTags
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(FindByTags400Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(FindByTags304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
 // Retrieve a file
-func (client *visAdminClient) GenericFileDownload(request *GenericFileDownloadRequest) (GenericFileDownloadResponse, error) {
+func (client *visAdminClient) GenericFileDownload(ctx context.Context, request *GenericFileDownloadRequest) (GenericFileDownloadResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
 	path := "/generic/download/{ext}"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
 	endpoint = strings.Replace(endpoint, "{ext}", url.QueryEscape(toString(request.Ext)), 1)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
+	if request.Range != "" {
+		httpRequest.Header[rangeHeader] = []string{request.Range}
+	}
+	if request.IfRange != "" {
+		httpRequest.Header[ifRangeHeader] = []string{request.IfRange}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -2274,44 +3566,63 @@ func (client *visAdminClient) GenericFileDownload(request *GenericFileDownloadRe
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "GenericFileDownload", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
+	}
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
+	if httpResponse.StatusCode == http.StatusPartialContent {
+		response := new(GenericFileDownload206Response)
+		response.ContentType = httpResponse.Header.Get(contentTypeHeader)
+		response.ContentRange = httpResponse.Header.Get(contentRangeHeader)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		response.Body = httpResponse.Body
+		return response, nil
 	}
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeInList(contentTypesForFiles, contentTypeOfResponse) {
 			response := new(GenericFileDownload200Response)
 			if err := fromString(httpResponse.Header.Get("Content-Type"), &response.ContentType); err != nil {
-				return nil, err
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, err)
 			}
 			if err := fromString(httpResponse.Header.Get("Pragma"), &response.Pragma); err != nil {
-				return nil, err
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, err)
 			}
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			response.Body = httpResponse.Body
 			return response, nil
 		}
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
 			response := new(GenericFileDownload200Response)
 			if err := fromString(httpResponse.Header.Get("Content-Type"), &response.ContentType); err != nil {
-				return nil, err
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, err)
 			}
 			if err := fromString(httpResponse.Header.Get("Pragma"), &response.Pragma); err != nil {
-				return nil, err
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, err)
 			}
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
 			response := new(GenericFileDownload200Response)
 			if err := fromString(httpResponse.Header.Get("Content-Type"), &response.ContentType); err != nil {
-				return nil, err
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, err)
 			}
 			if err := fromString(httpResponse.Header.Get("Pragma"), &response.Pragma); err != nil {
-				return nil, err
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, err)
 			}
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -2322,37 +3633,75 @@ func (client *visAdminClient) GenericFileDownload(request *GenericFileDownloadRe
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
 			response := new(GenericFileDownload500Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(GenericFileDownload304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-// get rental
-func (client *visAdminClient) GetRental(request *GetRentalRequest) (GetRentalResponse, error) {
+// Validates the constraints of path, header and form data parameters
+func (client *visAdminClient) ValidateParameters(ctx context.Context, request *ValidateParametersRequest) (ValidateParametersResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
-	path := "/rental"
-	method := "GET"
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
+	path := "/parameters/{id}"
+	method := "POST"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
-	jsonData := new(bytes.Buffer)
-	encodeErr := json.NewEncoder(jsonData).Encode(&request.Body)
-	if encodeErr != nil {
-		return nil, encodeErr
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	endpoint = strings.Replace(endpoint, "{id}", url.QueryEscape(toString(request.Id)), 1)
+	writeForm := func(bodyWriter *multipart.Writer) error {
+		if request.FormData.Ratio != nil {
+			fieldData0 := toString(request.FormData.Ratio)
+			if err := bodyWriter.WriteField("ratio", fieldData0); err != nil {
+				return err
+			}
+		}
+		if request.FormData.Label != nil {
+			fieldData1 := toString(request.FormData.Label)
+			if err := bodyWriter.WriteField("label", fieldData1); err != nil {
+				return err
+			}
+		}
+		return bodyWriter.Close()
+	}
+	formData := new(bytes.Buffer)
+	bodyWriter := multipart.NewWriter(formData)
+	if err := writeForm(bodyWriter); err != nil {
+		return nil, err
 	}
-	httpRequest, reqErr := http.NewRequest(method, endpoint, jsonData)
+	contentType := bodyWriter.FormDataContentType()
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, formData)
 	if reqErr != nil {
 		return nil, reqErr
 	}
-	httpRequest.Header[contentTypeHeader] = []string{contentTypeApplicationJson}
+	httpRequest.Header[contentTypeHeader] = []string{contentType}
+	if request.XMode != nil {
+		httpRequest.Header["X-Mode"] = []string{toString(request.XMode)}
+	}
+	if request.XLimit != nil {
+		httpRequest.Header["X-Limit"] = []string{toString(request.XLimit)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
@@ -2361,15 +3710,19 @@ func (client *visAdminClient) GetRental(request *GetRentalRequest) (GetRentalRes
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
 		httpRequest.Header["Accept"] = []string{"application/json"}
 	}
+	operation := ClientOperation{ID: "ValidateParameters", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
 	defer httpResponse.Body.Close()
-	if httpResponse.StatusCode == http.StatusOK {
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
+	if httpResponse.StatusCode == http.StatusNoContent {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
-			response := new(GetRental200Response)
+			response := new(ValidateParameters204Response)
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -2378,14 +3731,20 @@ func (client *visAdminClient) GetRental(request *GetRentalRequest) (GetRentalRes
 	if httpResponse.StatusCode == http.StatusBadRequest {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
-			response := new(GetRental400Response)
+			response := new(ValidateParameters400Response)
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			if client.responseErrors {
+				return nil, response
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
-			response := new(GetRental400Response)
+			response := new(ValidateParameters400Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
@@ -2393,135 +3752,1323 @@ func (client *visAdminClient) GetRental(request *GetRentalRequest) (GetRentalRes
 
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) GetShoes(request *GetShoesRequest) (GetShoesResponse, error) {
+// Lists the partners, the list is streamed as newline delimited JSON if accepted
+func (client *visAdminClient) ListPartners(ctx context.Context, request *ListPartnersRequest) (ListPartnersResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
-	path := "/shop/shoes"
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
+	path := "/partners"
 	method := "GET"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
-	httpRequest, reqErr := http.NewRequest(method, endpoint, nil)
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	query := make(url.Values)
+	if request.Limit != nil {
+		query.Add("limit", toString(request.Limit))
+	}
+	encodedQuery := query.Encode()
+	if encodedQuery != "" {
+		endpoint += "?" + encodedQuery
+	}
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
 		return nil, err
 	}
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
-		httpRequest.Header["Accept"] = []string{"application/hal+json"}
+		httpRequest.Header["Accept"] = []string{"application/json"}
+		if client.streamItems {
+			httpRequest.Header["Accept"] = []string{"application/x-ndjson, application/json;q=0.9"}
+		}
 	}
+	operation := ClientOperation{ID: "ListPartners", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
 	if err != nil {
-		return nil, err
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
 	}
-	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeApplicationNdjson {
+			response := new(ListPartners200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+			response.Items = readListPartnersItems(httpResponse.Body)
+			return response, nil
+		}
+		defer httpResponse.Body.Close()
 		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
-			response := new(GetShoes200Response)
+			response := new(ListPartners200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
 			if decodeErr != nil {
-				return nil, decodeErr
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
 			}
 			return response, nil
 		} else if contentTypeOfResponse == "" {
-			response := new(GetShoes200Response)
+			response := new(ListPartners200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	defer httpResponse.Body.Close()
+	if httpResponse.StatusCode == http.StatusBadRequest {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
+			response := new(ListPartners400Response)
+			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
+			if decodeErr != nil {
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		} else if contentTypeOfResponse == "" {
+			response := new(ListPartners400Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(ListPartners304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
 	if client.hooks.OnUnknownResponseCode != nil {
 		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
 }
 
-func (client *visAdminClient) PostUpload(request *PostUploadRequest) (PostUploadResponse, error) {
+// Creates a partner from a XML document
+func (client *visAdminClient) CreatePartner(ctx context.Context, request *CreatePartnerRequest) (CreatePartnerResponse, error) {
 	if request == nil {
 		return nil, newRequestObjectIsNilError
 	}
-	path := "/upload"
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
+	path := "/partners"
 	method := "POST"
 	endpoint := client.baseURL + path
-	httpContext := newHttpContextWrapper(client.ctx)
-	formData := new(bytes.Buffer)
-	bodyWriter := multipart.NewWriter(formData)
-	if request.FormData.Upfile != nil {
-		fileWriter0, writerErr0 := bodyWriter.CreateFormFile("upfile", "upfile")
-		if writerErr0 != nil {
-			bodyWriter.Close()
-			return nil, writerErr0
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	xmlData := new(bytes.Buffer)
+	encodeErr := xml.NewEncoder(xmlData).Encode(&request.Body)
+	if encodeErr != nil {
+		return nil, encodeErr
+	}
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, xmlData)
+	if reqErr != nil {
+		return nil, reqErr
+	}
+	httpRequest.Header[contentTypeHeader] = []string{"application/xml"}
+	// set all headers from client context
+	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
+	if err != nil {
+		return nil, err
+	}
+	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
+		httpRequest.Header["Accept"] = []string{"application/xml, application/json"}
+	}
+	operation := ClientOperation{ID: "CreatePartner", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
+	httpResponse, err := client.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
+	}
+	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
+	if httpResponse.StatusCode == http.StatusCreated {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
+			response := new(CreatePartner201Response)
+			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
+			if decodeErr != nil {
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			return response, nil
+		} else if contentTypeOfResponse == "" {
+			response := new(CreatePartner201Response)
+			return response, nil
 		}
-		_, copyFileErr0 := io.Copy(fileWriter0, request.FormData.Upfile.Content)
-		if copyFileErr0 != nil {
-			bodyWriter.Close()
-			return nil, copyFileErr0
+		if client.xmlMatcher.MatchString(contentTypeOfResponse) {
+			response := new(CreatePartner201Response)
+			decodeErr := xml.NewDecoder(httpResponse.Body).Decode(&response.Body)
+			if decodeErr != nil {
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			return response, nil
+		} else if contentTypeOfResponse == "" {
+			response := new(CreatePartner201Response)
+			return response, nil
 		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
-	if request.FormData.Note != nil {
-		fieldData0 := toString(request.FormData.Note)
-		fieldWriter0, fieldErr0 := bodyWriter.CreateFormField("note")
-		if fieldErr0 != nil {
-			bodyWriter.Close()
-			return nil, fieldErr0
+
+	if httpResponse.StatusCode == http.StatusBadRequest {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
+			response := new(CreatePartner400Response)
+			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
+			if decodeErr != nil {
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		} else if contentTypeOfResponse == "" {
+			response := new(CreatePartner400Response)
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
 		}
-		_, writeFieldErr0 := fieldWriter0.Write([]byte(fieldData0))
-		if writeFieldErr0 != nil {
-			bodyWriter.Close()
-			return nil, writeFieldErr0
+		if client.xmlMatcher.MatchString(contentTypeOfResponse) {
+			response := new(CreatePartner400Response)
+			decodeErr := xml.NewDecoder(httpResponse.Body).Decode(&response.Body)
+			if decodeErr != nil {
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		} else if contentTypeOfResponse == "" {
+			response := new(CreatePartner400Response)
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
 		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
-	contentType := bodyWriter.FormDataContentType()
-	bodyWriter.Close()
-	httpRequest, reqErr := http.NewRequest(method, endpoint, formData)
+
+	if client.hooks.OnUnknownResponseCode != nil {
+		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
+	}
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
+}
+
+// Streams the changed partners as server-sent events
+func (client *visAdminClient) WatchPartners(ctx context.Context, request *WatchPartnersRequest) (WatchPartnersResponse, error) {
+	if request == nil {
+		return nil, newRequestObjectIsNilError
+	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
+	path := "/partners/events"
+	method := "GET"
+	endpoint := client.baseURL + path
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	query := make(url.Values)
+	if request.Limit != nil {
+		query.Add("limit", toString(request.Limit))
+	}
+	encodedQuery := query.Encode()
+	if encodedQuery != "" {
+		endpoint += "?" + encodedQuery
+	}
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
 	if reqErr != nil {
 		return nil, reqErr
 	}
-	httpRequest.Header[contentTypeHeader] = []string{contentType}
+	if request.LastEventID != "" {
+		httpRequest.Header[lastEventIDHeader] = []string{request.LastEventID}
+	}
 	// set all headers from client context
 	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
 		return nil, err
 	}
 	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
-		httpRequest.Header["Accept"] = []string{"application/json"}
+		httpRequest.Header["Accept"] = []string{"text/event-stream"}
 	}
+	operation := ClientOperation{ID: "WatchPartners", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
 	httpResponse, err := client.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
+	}
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
+	if httpResponse.StatusCode == http.StatusOK {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeTextEventStream {
+			response := new(WatchPartners200Response)
+			response.Events = &WatchPartnersEventReader{reader: newEventReader(httpResponse.Body)}
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	defer httpResponse.Body.Close()
+	if httpResponse.StatusCode == http.StatusBadRequest {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
+			response := new(WatchPartners400Response)
+			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
+			if decodeErr != nil {
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		} else if contentTypeOfResponse == "" {
+			response := new(WatchPartners400Response)
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if client.hooks.OnUnknownResponseCode != nil {
+		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
+	}
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
+}
+
+// Returns a partner, conditional requests are answered with 304 if the partner is unchanged
+func (client *visAdminClient) GetPartner(ctx context.Context, request *GetPartnerRequest) (GetPartnerResponse, error) {
+	if request == nil {
+		return nil, newRequestObjectIsNilError
+	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
+	path := "/partners/{partnerId}"
+	method := "GET"
+	endpoint := client.baseURL + path
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	endpoint = strings.Replace(endpoint, "{partnerId}", url.QueryEscape(toString(request.PartnerId)), 1)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
+	if reqErr != nil {
+		return nil, reqErr
+	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
+	// set all headers from client context
+	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
 	if err != nil {
 		return nil, err
 	}
+	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
+		httpRequest.Header["Accept"] = []string{"application/json"}
+	}
+	operation := ClientOperation{ID: "GetPartner", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
+	httpResponse, err := client.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
+	}
 	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
 	if httpResponse.StatusCode == http.StatusOK {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
-		if contentTypeOfResponse == "" {
-			response := new(PostUpload200Response)
+		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
+			response := new(GetPartner200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
+			if decodeErr != nil {
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			return response, nil
+		} else if contentTypeOfResponse == "" {
+			response := new(GetPartner200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
-	if httpResponse.StatusCode == http.StatusInternalServerError {
+	if httpResponse.StatusCode == http.StatusNotFound {
 		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == "" {
-			response := new(PostUpload500Response)
+			response := new(GetPartner404Response)
+			if client.responseErrors {
+				return nil, response
+			}
 			return response, nil
 		}
 		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
 	}
 
-	if client.hooks.OnUnknownResponseCode != nil {
-		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
-		return nil, newErrOnUnknownResponseCode(message)
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(GetPartner304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
+	response := &GetPartnerDefaultResponse{Status: httpResponse.StatusCode}
+	if err := fromString(httpResponse.Header.Get("X-Request-ID"), &response.XRequestID); err != nil {
+		return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, err)
+	}
+	contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+	switch {
+	case contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson:
+		decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
+		if decodeErr != nil {
+			return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+		}
+	case contentTypeOfResponse != "":
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+	if client.responseErrors && response.Status >= http.StatusBadRequest {
+		return nil, response
+	}
+	return response, nil
+}
+
+// Updates a partner, conditional requests fail with 412 if the partner was changed
+func (client *visAdminClient) UpdatePartner(ctx context.Context, request *UpdatePartnerRequest) (UpdatePartnerResponse, error) {
+	if request == nil {
+		return nil, newRequestObjectIsNilError
+	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
 	}
-	return nil, newErrUnknownResponse(httpResponse.StatusCode)
+	path := "/partners/{partnerId}"
+	method := "PUT"
+	endpoint := client.baseURL + path
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	endpoint = strings.Replace(endpoint, "{partnerId}", url.QueryEscape(toString(request.PartnerId)), 1)
+	jsonData := new(bytes.Buffer)
+	encodeErr := json.NewEncoder(jsonData).Encode(&request.Body)
+	if encodeErr != nil {
+		return nil, encodeErr
+	}
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, jsonData)
+	if reqErr != nil {
+		return nil, reqErr
+	}
+	httpRequest.Header[contentTypeHeader] = []string{contentTypeApplicationJson}
+	if request.IfMatch != "" {
+		httpRequest.Header[ifMatchHeader] = []string{request.IfMatch}
+	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfUnmodifiedSince.IsZero() {
+		httpRequest.Header[ifUnmodifiedSinceHeader] = []string{formatHTTPTime(request.IfUnmodifiedSince)}
+	}
+	// set all headers from client context
+	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
+	if err != nil {
+		return nil, err
+	}
+	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
+		httpRequest.Header["Accept"] = []string{"application/json"}
+	}
+	operation := ClientOperation{ID: "UpdatePartner", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
+	httpResponse, err := client.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
+	}
+	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
+	if httpResponse.StatusCode == http.StatusOK {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
+			response := new(UpdatePartner200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
+			if decodeErr != nil {
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			return response, nil
+		} else if contentTypeOfResponse == "" {
+			response := new(UpdatePartner200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if httpResponse.StatusCode == http.StatusNotFound {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == "" {
+			response := new(UpdatePartner404Response)
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if httpResponse.StatusCode == http.StatusPreconditionFailed {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == "" {
+			response := new(UpdatePartner412Response)
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if client.hooks.OnUnknownResponseCode != nil {
+		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
+	}
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
+}
+
+// Imports the files of a partner while they are uploaded
+func (client *visAdminClient) ImportPartnerArchive(ctx context.Context, request *ImportPartnerArchiveRequest) (ImportPartnerArchiveResponse, error) {
+	if request == nil {
+		return nil, newRequestObjectIsNilError
+	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
+	path := "/partners/{partnerId}/archive"
+	method := "POST"
+	endpoint := client.baseURL + path
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	endpoint = strings.Replace(endpoint, "{partnerId}", url.QueryEscape(toString(request.PartnerId)), 1)
+	writeForm := func(bodyWriter *multipart.Writer) error {
+		fieldData0 := toString(request.FormData.Note)
+		if err := bodyWriter.WriteField("note", fieldData0); err != nil {
+			return err
+		}
+		for i := range request.FormData.Files {
+			if err := writeUpload(bodyWriter, "files", &request.FormData.Files[i]); err != nil {
+				return err
+			}
+		}
+		return bodyWriter.Close()
+	}
+	formData, pipeWriter := io.Pipe()
+	bodyWriter := multipart.NewWriter(pipeWriter)
+	contentType := bodyWriter.FormDataContentType()
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, formData)
+	if reqErr == nil {
+		go func() {
+			pipeWriter.CloseWithError(writeForm(bodyWriter))
+		}()
+	}
+	if reqErr != nil {
+		return nil, reqErr
+	}
+	httpRequest.Header[contentTypeHeader] = []string{contentType}
+	// set all headers from client context
+	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
+	if err != nil {
+		return nil, err
+	}
+	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
+		httpRequest.Header["Accept"] = []string{"application/json"}
+	}
+	operation := ClientOperation{ID: "ImportPartnerArchive", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
+	httpResponse, err := client.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
+	}
+	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
+	if httpResponse.StatusCode == http.StatusOK {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
+			response := new(ImportPartnerArchive200Response)
+			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
+			if decodeErr != nil {
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			return response, nil
+		} else if contentTypeOfResponse == "" {
+			response := new(ImportPartnerArchive200Response)
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if httpResponse.StatusCode == http.StatusRequestEntityTooLarge {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == "" {
+			response := new(ImportPartnerArchive413Response)
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if client.hooks.OnUnknownResponseCode != nil {
+		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
+	}
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
+}
+
+// Exchanges chat messages with a partner over a WebSocket
+func (client *visAdminClient) ChatWithPartner(ctx context.Context, request *ChatWithPartnerRequest) (ChatWithPartnerResponse, error) {
+	if request == nil {
+		return nil, newRequestObjectIsNilError
+	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
+	path := "/partners/{partnerId}/chat"
+	method := "GET"
+	endpoint := client.baseURL + path
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	endpoint = strings.Replace(endpoint, "{partnerId}", url.QueryEscape(toString(request.PartnerId)), 1)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
+	if reqErr != nil {
+		return nil, reqErr
+	}
+	// set all headers from client context
+	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
+	if err != nil {
+		return nil, err
+	}
+	operation := ClientOperation{ID: "ChatWithPartner", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
+	socket, httpResponse, err := dialWebSocket(client.httpClient.Client, httpRequest, clientMessageValidator(client.validator))
+	if err != nil && (httpResponse == nil || httpResponse.StatusCode == http.StatusSwitchingProtocols) {
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
+	}
+	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
+	if httpResponse.StatusCode == http.StatusSwitchingProtocols {
+		response := new(ChatWithPartner101Response)
+		response.Conn = &ChatWithPartnerClientConn{socket: socket}
+		return response, nil
+	}
+
+	if httpResponse.StatusCode == http.StatusNotFound {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == "" {
+			response := new(ChatWithPartner404Response)
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if client.hooks.OnUnknownResponseCode != nil {
+		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
+	}
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
+}
+
+// Returns the contract of a partner, range requests download a part of the contract
+func (client *visAdminClient) DownloadPartnerContract(ctx context.Context, request *DownloadPartnerContractRequest) (DownloadPartnerContractResponse, error) {
+	if request == nil {
+		return nil, newRequestObjectIsNilError
+	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
+	path := "/partners/{partnerId}/contract"
+	method := "GET"
+	endpoint := client.baseURL + path
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	endpoint = strings.Replace(endpoint, "{partnerId}", url.QueryEscape(toString(request.PartnerId)), 1)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
+	if reqErr != nil {
+		return nil, reqErr
+	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
+	if request.Range != "" {
+		httpRequest.Header[rangeHeader] = []string{request.Range}
+	}
+	if request.IfRange != "" {
+		httpRequest.Header[ifRangeHeader] = []string{request.IfRange}
+	}
+	// set all headers from client context
+	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
+	if err != nil {
+		return nil, err
+	}
+	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
+		httpRequest.Header["Accept"] = []string{"application/pdf"}
+	}
+	operation := ClientOperation{ID: "DownloadPartnerContract", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
+	httpResponse, err := client.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
+	}
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
+	if httpResponse.StatusCode == http.StatusPartialContent {
+		response := new(DownloadPartnerContract206Response)
+		response.ContentType = httpResponse.Header.Get(contentTypeHeader)
+		response.ContentRange = httpResponse.Header.Get(contentRangeHeader)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		response.Body = httpResponse.Body
+		return response, nil
+	}
+	if httpResponse.StatusCode == http.StatusOK {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeInList(contentTypesForFiles, contentTypeOfResponse) {
+			response := new(DownloadPartnerContract200Response)
+			if err := fromString(httpResponse.Header.Get("Content-Type"), &response.ContentType); err != nil {
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, err)
+			}
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+			response.Body = httpResponse.Body
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	defer httpResponse.Body.Close()
+	if httpResponse.StatusCode == http.StatusNotFound {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == "" {
+			response := new(DownloadPartnerContract404Response)
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(DownloadPartnerContract304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
+	if client.hooks.OnUnknownResponseCode != nil {
+		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
+	}
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
+}
+
+// Uploads several documents of a partner, the documents are limited to 64 bytes
+func (client *visAdminClient) UploadPartnerDocuments(ctx context.Context, request *UploadPartnerDocumentsRequest) (UploadPartnerDocumentsResponse, error) {
+	if request == nil {
+		return nil, newRequestObjectIsNilError
+	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
+	path := "/partners/{partnerId}/documents"
+	method := "POST"
+	endpoint := client.baseURL + path
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	endpoint = strings.Replace(endpoint, "{partnerId}", url.QueryEscape(toString(request.PartnerId)), 1)
+	writeForm := func(bodyWriter *multipart.Writer) error {
+		fieldData0 := toString(request.FormData.Category)
+		if err := bodyWriter.WriteField("category", fieldData0); err != nil {
+			return err
+		}
+		for i := range request.FormData.Documents {
+			if err := writeUpload(bodyWriter, "documents", &request.FormData.Documents[i]); err != nil {
+				return err
+			}
+		}
+		if request.FormData.Cover != nil {
+			if err := writeUpload(bodyWriter, "cover", request.FormData.Cover); err != nil {
+				return err
+			}
+		}
+		return bodyWriter.Close()
+	}
+	formData := new(bytes.Buffer)
+	bodyWriter := multipart.NewWriter(formData)
+	if err := writeForm(bodyWriter); err != nil {
+		return nil, err
+	}
+	contentType := bodyWriter.FormDataContentType()
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, formData)
+	if reqErr != nil {
+		return nil, reqErr
+	}
+	httpRequest.Header[contentTypeHeader] = []string{contentType}
+	// set all headers from client context
+	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
+	if err != nil {
+		return nil, err
+	}
+	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
+		httpRequest.Header["Accept"] = []string{"application/json"}
+	}
+	operation := ClientOperation{ID: "UploadPartnerDocuments", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
+	httpResponse, err := client.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
+	}
+	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
+	if httpResponse.StatusCode == http.StatusOK {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
+			response := new(UploadPartnerDocuments200Response)
+			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
+			if decodeErr != nil {
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			return response, nil
+		} else if contentTypeOfResponse == "" {
+			response := new(UploadPartnerDocuments200Response)
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if httpResponse.StatusCode == http.StatusRequestEntityTooLarge {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == "" {
+			response := new(UploadPartnerDocuments413Response)
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if httpResponse.StatusCode == http.StatusUnsupportedMediaType {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == "" {
+			response := new(UploadPartnerDocuments415Response)
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if client.hooks.OnUnknownResponseCode != nil {
+		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
+	}
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
+}
+
+// Returns the profile of a partner, plain text is produced by the declaration only
+func (client *visAdminClient) GetPartnerProfile(ctx context.Context, request *GetPartnerProfileRequest) (GetPartnerProfileResponse, error) {
+	if request == nil {
+		return nil, newRequestObjectIsNilError
+	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
+	path := "/partners/{partnerId}/profile"
+	method := "GET"
+	endpoint := client.baseURL + path
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	endpoint = strings.Replace(endpoint, "{partnerId}", url.QueryEscape(toString(request.PartnerId)), 1)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
+	if reqErr != nil {
+		return nil, reqErr
+	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
+	// set all headers from client context
+	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
+	if err != nil {
+		return nil, err
+	}
+	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
+		httpRequest.Header["Accept"] = []string{"application/json"}
+	}
+	operation := ClientOperation{ID: "GetPartnerProfile", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
+	httpResponse, err := client.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
+	}
+	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
+	if httpResponse.StatusCode == http.StatusOK {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
+			response := new(GetPartnerProfile200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
+			if decodeErr != nil {
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			return response, nil
+		} else if contentTypeOfResponse == "" {
+			response := new(GetPartnerProfile200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(GetPartnerProfile304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
+	if client.hooks.OnUnknownResponseCode != nil {
+		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
+	}
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
+}
+
+// Returns the statement of a partner, the access token of the OAuth2 security definition is required
+func (client *visAdminClient) GetPartnerStatement(ctx context.Context, request *GetPartnerStatementRequest) (GetPartnerStatementResponse, error) {
+	if request == nil {
+		return nil, newRequestObjectIsNilError
+	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
+	path := "/partners/{partnerId}/statement"
+	method := "GET"
+	endpoint := client.baseURL + path
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	endpoint = strings.Replace(endpoint, "{partnerId}", url.QueryEscape(toString(request.PartnerId)), 1)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
+	if reqErr != nil {
+		return nil, reqErr
+	}
+	if err := setCredential(ctx, httpRequest.Header, client.credentials, "OAuth2", "Authorization", request.Authorization); err != nil {
+		return nil, err
+	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
+	// set all headers from client context
+	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
+	if err != nil {
+		return nil, err
+	}
+	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
+		httpRequest.Header["Accept"] = []string{"application/json"}
+	}
+	operation := ClientOperation{ID: "GetPartnerStatement", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
+	httpResponse, err := client.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
+	}
+	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
+	if httpResponse.StatusCode == http.StatusOK {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
+			response := new(GetPartnerStatement200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
+			if decodeErr != nil {
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			return response, nil
+		} else if contentTypeOfResponse == "" {
+			response := new(GetPartnerStatement200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if httpResponse.StatusCode == http.StatusUnauthorized {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == "" {
+			response := new(GetPartnerStatement401Response)
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(GetPartnerStatement304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
+	if client.hooks.OnUnknownResponseCode != nil {
+		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
+	}
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
+}
+
+// get rental
+func (client *visAdminClient) GetRental(ctx context.Context, request *GetRentalRequest) (GetRentalResponse, error) {
+	if request == nil {
+		return nil, newRequestObjectIsNilError
+	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
+	path := "/rental"
+	method := "GET"
+	endpoint := client.baseURL + path
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	jsonData := new(bytes.Buffer)
+	encodeErr := json.NewEncoder(jsonData).Encode(&request.Body)
+	if encodeErr != nil {
+		return nil, encodeErr
+	}
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, jsonData)
+	if reqErr != nil {
+		return nil, reqErr
+	}
+	httpRequest.Header[contentTypeHeader] = []string{contentTypeApplicationJson}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
+	// set all headers from client context
+	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
+	if err != nil {
+		return nil, err
+	}
+	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
+		httpRequest.Header["Accept"] = []string{"application/json"}
+	}
+	operation := ClientOperation{ID: "GetRental", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
+	httpResponse, err := client.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
+	}
+	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
+	if httpResponse.StatusCode == http.StatusOK {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == "" {
+			response := new(GetRental200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if httpResponse.StatusCode == http.StatusBadRequest {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
+			response := new(GetRental400Response)
+			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
+			if decodeErr != nil {
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		} else if contentTypeOfResponse == "" {
+			response := new(GetRental400Response)
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(GetRental304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
+	if client.hooks.OnUnknownResponseCode != nil {
+		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
+	}
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
+}
+
+func (client *visAdminClient) GetShoes(ctx context.Context, request *GetShoesRequest) (GetShoesResponse, error) {
+	if request == nil {
+		return nil, newRequestObjectIsNilError
+	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
+	path := "/shop/shoes"
+	method := "GET"
+	endpoint := client.baseURL + path
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, nil)
+	if reqErr != nil {
+		return nil, reqErr
+	}
+	if request.IfNoneMatch != "" {
+		httpRequest.Header[ifNoneMatchHeader] = []string{request.IfNoneMatch}
+	}
+	if !request.IfModifiedSince.IsZero() {
+		httpRequest.Header[ifModifiedSinceHeader] = []string{formatHTTPTime(request.IfModifiedSince)}
+	}
+	// set all headers from client context
+	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
+	if err != nil {
+		return nil, err
+	}
+	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
+		httpRequest.Header["Accept"] = []string{"application/hal+json"}
+	}
+	operation := ClientOperation{ID: "GetShoes", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
+	httpResponse, err := client.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
+	}
+	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
+	if httpResponse.StatusCode == http.StatusOK {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeApplicationJson || contentTypeOfResponse == contentTypeApplicationHalJson {
+			response := new(GetShoes200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+			decodeErr := json.NewDecoder(httpResponse.Body).Decode(&response.Body)
+			if decodeErr != nil {
+				return nil, client.hooks.callOnError(operation, httpRequest, httpResponse, decodeErr)
+			}
+			return response, nil
+		} else if contentTypeOfResponse == "" {
+			response := new(GetShoes200Response)
+			response.ETag = httpResponse.Header.Get(eTagHeader)
+			response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if httpResponse.StatusCode == http.StatusNotModified {
+		response := new(GetShoes304Response)
+		response.ETag = httpResponse.Header.Get(eTagHeader)
+		response.LastModified = parseHTTPTime(httpResponse.Header.Get(lastModifiedHeader))
+		return response, nil
+	}
+	if client.hooks.OnUnknownResponseCode != nil {
+		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
+	}
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
+}
+
+func (client *visAdminClient) PostUpload(ctx context.Context, request *PostUploadRequest) (PostUploadResponse, error) {
+	if request == nil {
+		return nil, newRequestObjectIsNilError
+	}
+	if err := validateClientRequest(client.validator, request); err != nil {
+		return nil, err
+	}
+	path := "/upload"
+	method := "POST"
+	endpoint := client.baseURL + path
+	if ctx == nil {
+		ctx = client.ctx
+	}
+	httpContext := newHttpContextWrapper(ctx)
+	writeForm := func(bodyWriter *multipart.Writer) error {
+		if request.FormData.Note != nil {
+			fieldData0 := toString(request.FormData.Note)
+			if err := bodyWriter.WriteField("note", fieldData0); err != nil {
+				return err
+			}
+		}
+		if request.FormData.Upfile != nil {
+			if err := writeUpload(bodyWriter, "upfile", request.FormData.Upfile); err != nil {
+				return err
+			}
+		}
+		return bodyWriter.Close()
+	}
+	formData := new(bytes.Buffer)
+	bodyWriter := multipart.NewWriter(formData)
+	if err := writeForm(bodyWriter); err != nil {
+		return nil, err
+	}
+	contentType := bodyWriter.FormDataContentType()
+	httpRequest, reqErr := http.NewRequestWithContext(ctx, method, endpoint, formData)
+	if reqErr != nil {
+		return nil, reqErr
+	}
+	httpRequest.Header[contentTypeHeader] = []string{contentType}
+	// set all headers from client context
+	err := setRequestHeadersFromContext(httpContext, httpRequest.Header)
+	if err != nil {
+		return nil, err
+	}
+	if len(httpRequest.Header["accept"]) == 0 && len(httpRequest.Header["Accept"]) == 0 {
+		httpRequest.Header["Accept"] = []string{"application/json"}
+	}
+	operation := ClientOperation{ID: "PostUpload", Route: path, Method: method}
+	client.hooks.callOnRequest(operation, httpRequest)
+	start := time.Now()
+	httpResponse, err := client.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, client.hooks.callOnError(operation, httpRequest, nil, err)
+	}
+	defer httpResponse.Body.Close()
+	client.hooks.callOnResponse(operation, httpRequest, httpResponse, start)
+	if httpResponse.StatusCode == http.StatusOK {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == "" {
+			response := new(PostUpload200Response)
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if httpResponse.StatusCode == http.StatusInternalServerError {
+		contentTypeOfResponse := extractContentType(httpResponse.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == "" {
+			response := new(PostUpload500Response)
+			if client.responseErrors {
+				return nil, response
+			}
+			return response, nil
+		}
+		return nil, newNotSupportedContentType(415, contentTypeOfResponse)
+	}
+
+	if client.hooks.OnUnknownResponseCode != nil {
+		message := client.hooks.OnUnknownResponseCode(httpResponse, httpRequest)
+		return nil, newErrOnUnknownResponseCode(message, httpResponse.StatusCode)
+	}
+	return nil, newUnexpectedResponseError(httpRequest, httpResponse)
+}
+
+func (client *visAdminClient) registerValidators() {
+	regex2 := regexp.MustCompile("^([a-f0-9]{8}-[a-f0-9]{4}-[1-5]{1}[a-f0-9]{3}-[89aAbB][a-f0-9]{3}-[a-f0-9]{12})?$")
+	client.validator.RegisterPattern("regex2", regex2)
+	client.validator.RegisterPattern("regex3", regex2)
+	regex7 := regexp.MustCompile("^([a-z]{2})-([A-Z]{2})$")
+	client.validator.RegisterPattern("regex7", regex7)
+	regex5 := regexp.MustCompile("^(http|ftp|https):\\/\\/[\\w\\-_]+(\\.[\\w\\-_]+)+([\\w\\-\\.,@?^=%&amp;:/~\\+#]*[\\w\\-\\@?^=%&amp;/~\\+#])?$")
+	client.validator.RegisterPattern("regex5", regex5)
+	client.validator.RegisterPattern("regex6", regex5)
+	regex9 := regexp.MustCompile("^[0-9a-zA-Z ]*$")
+	client.validator.RegisterPattern("regex9", regex9)
+	regex1 := regexp.MustCompile("^[a-zA-Z]$")
+	client.validator.RegisterPattern("regex1", regex1)
+	client.validator.RegisterPattern("regex4", regex1)
+	regex8 := regexp.MustCompile("^[a-z]+$")
+	client.validator.RegisterPattern("regex8", regex8)
 }
@@ -1,31 +1,79 @@
 package api
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"github.com/go-ozzo/ozzo-routing"
 	"github.com/go-ozzo/ozzo-routing/fault"
 	"github.com/go-playground/validator"
+	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"io"
+	"io/ioutil"
+	"math"
+	"mime"
 	"mime/multipart"
 	"net/http"
 	"net/http/httputil"
+	"net/textproto"
+	"net/url"
 	"os"
 	"reflect"
+	"regexp"
 	"runtime"
 	"runtime/debug"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"text/tabwriter"
 	"time"
 )
 
+type ClientOperation struct {
+	ID string
+
+	Route  string
+	Method string
+}
+
 type HooksClient struct {
 	OnUnknownResponseCode func(response *http.Response, request *http.Request) string
+
+	OnRequest func(operation ClientOperation, request *http.Request)
+
+	OnResponse func(operation ClientOperation, request *http.Request, response *http.Response, duration time.Duration)
+
+	OnError func(operation ClientOperation, request *http.Request, response *http.Response, err error)
+}
+
+func (h *HooksClient) callOnRequest(operation ClientOperation, request *http.Request) {
+
+	if h.OnRequest != nil {
+		h.OnRequest(operation, request)
+	}
+}
+
+func (h *HooksClient) callOnResponse(operation ClientOperation, request *http.Request, response *http.Response, start time.Time) {
+
+	if h.OnResponse != nil {
+		h.OnResponse(operation, request, response, time.Since(start))
+	}
+}
+
+func (h *HooksClient) callOnError(operation ClientOperation, request *http.Request, response *http.Response, err error) error {
+
+	if h.OnError != nil {
+		h.OnError(operation, request, response, err)
+	}
+	return err
 }
 
 func DevHook() HooksClient {
@@ -49,10 +97,10 @@ func DevHook() HooksClient {
 
 			message := fmt.Sprintf("unknown response status code %d", response.StatusCode)
 			if len(httpRequestDump) != 0 {
-				message = message + "\n HTTP Request: \n '" + string(httpResponseDumpMessage) + "' \n"
+				message = message + "\n HTTP Request: \n '" + string(httpRequestDumpMessage) + "' \n"
 			}
 			if len(httpResponseDump) != 0 {
-				message = message + "HTTP Response: \n '" + string(httpRequestDumpMessage) + "'"
+				message = message + "HTTP Response: \n '" + string(httpResponseDumpMessage) + "'"
 			}
 			return message
 		},
@@ -289,6 +337,33 @@ var (
 	TypeError = errors.New("unexpected type")
 )
 
+type DecodeError struct {
+	Pointer string
+	Err     error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("%v at '%s'", e.Err, e.Pointer)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+var pointerTokenEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+func wrapDecodeError(token string, err error) error {
+
+	token = "/" + pointerTokenEscaper.Replace(token)
+
+	if decodeErr, ok := err.(*DecodeError); ok {
+		decodeErr.Pointer = token + decodeErr.Pointer
+		return decodeErr
+	}
+
+	return &DecodeError{Pointer: token, Err: err}
+}
+
 func JSON(r io.Reader, v interface{}, required bool) (err error) {
 
 	defer func() {
@@ -327,7 +402,7 @@ func JSON(r io.Reader, v interface{}, required bool) (err error) {
 
 			var abstractSlice []interface{}
 			if err := decoder.Decode(&abstractSlice); err != nil {
-				return err
+				return typeError(err)
 			}
 
 			if abstractSlice == nil {
@@ -340,7 +415,7 @@ func JSON(r io.Reader, v interface{}, required bool) (err error) {
 
 			var abstractMap map[string]interface{}
 			if err := decoder.Decode(&abstractMap); err != nil {
-				return err
+				return typeError(err)
 			}
 
 			if abstractMap == nil {
@@ -363,11 +438,11 @@ func JSON(r io.Reader, v interface{}, required bool) (err error) {
 	} else {
 
 		if !required {
-			return decoder.Decode(v)
+			return typeError(decoder.Decode(v))
 		}
 
 		if err := decoder.Decode(&v); err != nil {
-			return err
+			return typeError(err)
 		}
 
 		if v == nil {
@@ -378,6 +453,20 @@ func JSON(r io.Reader, v interface{}, required bool) (err error) {
 	return nil
 }
 
+func typeError(err error) error {
+
+	if typeErr, ok := err.(*json.UnmarshalTypeError); ok {
+		pointer := ""
+		if typeErr.Field != "" {
+			for _, token := range strings.Split(typeErr.Field, ".") {
+				pointer += "/" + pointerTokenEscaper.Replace(token)
+			}
+		}
+		return &DecodeError{Pointer: pointer, Err: TypeError}
+	}
+	return err
+}
+
 func setValue(value, dest reflect.Value) {
 
 	for dest.Kind() == reflect.Ptr {
@@ -402,7 +491,7 @@ func slice2concrete(typ reflect.Type, s []interface{}) (reflect.Value, error) {
 	for i, value := range s {
 		concretValue, err := convert(value, typ.Elem())
 		if err != nil {
-			return reflect.Value{}, err
+			return reflect.Value{}, wrapDecodeError(strconv.Itoa(i), err)
 		}
 		setValue(concretValue, concretSlice.Index(i))
 	}
@@ -418,12 +507,12 @@ func map2concrete(typ reflect.Type, m map[string]interface{}) (reflect.Value, er
 
 		concreteKey, err := convert(key, typ.Key())
 		if err != nil {
-			return reflect.Value{}, err
+			return reflect.Value{}, wrapDecodeError(key, err)
 		}
 
 		concreteValue, err := convert(value, typ.Elem())
 		if err != nil {
-			return reflect.Value{}, err
+			return reflect.Value{}, wrapDecodeError(key, err)
 		}
 
 		setMapValue(concreteKey, concreteValue, concreteMap)
@@ -467,13 +556,13 @@ func map2object(typ reflect.Type, m map[string]interface{}) (reflect.Value, erro
 
 			concreteValue, err := convert(value, field.Type)
 			if err != nil {
-				return reflect.Value{}, err
+				return reflect.Value{}, wrapDecodeError(key, err)
 			}
 			setValue(concreteValue, object.Field(i))
 
 		} else if required {
 
-			return reflect.Value{}, NullError
+			return reflect.Value{}, wrapDecodeError(key, NullError)
 		}
 	}
 
@@ -524,29 +613,158 @@ func convert(data interface{}, typ reflect.Type) (reflect.Value, error) {
 
 	} else {
 
-		return reflect.ValueOf(data).Convert(typ), nil
+		value := reflect.ValueOf(data)
+		if !value.IsValid() {
+			return reflect.Value{}, NullError
+		}
+
+		if !convertible(value, typ) {
+			return reflect.Value{}, TypeError
+		}
+
+		return value.Convert(typ), nil
+	}
+}
+
+func convertible(value reflect.Value, typ reflect.Type) bool {
+
+	switch typ.Kind() {
+	case reflect.Interface:
+		return value.Type().Implements(typ)
+	case reflect.Bool:
+		return value.Kind() == reflect.Bool
+	case reflect.String:
+		return value.Kind() == reflect.String
+	case reflect.Float32, reflect.Float64:
+		return value.Kind() == reflect.Float64
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Kind() == reflect.Float64 && value.Float() == math.Trunc(value.Float())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return value.Kind() == reflect.Float64 && value.Float() == math.Trunc(value.Float()) && value.Float() >= 0
+	}
+
+	return false
+}
+
+var SyntaxError = errors.New("invalid XML document")
+
+func XML(r io.Reader, v interface{}, required bool) error {
+
+	if err := xml.NewDecoder(r).Decode(v); err != nil {
+		if err == io.EOF {
+			if required {
+				return NullError
+			}
+			return nil
+		}
+		return fmt.Errorf("%w: %v", SyntaxError, err)
 	}
+
+	return nil
 }
 
+const (
+	LocationPath     = "path"
+	LocationQuery    = "query"
+	LocationHeader   = "header"
+	LocationFormData = "formData"
+	LocationBody     = "body"
+)
+
+const (
+	CodeRequired    = "required"
+	CodeInvalidType = "invalid-type"
+	CodeInvalidJSON = "invalid-json"
+	CodeInvalidXML  = "invalid-xml"
+
+	CodeInvalidPattern = "invalid-pattern"
+)
+
 type ValidationErrorsObject struct {
 	Message string                  `json:"message"`
 	Errors  []ValidationErrorObject `json:"errors"`
 }
 
 type ValidationErrorObject struct {
-	Message string `json:"message"`
-	Field   string `json:"field"`
-	Code    string `json:"code"`
+	Message  string `json:"message"`
+	Field    string `json:"field"`
+	Code     string `json:"code"`
+	Param    string `json:"param,omitempty"`
+	Location string `json:"location,omitempty"`
+	Pointer  string `json:"pointer,omitempty"`
+}
+
+func (v *ValidationErrorsObject) Error() string {
+
+	fields := make([]string, len(v.Errors))
+	for i, err := range v.Errors {
+		fields[i] = fmt.Sprintf("%s (%s)", err.Field, err.Code)
+	}
+	return fmt.Sprintf("%s: %s", v.Message, strings.Join(fields, ", "))
 }
 
 func NewValidation() *Validator {
+
+	v := validator.New()
+	v.RegisterTagNameFunc(jsonTagName)
+
 	return &Validator{
-		validator.New(),
+		Validate: v,
+		patterns: make(map[string]string),
 	}
 }
 
 type Validator struct {
 	*validator.Validate
+	patterns map[string]string
+}
+
+func jsonTagName(field reflect.StructField) string {
+
+	if name := strings.SplitN(field.Tag.Get("param"), ",", 2)[0]; name != "" {
+		return name
+	}
+
+	name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+func parameterLocation(typ reflect.Type, fields []string) (string, int) {
+
+	for i, name := range fields {
+
+		for typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+
+		if typ.Kind() != reflect.Struct {
+			break
+		}
+
+		field, ok := typ.FieldByName(name)
+		if !ok {
+			break
+		}
+
+		if param := strings.SplitN(field.Tag.Get("param"), ",", 2); len(param) == 2 {
+			return param[1], i + 1
+		}
+
+		typ = field.Type
+	}
+
+	return "", 0
+}
+
+func (v *Validator) RegisterPattern(tag string, regex *regexp.Regexp) error {
+
+	v.patterns[tag] = regex.String()
+	return v.RegisterValidation(tag, func(fl validator.FieldLevel) bool {
+		return regex.MatchString(fl.Field().String())
+	})
 }
 
 func (v *Validator) ValidateRequest(request interface{}) (*ValidationErrorsObject, error) {
@@ -563,15 +781,27 @@ func (v *Validator) ValidateRequest(request interface{}) (*ValidationErrorsObjec
 		for _, err := range errors {
 
 			errorCode := fmt.Sprintf("invalid-%s", err.Tag())
+			param := err.Param()
 			if err.Tag() == "required" {
 				errorCode = err.Tag()
+			} else if pattern, ok := v.patterns[err.Tag()]; ok {
+				errorCode = CodeInvalidPattern
+				param = pattern
 			}
 
 			validationError := ValidationErrorObject{
 				Message: fmt.Sprint(err),
 				Field:   err.Field(),
 				Code:    errorCode,
+				Param:   param,
+			}
+
+			location, depth := parameterLocation(reflect.TypeOf(request), namespaceTokens(err.StructNamespace()))
+			validationError.Location = location
+			if location == LocationBody {
+				validationError.Pointer = jsonPointer(namespaceTokens(err.Namespace())[depth:])
 			}
+
 			validationErrors.Errors = append(validationErrors.Errors, validationError)
 		}
 
@@ -581,6 +811,118 @@ func (v *Validator) ValidateRequest(request interface{}) (*ValidationErrorsObjec
 	return nil, nil
 }
 
+func (v *Validator) ValidateMessage(message interface{}) error {
+
+	typ := reflect.TypeOf(message)
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil
+	}
+
+	validationErrors, err := v.ValidateRequest(message)
+	if err != nil {
+		return err
+	}
+	if validationErrors != nil {
+		return validationErrors
+	}
+	return nil
+}
+
+func namespaceTokens(namespace string) []string {
+
+	var tokens []string
+	var token strings.Builder
+	inIndex := false
+
+	flush := func() {
+		tokens = append(tokens, token.String())
+		token.Reset()
+	}
+
+	for _, c := range namespace {
+		switch {
+		case c == '.' && !inIndex:
+			flush()
+		case c == '[' && !inIndex:
+			flush()
+			inIndex = true
+		case c == ']' && inIndex:
+			inIndex = false
+		default:
+			token.WriteRune(c)
+		}
+	}
+	flush()
+
+	var fields []string
+	for _, token := range tokens[1:] {
+		if token != "" {
+			fields = append(fields, token)
+		}
+	}
+	return fields
+}
+
+func jsonPointer(tokens []string) string {
+
+	var pointer strings.Builder
+	for _, token := range tokens {
+		pointer.WriteString("/")
+		pointer.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(token))
+	}
+	return pointer.String()
+}
+
+func NewParameterError(name, location, code, message string) *ValidationErrorsObject {
+
+	return &ValidationErrorsObject{
+		Message: "validation failed",
+		Errors: []ValidationErrorObject{
+			{
+				Message:  message,
+				Field:    name,
+				Code:     code,
+				Location: location,
+			},
+		},
+	}
+}
+
+func NewBodyError(name string, err error) *ValidationErrorsObject {
+
+	validationError := ValidationErrorObject{
+		Message:  fmt.Sprint(err),
+		Field:    name,
+		Code:     CodeInvalidJSON,
+		Location: LocationBody,
+	}
+
+	var decodeErr *DecodeError
+	if errors.As(err, &decodeErr) {
+		validationError.Pointer = decodeErr.Pointer
+		validationError.Code = CodeInvalidType
+		if i := strings.LastIndex(decodeErr.Pointer, "/"); i >= 0 {
+			validationError.Field = strings.NewReplacer("~1", "/", "~0", "~").Replace(decodeErr.Pointer[i+1:])
+		}
+	}
+
+	if errors.Is(err, NullError) {
+		validationError.Code = CodeRequired
+	}
+
+	if errors.Is(err, SyntaxError) {
+		validationError.Code = CodeInvalidXML
+	}
+
+	return &ValidationErrorsObject{
+		Message: "validation failed",
+		Errors:  []ValidationErrorObject{validationError},
+	}
+}
+
 var (
 	GitCommit string = "5c8b2ec75cd8f17faea02dfc4bba67fe24683cb7"
 	GitBranch string = "feature/interface_cleanup"
@@ -640,6 +982,14 @@ func (vi *VersionInfo) PrintTable() error {
 type Opts struct {
 	Hooks HooksClient
 	Ctx   context.Context
+
+	Validate bool
+
+	Credentials map[string]CredentialProvider
+
+	ResponseErrors bool
+
+	StreamItems bool
 }
 
 type httpClientWrapper struct {
@@ -681,130 +1031,1295 @@ func (c *httpClientWrapper) Into(body io.ReadCloser, r interface{}) error {
 	return nil
 }
 
-const (
-	contentTypeHeader                    string = "Content-Type"
-	contentTypeApplicationJson           string = "application/json"
-	contentTypeApplicationHalJson        string = "application/hal+json"
-	ContentTypeTextPlain                 string = "text/plain"
-	contentTypeMultipartFormData         string = "multipart/form-data"
-	contentTypeApplicationFormUrlencoded string = "application/x-www-form-urlencoded"
-)
+const tokenRefreshDelta = 30 * time.Second
 
-func extractContentType(header string) string {
-	if header == "" {
-		return ""
-	}
-	i := strings.Index(header, ";")
-	if i == -1 {
-		i = len(header)
-	}
-	return strings.TrimSpace(strings.ToLower(header[:i]))
+type CredentialProvider interface {
+	Credential(ctx context.Context) (string, error)
 }
 
-func contentTypeInList(types []string, typ string) bool {
+type CredentialFunc func(ctx context.Context) (string, error)
 
-	for _, t := range types {
-		if t == typ {
-			return true
-		}
-	}
-	return false
-}
-func newNotSupportedContentType(statusCode int, message string) error {
-	return &NotSupportedContentType{
-		message:    message,
-		statusCode: statusCode,
-	}
+func (f CredentialFunc) Credential(ctx context.Context) (string, error) {
+	return f(ctx)
 }
 
-type NotSupportedContentType struct {
-	message    string
-	statusCode int
+func APIKeyCredential(key string) CredentialProvider {
+	return CredentialFunc(func(ctx context.Context) (string, error) {
+		return key, nil
+	})
 }
 
-func (e *NotSupportedContentType) Error() string {
-	return fmt.Sprintf("error unsupported media type (%s)", e.message)
-}
+func BasicAuthCredential(user, password string) CredentialProvider {
 
-func (e *NotSupportedContentType) StatusCode() int {
-	return e.statusCode
+	credential := "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+password))
+	return CredentialFunc(func(ctx context.Context) (string, error) {
+		return credential, nil
+	})
 }
 
-var newRequestObjectIsNilError = errors.New("request object is nil")
-
-func newErrUnknownResponse(code int) *ErrUnknownResponse {
-	return &ErrUnknownResponse{
-		code: code,
-	}
+type OAuth2Token struct {
+	AccessToken string
+	Expiry      time.Time
 }
 
-type ErrUnknownResponse struct {
-	code int
+type OAuth2TokenSource interface {
+	Token(ctx context.Context) (*OAuth2Token, error)
 }
 
-func (err *ErrUnknownResponse) Error() string {
-	return fmt.Sprintf("unknown response status code '%d'", err.code)
+func BearerTokenCredential(source OAuth2TokenSource) CredentialProvider {
+	return &bearerTokenCredential{source: source}
 }
 
-func newErrOnUnknownResponseCode(message string) *ErrOnUnknownResponseCode {
-	return &ErrOnUnknownResponseCode{
-		Message: message,
-	}
+type bearerTokenCredential struct {
+	source OAuth2TokenSource
+	mutex  sync.Mutex
+	token  *OAuth2Token
 }
 
-type ErrOnUnknownResponseCode struct {
-	Message string
-}
+func (c *bearerTokenCredential) Credential(ctx context.Context) (string, error) {
 
-func (err *ErrOnUnknownResponseCode) Error() string {
-	return fmt.Sprintf(err.Message)
-}
-func serveJson(w http.ResponseWriter, status int, v interface{}) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
 
-	w.Header()["Content-Type"] = []string{"application/json"}
-	w.WriteHeader(status)
-	if err := json.NewEncoder(w).Encode(v); err != nil {
-		return err
+	if c.token == nil || (!c.token.Expiry.IsZero() && time.Now().Add(tokenRefreshDelta).After(c.token.Expiry)) {
+		token, err := c.source.Token(ctx)
+		if err != nil {
+			return "", errors.Wrap(err, "error fetching bearer token")
+		}
+		c.token = token
 	}
-	return nil
+	return "Bearer " + c.token.AccessToken, nil
 }
 
-func serveHalJson(w http.ResponseWriter, status int, v interface{}) error {
+func ClientCredentialsTokenSource(client *http.Client, tokenURL, clientID, clientSecret string, scopes ...string) OAuth2TokenSource {
 
-	w.Header()["Content-Type"] = []string{"application/hal+json"}
-	w.WriteHeader(status)
-	if err := json.NewEncoder(w).Encode(v); err != nil {
-		return err
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &clientCredentialsTokenSource{
+		client:       client,
+		tokenURL:     tokenURL,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		scopes:       scopes,
 	}
-	return nil
 }
 
-type MimeFile struct {
-	Header  *multipart.FileHeader
-	Content io.ReadCloser
+type clientCredentialsTokenSource struct {
+	client       *http.Client
+	tokenURL     string
+	clientID     string
+	clientSecret string
+	scopes       []string
 }
 
-func extractUpload(fileID string, r *http.Request) (*MimeFile, error) {
+func (s *clientCredentialsTokenSource) Token(ctx context.Context) (*OAuth2Token, error) {
 
-	if err := r.ParseMultipartForm(1024); err != nil {
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(s.scopes) > 0 {
+		form.Set("scope", strings.Join(s.scopes, " "))
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
 		return nil, err
 	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	request.SetBasicAuth(url.QueryEscape(s.clientID), url.QueryEscape(s.clientSecret))
 
-	file, header, err := r.FormFile(fileID)
+	response, err := s.client.Do(request)
 	if err != nil {
 		return nil, err
 	}
+	defer response.Body.Close()
 
-	mimeFile := MimeFile{
-		Header:  header,
-		Content: file,
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token endpoint responded with status code %d", response.StatusCode)
 	}
-	return &mimeFile, nil
-}
 
-type contextKey int
+	var body struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+		return nil, errors.Wrap(err, "error decoding token response")
+	}
+	if body.AccessToken == "" {
+		return nil, errors.New("token response without access token")
+	}
+	if body.TokenType != "" && !strings.EqualFold(body.TokenType, "bearer") {
+		return nil, fmt.Errorf("unsupported token type '%s'", body.TokenType)
+	}
 
-const (
+	token := &OAuth2Token{AccessToken: body.AccessToken}
+	if body.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(body.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+func setCredential(ctx context.Context, header http.Header, credentials map[string]CredentialProvider, definition, name, value string) error {
+
+	if provider, ok := credentials[definition]; ok && value == "" {
+		credential, err := provider.Credential(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "error providing credential of security definition '%s'", definition)
+		}
+		value = credential
+	}
+
+	header[name] = []string{value}
+	return nil
+}
+
+const maxUnexpectedBodySize int64 = 64 << 10
+
+type UnexpectedResponseError struct {
+	StatusCode int
+	Header     http.Header
+
+	Body []byte
+
+	Method string
+	URL    string
+}
+
+func (e *UnexpectedResponseError) Error() string {
+	return fmt.Sprintf("unknown response status code '%d' of %s %s", e.StatusCode, e.Method, e.URL)
+}
+
+func (e *UnexpectedResponseError) UnexpectedStatusCode() int {
+	return e.StatusCode
+}
+
+func newUnexpectedResponseError(request *http.Request, response *http.Response) *UnexpectedResponseError {
+
+	err := &UnexpectedResponseError{
+		StatusCode: response.StatusCode,
+		Header:     response.Header,
+		Method:     request.Method,
+	}
+
+	if request.URL != nil {
+		url := *request.URL
+		url.User = nil
+		err.URL = url.String()
+	}
+
+	if response.Body != nil {
+
+		err.Body, _ = ioutil.ReadAll(io.LimitReader(response.Body, maxUnexpectedBodySize))
+	}
+
+	return err
+}
+
+type RequestValidationError struct {
+	ValidationErrorsObject
+}
+
+func (e *RequestValidationError) Error() string {
+	return "invalid request: " + e.ValidationErrorsObject.Error()
+}
+
+func validateClientRequest(validator *Validator, request interface{}) error {
+
+	if validator == nil {
+		return nil
+	}
+
+	validationErrors, err := validator.ValidateRequest(request)
+	if err != nil {
+		return err
+	}
+	if validationErrors != nil {
+		return &RequestValidationError{ValidationErrorsObject: *validationErrors}
+	}
+	return nil
+}
+
+func clientMessageValidator(validator *Validator) MessageValidator {
+
+	if validator == nil {
+		return nil
+	}
+	return validator.ValidateMessage
+}
+
+type MediaRange struct {
+	Type    string
+	Subtype string
+	Q       float64
+}
+
+func (m MediaRange) matches(contentType string) (int, bool) {
+
+	typ, subtype := splitMediaType(contentType)
+
+	switch {
+	case m.Type == "*" && m.Subtype == "*":
+		return 0, true
+	case m.Type == typ && m.Subtype == "*":
+		return 1, true
+	case m.Type == typ && m.Subtype == subtype:
+		return 2, true
+	}
+	return 0, false
+}
+
+func splitMediaType(mediaType string) (string, string) {
+
+	mediaType = extractContentType(mediaType)
+	i := strings.Index(mediaType, "/")
+	if i == -1 {
+		return mediaType, ""
+	}
+	return mediaType[:i], mediaType[i+1:]
+}
+
+func ParseAccept(header string) []MediaRange {
+
+	var mediaRanges []MediaRange
+
+	for _, element := range strings.Split(header, ",") {
+
+		params := strings.Split(element, ";")
+		typ, subtype := splitMediaType(params[0])
+		if typ == "" || subtype == "" {
+			continue
+		}
+
+		mediaRange := MediaRange{Type: typ, Subtype: subtype, Q: 1}
+		valid := true
+		for _, param := range params[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(strings.ToLower(param), "q=") {
+				continue
+			}
+			q, err := strconv.ParseFloat(param[2:], 64)
+			if err != nil || q < 0 || q > 1 {
+				valid = false
+				break
+			}
+			mediaRange.Q = q
+		}
+
+		if valid {
+			mediaRanges = append(mediaRanges, mediaRange)
+		}
+	}
+
+	sort.SliceStable(mediaRanges, func(i, j int) bool {
+		return mediaRanges[i].Q > mediaRanges[j].Q
+	})
+
+	return mediaRanges
+}
+
+func negotiateContentType(header string, offers []string) (string, bool) {
+
+	if len(offers) == 0 {
+		return "", false
+	}
+
+	if strings.TrimSpace(header) == "" {
+		return offers[0], true
+	}
+
+	mediaRanges := ParseAccept(header)
+
+	var (
+		best  string
+		bestQ float64
+	)
+
+	for _, offer := range offers {
+
+		q, specificity := 0.0, -1
+		for _, mediaRange := range mediaRanges {
+			if s, ok := mediaRange.matches(offer); ok && s > specificity {
+				q, specificity = mediaRange.Q, s
+			}
+		}
+
+		if q > bestQ {
+			best, bestQ = offer, q
+		}
+	}
+
+	return best, bestQ > 0
+}
+
+type negotiatedContentTypeKey struct{}
+
+func withNegotiatedContentType(ctx context.Context, contentType string) context.Context {
+	return context.WithValue(ctx, negotiatedContentTypeKey{}, contentType)
+}
+
+func NegotiatedContentType(ctx context.Context) string {
+
+	contentType, _ := ctx.Value(negotiatedContentTypeKey{}).(string)
+	return contentType
+}
+
+const (
+	eTagHeader              string = "ETag"
+	lastModifiedHeader      string = "Last-Modified"
+	ifMatchHeader           string = "If-Match"
+	ifNoneMatchHeader       string = "If-None-Match"
+	ifModifiedSinceHeader   string = "If-Modified-Since"
+	ifUnmodifiedSinceHeader string = "If-Unmodified-Since"
+)
+
+func formatETag(etag string) string {
+
+	if etag == "" || strings.HasPrefix(etag, "\"") || strings.HasPrefix(etag, "W/\"") {
+		return etag
+	}
+	return "\"" + etag + "\""
+}
+
+func setValidators(header http.Header, etag string, lastModified time.Time) {
+
+	if etag != "" {
+		header.Set(eTagHeader, formatETag(etag))
+	}
+	if !lastModified.IsZero() {
+		header.Set(lastModifiedHeader, formatHTTPTime(lastModified))
+	}
+}
+
+func formatHTTPTime(t time.Time) string {
+
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(http.TimeFormat)
+}
+
+func parseHTTPTime(value string) time.Time {
+
+	if value == "" {
+		return time.Time{}
+	}
+	t, err := http.ParseTime(value)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+func hasPreconditions(header http.Header) bool {
+
+	return header.Get(ifMatchHeader) != "" || header.Get(ifNoneMatchHeader) != "" ||
+		header.Get(ifUnmodifiedSinceHeader) != "" || header.Get(ifModifiedSinceHeader) != ""
+}
+
+func evaluatePreconditions(r *http.Request, etag string, lastModified time.Time) int {
+
+	etag = formatETag(etag)
+	exists := etag != "" || !lastModified.IsZero()
+	lastModified = lastModified.Truncate(time.Second)
+	safe := r.Method == http.MethodGet || r.Method == http.MethodHead
+
+	if ifMatch := r.Header.Get(ifMatchHeader); ifMatch != "" {
+		if !matchETag(ifMatch, etag, exists, true) {
+			return http.StatusPreconditionFailed
+		}
+	} else if since := parseHTTPTime(r.Header.Get(ifUnmodifiedSinceHeader)); !since.IsZero() && !lastModified.IsZero() {
+		if lastModified.After(since) {
+			return http.StatusPreconditionFailed
+		}
+	}
+
+	if ifNoneMatch := r.Header.Get(ifNoneMatchHeader); ifNoneMatch != "" {
+		if matchETag(ifNoneMatch, etag, exists, false) {
+			if safe {
+				return http.StatusNotModified
+			}
+			return http.StatusPreconditionFailed
+		}
+	} else if since := parseHTTPTime(r.Header.Get(ifModifiedSinceHeader)); safe && !since.IsZero() && !lastModified.IsZero() {
+		if !lastModified.After(since) {
+			return http.StatusNotModified
+		}
+	}
+
+	return 0
+}
+
+func matchETag(list, etag string, exists, strong bool) bool {
+
+	if strings.TrimSpace(list) == "*" {
+		return exists
+	}
+	if etag == "" {
+		return false
+	}
+
+	for _, candidate := range splitETags(list) {
+		if strong {
+			if candidate == etag && !strings.HasPrefix(etag, "W/") {
+				return true
+			}
+		} else if strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+func splitETags(list string) []string {
+
+	var (
+		etags  []string
+		quoted bool
+		start  int
+	)
+	for i := 0; i < len(list); i++ {
+		switch list[i] {
+		case '"':
+			quoted = !quoted
+		case ',':
+			if !quoted {
+				etags = append(etags, strings.TrimSpace(list[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(etags, strings.TrimSpace(list[start:]))
+}
+
+const (
+	contentTypeHeader                    string = "Content-Type"
+	contentTypeApplicationJson           string = "application/json"
+	contentTypeApplicationHalJson        string = "application/hal+json"
+	ContentTypeTextPlain                 string = "text/plain"
+	contentTypeMultipartFormData         string = "multipart/form-data"
+	contentTypeApplicationFormUrlencoded string = "application/x-www-form-urlencoded"
+	contentTypeApplicationXml            string = "application/xml"
+	contentTypeTextXml                   string = "text/xml"
+	contentTypeApplicationNdjson         string = "application/x-ndjson"
+)
+
+func extractContentType(header string) string {
+	if header == "" {
+		return ""
+	}
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	return strings.TrimSpace(strings.ToLower(header[:i]))
+}
+
+func contentTypeInList(types []string, typ string) bool {
+
+	for _, t := range types {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
+func newNotSupportedContentType(statusCode int, message string) error {
+	return &NotSupportedContentType{
+		message:    message,
+		statusCode: statusCode,
+	}
+}
+
+type NotSupportedContentType struct {
+	message    string
+	statusCode int
+}
+
+func (e *NotSupportedContentType) Error() string {
+	return fmt.Sprintf("error unsupported media type (%s)", e.message)
+}
+
+func (e *NotSupportedContentType) StatusCode() int {
+	return e.statusCode
+}
+
+var newRequestObjectIsNilError = errors.New("request object is nil")
+
+func newErrUnknownResponse(code int) *ErrUnknownResponse {
+	return &ErrUnknownResponse{
+		code: code,
+	}
+}
+
+type ErrUnknownResponse struct {
+	code int
+}
+
+func (err *ErrUnknownResponse) Error() string {
+	return fmt.Sprintf("unknown response status code '%d'", err.code)
+}
+
+func newErrOnUnknownResponseCode(message string, statusCode int) *ErrOnUnknownResponseCode {
+	return &ErrOnUnknownResponseCode{
+		Message:    message,
+		statusCode: statusCode,
+	}
+}
+
+type ErrOnUnknownResponseCode struct {
+	Message    string
+	statusCode int
+}
+
+func (err *ErrOnUnknownResponseCode) Error() string {
+	return fmt.Sprintf(err.Message)
+}
+
+func (err *ErrOnUnknownResponseCode) UnexpectedStatusCode() int {
+	return err.statusCode
+}
+
+type ResponseError interface {
+	error
+	StatusCode() int
+	OperationID() string
+}
+
+func responseErrorMessage(operationID string, statusCode int, body interface{}) string {
+
+	message := fmt.Sprintf("%s responded with status code %d (%s)", operationID, statusCode, http.StatusText(statusCode))
+	if body == nil {
+		return message
+	}
+	if content, err := json.Marshal(body); err == nil {
+		return message + ": " + string(content)
+	}
+	return message + fmt.Sprintf(": %+v", body)
+}
+
+type unexpectedResponseError interface {
+	error
+	UnexpectedStatusCode() int
+}
+
+func ResponseStatusCode(err error) (int, bool) {
+
+	var responseErr ResponseError
+	if errors.As(err, &responseErr) {
+		return responseErr.StatusCode(), true
+	}
+	var unexpectedErr unexpectedResponseError
+	if errors.As(err, &unexpectedErr) {
+		return unexpectedErr.UnexpectedStatusCode(), true
+	}
+	return 0, false
+}
+
+func IsBadRequest(err error) bool {
+	return hasStatusCode(err, http.StatusBadRequest)
+}
+
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+func IsForbidden(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
+}
+
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+func IsPreconditionFailed(err error) bool {
+	return hasStatusCode(err, http.StatusPreconditionFailed)
+}
+
+func IsTooManyRequests(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+func IsServerError(err error) bool {
+	statusCode, ok := ResponseStatusCode(err)
+	return ok && statusCode >= http.StatusInternalServerError
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	code, ok := ResponseStatusCode(err)
+	return ok && code == statusCode
+}
+
+const (
+	contentTypeTextEventStream string = "text/event-stream"
+	lastEventIDHeader          string = "Last-Event-ID"
+)
+
+var ErrStreamingNotSupported = errors.New("response writer does not support streaming")
+
+type ServerSentEvent struct {
+	Name  string
+	ID    string
+	Data  string
+	Retry time.Duration
+}
+
+type eventStream struct {
+	w       http.ResponseWriter
+	r       *http.Request
+	started bool
+}
+
+func newEventStream(w http.ResponseWriter, r *http.Request) *eventStream {
+	return &eventStream{w: w, r: r}
+}
+
+func (s *eventStream) Send(name, id string, data interface{}, retry time.Duration) error {
+
+	if err := s.r.Context().Err(); err != nil {
+		return err
+	}
+
+	flusher, ok := s.w.(http.Flusher)
+	if !ok {
+		return ErrStreamingNotSupported
+	}
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if !s.started {
+
+		s.w.Header()["Content-Type"] = []string{contentTypeTextEventStream}
+		s.w.Header()["Cache-Control"] = []string{"no-cache"}
+		s.w.WriteHeader(http.StatusOK)
+		s.started = true
+	}
+
+	var event strings.Builder
+	if name != "" {
+		fmt.Fprintf(&event, "event: %s\n", stripLineBreaks(name))
+	}
+	if id != "" {
+		fmt.Fprintf(&event, "id: %s\n", stripLineBreaks(id))
+	}
+	if retry > 0 {
+		fmt.Fprintf(&event, "retry: %d\n", retry.Milliseconds())
+	}
+	fmt.Fprintf(&event, "data: %s\n\n", encoded)
+
+	if _, err := io.WriteString(s.w, event.String()); err != nil {
+		return err
+	}
+	flusher.Flush()
+
+	return nil
+}
+
+func (s *eventStream) Started() bool {
+	return s.started
+}
+
+func stripLineBreaks(s string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(s)
+}
+
+type eventReader struct {
+	body        io.ReadCloser
+	reader      *bufio.Reader
+	lastEventID string
+}
+
+func newEventReader(body io.ReadCloser) *eventReader {
+	return &eventReader{body: body, reader: bufio.NewReader(body)}
+}
+
+func (r *eventReader) Next() (*ServerSentEvent, error) {
+
+	event := new(ServerSentEvent)
+	var data []string
+
+	for {
+		line, err := r.reader.ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			return nil, err
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			if len(data) == 0 {
+				event = new(ServerSentEvent)
+				continue
+			}
+			event.ID = r.lastEventID
+			event.Data = strings.Join(data, "\n")
+			return event, nil
+		}
+
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		field, value := line, ""
+		if i := strings.Index(line, ":"); i >= 0 {
+			field, value = line[:i], strings.TrimPrefix(line[i+1:], " ")
+		}
+
+		switch field {
+		case "event":
+			event.Name = value
+		case "data":
+			data = append(data, value)
+		case "id":
+			if !strings.Contains(value, "\x00") {
+				r.lastEventID = value
+			}
+		case "retry":
+			if retry, err := strconv.ParseInt(value, 10, 64); err == nil {
+				event.Retry = time.Duration(retry) * time.Millisecond
+			}
+		}
+	}
+}
+
+func (r *eventReader) LastEventID() string {
+	return r.lastEventID
+}
+
+func (r *eventReader) Close() error {
+	return r.body.Close()
+}
+func serveJson(w http.ResponseWriter, status int, v interface{}) error {
+
+	w.Header()["Content-Type"] = []string{"application/json"}
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		return err
+	}
+	return nil
+}
+
+func serveHalJson(w http.ResponseWriter, status int, v interface{}) error {
+
+	w.Header()["Content-Type"] = []string{"application/hal+json"}
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		return err
+	}
+	return nil
+}
+
+type itemProducer func() (interface{}, error)
+
+func serveNdjson(w http.ResponseWriter, status int, next itemProducer) error {
+
+	w.Header()["Content-Type"] = []string{"application/x-ndjson"}
+	w.WriteHeader(status)
+
+	encoder := json.NewEncoder(w)
+	err := streamItems(w, next, func(item interface{}, first bool) error {
+		return encoder.Encode(item)
+	})
+	if err != nil {
+		return &StreamError{Err: err}
+	}
+	return nil
+}
+
+func serveJsonArray(w http.ResponseWriter, status int, next itemProducer) error {
+
+	w.Header()["Content-Type"] = []string{"application/json"}
+	w.WriteHeader(status)
+
+	if _, err := io.WriteString(w, "["); err != nil {
+		return &StreamError{Err: err}
+	}
+
+	err := streamItems(w, next, func(item interface{}, first bool) error {
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		data, err := json.Marshal(item)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	})
+	if err != nil {
+		return &StreamError{Err: err}
+	}
+
+	if _, err := io.WriteString(w, "]\n"); err != nil {
+		return &StreamError{Err: err}
+	}
+	return nil
+}
+
+func streamItems(w http.ResponseWriter, next itemProducer, write func(item interface{}, first bool) error) error {
+
+	flusher, _ := w.(http.Flusher)
+
+	for first := true; ; first = false {
+		item, err := next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if err := write(item, first); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
+func sliceItems(v interface{}) itemProducer {
+
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		value = reflect.ValueOf([]interface{}{v})
+	}
+
+	i := 0
+	return func() (interface{}, error) {
+		if i >= value.Len() {
+			return nil, io.EOF
+		}
+		i++
+		return value.Index(i - 1).Interface(), nil
+	}
+}
+
+type itemReader struct {
+	body    io.ReadCloser
+	decoder *json.Decoder
+}
+
+func newItemReader(body io.ReadCloser) *itemReader {
+	return &itemReader{body: body, decoder: json.NewDecoder(body)}
+}
+
+func (r *itemReader) Next(v interface{}) error {
+	return r.decoder.Decode(v)
+}
+
+func (r *itemReader) Close() error {
+	return r.body.Close()
+}
+
+const (
+	rangeHeader        string = "Range"
+	ifRangeHeader      string = "If-Range"
+	contentRangeHeader string = "Content-Range"
+	acceptRangesHeader string = "Accept-Ranges"
+)
+
+func serveRanges(w http.ResponseWriter, r *http.Request, content io.ReadSeeker, lastModified time.Time) {
+
+	http.ServeContent(w, r, "", lastModified, content)
+}
+
+type MimeFile struct {
+	Header  *multipart.FileHeader
+	Content io.ReadCloser
+}
+
+func extractUpload(fileID string, r *http.Request) (*MimeFile, error) {
+
+	if err := r.ParseMultipartForm(1024); err != nil {
+		return nil, err
+	}
+
+	file, header, err := r.FormFile(fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	mimeFile := MimeFile{
+		Header:  header,
+		Content: file,
+	}
+	return &mimeFile, nil
+}
+
+const maxUploadValuesSize int64 = 10 << 20
+
+var (
+	ErrUploadTooLarge = errors.New("upload too large")
+
+	ErrUnsupportedUploadContentType = errors.New("unsupported content type of upload")
+)
+
+type UploadLimits struct {
+	MaxFileSize int64
+
+	MaxTotalSize int64
+}
+
+func limitUploads(r *http.Request, limits UploadLimits) {
+
+	if limits.MaxTotalSize > 0 && r.Body != nil {
+		r.Body = &limitedBody{limitedReader: limitedReader{reader: r.Body, remaining: limits.MaxTotalSize}, closer: r.Body}
+	}
+}
+
+func extractUploads(fileID string, r *http.Request, limits UploadLimits, contentTypes []string) ([]MimeFile, error) {
+
+	if err := r.ParseMultipartForm(1024); err != nil {
+		if errors.Is(err, ErrUploadTooLarge) {
+			return nil, ErrUploadTooLarge
+		}
+		return nil, err
+	}
+
+	headers := r.MultipartForm.File[fileID]
+	files := make([]MimeFile, 0, len(headers))
+	for _, header := range headers {
+		if limits.MaxFileSize > 0 && header.Size > limits.MaxFileSize {
+			return nil, errors.Wrapf(ErrUploadTooLarge, "file '%s' of field '%s'", header.Filename, fileID)
+		}
+		if !acceptsUpload(contentTypes, header.Header.Get("Content-Type")) {
+			return nil, errors.Wrapf(ErrUnsupportedUploadContentType, "file '%s' of field '%s'", header.Filename, fileID)
+		}
+		file, err := header.Open()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, MimeFile{Header: header, Content: file})
+	}
+	return files, nil
+}
+
+func uploadErrorStatus(err error) int {
+
+	switch {
+	case errors.Is(err, ErrUploadTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, ErrUnsupportedUploadContentType):
+		return http.StatusUnsupportedMediaType
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+func writeUpload(writer *multipart.Writer, fieldName string, file *MimeFile) error {
+
+	fileName, contentType := fieldName, "application/octet-stream"
+	if file.Header != nil {
+		if file.Header.Filename != "" {
+			fileName = file.Header.Filename
+		}
+		if value := file.Header.Header.Get("Content-Type"); value != "" {
+			contentType = value
+		}
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, quoteEscaper.Replace(fieldName), quoteEscaper.Replace(fileName)))
+	header.Set("Content-Type", contentType)
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(part, file.Content)
+	return err
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+type UploadReader struct {
+	reader       *multipart.Reader
+	limits       UploadLimits
+	contentTypes []string
+	pending      *multipart.Part
+}
+
+type UploadPart struct {
+	FieldName   string
+	FileName    string
+	ContentType string
+	Header      textproto.MIMEHeader
+	reader      io.Reader
+}
+
+func (p *UploadPart) Read(b []byte) (int, error) {
+	return p.reader.Read(b)
+}
+
+func newUploadReader(r *http.Request, limits UploadLimits, contentTypes []string) (*UploadReader, error) {
+
+	limitUploads(r, limits)
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+	return &UploadReader{reader: reader, limits: limits, contentTypes: contentTypes}, nil
+}
+
+func (u *UploadReader) ReadValues() (url.Values, error) {
+
+	values := make(url.Values)
+	remaining := maxUploadValuesSize
+
+	for {
+		part, err := u.reader.NextPart()
+		if err == io.EOF {
+			return values, nil
+		}
+		if err != nil {
+			return nil, u.error(err)
+		}
+		if part.FileName() != "" {
+			u.pending = part
+			return values, nil
+		}
+
+		var value bytes.Buffer
+		n, err := io.CopyN(&value, part, remaining+1)
+		if err != nil && err != io.EOF {
+			return nil, u.error(err)
+		}
+		remaining -= n
+		if remaining < 0 {
+			return nil, errors.Wrap(ErrUploadTooLarge, "form values")
+		}
+		values.Add(part.FormName(), value.String())
+	}
+}
+
+func (u *UploadReader) Next() (*UploadPart, error) {
+
+	part := u.pending
+	u.pending = nil
+	if part == nil {
+		var err error
+		if part, err = u.reader.NextPart(); err != nil {
+			return nil, u.error(err)
+		}
+	}
+
+	contentType := part.Header.Get("Content-Type")
+	if part.FileName() != "" && !acceptsUpload(u.contentTypes, contentType) {
+		return nil, errors.Wrapf(ErrUnsupportedUploadContentType, "file '%s' of field '%s'", part.FileName(), part.FormName())
+	}
+
+	var reader io.Reader = part
+	if part.FileName() != "" && u.limits.MaxFileSize > 0 {
+		reader = &limitedReader{reader: part, remaining: u.limits.MaxFileSize}
+	}
+
+	return &UploadPart{
+		FieldName:   part.FormName(),
+		FileName:    part.FileName(),
+		ContentType: contentType,
+		Header:      part.Header,
+		reader:      reader,
+	}, nil
+}
+
+func (u *UploadReader) error(err error) error {
+
+	if errors.Is(err, ErrUploadTooLarge) {
+		return ErrUploadTooLarge
+	}
+	return err
+}
+
+func acceptsUpload(contentTypes []string, contentType string) bool {
+
+	if len(contentTypes) == 0 {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = "application/octet-stream"
+	}
+	for _, accepted := range contentTypes {
+		if strings.EqualFold(accepted, mediaType) {
+			return true
+		}
+	}
+	return false
+}
+
+type limitedBody struct {
+	limitedReader
+	closer io.Closer
+}
+
+func (b *limitedBody) Close() error {
+	return b.closer.Close()
+}
+
+type limitedReader struct {
+	reader    io.Reader
+	remaining int64
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+
+	if r.remaining < 0 {
+		return 0, ErrUploadTooLarge
+	}
+	if int64(len(p)) > r.remaining+1 {
+		p = p[:r.remaining+1]
+	}
+	n, err := r.reader.Read(p)
+	r.remaining -= int64(n)
+	if r.remaining < 0 {
+		return n + int(r.remaining), ErrUploadTooLarge
+	}
+	return n, err
+}
+
+type MessageValidator func(message interface{}) error
+
+const webSocketCloseTimeout = time.Second
+
+type WebSocketOpts struct {
+	CheckOrigin func(r *http.Request) bool
+
+	ReadBufferSize  int
+	WriteBufferSize int
+
+	HandshakeTimeout time.Duration
+}
+
+type webSocket struct {
+	w        http.ResponseWriter
+	r        *http.Request
+	opts     WebSocketOpts
+	conn     *websocket.Conn
+	started  bool
+	validate MessageValidator
+}
+
+func newWebSocket(w http.ResponseWriter, r *http.Request, opts WebSocketOpts, validate MessageValidator) *webSocket {
+	return &webSocket{w: w, r: r, opts: opts, validate: validate}
+}
+
+func dialWebSocket(client *http.Client, request *http.Request, validate MessageValidator) (*webSocket, *http.Response, error) {
+
+	var transport http.RoundTripper = http.DefaultTransport
+	dialer := &websocket.Dialer{Proxy: http.ProxyFromEnvironment}
+	if client != nil {
+		dialer.HandshakeTimeout = client.Timeout
+		dialer.Jar = client.Jar
+		if client.Transport != nil {
+			transport = client.Transport
+		}
+	}
+
+	if t, ok := transport.(*http.Transport); ok {
+		dialer.TLSClientConfig = t.TLSClientConfig.Clone()
+		dialer.Proxy = t.Proxy
+		dialer.NetDialContext = t.DialContext
+	}
+
+	u := *request.URL
+	u.Scheme = strings.Replace(u.Scheme, "http", "ws", 1)
+
+	conn, response, err := dialer.DialContext(request.Context(), u.String(), request.Header)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return &webSocket{conn: conn, started: true, validate: validate}, response, nil
+}
+
+func (s *webSocket) Upgrade() error {
+
+	if s.started {
+		if s.conn == nil {
+			return websocket.ErrBadHandshake
+		}
+		return nil
+	}
+	s.started = true
+
+	upgrader := &websocket.Upgrader{
+		CheckOrigin:      s.opts.CheckOrigin,
+		ReadBufferSize:   s.opts.ReadBufferSize,
+		WriteBufferSize:  s.opts.WriteBufferSize,
+		HandshakeTimeout: s.opts.HandshakeTimeout,
+	}
+	conn, err := upgrader.Upgrade(s.w, s.r, nil)
+	if err != nil {
+		return err
+	}
+	s.conn = conn
+
+	return nil
+}
+
+func (s *webSocket) Started() bool {
+	return s.started
+}
+
+func (s *webSocket) Read(message interface{}) error {
+
+	if err := s.Upgrade(); err != nil {
+		return err
+	}
+
+	if err := s.conn.ReadJSON(message); err != nil {
+		if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+			return io.EOF
+		}
+		return err
+	}
+
+	if s.validate != nil {
+		return s.validate(message)
+	}
+	return nil
+}
+
+func (s *webSocket) Write(message interface{}) error {
+
+	if s.validate != nil {
+		if err := s.validate(message); err != nil {
+			return err
+		}
+	}
+
+	if err := s.Upgrade(); err != nil {
+		return err
+	}
+	return s.conn.WriteJSON(message)
+}
+
+func (s *webSocket) Close() error {
+
+	if s.conn == nil {
+		return nil
+	}
+
+	message := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	if err := s.conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(webSocketCloseTimeout)); err != nil && err != websocket.ErrCloseSent {
+		s.conn.Close()
+		return err
+	}
+	return s.conn.Close()
+}
+
+type contextKey int
+
+const (
 	RequestHeaderKey contextKey = 1 + iota
 )
 
@@ -862,6 +2377,18 @@ func setRequestHeadersFromContext(httpContext HttpContext, header http.Header) e
 	}
 	return nil
 }
+func serveXml(w http.ResponseWriter, contentType string, status int, v interface{}) error {
+
+	w.Header()["Content-Type"] = []string{contentType}
+	w.WriteHeader(status)
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	if err := xml.NewEncoder(w).Encode(v); err != nil {
+		return err
+	}
+	return nil
+}
 
 type xHTTPError interface {
 	error
@@ -902,6 +2429,68 @@ func (e *HttpJsonError) Error() string {
 	return fmt.Sprintf("%s: %v", http.StatusText(e.statusCode), e.Message)
 }
 
+type HttpXmlError struct {
+	statusCode  int
+	ContentType string
+	Message     interface{}
+
+	Errors *ValidationErrorsObject
+}
+
+func newXmlValidationHTTPError(contentType string, errors *ValidationErrorsObject, body interface{}) xHTTPError {
+
+	data, err := json.Marshal(errors)
+	if err == nil {
+		err = json.Unmarshal(data, body)
+	}
+	if err != nil {
+		return newJsonHTTPError(http.StatusBadRequest, errors)
+	}
+	return &HttpXmlError{statusCode: http.StatusBadRequest, ContentType: contentType, Message: body, Errors: errors}
+}
+
+func (e *HttpXmlError) StatusCode() int {
+	return e.statusCode
+}
+
+func (e *HttpXmlError) Error() string {
+	if e.Errors != nil {
+		return fmt.Sprintf("%s: %s", http.StatusText(e.statusCode), e.Errors.Message)
+	}
+	return fmt.Sprintf("%s: %v", http.StatusText(e.statusCode), e.Message)
+}
+
+type HttpValidationError struct {
+	Errors *ValidationErrorsObject
+}
+
+func newValidationHTTPError(errors *ValidationErrorsObject) xHTTPError {
+	return &HttpValidationError{Errors: errors}
+}
+
+func (e *HttpValidationError) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+func (e *HttpValidationError) Error() string {
+	if e.Errors == nil {
+		return http.StatusText(http.StatusBadRequest)
+	}
+	return fmt.Sprintf("%s: %s", http.StatusText(http.StatusBadRequest), e.Errors.Message)
+}
+
+type StreamError struct {
+	Err error
+}
+
+func (e *StreamError) Error() string {
+	return fmt.Sprintf("error streaming response: %v", e.Err)
+}
+
+func (e *StreamError) Unwrap() error {
+	return e.Err
+}
+
 type PrometheusHandler struct {
 	counter   *prometheus.CounterVec
 	histogram *prometheus.HistogramVec
@@ -955,6 +2544,335 @@ func (h *PrometheusHandler) HandleRequest(path, method string, status int, durat
 	h.histogram.WithLabelValues(path).Observe(duration.Seconds())
 }
 
+type CircuitBreakerHandler struct {
+	state   *prometheus.GaugeVec
+	changes *prometheus.CounterVec
+}
+
+func NewCircuitBreakerHandler(namespace *string) *CircuitBreakerHandler {
+
+	stateName := "api_circuit_breaker_state"
+	changesName := "api_circuit_breaker_state_changes_total"
+
+	if namespace != nil {
+		stateName = fmt.Sprintf("%s_%s", *namespace, stateName)
+		changesName = fmt.Sprintf("%s_%s", *namespace, changesName)
+	}
+
+	state := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: stateName,
+		Help: "Current state of the circuits, the gauge of the current state is 1.",
+	}, []string{"circuit", "state"})
+
+	changes := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: changesName,
+		Help: "Total number of state changes of the circuits.",
+	}, []string{"circuit", "from", "to"})
+
+	if err := prometheus.Register(state); err != nil {
+		logrus.WithError(err).Warn("failed to register prometheus gauge")
+	}
+
+	if err := prometheus.Register(changes); err != nil {
+		logrus.WithError(err).Warn("failed to register prometheus counter")
+	}
+
+	return &CircuitBreakerHandler{
+		state:   state,
+		changes: changes,
+	}
+}
+
+func (h *CircuitBreakerHandler) HandleStateChange(circuit, from, to string) {
+
+	h.state.WithLabelValues(circuit, from).Set(0)
+	h.state.WithLabelValues(circuit, to).Set(1)
+	h.changes.WithLabelValues(circuit, from, to).Inc()
+}
+
+type ErrorRenderer func(w http.ResponseWriter, r *http.Request, err error, recovered interface{})
+
+type ErrorReporter func(r *http.Request, err error, recovered interface{})
+
+type PanicError struct {
+	Recovered interface{}
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("recovered from panic: %v", e.Recovered)
+}
+
+func (server *Server) renderError(w http.ResponseWriter, r *http.Request, err error, recovered interface{}) {
+
+	if server.errorReporter != nil {
+		server.errorReporter(r, err, recovered)
+	}
+
+	var streamErr *StreamError
+	if errors.As(err, &streamErr) {
+		server.ErrorLogger(fmt.Sprintf("aborted streamed response: %v", streamErr.Err))
+		panic(http.ErrAbortHandler)
+	}
+
+	switch {
+	case server.errorRenderer != nil:
+		server.errorRenderer(w, r, err, recovered)
+	case server.problemDetails:
+		server.writeProblem(w, r, err)
+	default:
+		server.writeError(w, r, err)
+	}
+}
+
+func (server *Server) writeError(w http.ResponseWriter, r *http.Request, err error) {
+
+	switch errType := err.(type) {
+	case *HttpJsonError:
+		w.Header()["Content-Type"] = []string{"application/json"}
+		w.WriteHeader(errType.StatusCode())
+		if e := json.NewEncoder(w).Encode(errType.Message); e != nil {
+			server.ErrorLogger(fmt.Sprintf("failed to write error message: %v", errType.Message))
+		}
+	case *HttpXmlError:
+		w.Header()["Content-Type"] = []string{errType.ContentType}
+		w.WriteHeader(errType.StatusCode())
+		if _, e := io.WriteString(w, xml.Header); e != nil {
+			server.ErrorLogger(fmt.Sprintf("failed to write error message: %v", errType.Message))
+		} else if e := xml.NewEncoder(w).Encode(errType.Message); e != nil {
+			server.ErrorLogger(fmt.Sprintf("failed to write error message: %v", errType.Message))
+		}
+	case *httpCodeError, *HttpValidationError:
+		w.Header()["Content-Type"] = []string{""}
+		w.WriteHeader(errType.(xHTTPError).StatusCode())
+	case *PanicError:
+		w.WriteHeader(http.StatusInternalServerError)
+	case interface{ StatusCode() int }:
+		w.Header()["Content-Type"] = []string{"text/plain; charset=utf-8"}
+		w.WriteHeader(errType.StatusCode())
+		if _, e := w.Write([]byte(err.Error())); e != nil {
+			server.ErrorLogger(fmt.Sprintf("failed to write error message: %v", err.Error()))
+		}
+	default:
+		server.ErrorLogger(fmt.Sprintf("unexpected error: %v", err))
+		w.Header()["Content-Type"] = []string{"text/plain; charset=utf-8"}
+		w.WriteHeader(http.StatusInternalServerError)
+		if _, e := w.Write([]byte(http.StatusText(http.StatusInternalServerError))); e != nil {
+			server.ErrorLogger(fmt.Sprintf("failed to write error message: %v", err.Error()))
+		}
+	}
+}
+
+const (
+	contentTypeApplicationProblemJson = "application/problem+json"
+	requestIdHeader                   = "X-Request-ID"
+)
+
+type Problem struct {
+	Type     string
+	Title    string
+	Status   int
+	Detail   string
+	Instance string
+
+	Extensions map[string]interface{}
+}
+
+type ProblemExtension func(r *http.Request, err error, problem *Problem)
+
+func (p *Problem) MarshalJSON() ([]byte, error) {
+
+	members := make(map[string]interface{}, len(p.Extensions)+5)
+	for key, value := range p.Extensions {
+		members[key] = value
+	}
+
+	members["type"] = p.Type
+	members["title"] = p.Title
+	members["status"] = p.Status
+	if p.Detail != "" {
+		members["detail"] = p.Detail
+	}
+	if p.Instance != "" {
+		members["instance"] = p.Instance
+	}
+
+	return json.Marshal(members)
+}
+
+func NewProblem(r *http.Request, err error) *Problem {
+
+	problem := &Problem{
+		Type:       "about:blank",
+		Status:     http.StatusInternalServerError,
+		Instance:   r.Header.Get(requestIdHeader),
+		Extensions: make(map[string]interface{}),
+	}
+
+	if statusErr, ok := err.(interface{ StatusCode() int }); ok {
+		problem.Status = statusErr.StatusCode()
+		problem.Detail = err.Error()
+	}
+
+	problem.Title = http.StatusText(problem.Status)
+	if problem.Detail == problem.Title {
+		problem.Detail = ""
+	}
+
+	if validationErr, ok := err.(*HttpValidationError); ok && validationErr.Errors != nil {
+		problem.Detail = validationErr.Errors.Message
+		problem.Extensions["errors"] = validationErr.Errors.Errors
+	}
+
+	if xmlErr, ok := err.(*HttpXmlError); ok && xmlErr.Errors != nil {
+		problem.Detail = xmlErr.Errors.Message
+		problem.Extensions["errors"] = xmlErr.Errors.Errors
+	}
+
+	if jsonErr, ok := err.(*HttpJsonError); ok {
+		problem.Detail = ""
+		switch message := jsonErr.Message.(type) {
+		case *ValidationErrorsObject:
+			problem.Detail = message.Message
+			problem.Extensions["errors"] = message.Errors
+		case string:
+			problem.Detail = message
+		default:
+			problem.Extensions["message"] = message
+		}
+	}
+
+	return problem
+}
+
+func (server *Server) writeProblem(w http.ResponseWriter, r *http.Request, err error) {
+
+	problem := NewProblem(r, err)
+	if server.problemExtension != nil {
+		server.problemExtension(r, err, problem)
+	}
+
+	w.Header()["Content-Type"] = []string{contentTypeApplicationProblemJson}
+	w.WriteHeader(problem.Status)
+	if e := json.NewEncoder(w).Encode(problem); e != nil {
+		server.ErrorLogger("failed to write problem: " + e.Error())
+	}
+}
+
+type Router interface {
+	http.Handler
+
+	Handle(method, path string, handler http.Handler)
+
+	PathParam(r *http.Request, name string) string
+
+	HandleNotFound(handler http.Handler)
+
+	HandleMethodNotAllowed(handler http.Handler)
+}
+
+type ozzoContextKey struct{}
+
+var ozzoPathItem = regexp.MustCompile(`\{([^}]*)\}`)
+
+type OzzoRouter struct {
+	*routing.Router
+	notFound         http.Handler
+	methodNotAllowed http.Handler
+}
+
+func NewOzzoRouter() *OzzoRouter {
+
+	router := routing.New()
+	router.UseEscapedPath = true
+
+	return &OzzoRouter{Router: router, notFound: http.NotFoundHandler(), methodNotAllowed: http.HandlerFunc(methodNotAllowed)}
+}
+
+func (router *OzzoRouter) Handle(method, path string, handler http.Handler) {
+
+	router.To(method, ozzoPathItem.ReplaceAllString(path, "<$1>"), func(c *routing.Context) error {
+		ctx := context.WithValue(c.Request.Context(), ozzoContextKey{}, c)
+		handler.ServeHTTP(c.Response, c.Request.WithContext(ctx))
+		c.Abort()
+		return nil
+	})
+}
+
+func (router *OzzoRouter) PathParam(r *http.Request, name string) string {
+
+	c, ok := r.Context().Value(ozzoContextKey{}).(*routing.Context)
+	if !ok {
+		return ""
+	}
+	return c.Param(name)
+}
+
+func (router *OzzoRouter) HandleNotFound(handler http.Handler) {
+
+	router.notFound = handler
+	router.Router.NotFound(router.handleRoutingError)
+}
+
+func (router *OzzoRouter) HandleMethodNotAllowed(handler http.Handler) {
+
+	router.methodNotAllowed = handler
+	router.Router.NotFound(router.handleRoutingError)
+}
+
+func (router *OzzoRouter) handleRoutingError(c *routing.Context) error {
+
+	response := c.Response
+	recorder := &statusRecorder{header: make(http.Header)}
+	c.Response = recorder
+	err := routing.MethodNotAllowedHandler(c)
+	c.Response = response
+	if err != nil {
+		return err
+	}
+
+	allow := recorder.header.Get("Allow")
+	if allow != "" {
+		response.Header().Set("Allow", allow)
+	}
+
+	switch {
+	case recorder.status == http.StatusMethodNotAllowed:
+		router.methodNotAllowed.ServeHTTP(response, c.Request)
+	case allow == "":
+		router.notFound.ServeHTTP(response, c.Request)
+	}
+
+	c.Abort()
+	return nil
+}
+
+func methodNotAllowed(w http.ResponseWriter, r *http.Request) {
+
+	http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+}
+
+type statusRecorder struct {
+	header http.Header
+	status int
+}
+
+func (r *statusRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return len(b), nil
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
+
 type (
 	Timeouts struct {
 		ReadTimeout       time.Duration
@@ -967,18 +2885,33 @@ type (
 		Timeouts
 		ErrorHandler ErrorHandler
 		Middleware   []Middleware
-		OnStart      func(router *routing.Router)
-		Prefix       string
+
+		Router  Router
+		OnStart func(router Router)
+		Prefix  string
+
+		ProblemDetails bool
+
+		ProblemExtension ProblemExtension
+
+		ErrorRenderer ErrorRenderer
+
+		ErrorReporter ErrorReporter
+
+		UploadLimits UploadLimits
+
+		WebSockets WebSocketOpts
 	}
 
 	Middleware struct {
-		Handler routing.Handler
-		After   bool
+		Handler func(next http.Handler) http.Handler
 	}
 
+	HandlerFunc func(w http.ResponseWriter, r *http.Request) error
+
 	RouteDescription struct {
 		Path       string
-		Handler    routing.Handler
+		Handler    HandlerFunc
 		Middleware []Middleware
 		Method     string
 	}
@@ -986,13 +2919,21 @@ type (
 	Server struct {
 		Timeouts
 		ErrorLogger func(v ...interface{})
-		OnStart     func(router *routing.Router)
+		OnStart     func(router Router)
 		server      *http.Server
-		Router      *routing.Router
-		after       []routing.Handler
-		before      []routing.Handler
+		Router      Router
+		middleware  []Middleware
 		SwaggerSpec string
 		Prefix      string
+
+		UploadLimits UploadLimits
+
+		WebSockets WebSocketOpts
+
+		problemDetails   bool
+		problemExtension ProblemExtension
+		errorRenderer    ErrorRenderer
+		errorReporter    ErrorReporter
 	}
 )
 
@@ -1003,6 +2944,7 @@ func newServer(opts *ServerOpts) *Server {
 	if opts == nil {
 		return &Server{
 			ErrorLogger: func(v ...interface{}) {},
+			Router:      NewOzzoRouter(),
 		}
 	}
 
@@ -1012,9 +2954,14 @@ func newServer(opts *ServerOpts) *Server {
 
 	server := &Server{
 		ErrorLogger: opts.ErrorHandler,
+		Router:      opts.Router,
 		Prefix:      "",
 	}
 
+	if server.Router == nil {
+		server.Router = NewOzzoRouter()
+	}
+
 	if opts.OnStart != nil {
 		server.OnStart = opts.OnStart
 	}
@@ -1024,21 +2971,16 @@ func newServer(opts *ServerOpts) *Server {
 	}
 
 	if len(opts.Middleware) != 0 {
-		before := make([]routing.Handler, 0)
-		after := make([]routing.Handler, 0)
-
-		for _, m := range opts.Middleware {
-			if m.After {
-				after = append(after, m.Handler)
-			} else {
-				before = append(before, m.Handler)
-			}
-		}
-
-		server.after = after
-		server.before = before
+		server.middleware = opts.Middleware
 	}
 
+	server.problemDetails = opts.ProblemDetails
+	server.problemExtension = opts.ProblemExtension
+	server.errorRenderer = opts.ErrorRenderer
+	server.errorReporter = opts.ErrorReporter
+	server.UploadLimits = opts.UploadLimits
+	server.WebSockets = opts.WebSockets
+
 	server.ReadTimeout = opts.ReadTimeout
 	server.ReadHeaderTimeout = opts.ReadHeaderTimeout
 	server.WriteTimeout = opts.WriteTimeout
@@ -1047,65 +2989,62 @@ func newServer(opts *ServerOpts) *Server {
 	return server
 }
 
-func (server *Server) makeRouter(routes []RouteDescription) (*routing.Router, error) {
+func (server *Server) PathParam(r *http.Request, name string) string {
 
-	router := routing.New()
-	router.UseEscapedPath = true
+	return server.Router.PathParam(r, name)
+}
+
+func (server *Server) makeRouter(routes []RouteDescription) (Router, error) {
+
+	router := server.Router
 
 	logError := func(format string, a ...interface{}) {
 		msg := fmt.Sprintf(format, a...)
 		server.ErrorLogger(msg)
 	}
 
-	var beforeStack []routing.Handler
-	beforeStack = append(beforeStack, errorHandler(logError))
-	if server.before != nil {
-		beforeStack = append(beforeStack, server.before...)
-	}
-
 	prefix := server.Prefix
 
 	if prefix == "/" {
 		prefix = ""
 	}
 
-	rg := router.Group(prefix)
+	spec := func(w http.ResponseWriter, r *http.Request) error {
+		_, err := io.WriteString(w, server.SwaggerSpec)
+		return err
+	}
+	router.Handle(http.MethodGet, prefix+"/spec", server.makeHandler(spec, nil, logError))
 
-	rg.Use(beforeStack...)
+	router.HandleNotFound(server.makeHandler(routingError(http.StatusNotFound), nil, logError))
+	router.HandleMethodNotAllowed(server.makeHandler(routingError(http.StatusMethodNotAllowed), nil, logError))
 
-	var afterStack []routing.Handler
-	if server.after != nil {
-		afterStack = append(afterStack, server.after...)
+	for _, route := range routes {
+		router.Handle(route.Method, prefix+route.Path, server.makeHandler(route.Handler, route.Middleware, logError))
 	}
 
-	rg.Get("/spec", func(c *routing.Context) error {
-		return c.Write(server.SwaggerSpec)
-	})
+	return router, nil
+}
 
-	for _, route := range routes {
+func routingError(status int) HandlerFunc {
 
-		var before, after []routing.Handler
+	return func(w http.ResponseWriter, r *http.Request) error {
+		return NewHTTPStatusCodeError(status)
+	}
+}
 
-		if route.Middleware != nil {
-			for _, m := range route.Middleware {
-				if m.After {
-					after = append(after, m.Handler)
-				} else {
-					before = append(before, m.Handler)
-				}
-			}
-		}
+func (server *Server) makeHandler(handler HandlerFunc, middleware []Middleware, logf fault.LogFunc) http.Handler {
 
-		var handler []routing.Handler
-		handler = append(handler, before...)
-		handler = append(handler, route.Handler)
-		handler = append(handler, after...)
-		handler = append(handler, afterStack...)
+	var h http.Handler = server.errorHandler(handler)
 
-		rg.To(route.Method, route.Path, handler...)
+	for i := len(middleware) - 1; i >= 0; i-- {
+		h = middleware[i].Handler(h)
 	}
 
-	return router, nil
+	for i := len(server.middleware) - 1; i >= 0; i-- {
+		h = server.middleware[i].Handler(h)
+	}
+
+	return server.recoverHandler(h, logf)
 }
 
 func (server *Server) Start(port int, routes []RouteDescription) error {
@@ -1117,7 +3056,6 @@ func (server *Server) Start(port int, routes []RouteDescription) error {
 	if server.OnStart != nil {
 		server.OnStart(router)
 	}
-	server.Router = router
 
 	httpServer := &http.Server{
 		ReadTimeout:       server.ReadTimeout,
@@ -1135,51 +3073,38 @@ func (server *Server) Start(port int, routes []RouteDescription) error {
 func (server *Server) Stop() error {
 
 	if server.server != nil {
-		deadline, _ := context.WithTimeout(context.TODO(), 30*time.Second)
+		deadline, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
+		defer cancel()
 		return server.server.Shutdown(deadline)
 	}
 
 	return nil
 }
 
-func errorHandler(logf fault.LogFunc) func(c *routing.Context) (err error) {
-	return func(c *routing.Context) (err error) {
+func (server *Server) recoverHandler(next http.Handler, logf fault.LogFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		defer func() {
-
 			if e := recover(); e != nil {
 
+				if e == http.ErrAbortHandler {
+					panic(e)
+				}
 				if logf != nil {
 					logf("recovered from panic: %v", string(debug.Stack()))
 				}
-				c.Response.WriteHeader(http.StatusInternalServerError)
-				err = nil
-				c.Abort()
-
-			} else if err != nil {
-
-				switch errType := err.(type) {
-				case *HttpJsonError:
-					c.Response.Header()["Content-Type"] = []string{"application/json"}
-					c.Response.WriteHeader(errType.StatusCode())
-					if e := json.NewEncoder(c.Response).Encode(errType.Message); e != nil && logf != nil {
-						logf("failed to write error message: %v", errType.Message)
-					}
-				case *httpCodeError:
-					c.Response.Header()["Content-Type"] = []string{""}
-					c.Response.WriteHeader(errType.StatusCode())
-				case routing.HTTPError:
-					c.Response.Header()["Content-Type"] = []string{"text/plain; charset=utf-8"}
-					c.Response.WriteHeader(errType.StatusCode())
-					if _, e := c.Response.Write([]byte(errType.Error())); e != nil {
-						logf("failed to write error message: %v", errType.Error())
-					}
-				}
-
-				err = nil
-				c.Abort()
+				server.renderError(w, r, &PanicError{Recovered: e}, e)
 			}
 		}()
 
-		return c.Next()
-	}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (server *Server) errorHandler(handler HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		if err := handler(w, r); err != nil {
+			server.renderError(w, r, err, nil)
+		}
+	})
 }
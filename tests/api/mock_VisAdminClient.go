@@ -2,20 +2,24 @@
 
 package api
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockVisAdminClient is an autogenerated mock type for the VisAdminClient type
 type MockVisAdminClient struct {
 	mock.Mock
 }
 
-// ActivateViewsSet provides a mock function with given fields: request
-func (_m *MockVisAdminClient) ActivateViewsSet(request *ActivateViewsSetRequest) (ActivateViewsSetResponse, error) {
-	ret := _m.Called(request)
+// ActivateViewsSet provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) ActivateViewsSet(ctx context.Context, request *ActivateViewsSetRequest) (ActivateViewsSetResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 ActivateViewsSetResponse
-	if rf, ok := ret.Get(0).(func(*ActivateViewsSetRequest) ActivateViewsSetResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *ActivateViewsSetRequest) ActivateViewsSetResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(ActivateViewsSetResponse)
@@ -23,8 +27,8 @@ func (_m *MockVisAdminClient) ActivateViewsSet(request *ActivateViewsSetRequest)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*ActivateViewsSetRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *ActivateViewsSetRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -32,13 +36,36 @@ func (_m *MockVisAdminClient) ActivateViewsSet(request *ActivateViewsSetRequest)
 	return r0, r1
 }
 
-// Code provides a mock function with given fields: request
-func (_m *MockVisAdminClient) Code(request *CodeRequest) (CodeResponse, error) {
-	ret := _m.Called(request)
+// ChatWithPartner provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) ChatWithPartner(ctx context.Context, request *ChatWithPartnerRequest) (ChatWithPartnerResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 ChatWithPartnerResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *ChatWithPartnerRequest) ChatWithPartnerResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ChatWithPartnerResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ChatWithPartnerRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Code provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) Code(ctx context.Context, request *CodeRequest) (CodeResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 CodeResponse
-	if rf, ok := ret.Get(0).(func(*CodeRequest) CodeResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *CodeRequest) CodeResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(CodeResponse)
@@ -46,8 +73,8 @@ func (_m *MockVisAdminClient) Code(request *CodeRequest) (CodeResponse, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*CodeRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *CodeRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -55,13 +82,13 @@ func (_m *MockVisAdminClient) Code(request *CodeRequest) (CodeResponse, error) {
 	return r0, r1
 }
 
-// CreateCustomerSession provides a mock function with given fields: request
-func (_m *MockVisAdminClient) CreateCustomerSession(request *CreateCustomerSessionRequest) (CreateCustomerSessionResponse, error) {
-	ret := _m.Called(request)
+// CreateCustomerSession provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) CreateCustomerSession(ctx context.Context, request *CreateCustomerSessionRequest) (CreateCustomerSessionResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 CreateCustomerSessionResponse
-	if rf, ok := ret.Get(0).(func(*CreateCustomerSessionRequest) CreateCustomerSessionResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *CreateCustomerSessionRequest) CreateCustomerSessionResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(CreateCustomerSessionResponse)
@@ -69,8 +96,8 @@ func (_m *MockVisAdminClient) CreateCustomerSession(request *CreateCustomerSessi
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*CreateCustomerSessionRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *CreateCustomerSessionRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -78,13 +105,13 @@ func (_m *MockVisAdminClient) CreateCustomerSession(request *CreateCustomerSessi
 	return r0, r1
 }
 
-// CreateOrUpdateClient provides a mock function with given fields: request
-func (_m *MockVisAdminClient) CreateOrUpdateClient(request *CreateOrUpdateClientRequest) (CreateOrUpdateClientResponse, error) {
-	ret := _m.Called(request)
+// CreateOrUpdateClient provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) CreateOrUpdateClient(ctx context.Context, request *CreateOrUpdateClientRequest) (CreateOrUpdateClientResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 CreateOrUpdateClientResponse
-	if rf, ok := ret.Get(0).(func(*CreateOrUpdateClientRequest) CreateOrUpdateClientResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *CreateOrUpdateClientRequest) CreateOrUpdateClientResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(CreateOrUpdateClientResponse)
@@ -92,8 +119,8 @@ func (_m *MockVisAdminClient) CreateOrUpdateClient(request *CreateOrUpdateClient
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*CreateOrUpdateClientRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *CreateOrUpdateClientRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -101,13 +128,13 @@ func (_m *MockVisAdminClient) CreateOrUpdateClient(request *CreateOrUpdateClient
 	return r0, r1
 }
 
-// CreateOrUpdateUser provides a mock function with given fields: request
-func (_m *MockVisAdminClient) CreateOrUpdateUser(request *CreateOrUpdateUserRequest) (CreateOrUpdateUserResponse, error) {
-	ret := _m.Called(request)
+// CreateOrUpdateUser provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) CreateOrUpdateUser(ctx context.Context, request *CreateOrUpdateUserRequest) (CreateOrUpdateUserResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 CreateOrUpdateUserResponse
-	if rf, ok := ret.Get(0).(func(*CreateOrUpdateUserRequest) CreateOrUpdateUserResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *CreateOrUpdateUserRequest) CreateOrUpdateUserResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(CreateOrUpdateUserResponse)
@@ -115,8 +142,8 @@ func (_m *MockVisAdminClient) CreateOrUpdateUser(request *CreateOrUpdateUserRequ
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*CreateOrUpdateUserRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *CreateOrUpdateUserRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -124,13 +151,13 @@ func (_m *MockVisAdminClient) CreateOrUpdateUser(request *CreateOrUpdateUserRequ
 	return r0, r1
 }
 
-// CreateOrUpdateViewsSet provides a mock function with given fields: request
-func (_m *MockVisAdminClient) CreateOrUpdateViewsSet(request *CreateOrUpdateViewsSetRequest) (CreateOrUpdateViewsSetResponse, error) {
-	ret := _m.Called(request)
+// CreateOrUpdateViewsSet provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) CreateOrUpdateViewsSet(ctx context.Context, request *CreateOrUpdateViewsSetRequest) (CreateOrUpdateViewsSetResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 CreateOrUpdateViewsSetResponse
-	if rf, ok := ret.Get(0).(func(*CreateOrUpdateViewsSetRequest) CreateOrUpdateViewsSetResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *CreateOrUpdateViewsSetRequest) CreateOrUpdateViewsSetResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(CreateOrUpdateViewsSetResponse)
@@ -138,8 +165,31 @@ func (_m *MockVisAdminClient) CreateOrUpdateViewsSet(request *CreateOrUpdateView
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*CreateOrUpdateViewsSetRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *CreateOrUpdateViewsSetRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreatePartner provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) CreatePartner(ctx context.Context, request *CreatePartnerRequest) (CreatePartnerResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 CreatePartnerResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *CreatePartnerRequest) CreatePartnerResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(CreatePartnerResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *CreatePartnerRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -147,13 +197,13 @@ func (_m *MockVisAdminClient) CreateOrUpdateViewsSet(request *CreateOrUpdateView
 	return r0, r1
 }
 
-// CreateSession provides a mock function with given fields: request
-func (_m *MockVisAdminClient) CreateSession(request *CreateSessionRequest) (CreateSessionResponse, error) {
-	ret := _m.Called(request)
+// CreateSession provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) CreateSession(ctx context.Context, request *CreateSessionRequest) (CreateSessionResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 CreateSessionResponse
-	if rf, ok := ret.Get(0).(func(*CreateSessionRequest) CreateSessionResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *CreateSessionRequest) CreateSessionResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(CreateSessionResponse)
@@ -161,8 +211,8 @@ func (_m *MockVisAdminClient) CreateSession(request *CreateSessionRequest) (Crea
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*CreateSessionRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *CreateSessionRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -170,13 +220,13 @@ func (_m *MockVisAdminClient) CreateSession(request *CreateSessionRequest) (Crea
 	return r0, r1
 }
 
-// DeleteClient provides a mock function with given fields: request
-func (_m *MockVisAdminClient) DeleteClient(request *DeleteClientRequest) (DeleteClientResponse, error) {
-	ret := _m.Called(request)
+// DeleteClient provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) DeleteClient(ctx context.Context, request *DeleteClientRequest) (DeleteClientResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 DeleteClientResponse
-	if rf, ok := ret.Get(0).(func(*DeleteClientRequest) DeleteClientResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *DeleteClientRequest) DeleteClientResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(DeleteClientResponse)
@@ -184,8 +234,8 @@ func (_m *MockVisAdminClient) DeleteClient(request *DeleteClientRequest) (Delete
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*DeleteClientRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *DeleteClientRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -193,13 +243,13 @@ func (_m *MockVisAdminClient) DeleteClient(request *DeleteClientRequest) (Delete
 	return r0, r1
 }
 
-// DeleteCustomerSession provides a mock function with given fields: request
-func (_m *MockVisAdminClient) DeleteCustomerSession(request *DeleteCustomerSessionRequest) (DeleteCustomerSessionResponse, error) {
-	ret := _m.Called(request)
+// DeleteCustomerSession provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) DeleteCustomerSession(ctx context.Context, request *DeleteCustomerSessionRequest) (DeleteCustomerSessionResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 DeleteCustomerSessionResponse
-	if rf, ok := ret.Get(0).(func(*DeleteCustomerSessionRequest) DeleteCustomerSessionResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *DeleteCustomerSessionRequest) DeleteCustomerSessionResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(DeleteCustomerSessionResponse)
@@ -207,8 +257,8 @@ func (_m *MockVisAdminClient) DeleteCustomerSession(request *DeleteCustomerSessi
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*DeleteCustomerSessionRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *DeleteCustomerSessionRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -216,13 +266,13 @@ func (_m *MockVisAdminClient) DeleteCustomerSession(request *DeleteCustomerSessi
 	return r0, r1
 }
 
-// DeleteUser provides a mock function with given fields: request
-func (_m *MockVisAdminClient) DeleteUser(request *DeleteUserRequest) (DeleteUserResponse, error) {
-	ret := _m.Called(request)
+// DeleteUser provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) DeleteUser(ctx context.Context, request *DeleteUserRequest) (DeleteUserResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 DeleteUserResponse
-	if rf, ok := ret.Get(0).(func(*DeleteUserRequest) DeleteUserResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *DeleteUserRequest) DeleteUserResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(DeleteUserResponse)
@@ -230,8 +280,8 @@ func (_m *MockVisAdminClient) DeleteUser(request *DeleteUserRequest) (DeleteUser
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*DeleteUserRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *DeleteUserRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -239,13 +289,13 @@ func (_m *MockVisAdminClient) DeleteUser(request *DeleteUserRequest) (DeleteUser
 	return r0, r1
 }
 
-// DeleteViewsSet provides a mock function with given fields: request
-func (_m *MockVisAdminClient) DeleteViewsSet(request *DeleteViewsSetRequest) (DeleteViewsSetResponse, error) {
-	ret := _m.Called(request)
+// DeleteViewsSet provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) DeleteViewsSet(ctx context.Context, request *DeleteViewsSetRequest) (DeleteViewsSetResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 DeleteViewsSetResponse
-	if rf, ok := ret.Get(0).(func(*DeleteViewsSetRequest) DeleteViewsSetResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *DeleteViewsSetRequest) DeleteViewsSetResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(DeleteViewsSetResponse)
@@ -253,8 +303,8 @@ func (_m *MockVisAdminClient) DeleteViewsSet(request *DeleteViewsSetRequest) (De
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*DeleteViewsSetRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *DeleteViewsSetRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -262,13 +312,13 @@ func (_m *MockVisAdminClient) DeleteViewsSet(request *DeleteViewsSetRequest) (De
 	return r0, r1
 }
 
-// DestroySession provides a mock function with given fields: request
-func (_m *MockVisAdminClient) DestroySession(request *DestroySessionRequest) (DestroySessionResponse, error) {
-	ret := _m.Called(request)
+// DestroySession provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) DestroySession(ctx context.Context, request *DestroySessionRequest) (DestroySessionResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 DestroySessionResponse
-	if rf, ok := ret.Get(0).(func(*DestroySessionRequest) DestroySessionResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *DestroySessionRequest) DestroySessionResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(DestroySessionResponse)
@@ -276,8 +326,8 @@ func (_m *MockVisAdminClient) DestroySession(request *DestroySessionRequest) (De
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*DestroySessionRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *DestroySessionRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -285,13 +335,13 @@ func (_m *MockVisAdminClient) DestroySession(request *DestroySessionRequest) (De
 	return r0, r1
 }
 
-// DownloadFile provides a mock function with given fields: request
-func (_m *MockVisAdminClient) DownloadFile(request *DownloadFileRequest) (DownloadFileResponse, error) {
-	ret := _m.Called(request)
+// DownloadFile provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) DownloadFile(ctx context.Context, request *DownloadFileRequest) (DownloadFileResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 DownloadFileResponse
-	if rf, ok := ret.Get(0).(func(*DownloadFileRequest) DownloadFileResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *DownloadFileRequest) DownloadFileResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(DownloadFileResponse)
@@ -299,8 +349,8 @@ func (_m *MockVisAdminClient) DownloadFile(request *DownloadFileRequest) (Downlo
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*DownloadFileRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *DownloadFileRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -308,13 +358,13 @@ func (_m *MockVisAdminClient) DownloadFile(request *DownloadFileRequest) (Downlo
 	return r0, r1
 }
 
-// DownloadImage provides a mock function with given fields: request
-func (_m *MockVisAdminClient) DownloadImage(request *DownloadImageRequest) (DownloadImageResponse, error) {
-	ret := _m.Called(request)
+// DownloadImage provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) DownloadImage(ctx context.Context, request *DownloadImageRequest) (DownloadImageResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 DownloadImageResponse
-	if rf, ok := ret.Get(0).(func(*DownloadImageRequest) DownloadImageResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *DownloadImageRequest) DownloadImageResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(DownloadImageResponse)
@@ -322,8 +372,8 @@ func (_m *MockVisAdminClient) DownloadImage(request *DownloadImageRequest) (Down
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*DownloadImageRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *DownloadImageRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -331,13 +381,13 @@ func (_m *MockVisAdminClient) DownloadImage(request *DownloadImageRequest) (Down
 	return r0, r1
 }
 
-// DownloadNestedFile provides a mock function with given fields: request
-func (_m *MockVisAdminClient) DownloadNestedFile(request *DownloadNestedFileRequest) (DownloadNestedFileResponse, error) {
-	ret := _m.Called(request)
+// DownloadNestedFile provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) DownloadNestedFile(ctx context.Context, request *DownloadNestedFileRequest) (DownloadNestedFileResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 DownloadNestedFileResponse
-	if rf, ok := ret.Get(0).(func(*DownloadNestedFileRequest) DownloadNestedFileResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *DownloadNestedFileRequest) DownloadNestedFileResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(DownloadNestedFileResponse)
@@ -345,8 +395,8 @@ func (_m *MockVisAdminClient) DownloadNestedFile(request *DownloadNestedFileRequ
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*DownloadNestedFileRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *DownloadNestedFileRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -354,13 +404,36 @@ func (_m *MockVisAdminClient) DownloadNestedFile(request *DownloadNestedFileRequ
 	return r0, r1
 }
 
-// FileUpload provides a mock function with given fields: request
-func (_m *MockVisAdminClient) FileUpload(request *FileUploadRequest) (FileUploadResponse, error) {
-	ret := _m.Called(request)
+// DownloadPartnerContract provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) DownloadPartnerContract(ctx context.Context, request *DownloadPartnerContractRequest) (DownloadPartnerContractResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 DownloadPartnerContractResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *DownloadPartnerContractRequest) DownloadPartnerContractResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(DownloadPartnerContractResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *DownloadPartnerContractRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FileUpload provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) FileUpload(ctx context.Context, request *FileUploadRequest) (FileUploadResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 FileUploadResponse
-	if rf, ok := ret.Get(0).(func(*FileUploadRequest) FileUploadResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *FileUploadRequest) FileUploadResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(FileUploadResponse)
@@ -368,8 +441,8 @@ func (_m *MockVisAdminClient) FileUpload(request *FileUploadRequest) (FileUpload
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*FileUploadRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *FileUploadRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -377,13 +450,13 @@ func (_m *MockVisAdminClient) FileUpload(request *FileUploadRequest) (FileUpload
 	return r0, r1
 }
 
-// FindByTags provides a mock function with given fields: request
-func (_m *MockVisAdminClient) FindByTags(request *FindByTagsRequest) (FindByTagsResponse, error) {
-	ret := _m.Called(request)
+// FindByTags provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) FindByTags(ctx context.Context, request *FindByTagsRequest) (FindByTagsResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 FindByTagsResponse
-	if rf, ok := ret.Get(0).(func(*FindByTagsRequest) FindByTagsResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *FindByTagsRequest) FindByTagsResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(FindByTagsResponse)
@@ -391,8 +464,8 @@ func (_m *MockVisAdminClient) FindByTags(request *FindByTagsRequest) (FindByTags
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*FindByTagsRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *FindByTagsRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -400,13 +473,13 @@ func (_m *MockVisAdminClient) FindByTags(request *FindByTagsRequest) (FindByTags
 	return r0, r1
 }
 
-// GenericFileDownload provides a mock function with given fields: request
-func (_m *MockVisAdminClient) GenericFileDownload(request *GenericFileDownloadRequest) (GenericFileDownloadResponse, error) {
-	ret := _m.Called(request)
+// GenericFileDownload provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) GenericFileDownload(ctx context.Context, request *GenericFileDownloadRequest) (GenericFileDownloadResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 GenericFileDownloadResponse
-	if rf, ok := ret.Get(0).(func(*GenericFileDownloadRequest) GenericFileDownloadResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *GenericFileDownloadRequest) GenericFileDownloadResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(GenericFileDownloadResponse)
@@ -414,8 +487,8 @@ func (_m *MockVisAdminClient) GenericFileDownload(request *GenericFileDownloadRe
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*GenericFileDownloadRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *GenericFileDownloadRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -423,13 +496,13 @@ func (_m *MockVisAdminClient) GenericFileDownload(request *GenericFileDownloadRe
 	return r0, r1
 }
 
-// GetBooking provides a mock function with given fields: request
-func (_m *MockVisAdminClient) GetBooking(request *GetBookingRequest) (GetBookingResponse, error) {
-	ret := _m.Called(request)
+// GetBooking provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) GetBooking(ctx context.Context, request *GetBookingRequest) (GetBookingResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 GetBookingResponse
-	if rf, ok := ret.Get(0).(func(*GetBookingRequest) GetBookingResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *GetBookingRequest) GetBookingResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(GetBookingResponse)
@@ -437,8 +510,31 @@ func (_m *MockVisAdminClient) GetBooking(request *GetBookingRequest) (GetBooking
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*GetBookingRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *GetBookingRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBookingYaml provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) GetBookingYaml(ctx context.Context, request *GetBookingYamlRequest) (GetBookingYamlResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 GetBookingYamlResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *GetBookingYamlRequest) GetBookingYamlResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(GetBookingYamlResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *GetBookingYamlRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -446,13 +542,13 @@ func (_m *MockVisAdminClient) GetBooking(request *GetBookingRequest) (GetBooking
 	return r0, r1
 }
 
-// GetBookings provides a mock function with given fields: request
-func (_m *MockVisAdminClient) GetBookings(request *GetBookingsRequest) (GetBookingsResponse, error) {
-	ret := _m.Called(request)
+// GetBookings provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) GetBookings(ctx context.Context, request *GetBookingsRequest) (GetBookingsResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 GetBookingsResponse
-	if rf, ok := ret.Get(0).(func(*GetBookingsRequest) GetBookingsResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *GetBookingsRequest) GetBookingsResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(GetBookingsResponse)
@@ -460,8 +556,8 @@ func (_m *MockVisAdminClient) GetBookings(request *GetBookingsRequest) (GetBooki
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*GetBookingsRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *GetBookingsRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -469,13 +565,13 @@ func (_m *MockVisAdminClient) GetBookings(request *GetBookingsRequest) (GetBooki
 	return r0, r1
 }
 
-// GetClasses provides a mock function with given fields: request
-func (_m *MockVisAdminClient) GetClasses(request *GetClassesRequest) (GetClassesResponse, error) {
-	ret := _m.Called(request)
+// GetClasses provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) GetClasses(ctx context.Context, request *GetClassesRequest) (GetClassesResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 GetClassesResponse
-	if rf, ok := ret.Get(0).(func(*GetClassesRequest) GetClassesResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *GetClassesRequest) GetClassesResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(GetClassesResponse)
@@ -483,8 +579,8 @@ func (_m *MockVisAdminClient) GetClasses(request *GetClassesRequest) (GetClasses
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*GetClassesRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *GetClassesRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -492,13 +588,13 @@ func (_m *MockVisAdminClient) GetClasses(request *GetClassesRequest) (GetClasses
 	return r0, r1
 }
 
-// GetClient provides a mock function with given fields: request
-func (_m *MockVisAdminClient) GetClient(request *GetClientRequest) (GetClientResponse, error) {
-	ret := _m.Called(request)
+// GetClient provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) GetClient(ctx context.Context, request *GetClientRequest) (GetClientResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 GetClientResponse
-	if rf, ok := ret.Get(0).(func(*GetClientRequest) GetClientResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *GetClientRequest) GetClientResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(GetClientResponse)
@@ -506,8 +602,8 @@ func (_m *MockVisAdminClient) GetClient(request *GetClientRequest) (GetClientRes
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*GetClientRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *GetClientRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -515,13 +611,13 @@ func (_m *MockVisAdminClient) GetClient(request *GetClientRequest) (GetClientRes
 	return r0, r1
 }
 
-// GetClients provides a mock function with given fields: request
-func (_m *MockVisAdminClient) GetClients(request *GetClientsRequest) (GetClientsResponse, error) {
-	ret := _m.Called(request)
+// GetClients provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) GetClients(ctx context.Context, request *GetClientsRequest) (GetClientsResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 GetClientsResponse
-	if rf, ok := ret.Get(0).(func(*GetClientsRequest) GetClientsResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *GetClientsRequest) GetClientsResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(GetClientsResponse)
@@ -529,8 +625,31 @@ func (_m *MockVisAdminClient) GetClients(request *GetClientsRequest) (GetClients
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*GetClientsRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *GetClientsRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPartner provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) GetPartner(ctx context.Context, request *GetPartnerRequest) (GetPartnerResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 GetPartnerResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *GetPartnerRequest) GetPartnerResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(GetPartnerResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *GetPartnerRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -538,13 +657,59 @@ func (_m *MockVisAdminClient) GetClients(request *GetClientsRequest) (GetClients
 	return r0, r1
 }
 
-// GetPermissions provides a mock function with given fields: request
-func (_m *MockVisAdminClient) GetPermissions(request *GetPermissionsRequest) (GetPermissionsResponse, error) {
-	ret := _m.Called(request)
+// GetPartnerProfile provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) GetPartnerProfile(ctx context.Context, request *GetPartnerProfileRequest) (GetPartnerProfileResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 GetPartnerProfileResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *GetPartnerProfileRequest) GetPartnerProfileResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(GetPartnerProfileResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *GetPartnerProfileRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPartnerStatement provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) GetPartnerStatement(ctx context.Context, request *GetPartnerStatementRequest) (GetPartnerStatementResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 GetPartnerStatementResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *GetPartnerStatementRequest) GetPartnerStatementResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(GetPartnerStatementResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *GetPartnerStatementRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPermissions provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) GetPermissions(ctx context.Context, request *GetPermissionsRequest) (GetPermissionsResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 GetPermissionsResponse
-	if rf, ok := ret.Get(0).(func(*GetPermissionsRequest) GetPermissionsResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *GetPermissionsRequest) GetPermissionsResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(GetPermissionsResponse)
@@ -552,8 +717,8 @@ func (_m *MockVisAdminClient) GetPermissions(request *GetPermissionsRequest) (Ge
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*GetPermissionsRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *GetPermissionsRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -561,13 +726,13 @@ func (_m *MockVisAdminClient) GetPermissions(request *GetPermissionsRequest) (Ge
 	return r0, r1
 }
 
-// GetRental provides a mock function with given fields: request
-func (_m *MockVisAdminClient) GetRental(request *GetRentalRequest) (GetRentalResponse, error) {
-	ret := _m.Called(request)
+// GetRental provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) GetRental(ctx context.Context, request *GetRentalRequest) (GetRentalResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 GetRentalResponse
-	if rf, ok := ret.Get(0).(func(*GetRentalRequest) GetRentalResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *GetRentalRequest) GetRentalResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(GetRentalResponse)
@@ -575,8 +740,8 @@ func (_m *MockVisAdminClient) GetRental(request *GetRentalRequest) (GetRentalRes
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*GetRentalRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *GetRentalRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -584,13 +749,13 @@ func (_m *MockVisAdminClient) GetRental(request *GetRentalRequest) (GetRentalRes
 	return r0, r1
 }
 
-// GetShoes provides a mock function with given fields: request
-func (_m *MockVisAdminClient) GetShoes(request *GetShoesRequest) (GetShoesResponse, error) {
-	ret := _m.Called(request)
+// GetShoes provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) GetShoes(ctx context.Context, request *GetShoesRequest) (GetShoesResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 GetShoesResponse
-	if rf, ok := ret.Get(0).(func(*GetShoesRequest) GetShoesResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *GetShoesRequest) GetShoesResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(GetShoesResponse)
@@ -598,8 +763,8 @@ func (_m *MockVisAdminClient) GetShoes(request *GetShoesRequest) (GetShoesRespon
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*GetShoesRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *GetShoesRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -607,13 +772,13 @@ func (_m *MockVisAdminClient) GetShoes(request *GetShoesRequest) (GetShoesRespon
 	return r0, r1
 }
 
-// GetUser provides a mock function with given fields: request
-func (_m *MockVisAdminClient) GetUser(request *GetUserRequest) (GetUserResponse, error) {
-	ret := _m.Called(request)
+// GetUser provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) GetUser(ctx context.Context, request *GetUserRequest) (GetUserResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 GetUserResponse
-	if rf, ok := ret.Get(0).(func(*GetUserRequest) GetUserResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *GetUserRequest) GetUserResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(GetUserResponse)
@@ -621,8 +786,8 @@ func (_m *MockVisAdminClient) GetUser(request *GetUserRequest) (GetUserResponse,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*GetUserRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *GetUserRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -630,13 +795,13 @@ func (_m *MockVisAdminClient) GetUser(request *GetUserRequest) (GetUserResponse,
 	return r0, r1
 }
 
-// GetUserInfo provides a mock function with given fields: request
-func (_m *MockVisAdminClient) GetUserInfo(request *GetUserInfoRequest) (GetUserInfoResponse, error) {
-	ret := _m.Called(request)
+// GetUserInfo provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) GetUserInfo(ctx context.Context, request *GetUserInfoRequest) (GetUserInfoResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 GetUserInfoResponse
-	if rf, ok := ret.Get(0).(func(*GetUserInfoRequest) GetUserInfoResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *GetUserInfoRequest) GetUserInfoResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(GetUserInfoResponse)
@@ -644,8 +809,8 @@ func (_m *MockVisAdminClient) GetUserInfo(request *GetUserInfoRequest) (GetUserI
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*GetUserInfoRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *GetUserInfoRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -653,13 +818,13 @@ func (_m *MockVisAdminClient) GetUserInfo(request *GetUserInfoRequest) (GetUserI
 	return r0, r1
 }
 
-// GetUsers provides a mock function with given fields: request
-func (_m *MockVisAdminClient) GetUsers(request *GetUsersRequest) (GetUsersResponse, error) {
-	ret := _m.Called(request)
+// GetUsers provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) GetUsers(ctx context.Context, request *GetUsersRequest) (GetUsersResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 GetUsersResponse
-	if rf, ok := ret.Get(0).(func(*GetUsersRequest) GetUsersResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *GetUsersRequest) GetUsersResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(GetUsersResponse)
@@ -667,8 +832,8 @@ func (_m *MockVisAdminClient) GetUsers(request *GetUsersRequest) (GetUsersRespon
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*GetUsersRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *GetUsersRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -676,13 +841,13 @@ func (_m *MockVisAdminClient) GetUsers(request *GetUsersRequest) (GetUsersRespon
 	return r0, r1
 }
 
-// GetViewsSet provides a mock function with given fields: request
-func (_m *MockVisAdminClient) GetViewsSet(request *GetViewsSetRequest) (GetViewsSetResponse, error) {
-	ret := _m.Called(request)
+// GetViewsSet provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) GetViewsSet(ctx context.Context, request *GetViewsSetRequest) (GetViewsSetResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 GetViewsSetResponse
-	if rf, ok := ret.Get(0).(func(*GetViewsSetRequest) GetViewsSetResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *GetViewsSetRequest) GetViewsSetResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(GetViewsSetResponse)
@@ -690,8 +855,8 @@ func (_m *MockVisAdminClient) GetViewsSet(request *GetViewsSetRequest) (GetViews
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*GetViewsSetRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *GetViewsSetRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -699,13 +864,13 @@ func (_m *MockVisAdminClient) GetViewsSet(request *GetViewsSetRequest) (GetViews
 	return r0, r1
 }
 
-// GetViewsSets provides a mock function with given fields: request
-func (_m *MockVisAdminClient) GetViewsSets(request *GetViewsSetsRequest) (GetViewsSetsResponse, error) {
-	ret := _m.Called(request)
+// GetViewsSets provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) GetViewsSets(ctx context.Context, request *GetViewsSetsRequest) (GetViewsSetsResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 GetViewsSetsResponse
-	if rf, ok := ret.Get(0).(func(*GetViewsSetsRequest) GetViewsSetsResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *GetViewsSetsRequest) GetViewsSetsResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(GetViewsSetsResponse)
@@ -713,8 +878,8 @@ func (_m *MockVisAdminClient) GetViewsSets(request *GetViewsSetsRequest) (GetVie
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*GetViewsSetsRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *GetViewsSetsRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -722,13 +887,36 @@ func (_m *MockVisAdminClient) GetViewsSets(request *GetViewsSetsRequest) (GetVie
 	return r0, r1
 }
 
-// ListElements provides a mock function with given fields: request
-func (_m *MockVisAdminClient) ListElements(request *ListElementsRequest) (ListElementsResponse, error) {
-	ret := _m.Called(request)
+// ImportPartnerArchive provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) ImportPartnerArchive(ctx context.Context, request *ImportPartnerArchiveRequest) (ImportPartnerArchiveResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 ImportPartnerArchiveResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *ImportPartnerArchiveRequest) ImportPartnerArchiveResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ImportPartnerArchiveResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ImportPartnerArchiveRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListElements provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) ListElements(ctx context.Context, request *ListElementsRequest) (ListElementsResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 ListElementsResponse
-	if rf, ok := ret.Get(0).(func(*ListElementsRequest) ListElementsResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *ListElementsRequest) ListElementsResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(ListElementsResponse)
@@ -736,8 +924,8 @@ func (_m *MockVisAdminClient) ListElements(request *ListElementsRequest) (ListEl
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*ListElementsRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *ListElementsRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -745,13 +933,13 @@ func (_m *MockVisAdminClient) ListElements(request *ListElementsRequest) (ListEl
 	return r0, r1
 }
 
-// ListModels provides a mock function with given fields: request
-func (_m *MockVisAdminClient) ListModels(request *ListModelsRequest) (ListModelsResponse, error) {
-	ret := _m.Called(request)
+// ListModels provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) ListModels(ctx context.Context, request *ListModelsRequest) (ListModelsResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 ListModelsResponse
-	if rf, ok := ret.Get(0).(func(*ListModelsRequest) ListModelsResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *ListModelsRequest) ListModelsResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(ListModelsResponse)
@@ -759,8 +947,31 @@ func (_m *MockVisAdminClient) ListModels(request *ListModelsRequest) (ListModels
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*ListModelsRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *ListModelsRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPartners provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) ListPartners(ctx context.Context, request *ListPartnersRequest) (ListPartnersResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 ListPartnersResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *ListPartnersRequest) ListPartnersResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ListPartnersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ListPartnersRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -768,13 +979,13 @@ func (_m *MockVisAdminClient) ListModels(request *ListModelsRequest) (ListModels
 	return r0, r1
 }
 
-// PostUpload provides a mock function with given fields: request
-func (_m *MockVisAdminClient) PostUpload(request *PostUploadRequest) (PostUploadResponse, error) {
-	ret := _m.Called(request)
+// PostUpload provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) PostUpload(ctx context.Context, request *PostUploadRequest) (PostUploadResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 PostUploadResponse
-	if rf, ok := ret.Get(0).(func(*PostUploadRequest) PostUploadResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *PostUploadRequest) PostUploadResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(PostUploadResponse)
@@ -782,8 +993,8 @@ func (_m *MockVisAdminClient) PostUpload(request *PostUploadRequest) (PostUpload
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*PostUploadRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *PostUploadRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -791,13 +1002,13 @@ func (_m *MockVisAdminClient) PostUpload(request *PostUploadRequest) (PostUpload
 	return r0, r1
 }
 
-// ShowVehicleInView provides a mock function with given fields: request
-func (_m *MockVisAdminClient) ShowVehicleInView(request *ShowVehicleInViewRequest) (ShowVehicleInViewResponse, error) {
-	ret := _m.Called(request)
+// ShowVehicleInView provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) ShowVehicleInView(ctx context.Context, request *ShowVehicleInViewRequest) (ShowVehicleInViewResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 ShowVehicleInViewResponse
-	if rf, ok := ret.Get(0).(func(*ShowVehicleInViewRequest) ShowVehicleInViewResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(context.Context, *ShowVehicleInViewRequest) ShowVehicleInViewResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(ShowVehicleInViewResponse)
@@ -805,8 +1016,100 @@ func (_m *MockVisAdminClient) ShowVehicleInView(request *ShowVehicleInViewReques
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*ShowVehicleInViewRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(context.Context, *ShowVehicleInViewRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePartner provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) UpdatePartner(ctx context.Context, request *UpdatePartnerRequest) (UpdatePartnerResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 UpdatePartnerResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *UpdatePartnerRequest) UpdatePartnerResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(UpdatePartnerResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *UpdatePartnerRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadPartnerDocuments provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) UploadPartnerDocuments(ctx context.Context, request *UploadPartnerDocumentsRequest) (UploadPartnerDocumentsResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 UploadPartnerDocumentsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *UploadPartnerDocumentsRequest) UploadPartnerDocumentsResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(UploadPartnerDocumentsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *UploadPartnerDocumentsRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateParameters provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) ValidateParameters(ctx context.Context, request *ValidateParametersRequest) (ValidateParametersResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 ValidateParametersResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *ValidateParametersRequest) ValidateParametersResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ValidateParametersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *ValidateParametersRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WatchPartners provides a mock function with given fields: ctx, request
+func (_m *MockVisAdminClient) WatchPartners(ctx context.Context, request *WatchPartnersRequest) (WatchPartnersResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 WatchPartnersResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *WatchPartnersRequest) WatchPartnersResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(WatchPartnersResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *WatchPartnersRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
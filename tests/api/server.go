@@ -2,14 +2,12 @@ package api
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	routing "github.com/go-ozzo/ozzo-routing"
-	validator "github.com/go-playground/validator"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"regexp"
+	"time"
 )
 
 func NewVisAdminServer(options *ServerOpts) *VisAdminServer {
@@ -28,42 +26,64 @@ func NewVisAdminServer(options *ServerOpts) *VisAdminServer {
 
 type VisAdminServer struct {
 	*Server
-	Validator                     *Validator
-	getClientsHandler             *getClientsHandlerRoute
-	deleteClientHandler           *deleteClientHandlerRoute
-	getClientHandler              *getClientHandlerRoute
-	createOrUpdateClientHandler   *createOrUpdateClientHandlerRoute
-	getViewsSetsHandler           *getViewsSetsHandlerRoute
-	deleteViewsSetHandler         *deleteViewsSetHandlerRoute
-	getViewsSetHandler            *getViewsSetHandlerRoute
-	activateViewsSetHandler       *activateViewsSetHandlerRoute
-	createOrUpdateViewsSetHandler *createOrUpdateViewsSetHandlerRoute
-	showVehicleInViewHandler      *showVehicleInViewHandlerRoute
-	getPermissionsHandler         *getPermissionsHandlerRoute
-	destroySessionHandler         *destroySessionHandlerRoute
-	getUserInfoHandler            *getUserInfoHandlerRoute
-	createSessionHandler          *createSessionHandlerRoute
-	getUsersHandler               *getUsersHandlerRoute
-	deleteUserHandler             *deleteUserHandlerRoute
-	getUserHandler                *getUserHandlerRoute
-	createOrUpdateUserHandler     *createOrUpdateUserHandlerRoute
-	getBookingHandler             *getBookingHandlerRoute
-	getBookingsHandler            *getBookingsHandlerRoute
-	listModelsHandler             *listModelsHandlerRoute
-	getClassesHandler             *getClassesHandlerRoute
-	codeHandler                   *codeHandlerRoute
-	deleteCustomerSessionHandler  *deleteCustomerSessionHandlerRoute
-	createCustomerSessionHandler  *createCustomerSessionHandlerRoute
-	downloadNestedFileHandler     *downloadNestedFileHandlerRoute
-	downloadImageHandler          *downloadImageHandlerRoute
-	listElementsHandler           *listElementsHandlerRoute
-	fileUploadHandler             *fileUploadHandlerRoute
-	downloadFileHandler           *downloadFileHandlerRoute
-	findByTagsHandler             *findByTagsHandlerRoute
-	genericFileDownloadHandler    *genericFileDownloadHandlerRoute
-	getRentalHandler              *getRentalHandlerRoute
-	getShoesHandler               *getShoesHandlerRoute
-	postUploadHandler             *postUploadHandlerRoute
+	Validator                          *Validator
+	getClientsHandler                  *getClientsHandlerRoute
+	deleteClientHandler                *deleteClientHandlerRoute
+	deleteClientPrecondition           DeleteClientPrecondition
+	getClientHandler                   *getClientHandlerRoute
+	createOrUpdateClientHandler        *createOrUpdateClientHandlerRoute
+	createOrUpdateClientPrecondition   CreateOrUpdateClientPrecondition
+	getViewsSetsHandler                *getViewsSetsHandlerRoute
+	deleteViewsSetHandler              *deleteViewsSetHandlerRoute
+	deleteViewsSetPrecondition         DeleteViewsSetPrecondition
+	getViewsSetHandler                 *getViewsSetHandlerRoute
+	activateViewsSetHandler            *activateViewsSetHandlerRoute
+	createOrUpdateViewsSetHandler      *createOrUpdateViewsSetHandlerRoute
+	createOrUpdateViewsSetPrecondition CreateOrUpdateViewsSetPrecondition
+	showVehicleInViewHandler           *showVehicleInViewHandlerRoute
+	getPermissionsHandler              *getPermissionsHandlerRoute
+	destroySessionHandler              *destroySessionHandlerRoute
+	destroySessionPrecondition         DestroySessionPrecondition
+	getUserInfoHandler                 *getUserInfoHandlerRoute
+	createSessionHandler               *createSessionHandlerRoute
+	getUsersHandler                    *getUsersHandlerRoute
+	deleteUserHandler                  *deleteUserHandlerRoute
+	deleteUserPrecondition             DeleteUserPrecondition
+	getUserHandler                     *getUserHandlerRoute
+	createOrUpdateUserHandler          *createOrUpdateUserHandlerRoute
+	createOrUpdateUserPrecondition     CreateOrUpdateUserPrecondition
+	getBookingHandler                  *getBookingHandlerRoute
+	getBookingYamlHandler              *getBookingYamlHandlerRoute
+	getBookingsHandler                 *getBookingsHandlerRoute
+	listModelsHandler                  *listModelsHandlerRoute
+	getClassesHandler                  *getClassesHandlerRoute
+	codeHandler                        *codeHandlerRoute
+	deleteCustomerSessionHandler       *deleteCustomerSessionHandlerRoute
+	deleteCustomerSessionPrecondition  DeleteCustomerSessionPrecondition
+	createCustomerSessionHandler       *createCustomerSessionHandlerRoute
+	downloadNestedFileHandler          *downloadNestedFileHandlerRoute
+	downloadImageHandler               *downloadImageHandlerRoute
+	listElementsHandler                *listElementsHandlerRoute
+	fileUploadHandler                  *fileUploadHandlerRoute
+	downloadFileHandler                *downloadFileHandlerRoute
+	findByTagsHandler                  *findByTagsHandlerRoute
+	genericFileDownloadHandler         *genericFileDownloadHandlerRoute
+	validateParametersHandler          *validateParametersHandlerRoute
+	listPartnersHandler                *listPartnersHandlerRoute
+	createPartnerHandler               *createPartnerHandlerRoute
+	watchPartnersHandler               *watchPartnersHandlerRoute
+	getPartnerHandler                  *getPartnerHandlerRoute
+	updatePartnerHandler               *updatePartnerHandlerRoute
+	updatePartnerPrecondition          UpdatePartnerPrecondition
+	importPartnerArchiveHandler        *importPartnerArchiveHandlerRoute
+	chatWithPartnerHandler             *chatWithPartnerHandlerRoute
+	downloadPartnerContractHandler     *downloadPartnerContractHandlerRoute
+	uploadPartnerDocumentsHandler      *uploadPartnerDocumentsHandlerRoute
+	getPartnerProfileHandler           *getPartnerProfileHandlerRoute
+	getPartnerStatementHandler         *getPartnerStatementHandlerRoute
+	getRentalHandler                   *getRentalHandlerRoute
+	getShoesHandler                    *getShoesHandlerRoute
+	postUploadHandler                  *postUploadHandlerRoute
 }
 type GetClientsHandler func(ctx context.Context, request *GetClientsRequest) GetClientsResponse
 
@@ -76,34 +96,63 @@ func (server *VisAdminServer) SetGetClientsHandler(handler GetClientsHandler, mi
 	server.getClientsHandler = &getClientsHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/api/client", Handler: server.GetClientsHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) GetClientsHandler(c *routing.Context) error {
+func (server *VisAdminServer) GetClientsHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.getClientsHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: GetClients (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetClients (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(GetClientsRequest)
-		if len(c.Request.Header["X-Auth"]) > 0 {
-			if err := fromString(c.Request.Header["X-Auth"][0], &request.XAuth); err != nil {
+		if len(r.Header["X-Auth"]) > 0 {
+			if err := fromString(r.Header["X-Auth"][0], &request.XAuth); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: GetClients (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeInvalidType, err.Error()))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeRequired, "header parameter 'X-Auth' is required"))
 		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetClients (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.getClientsHandler.customHandler(c.Request.Context(), request)
+		response := server.getClientsHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: GetClients (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *GetClients200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &GetClients304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		case *GetClients204Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &GetClients304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetClients (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -119,41 +168,70 @@ type deleteClientHandlerRoute struct {
 }
 
 func (server *VisAdminServer) SetDeleteClientHandler(handler DeleteClientHandler, middleware ...Middleware) {
-	server.deleteClientHandler = &deleteClientHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "DELETE", Path: "/api/client/<clientId>", Handler: server.DeleteClientHandler, Middleware: middleware}}
+	server.deleteClientHandler = &deleteClientHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "DELETE", Path: "/api/client/{clientId}", Handler: server.DeleteClientHandler, Middleware: middleware}}
+}
+
+// DeleteClientPrecondition returns the ETag and Last-Modified of the current representation of the resource, which are compared
+// with the conditional headers of a request before it is handled. An empty ETag and a zero time stand for a missing resource.
+type DeleteClientPrecondition func(ctx context.Context, request *DeleteClientRequest) (etag string, lastModified time.Time, err error)
+
+// SetDeleteClientPrecondition sets the precondition of DeleteClient requests, a failed precondition is answered with 412 (Precondition Failed)
+func (server *VisAdminServer) SetDeleteClientPrecondition(precondition DeleteClientPrecondition) {
+	server.deleteClientPrecondition = precondition
 }
 
-func (server *VisAdminServer) DeleteClientHandler(c *routing.Context) error {
+func (server *VisAdminServer) DeleteClientHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.deleteClientHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: DeleteClient (DELETE) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteClient (DELETE) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(DeleteClientRequest)
-		if err := fromString(c.Param("clientId"), &request.ClientId); err != nil {
+		if err := fromString(server.PathParam(r, "clientId"), &request.ClientId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteClient (DELETE) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("clientId", "path", CodeInvalidType, err.Error()))
 		}
-		if len(c.Request.Header["X-Auth"]) > 0 {
-			if err := fromString(c.Request.Header["X-Auth"][0], &request.XAuth); err != nil {
+		if len(r.Header["X-Auth"]) > 0 {
+			if err := fromString(r.Header["X-Auth"][0], &request.XAuth); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteClient (DELETE) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeInvalidType, err.Error()))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeRequired, "header parameter 'X-Auth' is required"))
 		}
+		request.IfMatch = r.Header.Get(ifMatchHeader)
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfUnmodifiedSince = parseHTTPTime(r.Header.Get(ifUnmodifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteClient (DELETE) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
+		}
+		if server.deleteClientPrecondition != nil && hasPreconditions(r.Header) {
+			etag, lastModified, err := server.deleteClientPrecondition(r.Context(), request)
+			if err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteClient (DELETE) could not evaluate preconditions (error: %v)", err))
+				return NewHTTPStatusCodeError(http.StatusInternalServerError)
+			}
+			if evaluatePreconditions(r, etag, lastModified) != 0 {
+				return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+			}
 		}
-		response := server.deleteClientHandler.customHandler(c.Request.Context(), request)
+		response := server.deleteClientHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: DeleteClient (DELETE) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteClient (DELETE) could not send response (error: %v)", err))
 			return err
 		}
@@ -169,41 +247,61 @@ type getClientHandlerRoute struct {
 }
 
 func (server *VisAdminServer) SetGetClientHandler(handler GetClientHandler, middleware ...Middleware) {
-	server.getClientHandler = &getClientHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/api/client/<clientId>", Handler: server.GetClientHandler, Middleware: middleware}}
+	server.getClientHandler = &getClientHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/api/client/{clientId}", Handler: server.GetClientHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) GetClientHandler(c *routing.Context) error {
+func (server *VisAdminServer) GetClientHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.getClientHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: GetClient (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetClient (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(GetClientRequest)
-		if err := fromString(c.Param("clientId"), &request.ClientId); err != nil {
+		if err := fromString(server.PathParam(r, "clientId"), &request.ClientId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetClient (GET) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("clientId", "path", CodeInvalidType, err.Error()))
 		}
-		if len(c.Request.Header["X-Auth"]) > 0 {
-			if err := fromString(c.Request.Header["X-Auth"][0], &request.XAuth); err != nil {
+		if len(r.Header["X-Auth"]) > 0 {
+			if err := fromString(r.Header["X-Auth"][0], &request.XAuth); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: GetClient (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeInvalidType, err.Error()))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeRequired, "header parameter 'X-Auth' is required"))
 		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetClient (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.getClientHandler.customHandler(c.Request.Context(), request)
+		response := server.getClientHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: GetClient (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *GetClient200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &GetClient304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetClient (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -219,52 +317,81 @@ type createOrUpdateClientHandlerRoute struct {
 }
 
 func (server *VisAdminServer) SetCreateOrUpdateClientHandler(handler CreateOrUpdateClientHandler, middleware ...Middleware) {
-	server.createOrUpdateClientHandler = &createOrUpdateClientHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "PUT", Path: "/api/client/<clientId>", Handler: server.CreateOrUpdateClientHandler, Middleware: middleware}}
+	server.createOrUpdateClientHandler = &createOrUpdateClientHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "PUT", Path: "/api/client/{clientId}", Handler: server.CreateOrUpdateClientHandler, Middleware: middleware}}
+}
+
+// CreateOrUpdateClientPrecondition returns the ETag and Last-Modified of the current representation of the resource, which are compared
+// with the conditional headers of a request before it is handled. An empty ETag and a zero time stand for a missing resource.
+type CreateOrUpdateClientPrecondition func(ctx context.Context, request *CreateOrUpdateClientRequest) (etag string, lastModified time.Time, err error)
+
+// SetCreateOrUpdateClientPrecondition sets the precondition of CreateOrUpdateClient requests, a failed precondition is answered with 412 (Precondition Failed)
+func (server *VisAdminServer) SetCreateOrUpdateClientPrecondition(precondition CreateOrUpdateClientPrecondition) {
+	server.createOrUpdateClientPrecondition = precondition
 }
 
-func (server *VisAdminServer) CreateOrUpdateClientHandler(c *routing.Context) error {
+func (server *VisAdminServer) CreateOrUpdateClientHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.createOrUpdateClientHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: CreateOrUpdateClient (PUT) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateClient (PUT) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(CreateOrUpdateClientRequest)
-		contentTypeOfResponse := extractContentType(c.Request.Header.Get(contentTypeHeader))
+		contentTypeOfResponse := extractContentType(r.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson {
-			err := JSON(c.Request.Body, &request.Body, true)
+			err := JSON(r.Body, &request.Body, true)
 			if err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateClient (PUT) could not decode request body of incoming request (%v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewBodyError("body", err))
 			}
 		} else {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateClient (PUT) content type of incoming request is bad (want: application/json, got: %s)", contentTypeOfResponse))
 			return newNotSupportedContentType(415, contentTypeOfResponse)
 		}
-		if err := fromString(c.Param("clientId"), &request.ClientId); err != nil {
+		if err := fromString(server.PathParam(r, "clientId"), &request.ClientId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateClient (PUT) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("clientId", "path", CodeInvalidType, err.Error()))
 		}
-		if len(c.Request.Header["X-Auth"]) > 0 {
-			if err := fromString(c.Request.Header["X-Auth"][0], &request.XAuth); err != nil {
+		if len(r.Header["X-Auth"]) > 0 {
+			if err := fromString(r.Header["X-Auth"][0], &request.XAuth); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateClient (PUT) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeInvalidType, err.Error()))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeRequired, "header parameter 'X-Auth' is required"))
 		}
+		request.IfMatch = r.Header.Get(ifMatchHeader)
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfUnmodifiedSince = parseHTTPTime(r.Header.Get(ifUnmodifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateClient (PUT) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
+		}
+		if server.createOrUpdateClientPrecondition != nil && hasPreconditions(r.Header) {
+			etag, lastModified, err := server.createOrUpdateClientPrecondition(r.Context(), request)
+			if err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateClient (PUT) could not evaluate preconditions (error: %v)", err))
+				return NewHTTPStatusCodeError(http.StatusInternalServerError)
+			}
+			if evaluatePreconditions(r, etag, lastModified) != 0 {
+				return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+			}
 		}
-		response := server.createOrUpdateClientHandler.customHandler(c.Request.Context(), request)
+		response := server.createOrUpdateClientHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: CreateOrUpdateClient (PUT) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateClient (PUT) could not send response (error: %v)", err))
 			return err
 		}
@@ -280,41 +407,61 @@ type getViewsSetsHandlerRoute struct {
 }
 
 func (server *VisAdminServer) SetGetViewsSetsHandler(handler GetViewsSetsHandler, middleware ...Middleware) {
-	server.getViewsSetsHandler = &getViewsSetsHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/api/client/<clientId>/views", Handler: server.GetViewsSetsHandler, Middleware: middleware}}
+	server.getViewsSetsHandler = &getViewsSetsHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/api/client/{clientId}/views", Handler: server.GetViewsSetsHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) GetViewsSetsHandler(c *routing.Context) error {
+func (server *VisAdminServer) GetViewsSetsHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.getViewsSetsHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: GetViewsSets (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetViewsSets (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(GetViewsSetsRequest)
-		if err := fromString(c.Param("clientId"), &request.ClientId); err != nil {
+		if err := fromString(server.PathParam(r, "clientId"), &request.ClientId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetViewsSets (GET) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("clientId", "path", CodeInvalidType, err.Error()))
 		}
-		if len(c.Request.Header["X-Auth"]) > 0 {
-			if err := fromString(c.Request.Header["X-Auth"][0], &request.XAuth); err != nil {
+		if len(r.Header["X-Auth"]) > 0 {
+			if err := fromString(r.Header["X-Auth"][0], &request.XAuth); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: GetViewsSets (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeInvalidType, err.Error()))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeRequired, "header parameter 'X-Auth' is required"))
 		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetViewsSets (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.getViewsSetsHandler.customHandler(c.Request.Context(), request)
+		response := server.getViewsSetsHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: GetViewsSets (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *GetViewsSets200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &GetViewsSets304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetViewsSets (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -330,45 +477,74 @@ type deleteViewsSetHandlerRoute struct {
 }
 
 func (server *VisAdminServer) SetDeleteViewsSetHandler(handler DeleteViewsSetHandler, middleware ...Middleware) {
-	server.deleteViewsSetHandler = &deleteViewsSetHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "DELETE", Path: "/api/client/<clientId>/views/<viewsId>", Handler: server.DeleteViewsSetHandler, Middleware: middleware}}
+	server.deleteViewsSetHandler = &deleteViewsSetHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "DELETE", Path: "/api/client/{clientId}/views/{viewsId}", Handler: server.DeleteViewsSetHandler, Middleware: middleware}}
+}
+
+// DeleteViewsSetPrecondition returns the ETag and Last-Modified of the current representation of the resource, which are compared
+// with the conditional headers of a request before it is handled. An empty ETag and a zero time stand for a missing resource.
+type DeleteViewsSetPrecondition func(ctx context.Context, request *DeleteViewsSetRequest) (etag string, lastModified time.Time, err error)
+
+// SetDeleteViewsSetPrecondition sets the precondition of DeleteViewsSet requests, a failed precondition is answered with 412 (Precondition Failed)
+func (server *VisAdminServer) SetDeleteViewsSetPrecondition(precondition DeleteViewsSetPrecondition) {
+	server.deleteViewsSetPrecondition = precondition
 }
 
-func (server *VisAdminServer) DeleteViewsSetHandler(c *routing.Context) error {
+func (server *VisAdminServer) DeleteViewsSetHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.deleteViewsSetHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: DeleteViewsSet (DELETE) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteViewsSet (DELETE) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(DeleteViewsSetRequest)
-		if err := fromString(c.Param("clientId"), &request.ClientId); err != nil {
+		if err := fromString(server.PathParam(r, "clientId"), &request.ClientId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteViewsSet (DELETE) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("clientId", "path", CodeInvalidType, err.Error()))
 		}
-		if err := fromString(c.Param("viewsId"), &request.ViewsId); err != nil {
+		if err := fromString(server.PathParam(r, "viewsId"), &request.ViewsId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteViewsSet (DELETE) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("viewsId", "path", CodeInvalidType, err.Error()))
 		}
-		if len(c.Request.Header["X-Auth"]) > 0 {
-			if err := fromString(c.Request.Header["X-Auth"][0], &request.XAuth); err != nil {
+		if len(r.Header["X-Auth"]) > 0 {
+			if err := fromString(r.Header["X-Auth"][0], &request.XAuth); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteViewsSet (DELETE) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeInvalidType, err.Error()))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeRequired, "header parameter 'X-Auth' is required"))
 		}
+		request.IfMatch = r.Header.Get(ifMatchHeader)
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfUnmodifiedSince = parseHTTPTime(r.Header.Get(ifUnmodifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteViewsSet (DELETE) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
+		}
+		if server.deleteViewsSetPrecondition != nil && hasPreconditions(r.Header) {
+			etag, lastModified, err := server.deleteViewsSetPrecondition(r.Context(), request)
+			if err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteViewsSet (DELETE) could not evaluate preconditions (error: %v)", err))
+				return NewHTTPStatusCodeError(http.StatusInternalServerError)
+			}
+			if evaluatePreconditions(r, etag, lastModified) != 0 {
+				return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+			}
 		}
-		response := server.deleteViewsSetHandler.customHandler(c.Request.Context(), request)
+		response := server.deleteViewsSetHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: DeleteViewsSet (DELETE) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteViewsSet (DELETE) could not send response (error: %v)", err))
 			return err
 		}
@@ -384,53 +560,73 @@ type getViewsSetHandlerRoute struct {
 }
 
 func (server *VisAdminServer) SetGetViewsSetHandler(handler GetViewsSetHandler, middleware ...Middleware) {
-	server.getViewsSetHandler = &getViewsSetHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/api/client/<clientId>/views/<viewsId>", Handler: server.GetViewsSetHandler, Middleware: middleware}}
+	server.getViewsSetHandler = &getViewsSetHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/api/client/{clientId}/views/{viewsId}", Handler: server.GetViewsSetHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) GetViewsSetHandler(c *routing.Context) error {
+func (server *VisAdminServer) GetViewsSetHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.getViewsSetHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: GetViewsSet (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetViewsSet (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(GetViewsSetRequest)
-		if err := fromString(c.Param("clientId"), &request.ClientId); err != nil {
+		if err := fromString(server.PathParam(r, "clientId"), &request.ClientId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetViewsSet (GET) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("clientId", "path", CodeInvalidType, err.Error()))
 		}
-		if err := fromString(c.Param("viewsId"), &request.ViewsId); err != nil {
+		if err := fromString(server.PathParam(r, "viewsId"), &request.ViewsId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetViewsSet (GET) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("viewsId", "path", CodeInvalidType, err.Error()))
 		}
-		if len(c.Request.Header["X-Auth"]) > 0 {
-			if err := fromString(c.Request.Header["X-Auth"][0], &request.XAuth); err != nil {
+		if len(r.Header["X-Auth"]) > 0 {
+			if err := fromString(r.Header["X-Auth"][0], &request.XAuth); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: GetViewsSet (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeInvalidType, err.Error()))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeRequired, "header parameter 'X-Auth' is required"))
 		}
-		if len(c.Request.URL.Query()["page"]) > 0 {
-			if err := fromString(c.Request.URL.Query()["page"][0], &request.Page); err != nil {
+		if len(r.URL.Query()["page"]) > 0 {
+			if err := fromString(r.URL.Query()["page"][0], &request.Page); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: GetViewsSet (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("page", "query", CodeInvalidType, err.Error()))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("page", "query", CodeRequired, "query parameter 'page' is required"))
 		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetViewsSet (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.getViewsSetHandler.customHandler(c.Request.Context(), request)
+		response := server.getViewsSetHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: GetViewsSet (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *GetViewsSet200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &GetViewsSet304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetViewsSet (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -447,30 +643,37 @@ type activateViewsSetHandlerRoute struct {
 }
 
 func (server *VisAdminServer) SetActivateViewsSetHandler(handler ActivateViewsSetHandler, middleware ...Middleware) {
-	server.activateViewsSetHandler = &activateViewsSetHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "POST", Path: "/api/client/<clientId>/views/<viewsId>", Handler: server.ActivateViewsSetHandler, Middleware: middleware}}
+	server.activateViewsSetHandler = &activateViewsSetHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "POST", Path: "/api/client/{clientId}/views/{viewsId}", Handler: server.ActivateViewsSetHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) ActivateViewsSetHandler(c *routing.Context) error {
+func (server *VisAdminServer) ActivateViewsSetHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.activateViewsSetHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: ActivateViewsSet (POST) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ActivateViewsSet (POST) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(ActivateViewsSetRequest)
-		if err := fromString(c.Param("clientId"), &request.ClientId); err != nil {
+		if err := fromString(server.PathParam(r, "clientId"), &request.ClientId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: ActivateViewsSet (POST) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("clientId", "path", CodeInvalidType, err.Error()))
 		}
-		if err := fromString(c.Param("viewsId"), &request.ViewsId); err != nil {
+		if err := fromString(server.PathParam(r, "viewsId"), &request.ViewsId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: ActivateViewsSet (POST) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("viewsId", "path", CodeInvalidType, err.Error()))
 		}
-		if len(c.Request.Header["X-Auth"]) > 0 {
-			if err := fromString(c.Request.Header["X-Auth"][0], &request.XAuth); err != nil {
+		if len(r.Header["X-Auth"]) > 0 {
+			if err := fromString(r.Header["X-Auth"][0], &request.XAuth); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: ActivateViewsSet (POST) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeInvalidType, err.Error()))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeRequired, "header parameter 'X-Auth' is required"))
 		}
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
@@ -478,14 +681,14 @@ func (server *VisAdminServer) ActivateViewsSetHandler(c *routing.Context) error
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.activateViewsSetHandler.customHandler(c.Request.Context(), request)
+		response := server.activateViewsSetHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: ActivateViewsSet (POST) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: ActivateViewsSet (POST) could not send response (error: %v)", err))
 			return err
 		}
@@ -501,56 +704,85 @@ type createOrUpdateViewsSetHandlerRoute struct {
 }
 
 func (server *VisAdminServer) SetCreateOrUpdateViewsSetHandler(handler CreateOrUpdateViewsSetHandler, middleware ...Middleware) {
-	server.createOrUpdateViewsSetHandler = &createOrUpdateViewsSetHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "PUT", Path: "/api/client/<clientId>/views/<viewsId>", Handler: server.CreateOrUpdateViewsSetHandler, Middleware: middleware}}
+	server.createOrUpdateViewsSetHandler = &createOrUpdateViewsSetHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "PUT", Path: "/api/client/{clientId}/views/{viewsId}", Handler: server.CreateOrUpdateViewsSetHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) CreateOrUpdateViewsSetHandler(c *routing.Context) error {
+// CreateOrUpdateViewsSetPrecondition returns the ETag and Last-Modified of the current representation of the resource, which are compared
+// with the conditional headers of a request before it is handled. An empty ETag and a zero time stand for a missing resource.
+type CreateOrUpdateViewsSetPrecondition func(ctx context.Context, request *CreateOrUpdateViewsSetRequest) (etag string, lastModified time.Time, err error)
+
+// SetCreateOrUpdateViewsSetPrecondition sets the precondition of CreateOrUpdateViewsSet requests, a failed precondition is answered with 412 (Precondition Failed)
+func (server *VisAdminServer) SetCreateOrUpdateViewsSetPrecondition(precondition CreateOrUpdateViewsSetPrecondition) {
+	server.createOrUpdateViewsSetPrecondition = precondition
+}
+
+func (server *VisAdminServer) CreateOrUpdateViewsSetHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.createOrUpdateViewsSetHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: CreateOrUpdateViewsSet (PUT) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateViewsSet (PUT) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(CreateOrUpdateViewsSetRequest)
-		contentTypeOfResponse := extractContentType(c.Request.Header.Get(contentTypeHeader))
+		contentTypeOfResponse := extractContentType(r.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson {
-			err := JSON(c.Request.Body, &request.Body, true)
+			err := JSON(r.Body, &request.Body, true)
 			if err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateViewsSet (PUT) could not decode request body of incoming request (%v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewBodyError("body", err))
 			}
 		} else {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateViewsSet (PUT) content type of incoming request is bad (want: application/json, got: %s)", contentTypeOfResponse))
 			return newNotSupportedContentType(415, contentTypeOfResponse)
 		}
-		if err := fromString(c.Param("clientId"), &request.ClientId); err != nil {
+		if err := fromString(server.PathParam(r, "clientId"), &request.ClientId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateViewsSet (PUT) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("clientId", "path", CodeInvalidType, err.Error()))
 		}
-		if err := fromString(c.Param("viewsId"), &request.ViewsId); err != nil {
+		if err := fromString(server.PathParam(r, "viewsId"), &request.ViewsId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateViewsSet (PUT) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("viewsId", "path", CodeInvalidType, err.Error()))
 		}
-		if len(c.Request.Header["X-Auth"]) > 0 {
-			if err := fromString(c.Request.Header["X-Auth"][0], &request.XAuth); err != nil {
+		if len(r.Header["X-Auth"]) > 0 {
+			if err := fromString(r.Header["X-Auth"][0], &request.XAuth); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateViewsSet (PUT) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeInvalidType, err.Error()))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeRequired, "header parameter 'X-Auth' is required"))
 		}
+		request.IfMatch = r.Header.Get(ifMatchHeader)
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfUnmodifiedSince = parseHTTPTime(r.Header.Get(ifUnmodifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateViewsSet (PUT) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
+		}
+		if server.createOrUpdateViewsSetPrecondition != nil && hasPreconditions(r.Header) {
+			etag, lastModified, err := server.createOrUpdateViewsSetPrecondition(r.Context(), request)
+			if err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateViewsSet (PUT) could not evaluate preconditions (error: %v)", err))
+				return NewHTTPStatusCodeError(http.StatusInternalServerError)
+			}
+			if evaluatePreconditions(r, etag, lastModified) != 0 {
+				return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+			}
 		}
-		response := server.createOrUpdateViewsSetHandler.customHandler(c.Request.Context(), request)
+		response := server.createOrUpdateViewsSetHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: CreateOrUpdateViewsSet (PUT) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateViewsSet (PUT) could not send response (error: %v)", err))
 			return err
 		}
@@ -566,57 +798,77 @@ type showVehicleInViewHandlerRoute struct {
 }
 
 func (server *VisAdminServer) SetShowVehicleInViewHandler(handler ShowVehicleInViewHandler, middleware ...Middleware) {
-	server.showVehicleInViewHandler = &showVehicleInViewHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/api/client/<clientId>/views/<viewsId>/<view>/<breakpoint>/<spec>", Handler: server.ShowVehicleInViewHandler, Middleware: middleware}}
+	server.showVehicleInViewHandler = &showVehicleInViewHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/api/client/{clientId}/views/{viewsId}/{view}/{breakpoint}/{spec}", Handler: server.ShowVehicleInViewHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) ShowVehicleInViewHandler(c *routing.Context) error {
+func (server *VisAdminServer) ShowVehicleInViewHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.showVehicleInViewHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: ShowVehicleInView (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ShowVehicleInView (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(ShowVehicleInViewRequest)
-		if err := fromString(c.Param("clientId"), &request.ClientId); err != nil {
+		if err := fromString(server.PathParam(r, "clientId"), &request.ClientId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: ShowVehicleInView (GET) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("clientId", "path", CodeInvalidType, err.Error()))
 		}
-		if err := fromString(c.Param("viewsId"), &request.ViewsId); err != nil {
+		if err := fromString(server.PathParam(r, "viewsId"), &request.ViewsId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: ShowVehicleInView (GET) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("viewsId", "path", CodeInvalidType, err.Error()))
 		}
-		if err := fromString(c.Param("view"), &request.View); err != nil {
+		if err := fromString(server.PathParam(r, "view"), &request.View); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: ShowVehicleInView (GET) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("view", "path", CodeInvalidType, err.Error()))
 		}
-		if err := fromString(c.Param("breakpoint"), &request.Breakpoint); err != nil {
+		if err := fromString(server.PathParam(r, "breakpoint"), &request.Breakpoint); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: ShowVehicleInView (GET) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("breakpoint", "path", CodeInvalidType, err.Error()))
 		}
-		if err := fromString(c.Param("spec"), &request.Spec); err != nil {
+		if err := fromString(server.PathParam(r, "spec"), &request.Spec); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: ShowVehicleInView (GET) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("spec", "path", CodeInvalidType, err.Error()))
 		}
-		if len(c.Request.Header["X-Auth"]) > 0 {
-			if err := fromString(c.Request.Header["X-Auth"][0], &request.XAuth); err != nil {
+		if len(r.Header["X-Auth"]) > 0 {
+			if err := fromString(r.Header["X-Auth"][0], &request.XAuth); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: ShowVehicleInView (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeInvalidType, err.Error()))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeRequired, "header parameter 'X-Auth' is required"))
 		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: ShowVehicleInView (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.showVehicleInViewHandler.customHandler(c.Request.Context(), request)
+		response := server.showVehicleInViewHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: ShowVehicleInView (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *ShowVehicleInView200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &ShowVehicleInView304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: ShowVehicleInView (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -639,34 +891,54 @@ func (server *VisAdminServer) SetGetPermissionsHandler(handler GetPermissionsHan
 	server.getPermissionsHandler = &getPermissionsHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/api/permission", Handler: server.GetPermissionsHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) GetPermissionsHandler(c *routing.Context) error {
+func (server *VisAdminServer) GetPermissionsHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.getPermissionsHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: GetPermissions (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetPermissions (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(GetPermissionsRequest)
-		if len(c.Request.Header["X-Auth"]) > 0 {
-			if err := fromString(c.Request.Header["X-Auth"][0], &request.XAuth); err != nil {
+		if len(r.Header["X-Auth"]) > 0 {
+			if err := fromString(r.Header["X-Auth"][0], &request.XAuth); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: GetPermissions (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeInvalidType, err.Error()))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeRequired, "header parameter 'X-Auth' is required"))
 		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetPermissions (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.getPermissionsHandler.customHandler(c.Request.Context(), request)
+		response := server.getPermissionsHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: GetPermissions (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *GetPermissions200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &GetPermissions304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetPermissions (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -685,34 +957,63 @@ func (server *VisAdminServer) SetDestroySessionHandler(handler DestroySessionHan
 	server.destroySessionHandler = &destroySessionHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "DELETE", Path: "/api/session", Handler: server.DestroySessionHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) DestroySessionHandler(c *routing.Context) error {
+// DestroySessionPrecondition returns the ETag and Last-Modified of the current representation of the resource, which are compared
+// with the conditional headers of a request before it is handled. An empty ETag and a zero time stand for a missing resource.
+type DestroySessionPrecondition func(ctx context.Context, request *DestroySessionRequest) (etag string, lastModified time.Time, err error)
+
+// SetDestroySessionPrecondition sets the precondition of DestroySession requests, a failed precondition is answered with 412 (Precondition Failed)
+func (server *VisAdminServer) SetDestroySessionPrecondition(precondition DestroySessionPrecondition) {
+	server.destroySessionPrecondition = precondition
+}
+
+func (server *VisAdminServer) DestroySessionHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.destroySessionHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: DestroySession (DELETE) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: DestroySession (DELETE) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(DestroySessionRequest)
-		if len(c.Request.Header["X-Auth"]) > 0 {
-			if err := fromString(c.Request.Header["X-Auth"][0], &request.XAuth); err != nil {
+		if len(r.Header["X-Auth"]) > 0 {
+			if err := fromString(r.Header["X-Auth"][0], &request.XAuth); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: DestroySession (DELETE) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeInvalidType, err.Error()))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeRequired, "header parameter 'X-Auth' is required"))
 		}
+		request.IfMatch = r.Header.Get(ifMatchHeader)
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfUnmodifiedSince = parseHTTPTime(r.Header.Get(ifUnmodifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DestroySession (DELETE) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
+		}
+		if server.destroySessionPrecondition != nil && hasPreconditions(r.Header) {
+			etag, lastModified, err := server.destroySessionPrecondition(r.Context(), request)
+			if err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: DestroySession (DELETE) could not evaluate preconditions (error: %v)", err))
+				return NewHTTPStatusCodeError(http.StatusInternalServerError)
+			}
+			if evaluatePreconditions(r, etag, lastModified) != 0 {
+				return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+			}
 		}
-		response := server.destroySessionHandler.customHandler(c.Request.Context(), request)
+		response := server.destroySessionHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: DestroySession (DELETE) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DestroySession (DELETE) could not send response (error: %v)", err))
 			return err
 		}
@@ -731,47 +1032,60 @@ func (server *VisAdminServer) SetGetUserInfoHandler(handler GetUserInfoHandler,
 	server.getUserInfoHandler = &getUserInfoHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/api/session", Handler: server.GetUserInfoHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) GetUserInfoHandler(c *routing.Context) error {
+func (server *VisAdminServer) GetUserInfoHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.getUserInfoHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: GetUserInfo (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetUserInfo (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(GetUserInfoRequest)
-		if len(c.Request.Header["X-Auth"]) > 0 {
-			if err := fromString(c.Request.Header["X-Auth"][0], &request.XAuth); err != nil {
+		if len(r.Header["X-Auth"]) > 0 {
+			if err := fromString(r.Header["X-Auth"][0], &request.XAuth); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: GetUserInfo (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newJsonHTTPError(http.StatusBadRequest, NewParameterError("X-Auth", "header", CodeInvalidType, err.Error()))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newJsonHTTPError(http.StatusBadRequest, NewParameterError("X-Auth", "header", CodeRequired, "header parameter 'X-Auth' is required"))
 		}
-		if len(c.Request.Header["subID"]) > 0 {
-			if err := fromString(c.Request.Header["subID"][0], &request.SubID); err != nil {
+		if len(r.Header["subID"]) > 0 {
+			if err := fromString(r.Header["subID"][0], &request.SubID); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: GetUserInfo (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newJsonHTTPError(http.StatusBadRequest, NewParameterError("subID", "header", CodeInvalidType, err.Error()))
 			}
 		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetUserInfo (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			c.Response.Header()[contentTypeHeader] = []string{contentTypeApplicationJson}
-			c.Response.WriteHeader(http.StatusBadRequest)
-			encodeErr := json.NewEncoder(c.Response).Encode(validationErrors)
-			if encodeErr != nil {
-				server.ErrorLogger(fmt.Sprintf("wrap handler: GetUserInfo (GET) could not encode validation response (error: %v)", encodeErr))
-				return NewHTTPStatusCodeError(http.StatusInternalServerError)
-			}
-			return nil
+			return newJsonHTTPError(http.StatusBadRequest, validationErrors)
 		}
-		response := server.getUserInfoHandler.customHandler(c.Request.Context(), request)
+		response := server.getUserInfoHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: GetUserInfo (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *GetUserInfo200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &GetUserInfo304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetUserInfo (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -790,18 +1104,25 @@ func (server *VisAdminServer) SetCreateSessionHandler(handler CreateSessionHandl
 	server.createSessionHandler = &createSessionHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "POST", Path: "/api/session", Handler: server.CreateSessionHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) CreateSessionHandler(c *routing.Context) error {
+func (server *VisAdminServer) CreateSessionHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.createSessionHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: CreateSession (POST) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateSession (POST) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(CreateSessionRequest)
-		contentTypeOfResponse := extractContentType(c.Request.Header.Get(contentTypeHeader))
+		contentTypeOfResponse := extractContentType(r.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson {
-			err := JSON(c.Request.Body, &request.Body, true)
+			err := JSON(r.Body, &request.Body, true)
 			if err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: CreateSession (POST) could not decode request body of incoming request (%v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newJsonHTTPError(http.StatusBadRequest, NewBodyError("body", err))
 			}
 		} else {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateSession (POST) content type of incoming request is bad (want: application/json, got: %s)", contentTypeOfResponse))
@@ -813,21 +1134,14 @@ func (server *VisAdminServer) CreateSessionHandler(c *routing.Context) error {
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			c.Response.Header()[contentTypeHeader] = []string{contentTypeApplicationJson}
-			c.Response.WriteHeader(http.StatusBadRequest)
-			encodeErr := json.NewEncoder(c.Response).Encode(validationErrors)
-			if encodeErr != nil {
-				server.ErrorLogger(fmt.Sprintf("wrap handler: CreateSession (POST) could not encode validation response (error: %v)", encodeErr))
-				return NewHTTPStatusCodeError(http.StatusInternalServerError)
-			}
-			return nil
+			return newJsonHTTPError(http.StatusBadRequest, validationErrors)
 		}
-		response := server.createSessionHandler.customHandler(c.Request.Context(), request)
+		response := server.createSessionHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: CreateSession (POST) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateSession (POST) could not send response (error: %v)", err))
 			return err
 		}
@@ -846,34 +1160,54 @@ func (server *VisAdminServer) SetGetUsersHandler(handler GetUsersHandler, middle
 	server.getUsersHandler = &getUsersHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/api/user", Handler: server.GetUsersHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) GetUsersHandler(c *routing.Context) error {
+func (server *VisAdminServer) GetUsersHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.getUsersHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: GetUsers (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetUsers (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(GetUsersRequest)
-		if len(c.Request.Header["X-Auth"]) > 0 {
-			if err := fromString(c.Request.Header["X-Auth"][0], &request.XAuth); err != nil {
+		if len(r.Header["X-Auth"]) > 0 {
+			if err := fromString(r.Header["X-Auth"][0], &request.XAuth); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: GetUsers (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeInvalidType, err.Error()))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeRequired, "header parameter 'X-Auth' is required"))
 		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetUsers (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.getUsersHandler.customHandler(c.Request.Context(), request)
+		response := server.getUsersHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: GetUsers (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *GetUsers200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &GetUsers304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetUsers (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -889,47 +1223,76 @@ type deleteUserHandlerRoute struct {
 }
 
 func (server *VisAdminServer) SetDeleteUserHandler(handler DeleteUserHandler, middleware ...Middleware) {
-	server.deleteUserHandler = &deleteUserHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "DELETE", Path: "/api/user/<userId>", Handler: server.DeleteUserHandler, Middleware: middleware}}
+	server.deleteUserHandler = &deleteUserHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "DELETE", Path: "/api/user/{userId}", Handler: server.DeleteUserHandler, Middleware: middleware}}
+}
+
+// DeleteUserPrecondition returns the ETag and Last-Modified of the current representation of the resource, which are compared
+// with the conditional headers of a request before it is handled. An empty ETag and a zero time stand for a missing resource.
+type DeleteUserPrecondition func(ctx context.Context, request *DeleteUserRequest) (etag string, lastModified time.Time, err error)
+
+// SetDeleteUserPrecondition sets the precondition of DeleteUser requests, a failed precondition is answered with 412 (Precondition Failed)
+func (server *VisAdminServer) SetDeleteUserPrecondition(precondition DeleteUserPrecondition) {
+	server.deleteUserPrecondition = precondition
 }
 
-func (server *VisAdminServer) DeleteUserHandler(c *routing.Context) error {
+func (server *VisAdminServer) DeleteUserHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.deleteUserHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: DeleteUser (DELETE) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteUser (DELETE) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(DeleteUserRequest)
-		if err := fromString(c.Param("userId"), &request.UserId); err != nil {
+		if err := fromString(server.PathParam(r, "userId"), &request.UserId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteUser (DELETE) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("userId", "path", CodeInvalidType, err.Error()))
 		}
-		if len(c.Request.Header["X-Auth"]) > 0 {
-			if err := fromString(c.Request.Header["X-Auth"][0], &request.XAuth); err != nil {
+		if len(r.Header["X-Auth"]) > 0 {
+			if err := fromString(r.Header["X-Auth"][0], &request.XAuth); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteUser (DELETE) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeInvalidType, err.Error()))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeRequired, "header parameter 'X-Auth' is required"))
 		}
-		if len(c.Request.URL.Query()["allKeys"]) > 0 {
-			if err := fromString(c.Request.URL.Query()["allKeys"][0], &request.AllKeys); err != nil {
+		if len(r.URL.Query()["allKeys"]) > 0 {
+			if err := fromString(r.URL.Query()["allKeys"][0], &request.AllKeys); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteUser (DELETE) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("allKeys", "query", CodeInvalidType, err.Error()))
 			}
 		}
+		request.IfMatch = r.Header.Get(ifMatchHeader)
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfUnmodifiedSince = parseHTTPTime(r.Header.Get(ifUnmodifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteUser (DELETE) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.deleteUserHandler.customHandler(c.Request.Context(), request)
+		if server.deleteUserPrecondition != nil && hasPreconditions(r.Header) {
+			etag, lastModified, err := server.deleteUserPrecondition(r.Context(), request)
+			if err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteUser (DELETE) could not evaluate preconditions (error: %v)", err))
+				return NewHTTPStatusCodeError(http.StatusInternalServerError)
+			}
+			if evaluatePreconditions(r, etag, lastModified) != 0 {
+				return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+			}
+		}
+		response := server.deleteUserHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: DeleteUser (DELETE) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteUser (DELETE) could not send response (error: %v)", err))
 			return err
 		}
@@ -945,47 +1308,67 @@ type getUserHandlerRoute struct {
 }
 
 func (server *VisAdminServer) SetGetUserHandler(handler GetUserHandler, middleware ...Middleware) {
-	server.getUserHandler = &getUserHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/api/user/<userId>", Handler: server.GetUserHandler, Middleware: middleware}}
+	server.getUserHandler = &getUserHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/api/user/{userId}", Handler: server.GetUserHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) GetUserHandler(c *routing.Context) error {
+func (server *VisAdminServer) GetUserHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.getUserHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: GetUser (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetUser (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(GetUserRequest)
-		if err := fromString(c.Param("userId"), &request.UserId); err != nil {
+		if err := fromString(server.PathParam(r, "userId"), &request.UserId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetUser (GET) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("userId", "path", CodeInvalidType, err.Error()))
 		}
-		if len(c.Request.Header["X-Auth"]) > 0 {
-			if err := fromString(c.Request.Header["X-Auth"][0], &request.XAuth); err != nil {
+		if len(r.Header["X-Auth"]) > 0 {
+			if err := fromString(r.Header["X-Auth"][0], &request.XAuth); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: GetUser (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeInvalidType, err.Error()))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeRequired, "header parameter 'X-Auth' is required"))
 		}
-		if len(c.Request.URL.Query()["allKeys"]) > 0 {
-			if err := fromString(c.Request.URL.Query()["allKeys"][0], &request.AllKeys); err != nil {
+		if len(r.URL.Query()["allKeys"]) > 0 {
+			if err := fromString(r.URL.Query()["allKeys"][0], &request.AllKeys); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: GetUser (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("allKeys", "query", CodeInvalidType, err.Error()))
 			}
 		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetUser (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.getUserHandler.customHandler(c.Request.Context(), request)
+		response := server.getUserHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: GetUser (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *GetUser200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &GetUser304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetUser (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -1001,58 +1384,87 @@ type createOrUpdateUserHandlerRoute struct {
 }
 
 func (server *VisAdminServer) SetCreateOrUpdateUserHandler(handler CreateOrUpdateUserHandler, middleware ...Middleware) {
-	server.createOrUpdateUserHandler = &createOrUpdateUserHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "PUT", Path: "/api/user/<userId>", Handler: server.CreateOrUpdateUserHandler, Middleware: middleware}}
+	server.createOrUpdateUserHandler = &createOrUpdateUserHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "PUT", Path: "/api/user/{userId}", Handler: server.CreateOrUpdateUserHandler, Middleware: middleware}}
+}
+
+// CreateOrUpdateUserPrecondition returns the ETag and Last-Modified of the current representation of the resource, which are compared
+// with the conditional headers of a request before it is handled. An empty ETag and a zero time stand for a missing resource.
+type CreateOrUpdateUserPrecondition func(ctx context.Context, request *CreateOrUpdateUserRequest) (etag string, lastModified time.Time, err error)
+
+// SetCreateOrUpdateUserPrecondition sets the precondition of CreateOrUpdateUser requests, a failed precondition is answered with 412 (Precondition Failed)
+func (server *VisAdminServer) SetCreateOrUpdateUserPrecondition(precondition CreateOrUpdateUserPrecondition) {
+	server.createOrUpdateUserPrecondition = precondition
 }
 
-func (server *VisAdminServer) CreateOrUpdateUserHandler(c *routing.Context) error {
+func (server *VisAdminServer) CreateOrUpdateUserHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.createOrUpdateUserHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: CreateOrUpdateUser (PUT) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateUser (PUT) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(CreateOrUpdateUserRequest)
-		contentTypeOfResponse := extractContentType(c.Request.Header.Get(contentTypeHeader))
+		contentTypeOfResponse := extractContentType(r.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeApplicationJson {
-			err := JSON(c.Request.Body, &request.Body, true)
+			err := JSON(r.Body, &request.Body, true)
 			if err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateUser (PUT) could not decode request body of incoming request (%v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewBodyError("body", err))
 			}
 		} else {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateUser (PUT) content type of incoming request is bad (want: application/json, got: %s)", contentTypeOfResponse))
 			return newNotSupportedContentType(415, contentTypeOfResponse)
 		}
-		if err := fromString(c.Param("userId"), &request.UserId); err != nil {
+		if err := fromString(server.PathParam(r, "userId"), &request.UserId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateUser (PUT) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("userId", "path", CodeInvalidType, err.Error()))
 		}
-		if len(c.Request.Header["X-Auth"]) > 0 {
-			if err := fromString(c.Request.Header["X-Auth"][0], &request.XAuth); err != nil {
+		if len(r.Header["X-Auth"]) > 0 {
+			if err := fromString(r.Header["X-Auth"][0], &request.XAuth); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateUser (PUT) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeInvalidType, err.Error()))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("X-Auth", "header", CodeRequired, "header parameter 'X-Auth' is required"))
 		}
-		if len(c.Request.URL.Query()["allKeys"]) > 0 {
-			if err := fromString(c.Request.URL.Query()["allKeys"][0], &request.AllKeys); err != nil {
+		if len(r.URL.Query()["allKeys"]) > 0 {
+			if err := fromString(r.URL.Query()["allKeys"][0], &request.AllKeys); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateUser (PUT) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("allKeys", "query", CodeInvalidType, err.Error()))
 			}
 		}
+		request.IfMatch = r.Header.Get(ifMatchHeader)
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfUnmodifiedSince = parseHTTPTime(r.Header.Get(ifUnmodifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateUser (PUT) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
+		}
+		if server.createOrUpdateUserPrecondition != nil && hasPreconditions(r.Header) {
+			etag, lastModified, err := server.createOrUpdateUserPrecondition(r.Context(), request)
+			if err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateUser (PUT) could not evaluate preconditions (error: %v)", err))
+				return NewHTTPStatusCodeError(http.StatusInternalServerError)
+			}
+			if evaluatePreconditions(r, etag, lastModified) != 0 {
+				return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+			}
 		}
-		response := server.createOrUpdateUserHandler.customHandler(c.Request.Context(), request)
+		response := server.createOrUpdateUserHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: CreateOrUpdateUser (PUT) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateOrUpdateUser (PUT) could not send response (error: %v)", err))
 			return err
 		}
@@ -1072,7 +1484,67 @@ func (server *VisAdminServer) SetGetBookingHandler(handler GetBookingHandler, mi
 	server.getBookingHandler = &getBookingHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/booking", Handler: server.GetBookingHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) GetBookingHandler(c *routing.Context) error {
+func (server *VisAdminServer) GetBookingHandler(w http.ResponseWriter, r *http.Request) error {
+	if server.getBookingHandler.customHandler == nil {
+		server.ErrorLogger("wrap handler: GetBooking (GET) endpoint is not registered")
+		return NewHTTPStatusCodeError(http.StatusNotFound)
+	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetBooking (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
+		request := new(GetBookingRequest)
+		request.XSessionID = r.Header.Get("X-Session-ID")
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
+		validationErrors, err := server.Validator.ValidateRequest(request)
+		if err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetBooking (GET) could not validate incoming request (error: %v)", err))
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if validationErrors != nil {
+			return newValidationHTTPError(validationErrors)
+		}
+		response := server.getBookingHandler.customHandler(r.Context(), request)
+		if response == nil {
+			server.ErrorLogger("wrap handler: GetBooking (GET) received a nil response object")
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		switch conditional := response.(type) {
+		case *GetBooking200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &GetBooking304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetBooking (GET) could not send response (error: %v)", err))
+			return err
+		}
+	}
+	return nil
+}
+
+// Get booking of session owner, the consumed content type isn't supported
+type GetBookingYamlHandler func(ctx context.Context, request *GetBookingYamlRequest) GetBookingYamlResponse
+
+type getBookingYamlHandlerRoute struct {
+	routeDescription RouteDescription
+	customHandler    GetBookingYamlHandler
+}
+
+func (server *VisAdminServer) SetGetBookingYamlHandler(handler GetBookingYamlHandler, middleware ...Middleware) {
+	server.getBookingYamlHandler = &getBookingYamlHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/booking/yaml", Handler: server.GetBookingYamlHandler, Middleware: middleware}}
+}
+
+func (server *VisAdminServer) GetBookingYamlHandler(w http.ResponseWriter, r *http.Request) error {
 	return newNotSupportedContentType(415, "no supported content type")
 }
 
@@ -1088,39 +1560,59 @@ func (server *VisAdminServer) SetGetBookingsHandler(handler GetBookingsHandler,
 	server.getBookingsHandler = &getBookingsHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/bookings", Handler: server.GetBookingsHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) GetBookingsHandler(c *routing.Context) error {
+func (server *VisAdminServer) GetBookingsHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.getBookingsHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: GetBookings (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetBookings (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(GetBookingsRequest)
-		request.XSessionID = c.Request.Header.Get("X-Session-ID")
-		if len(c.Request.Header["date"]) > 0 {
-			if err := fromString(c.Request.Header["date"][0], &request.Date); err != nil {
+		request.XSessionID = r.Header.Get("X-Session-ID")
+		if len(r.Header["date"]) > 0 {
+			if err := fromString(r.Header["date"][0], &request.Date); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: GetBookings (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("date", "header", CodeInvalidType, err.Error()))
 			}
 		}
-		if len(c.Request.URL.Query()["ids"]) > 0 {
-			if err := fromString(c.Request.URL.Query()["ids"][0], &request.Ids); err != nil {
+		if len(r.URL.Query()["ids"]) > 0 {
+			if err := fromString(r.URL.Query()["ids"][0], &request.Ids); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: GetBookings (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("ids", "query", CodeInvalidType, err.Error()))
 			}
 		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetBookings (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.getBookingsHandler.customHandler(c.Request.Context(), request)
+		response := server.getBookingsHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: GetBookings (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *GetBookings200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &GetBookings304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetBookings (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -1136,63 +1628,83 @@ type listModelsHandlerRoute struct {
 }
 
 func (server *VisAdminServer) SetListModelsHandler(handler ListModelsHandler, middleware ...Middleware) {
-	server.listModelsHandler = &listModelsHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/brands/<brandId>/models", Handler: server.ListModelsHandler, Middleware: middleware}}
+	server.listModelsHandler = &listModelsHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/brands/{brandId}/models", Handler: server.ListModelsHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) ListModelsHandler(c *routing.Context) error {
+func (server *VisAdminServer) ListModelsHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.listModelsHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: ListModels (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ListModels (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(ListModelsRequest)
-		if err := fromString(c.Param("brandId"), &request.BrandId); err != nil {
+		if err := fromString(server.PathParam(r, "brandId"), &request.BrandId); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: ListModels (GET) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("brandId", "path", CodeInvalidType, err.Error()))
 		}
-		if len(c.Request.URL.Query()["driveConcept"]) > 0 {
-			if err := fromString(c.Request.URL.Query()["driveConcept"][0], &request.DriveConcept); err != nil {
+		if len(r.URL.Query()["driveConcept"]) > 0 {
+			if err := fromString(r.URL.Query()["driveConcept"][0], &request.DriveConcept); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: ListModels (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("driveConcept", "query", CodeInvalidType, err.Error()))
 			}
 		}
-		if len(c.Request.URL.Query()["languageId"]) > 0 {
-			if err := fromString(c.Request.URL.Query()["languageId"][0], &request.LanguageId); err != nil {
+		if len(r.URL.Query()["languageId"]) > 0 {
+			if err := fromString(r.URL.Query()["languageId"][0], &request.LanguageId); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: ListModels (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("languageId", "query", CodeInvalidType, err.Error()))
 			}
 		}
-		if len(c.Request.URL.Query()["classId"]) > 0 {
-			if err := fromString(c.Request.URL.Query()["classId"][0], &request.ClassId); err != nil {
+		if len(r.URL.Query()["classId"]) > 0 {
+			if err := fromString(r.URL.Query()["classId"][0], &request.ClassId); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: ListModels (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("classId", "query", CodeInvalidType, err.Error()))
 			}
 		}
-		if len(c.Request.URL.Query()["lineId"]) > 0 {
-			if err := fromString(c.Request.URL.Query()["lineId"][0], &request.LineId); err != nil {
+		if len(r.URL.Query()["lineId"]) > 0 {
+			if err := fromString(r.URL.Query()["lineId"][0], &request.LineId); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: ListModels (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("lineId", "query", CodeInvalidType, err.Error()))
 			}
 		}
-		if len(c.Request.URL.Query()["ids"]) > 0 {
-			if err := fromString(c.Request.URL.Query()["ids"][0], &request.Ids); err != nil {
+		if len(r.URL.Query()["ids"]) > 0 {
+			if err := fromString(r.URL.Query()["ids"][0], &request.Ids); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: ListModels (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("ids", "query", CodeInvalidType, err.Error()))
 			}
 		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: ListModels (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.listModelsHandler.customHandler(c.Request.Context(), request)
+		response := server.listModelsHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: ListModels (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *ListModels200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &ListModels304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: ListModels (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -1208,39 +1720,59 @@ type getClassesHandlerRoute struct {
 }
 
 func (server *VisAdminServer) SetGetClassesHandler(handler GetClassesHandler, middleware ...Middleware) {
-	server.getClassesHandler = &getClassesHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/classes/<productGroup>", Handler: server.GetClassesHandler, Middleware: middleware}}
+	server.getClassesHandler = &getClassesHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/classes/{productGroup}", Handler: server.GetClassesHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) GetClassesHandler(c *routing.Context) error {
+func (server *VisAdminServer) GetClassesHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.getClassesHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: GetClasses (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetClasses (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(GetClassesRequest)
-		if err := fromString(c.Param("productGroup"), &request.ProductGroup); err != nil {
+		if err := fromString(server.PathParam(r, "productGroup"), &request.ProductGroup); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetClasses (GET) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("productGroup", "path", CodeInvalidType, err.Error()))
 		}
-		if len(c.Request.URL.Query()["componentTypes"]) > 0 {
-			if err := fromString(c.Request.URL.Query()["componentTypes"][0], &request.ComponentTypes); err != nil {
+		if len(r.URL.Query()["componentTypes"]) > 0 {
+			if err := fromString(r.URL.Query()["componentTypes"][0], &request.ComponentTypes); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: GetClasses (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("componentTypes", "query", CodeInvalidType, err.Error()))
 			}
 		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetClasses (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.getClassesHandler.customHandler(c.Request.Context(), request)
+		response := server.getClassesHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: GetClasses (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *GetClasses200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &GetClasses304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetClasses (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -1259,16 +1791,23 @@ func (server *VisAdminServer) SetCodeHandler(handler CodeHandler, middleware ...
 	server.codeHandler = &codeHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "POST", Path: "/code", Handler: server.CodeHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) CodeHandler(c *routing.Context) error {
+func (server *VisAdminServer) CodeHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.codeHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: Code (POST) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: Code (POST) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(CodeRequest)
-		contentTypeOfResponse := extractContentType(c.Request.Header.Get(contentTypeHeader))
+		contentTypeOfResponse := extractContentType(r.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeMultipartFormData {
 		} else if contentTypeOfResponse == contentTypeApplicationFormUrlencoded {
-			rawBody, err := ioutil.ReadAll(c.Request.Body)
+			rawBody, err := ioutil.ReadAll(r.Body)
 			if err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: Code (POST) could not read body of incoming request (error: %v)", err))
 				return NewHTTPStatusCodeError(http.StatusInternalServerError)
@@ -1281,33 +1820,33 @@ func (server *VisAdminServer) CodeHandler(c *routing.Context) error {
 			if len(queryInBody["state"]) > 0 {
 				if err := fromString(queryInBody.Get("state"), &request.State); err != nil {
 					server.ErrorLogger(fmt.Sprintf("wrap handler: Code (POST) could not convert string to specific type (error: %v)", err))
-					return NewHTTPStatusCodeError(http.StatusBadRequest)
+					return newValidationHTTPError(NewParameterError("state", "formData", CodeInvalidType, err.Error()))
 				}
 			}
 			if len(queryInBody["response_mode"]) > 0 {
 				if err := fromString(queryInBody.Get("response_mode"), &request.ResponseMode); err != nil {
 					server.ErrorLogger(fmt.Sprintf("wrap handler: Code (POST) could not convert string to specific type (error: %v)", err))
-					return NewHTTPStatusCodeError(http.StatusBadRequest)
+					return newValidationHTTPError(NewParameterError("response_mode", "formData", CodeInvalidType, err.Error()))
 				}
 			}
 			if len(queryInBody["code"]) > 0 {
 				if err := fromString(queryInBody.Get("code"), &request.Code); err != nil {
 					server.ErrorLogger(fmt.Sprintf("wrap handler: Code (POST) could not convert string to specific type (error: %v)", err))
-					return NewHTTPStatusCodeError(http.StatusBadRequest)
+					return newValidationHTTPError(NewParameterError("code", "formData", CodeInvalidType, err.Error()))
 				}
 			} else {
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("code", "formData", CodeRequired, "formData parameter 'code' is required"))
 			}
 		} else {
 			return newNotSupportedContentType(415, contentTypeOfResponse)
 		}
-		if len(c.Request.URL.Query()["session"]) > 0 {
-			if err := fromString(c.Request.URL.Query()["session"][0], &request.Session); err != nil {
+		if len(r.URL.Query()["session"]) > 0 {
+			if err := fromString(r.URL.Query()["session"][0], &request.Session); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: Code (POST) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("session", "query", CodeInvalidType, err.Error()))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("session", "query", CodeRequired, "query parameter 'session' is required"))
 		}
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
@@ -1315,14 +1854,14 @@ func (server *VisAdminServer) CodeHandler(c *routing.Context) error {
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.codeHandler.customHandler(c.Request.Context(), request)
+		response := server.codeHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: Code (POST) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: Code (POST) could not send response (error: %v)", err))
 			return err
 		}
@@ -1344,33 +1883,62 @@ func (server *VisAdminServer) SetDeleteCustomerSessionHandler(handler DeleteCust
 	server.deleteCustomerSessionHandler = &deleteCustomerSessionHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "DELETE", Path: "/customer/session", Handler: server.DeleteCustomerSessionHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) DeleteCustomerSessionHandler(c *routing.Context) error {
+// DeleteCustomerSessionPrecondition returns the ETag and Last-Modified of the current representation of the resource, which are compared
+// with the conditional headers of a request before it is handled. An empty ETag and a zero time stand for a missing resource.
+type DeleteCustomerSessionPrecondition func(ctx context.Context, request *DeleteCustomerSessionRequest) (etag string, lastModified time.Time, err error)
+
+// SetDeleteCustomerSessionPrecondition sets the precondition of DeleteCustomerSession requests, a failed precondition is answered with 412 (Precondition Failed)
+func (server *VisAdminServer) SetDeleteCustomerSessionPrecondition(precondition DeleteCustomerSessionPrecondition) {
+	server.deleteCustomerSessionPrecondition = precondition
+}
+
+func (server *VisAdminServer) DeleteCustomerSessionHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.deleteCustomerSessionHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: DeleteCustomerSession (DELETE) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteCustomerSession (DELETE) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(DeleteCustomerSessionRequest)
-		request.XSessionID = c.Request.Header.Get("X-Session-ID")
-		if len(c.Request.Header["X-Request-ID"]) > 0 {
-			if err := fromString(c.Request.Header["X-Request-ID"][0], &request.XRequestID); err != nil {
+		request.XSessionID = r.Header.Get("X-Session-ID")
+		if len(r.Header["X-Request-ID"]) > 0 {
+			if err := fromString(r.Header["X-Request-ID"][0], &request.XRequestID); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteCustomerSession (DELETE) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("X-Request-ID", "header", CodeInvalidType, err.Error()))
 			}
 		}
+		request.IfMatch = r.Header.Get(ifMatchHeader)
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfUnmodifiedSince = parseHTTPTime(r.Header.Get(ifUnmodifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteCustomerSession (DELETE) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
+		}
+		if server.deleteCustomerSessionPrecondition != nil && hasPreconditions(r.Header) {
+			etag, lastModified, err := server.deleteCustomerSessionPrecondition(r.Context(), request)
+			if err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteCustomerSession (DELETE) could not evaluate preconditions (error: %v)", err))
+				return NewHTTPStatusCodeError(http.StatusInternalServerError)
+			}
+			if evaluatePreconditions(r, etag, lastModified) != 0 {
+				return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+			}
 		}
-		response := server.deleteCustomerSessionHandler.customHandler(c.Request.Context(), request)
+		response := server.deleteCustomerSessionHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: DeleteCustomerSession (DELETE) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DeleteCustomerSession (DELETE) could not send response (error: %v)", err))
 			return err
 		}
@@ -1392,16 +1960,23 @@ func (server *VisAdminServer) SetCreateCustomerSessionHandler(handler CreateCust
 	server.createCustomerSessionHandler = &createCustomerSessionHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "POST", Path: "/customer/session", Handler: server.CreateCustomerSessionHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) CreateCustomerSessionHandler(c *routing.Context) error {
+func (server *VisAdminServer) CreateCustomerSessionHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.createCustomerSessionHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: CreateCustomerSession (POST) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateCustomerSession (POST) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(CreateCustomerSessionRequest)
-		contentTypeOfResponse := extractContentType(c.Request.Header.Get(contentTypeHeader))
+		contentTypeOfResponse := extractContentType(r.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeMultipartFormData {
 		} else if contentTypeOfResponse == contentTypeApplicationFormUrlencoded {
-			rawBody, err := ioutil.ReadAll(c.Request.Body)
+			rawBody, err := ioutil.ReadAll(r.Body)
 			if err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: CreateCustomerSession (POST) could not read body of incoming request (error: %v)", err))
 				return NewHTTPStatusCodeError(http.StatusInternalServerError)
@@ -1414,24 +1989,24 @@ func (server *VisAdminServer) CreateCustomerSessionHandler(c *routing.Context) e
 			if len(queryInBody["code"]) > 0 {
 				if err := fromString(queryInBody.Get("code"), &request.Code); err != nil {
 					server.ErrorLogger(fmt.Sprintf("wrap handler: CreateCustomerSession (POST) could not convert string to specific type (error: %v)", err))
-					return NewHTTPStatusCodeError(http.StatusBadRequest)
+					return newValidationHTTPError(NewParameterError("code", "formData", CodeInvalidType, err.Error()))
 				}
 			} else {
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("code", "formData", CodeRequired, "formData parameter 'code' is required"))
 			}
 			if len(queryInBody["locale"]) > 0 {
 				if err := fromString(queryInBody.Get("locale"), &request.Locale); err != nil {
 					server.ErrorLogger(fmt.Sprintf("wrap handler: CreateCustomerSession (POST) could not convert string to specific type (error: %v)", err))
-					return NewHTTPStatusCodeError(http.StatusBadRequest)
+					return newValidationHTTPError(NewParameterError("locale", "formData", CodeInvalidType, err.Error()))
 				}
 			}
 		} else {
 			return newNotSupportedContentType(415, contentTypeOfResponse)
 		}
-		if len(c.Request.Header["X-Request-ID"]) > 0 {
-			if err := fromString(c.Request.Header["X-Request-ID"][0], &request.XRequestID); err != nil {
+		if len(r.Header["X-Request-ID"]) > 0 {
+			if err := fromString(r.Header["X-Request-ID"][0], &request.XRequestID); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: CreateCustomerSession (POST) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("X-Request-ID", "header", CodeInvalidType, err.Error()))
 			}
 		}
 		validationErrors, err := server.Validator.ValidateRequest(request)
@@ -1440,14 +2015,14 @@ func (server *VisAdminServer) CreateCustomerSessionHandler(c *routing.Context) e
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.createCustomerSessionHandler.customHandler(c.Request.Context(), request)
+		response := server.createCustomerSessionHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: CreateCustomerSession (POST) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: CreateCustomerSession (POST) could not send response (error: %v)", err))
 			return err
 		}
@@ -1469,26 +2044,46 @@ func (server *VisAdminServer) SetDownloadNestedFileHandler(handler DownloadNeste
 	server.downloadNestedFileHandler = &downloadNestedFileHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/download/nested/file", Handler: server.DownloadNestedFileHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) DownloadNestedFileHandler(c *routing.Context) error {
+func (server *VisAdminServer) DownloadNestedFileHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.downloadNestedFileHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: DownloadNestedFile (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: DownloadNestedFile (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(DownloadNestedFileRequest)
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DownloadNestedFile (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.downloadNestedFileHandler.customHandler(c.Request.Context(), request)
+		response := server.downloadNestedFileHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: DownloadNestedFile (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *DownloadNestedFile200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &DownloadNestedFile304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DownloadNestedFile (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -1505,33 +2100,59 @@ type downloadImageHandlerRoute struct {
 }
 
 func (server *VisAdminServer) SetDownloadImageHandler(handler DownloadImageHandler, middleware ...Middleware) {
-	server.downloadImageHandler = &downloadImageHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/download/<image>", Handler: server.DownloadImageHandler, Middleware: middleware}}
+	server.downloadImageHandler = &downloadImageHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/download/{image}", Handler: server.DownloadImageHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) DownloadImageHandler(c *routing.Context) error {
+func (server *VisAdminServer) DownloadImageHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.downloadImageHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: DownloadImage (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"image/png"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: DownloadImage (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(DownloadImageRequest)
-		if err := fromString(c.Param("image"), &request.Image); err != nil {
+		if err := fromString(server.PathParam(r, "image"), &request.Image); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DownloadImage (GET) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("image", "path", CodeInvalidType, err.Error()))
 		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DownloadImage (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.downloadImageHandler.customHandler(c.Request.Context(), request)
+		response := server.downloadImageHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: DownloadImage (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *DownloadImage200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					if conditional.Body != nil {
+						conditional.Body.Close()
+					}
+					response = &DownloadImage304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					if conditional.Body != nil {
+						conditional.Body.Close()
+					}
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: DownloadImage (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -1550,44 +2171,64 @@ func (server *VisAdminServer) SetListElementsHandler(handler ListElementsHandler
 	server.listElementsHandler = &listElementsHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/elements", Handler: server.ListElementsHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) ListElementsHandler(c *routing.Context) error {
+func (server *VisAdminServer) ListElementsHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.listElementsHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: ListElements (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ListElements (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(ListElementsRequest)
-		if len(c.Request.URL.Query()["_page"]) > 0 {
-			if err := fromString(c.Request.URL.Query()["_page"][0], &request.Page); err != nil {
+		if len(r.URL.Query()["_page"]) > 0 {
+			if err := fromString(r.URL.Query()["_page"][0], &request.Page); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: ListElements (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("_page", "query", CodeInvalidType, err.Error()))
 			}
 		} else {
 			request.Page = new(int64)
 			*request.Page = 1
 		}
-		if len(c.Request.URL.Query()["_perPage"]) > 0 {
-			if err := fromString(c.Request.URL.Query()["_perPage"][0], &request.PerPage); err != nil {
+		if len(r.URL.Query()["_perPage"]) > 0 {
+			if err := fromString(r.URL.Query()["_perPage"][0], &request.PerPage); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: ListElements (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("_perPage", "query", CodeInvalidType, err.Error()))
 			}
 		} else {
 			request.PerPage = new(int64)
 			*request.PerPage = 10
 		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: ListElements (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.listElementsHandler.customHandler(c.Request.Context(), request)
+		response := server.listElementsHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: ListElements (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *ListElements200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &ListElements304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: ListElements (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -1606,21 +2247,32 @@ func (server *VisAdminServer) SetFileUploadHandler(handler FileUploadHandler, mi
 	server.fileUploadHandler = &fileUploadHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "POST", Path: "/file-upload", Handler: server.FileUploadHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) FileUploadHandler(c *routing.Context) error {
+func (server *VisAdminServer) FileUploadHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.fileUploadHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: FileUpload (POST) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: FileUpload (POST) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(FileUploadRequest)
-		contentTypeOfResponse := extractContentType(c.Request.Header.Get(contentTypeHeader))
+		contentTypeOfResponse := extractContentType(r.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeMultipartFormData {
+			uploadLimits := server.UploadLimits
+			limitUploads(r, uploadLimits)
 			formData := &request.FormData
-			file0, extractErr0 := extractUpload("file", c.Request)
+			files0, extractErr0 := extractUploads("file", r, uploadLimits, nil)
 			if extractErr0 != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: FileUpload (POST) could not extract upload from incoming request (error: %v)", extractErr0))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return NewHTTPStatusCodeError(uploadErrorStatus(extractErr0))
+			}
+			if len(files0) > 0 {
+				formData.File = &files0[0]
 			}
-			formData.File = file0
 		} else if contentTypeOfResponse == contentTypeApplicationFormUrlencoded {
 		} else {
 			return newNotSupportedContentType(415, contentTypeOfResponse)
@@ -1631,14 +2283,14 @@ func (server *VisAdminServer) FileUploadHandler(c *routing.Context) error {
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.fileUploadHandler.customHandler(c.Request.Context(), request)
+		response := server.fileUploadHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: FileUpload (POST) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: FileUpload (POST) could not send response (error: %v)", err))
 			return err
 		}
@@ -1655,11 +2307,64 @@ type downloadFileHandlerRoute struct {
 }
 
 func (server *VisAdminServer) SetDownloadFileHandler(handler DownloadFileHandler, middleware ...Middleware) {
-	server.downloadFileHandler = &downloadFileHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/filedownload/<file>", Handler: server.DownloadFileHandler, Middleware: middleware}}
+	server.downloadFileHandler = &downloadFileHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/filedownload/{file}", Handler: server.DownloadFileHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) DownloadFileHandler(c *routing.Context) error {
-	return newNotSupportedContentType(415, "no supported content type")
+func (server *VisAdminServer) DownloadFileHandler(w http.ResponseWriter, r *http.Request) error {
+	if server.downloadFileHandler.customHandler == nil {
+		server.ErrorLogger("wrap handler: DownloadFile (GET) endpoint is not registered")
+		return NewHTTPStatusCodeError(http.StatusNotFound)
+	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"text/xml"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: DownloadFile (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
+		request := new(DownloadFileRequest)
+		if err := fromString(server.PathParam(r, "file"), &request.File); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: DownloadFile (GET) could not convert string to specific type (error: %v)", err))
+			return newValidationHTTPError(NewParameterError("file", "path", CodeInvalidType, err.Error()))
+		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
+		validationErrors, err := server.Validator.ValidateRequest(request)
+		if err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: DownloadFile (GET) could not validate incoming request (error: %v)", err))
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if validationErrors != nil {
+			return newValidationHTTPError(validationErrors)
+		}
+		response := server.downloadFileHandler.customHandler(r.Context(), request)
+		if response == nil {
+			server.ErrorLogger("wrap handler: DownloadFile (GET) received a nil response object")
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		switch conditional := response.(type) {
+		case *DownloadFile200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					if conditional.Body != nil {
+						conditional.Body.Close()
+					}
+					response = &DownloadFile304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					if conditional.Body != nil {
+						conditional.Body.Close()
+					}
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: DownloadFile (GET) could not send response (error: %v)", err))
+			return err
+		}
+	}
+	return nil
 }
 
 // Multiple tags can be provided with comma separated strings. Use tag1, tag2, tag3 for testing.
@@ -1674,42 +2379,62 @@ func (server *VisAdminServer) SetFindByTagsHandler(handler FindByTagsHandler, mi
 	server.findByTagsHandler = &findByTagsHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/findByTags", Handler: server.FindByTagsHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) FindByTagsHandler(c *routing.Context) error {
+func (server *VisAdminServer) FindByTagsHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.findByTagsHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: FindByTags (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: FindByTags (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(FindByTagsRequest)
-		if len(c.Request.URL.Query()["tags"]) > 0 {
-			if err := fromString(c.Request.URL.Query()["tags"][0], &request.Tags); err != nil {
+		if len(r.URL.Query()["tags"]) > 0 {
+			if err := fromString(r.URL.Query()["tags"][0], &request.Tags); err != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: FindByTags (GET) could not convert string to specific type (error: %v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("tags", "query", CodeInvalidType, err.Error()))
 			}
 			// minItems validator constrain at least 2 items
 			if len(request.Tags) < 2 {
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("tags", "query", "invalid-min", "query parameter 'tags' requires at least 2 items"))
 			}
 			// maxItems validator constrain at maximum 5 items
 			if len(request.Tags) > 5 {
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return newValidationHTTPError(NewParameterError("tags", "query", "invalid-max", "query parameter 'tags' allows at maximum 5 items"))
 			}
 		} else {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("tags", "query", CodeRequired, "query parameter 'tags' is required"))
 		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: FindByTags (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.findByTagsHandler.customHandler(c.Request.Context(), request)
+		response := server.findByTagsHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: FindByTags (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *FindByTags200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &FindByTags304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: FindByTags (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -1726,33 +2451,59 @@ type genericFileDownloadHandlerRoute struct {
 }
 
 func (server *VisAdminServer) SetGenericFileDownloadHandler(handler GenericFileDownloadHandler, middleware ...Middleware) {
-	server.genericFileDownloadHandler = &genericFileDownloadHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/generic/download/<ext>", Handler: server.GenericFileDownloadHandler, Middleware: middleware}}
+	server.genericFileDownloadHandler = &genericFileDownloadHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/generic/download/{ext}", Handler: server.GenericFileDownloadHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) GenericFileDownloadHandler(c *routing.Context) error {
+func (server *VisAdminServer) GenericFileDownloadHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.genericFileDownloadHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: GenericFileDownload (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GenericFileDownload (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(GenericFileDownloadRequest)
-		if err := fromString(c.Param("ext"), &request.Ext); err != nil {
+		if err := fromString(server.PathParam(r, "ext"), &request.Ext); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GenericFileDownload (GET) could not convert string to specific type (error: %v)", err))
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(NewParameterError("ext", "path", CodeInvalidType, err.Error()))
 		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GenericFileDownload (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.genericFileDownloadHandler.customHandler(c.Request.Context(), request)
+		response := server.genericFileDownloadHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: GenericFileDownload (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *GenericFileDownload200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					if conditional.Body != nil {
+						conditional.Body.Close()
+					}
+					response = &GenericFileDownload304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					if conditional.Body != nil {
+						conditional.Body.Close()
+					}
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GenericFileDownload (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -1760,56 +2511,905 @@ func (server *VisAdminServer) GenericFileDownloadHandler(c *routing.Context) err
 	return nil
 }
 
-// get rental
-type GetRentalHandler func(ctx context.Context, request *GetRentalRequest) GetRentalResponse
+// Validates the constraints of path, header and form data parameters
+type ValidateParametersHandler func(ctx context.Context, request *ValidateParametersRequest) ValidateParametersResponse
 
-type getRentalHandlerRoute struct {
+type validateParametersHandlerRoute struct {
 	routeDescription RouteDescription
-	customHandler    GetRentalHandler
+	customHandler    ValidateParametersHandler
 }
 
-func (server *VisAdminServer) SetGetRentalHandler(handler GetRentalHandler, middleware ...Middleware) {
-	server.getRentalHandler = &getRentalHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/rental", Handler: server.GetRentalHandler, Middleware: middleware}}
+func (server *VisAdminServer) SetValidateParametersHandler(handler ValidateParametersHandler, middleware ...Middleware) {
+	server.validateParametersHandler = &validateParametersHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "POST", Path: "/parameters/{id}", Handler: server.ValidateParametersHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) GetRentalHandler(c *routing.Context) error {
-	if server.getRentalHandler.customHandler == nil {
-		server.ErrorLogger("wrap handler: GetRental (GET) endpoint is not registered")
+func (server *VisAdminServer) ValidateParametersHandler(w http.ResponseWriter, r *http.Request) error {
+	if server.validateParametersHandler.customHandler == nil {
+		server.ErrorLogger("wrap handler: ValidateParameters (POST) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
-		request := new(GetRentalRequest)
-		contentTypeOfResponse := extractContentType(c.Request.Header.Get(contentTypeHeader))
-		if contentTypeOfResponse == contentTypeApplicationJson {
-			err := JSON(c.Request.Body, &request.Body, true)
-			if err != nil {
-				server.ErrorLogger(fmt.Sprintf("wrap handler: GetRental (GET) could not decode request body of incoming request (%v)", err))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ValidateParameters (POST) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
+		request := new(ValidateParametersRequest)
+		contentTypeOfResponse := extractContentType(r.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeMultipartFormData {
+			uploadLimits := server.UploadLimits
+			limitUploads(r, uploadLimits)
+			if err := r.ParseMultipartForm(1024); err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: ValidateParameters (POST) could not parse multipart form of incoming request (error: %v)", err))
+				return NewHTTPStatusCodeError(uploadErrorStatus(err))
 			}
+			formData := &request.FormData
+			if len(r.Form["ratio"]) > 0 {
+				if err := fromString(r.Form["ratio"][0], &formData.Ratio); err != nil {
+					server.ErrorLogger(fmt.Sprintf("wrap handler: ValidateParameters (POST) could not convert string to specific type (error: %v)", err))
+					return newJsonHTTPError(http.StatusBadRequest, NewParameterError("ratio", "formData", CodeInvalidType, err.Error()))
+				}
+			}
+			if len(r.Form["label"]) > 0 {
+				if err := fromString(r.Form["label"][0], &formData.Label); err != nil {
+					server.ErrorLogger(fmt.Sprintf("wrap handler: ValidateParameters (POST) could not convert string to specific type (error: %v)", err))
+					return newJsonHTTPError(http.StatusBadRequest, NewParameterError("label", "formData", CodeInvalidType, err.Error()))
+				}
+			}
+		} else if contentTypeOfResponse == contentTypeApplicationFormUrlencoded {
 		} else {
-			server.ErrorLogger(fmt.Sprintf("wrap handler: GetRental (GET) content type of incoming request is bad (want: application/json, got: %s)", contentTypeOfResponse))
 			return newNotSupportedContentType(415, contentTypeOfResponse)
 		}
+		if err := fromString(server.PathParam(r, "id"), &request.Id); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ValidateParameters (POST) could not convert string to specific type (error: %v)", err))
+			return newJsonHTTPError(http.StatusBadRequest, NewParameterError("id", "path", CodeInvalidType, err.Error()))
+		}
+		if len(r.Header["X-Mode"]) > 0 {
+			if err := fromString(r.Header["X-Mode"][0], &request.XMode); err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: ValidateParameters (POST) could not convert string to specific type (error: %v)", err))
+				return newJsonHTTPError(http.StatusBadRequest, NewParameterError("X-Mode", "header", CodeInvalidType, err.Error()))
+			}
+		}
+		if len(r.Header["X-Limit"]) > 0 {
+			if err := fromString(r.Header["X-Limit"][0], &request.XLimit); err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: ValidateParameters (POST) could not convert string to specific type (error: %v)", err))
+				return newJsonHTTPError(http.StatusBadRequest, NewParameterError("X-Limit", "header", CodeInvalidType, err.Error()))
+			}
+		}
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
-			server.ErrorLogger(fmt.Sprintf("wrap handler: GetRental (GET) could not validate incoming request (error: %v)", err))
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ValidateParameters (POST) could not validate incoming request (error: %v)", err))
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if validationErrors != nil {
+			return newJsonHTTPError(http.StatusBadRequest, validationErrors)
+		}
+		response := server.validateParametersHandler.customHandler(r.Context(), request)
+		if response == nil {
+			server.ErrorLogger("wrap handler: ValidateParameters (POST) received a nil response object")
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ValidateParameters (POST) could not send response (error: %v)", err))
+			return err
+		}
+	}
+	return nil
+}
+
+// Lists the partners, the list is streamed as newline delimited JSON if accepted
+type ListPartnersHandler func(ctx context.Context, request *ListPartnersRequest) ListPartnersResponse
+
+type listPartnersHandlerRoute struct {
+	routeDescription RouteDescription
+	customHandler    ListPartnersHandler
+}
+
+func (server *VisAdminServer) SetListPartnersHandler(handler ListPartnersHandler, middleware ...Middleware) {
+	server.listPartnersHandler = &listPartnersHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/partners", Handler: server.ListPartnersHandler, Middleware: middleware}}
+}
+
+func (server *VisAdminServer) ListPartnersHandler(w http.ResponseWriter, r *http.Request) error {
+	if server.listPartnersHandler.customHandler == nil {
+		server.ErrorLogger("wrap handler: ListPartners (GET) endpoint is not registered")
+		return NewHTTPStatusCodeError(http.StatusNotFound)
+	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json", "application/x-ndjson"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ListPartners (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
+		request := new(ListPartnersRequest)
+		if len(r.URL.Query()["limit"]) > 0 {
+			if err := fromString(r.URL.Query()["limit"][0], &request.Limit); err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: ListPartners (GET) could not convert string to specific type (error: %v)", err))
+				return newJsonHTTPError(http.StatusBadRequest, NewParameterError("limit", "query", CodeInvalidType, err.Error()))
+			}
+		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
+		validationErrors, err := server.Validator.ValidateRequest(request)
+		if err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ListPartners (GET) could not validate incoming request (error: %v)", err))
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if validationErrors != nil {
+			return newJsonHTTPError(http.StatusBadRequest, validationErrors)
+		}
+		response := server.listPartnersHandler.customHandler(r.Context(), request)
+		if response == nil {
+			server.ErrorLogger("wrap handler: ListPartners (GET) received a nil response object")
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		switch conditional := response.(type) {
+		case *ListPartners200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &ListPartners304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ListPartners (GET) could not send response (error: %v)", err))
+			return err
+		}
+	}
+	return nil
+}
+
+// Creates a partner from a XML document
+type CreatePartnerHandler func(ctx context.Context, request *CreatePartnerRequest) CreatePartnerResponse
+
+type createPartnerHandlerRoute struct {
+	routeDescription RouteDescription
+	customHandler    CreatePartnerHandler
+}
+
+func (server *VisAdminServer) SetCreatePartnerHandler(handler CreatePartnerHandler, middleware ...Middleware) {
+	server.createPartnerHandler = &createPartnerHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "POST", Path: "/partners", Handler: server.CreatePartnerHandler, Middleware: middleware}}
+}
+
+func (server *VisAdminServer) CreatePartnerHandler(w http.ResponseWriter, r *http.Request) error {
+	if server.createPartnerHandler.customHandler == nil {
+		server.ErrorLogger("wrap handler: CreatePartner (POST) endpoint is not registered")
+		return NewHTTPStatusCodeError(http.StatusNotFound)
+	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/xml", "application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: CreatePartner (POST) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
+		request := new(CreatePartnerRequest)
+		contentTypeOfResponse := extractContentType(r.Header.Get(contentTypeHeader))
+		if contentTypeInList([]string{"application/xml", "text/xml"}, contentTypeOfResponse) {
+			err := XML(r.Body, &request.Body, true)
+			if err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: CreatePartner (POST) could not decode request body of incoming request (%v)", err))
+				if contentTypeInList([]string{"application/xml"}, negotiatedContentType) {
+					return newXmlValidationHTTPError(negotiatedContentType, NewBodyError("body", err), new(ValidationErrors))
+				}
+				return newJsonHTTPError(http.StatusBadRequest, NewBodyError("body", err))
+			}
+		} else {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: CreatePartner (POST) content type of incoming request is bad (want: application/xml, text/xml, got: %s)", contentTypeOfResponse))
+			return newNotSupportedContentType(415, contentTypeOfResponse)
+		}
+		validationErrors, err := server.Validator.ValidateRequest(request)
+		if err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: CreatePartner (POST) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			c.Response.Header()[contentTypeHeader] = []string{contentTypeApplicationJson}
-			c.Response.WriteHeader(http.StatusBadRequest)
-			encodeErr := json.NewEncoder(c.Response).Encode(validationErrors)
-			if encodeErr != nil {
-				server.ErrorLogger(fmt.Sprintf("wrap handler: GetRental (GET) could not encode validation response (error: %v)", encodeErr))
+			if contentTypeInList([]string{"application/xml"}, negotiatedContentType) {
+				return newXmlValidationHTTPError(negotiatedContentType, validationErrors, new(ValidationErrors))
+			}
+			return newJsonHTTPError(http.StatusBadRequest, validationErrors)
+		}
+		response := server.createPartnerHandler.customHandler(r.Context(), request)
+		if response == nil {
+			server.ErrorLogger("wrap handler: CreatePartner (POST) received a nil response object")
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: CreatePartner (POST) could not send response (error: %v)", err))
+			return err
+		}
+	}
+	return nil
+}
+
+// Streams the changed partners as server-sent events
+type WatchPartnersHandler func(ctx context.Context, request *WatchPartnersRequest, events *WatchPartnersEventStream) WatchPartnersResponse
+
+type watchPartnersHandlerRoute struct {
+	routeDescription RouteDescription
+	customHandler    WatchPartnersHandler
+}
+
+func (server *VisAdminServer) SetWatchPartnersHandler(handler WatchPartnersHandler, middleware ...Middleware) {
+	server.watchPartnersHandler = &watchPartnersHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/partners/events", Handler: server.WatchPartnersHandler, Middleware: middleware}}
+}
+
+func (server *VisAdminServer) WatchPartnersHandler(w http.ResponseWriter, r *http.Request) error {
+	if server.watchPartnersHandler.customHandler == nil {
+		server.ErrorLogger("wrap handler: WatchPartners (GET) endpoint is not registered")
+		return NewHTTPStatusCodeError(http.StatusNotFound)
+	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"text/event-stream"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: WatchPartners (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
+		request := new(WatchPartnersRequest)
+		if len(r.URL.Query()["limit"]) > 0 {
+			if err := fromString(r.URL.Query()["limit"][0], &request.Limit); err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: WatchPartners (GET) could not convert string to specific type (error: %v)", err))
+				return newJsonHTTPError(http.StatusBadRequest, NewParameterError("limit", "query", CodeInvalidType, err.Error()))
+			}
+		}
+		request.LastEventID = r.Header.Get(lastEventIDHeader)
+		validationErrors, err := server.Validator.ValidateRequest(request)
+		if err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: WatchPartners (GET) could not validate incoming request (error: %v)", err))
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if validationErrors != nil {
+			return newJsonHTTPError(http.StatusBadRequest, validationErrors)
+		}
+		events := &WatchPartnersEventStream{stream: newEventStream(w, r)}
+		response := server.watchPartnersHandler.customHandler(r.Context(), request, events)
+		if events.stream.Started() {
+			// the response was sent with the events
+			return nil
+		}
+		if response == nil {
+			server.ErrorLogger("wrap handler: WatchPartners (GET) received a nil response object")
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: WatchPartners (GET) could not send response (error: %v)", err))
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns a partner, conditional requests are answered with 304 if the partner is unchanged
+type GetPartnerHandler func(ctx context.Context, request *GetPartnerRequest) GetPartnerResponse
+
+type getPartnerHandlerRoute struct {
+	routeDescription RouteDescription
+	customHandler    GetPartnerHandler
+}
+
+func (server *VisAdminServer) SetGetPartnerHandler(handler GetPartnerHandler, middleware ...Middleware) {
+	server.getPartnerHandler = &getPartnerHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/partners/{partnerId}", Handler: server.GetPartnerHandler, Middleware: middleware}}
+}
+
+func (server *VisAdminServer) GetPartnerHandler(w http.ResponseWriter, r *http.Request) error {
+	if server.getPartnerHandler.customHandler == nil {
+		server.ErrorLogger("wrap handler: GetPartner (GET) endpoint is not registered")
+		return NewHTTPStatusCodeError(http.StatusNotFound)
+	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetPartner (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
+		request := new(GetPartnerRequest)
+		if err := fromString(server.PathParam(r, "partnerId"), &request.PartnerId); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetPartner (GET) could not convert string to specific type (error: %v)", err))
+			return newValidationHTTPError(NewParameterError("partnerId", "path", CodeInvalidType, err.Error()))
+		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
+		validationErrors, err := server.Validator.ValidateRequest(request)
+		if err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetPartner (GET) could not validate incoming request (error: %v)", err))
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if validationErrors != nil {
+			return newValidationHTTPError(validationErrors)
+		}
+		response := server.getPartnerHandler.customHandler(r.Context(), request)
+		if response == nil {
+			server.ErrorLogger("wrap handler: GetPartner (GET) received a nil response object")
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		switch conditional := response.(type) {
+		case *GetPartner200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &GetPartner304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetPartner (GET) could not send response (error: %v)", err))
+			return err
+		}
+	}
+	return nil
+}
+
+// Updates a partner, conditional requests fail with 412 if the partner was changed
+type UpdatePartnerHandler func(ctx context.Context, request *UpdatePartnerRequest) UpdatePartnerResponse
+
+type updatePartnerHandlerRoute struct {
+	routeDescription RouteDescription
+	customHandler    UpdatePartnerHandler
+}
+
+func (server *VisAdminServer) SetUpdatePartnerHandler(handler UpdatePartnerHandler, middleware ...Middleware) {
+	server.updatePartnerHandler = &updatePartnerHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "PUT", Path: "/partners/{partnerId}", Handler: server.UpdatePartnerHandler, Middleware: middleware}}
+}
+
+// UpdatePartnerPrecondition returns the ETag and Last-Modified of the current representation of the resource, which are compared
+// with the conditional headers of a request before it is handled. An empty ETag and a zero time stand for a missing resource.
+type UpdatePartnerPrecondition func(ctx context.Context, request *UpdatePartnerRequest) (etag string, lastModified time.Time, err error)
+
+// SetUpdatePartnerPrecondition sets the precondition of UpdatePartner requests, a failed precondition is answered with 412 (Precondition Failed)
+func (server *VisAdminServer) SetUpdatePartnerPrecondition(precondition UpdatePartnerPrecondition) {
+	server.updatePartnerPrecondition = precondition
+}
+
+func (server *VisAdminServer) UpdatePartnerHandler(w http.ResponseWriter, r *http.Request) error {
+	if server.updatePartnerHandler.customHandler == nil {
+		server.ErrorLogger("wrap handler: UpdatePartner (PUT) endpoint is not registered")
+		return NewHTTPStatusCodeError(http.StatusNotFound)
+	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: UpdatePartner (PUT) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
+		request := new(UpdatePartnerRequest)
+		contentTypeOfResponse := extractContentType(r.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeApplicationJson {
+			err := JSON(r.Body, &request.Body, true)
+			if err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: UpdatePartner (PUT) could not decode request body of incoming request (%v)", err))
+				return newValidationHTTPError(NewBodyError("body", err))
+			}
+		} else {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: UpdatePartner (PUT) content type of incoming request is bad (want: application/json, got: %s)", contentTypeOfResponse))
+			return newNotSupportedContentType(415, contentTypeOfResponse)
+		}
+		if err := fromString(server.PathParam(r, "partnerId"), &request.PartnerId); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: UpdatePartner (PUT) could not convert string to specific type (error: %v)", err))
+			return newValidationHTTPError(NewParameterError("partnerId", "path", CodeInvalidType, err.Error()))
+		}
+		request.IfMatch = r.Header.Get(ifMatchHeader)
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfUnmodifiedSince = parseHTTPTime(r.Header.Get(ifUnmodifiedSinceHeader))
+		validationErrors, err := server.Validator.ValidateRequest(request)
+		if err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: UpdatePartner (PUT) could not validate incoming request (error: %v)", err))
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if validationErrors != nil {
+			return newValidationHTTPError(validationErrors)
+		}
+		if server.updatePartnerPrecondition != nil && hasPreconditions(r.Header) {
+			etag, lastModified, err := server.updatePartnerPrecondition(r.Context(), request)
+			if err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: UpdatePartner (PUT) could not evaluate preconditions (error: %v)", err))
 				return NewHTTPStatusCodeError(http.StatusInternalServerError)
 			}
+			if evaluatePreconditions(r, etag, lastModified) != 0 {
+				return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+			}
+		}
+		response := server.updatePartnerHandler.customHandler(r.Context(), request)
+		if response == nil {
+			server.ErrorLogger("wrap handler: UpdatePartner (PUT) received a nil response object")
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: UpdatePartner (PUT) could not send response (error: %v)", err))
+			return err
+		}
+	}
+	return nil
+}
+
+// Imports the files of a partner while they are uploaded
+type ImportPartnerArchiveHandler func(ctx context.Context, request *ImportPartnerArchiveRequest) ImportPartnerArchiveResponse
+
+type importPartnerArchiveHandlerRoute struct {
+	routeDescription RouteDescription
+	customHandler    ImportPartnerArchiveHandler
+}
+
+func (server *VisAdminServer) SetImportPartnerArchiveHandler(handler ImportPartnerArchiveHandler, middleware ...Middleware) {
+	server.importPartnerArchiveHandler = &importPartnerArchiveHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "POST", Path: "/partners/{partnerId}/archive", Handler: server.ImportPartnerArchiveHandler, Middleware: middleware}}
+}
+
+func (server *VisAdminServer) ImportPartnerArchiveHandler(w http.ResponseWriter, r *http.Request) error {
+	if server.importPartnerArchiveHandler.customHandler == nil {
+		server.ErrorLogger("wrap handler: ImportPartnerArchive (POST) endpoint is not registered")
+		return NewHTTPStatusCodeError(http.StatusNotFound)
+	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ImportPartnerArchive (POST) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
+		request := new(ImportPartnerArchiveRequest)
+		contentTypeOfResponse := extractContentType(r.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeMultipartFormData {
+			uploadLimits := server.UploadLimits
+			uploadLimits.MaxTotalSize = 4096
+			uploads, err := newUploadReader(r, uploadLimits, nil)
+			if err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: ImportPartnerArchive (POST) could not parse multipart form of incoming request (error: %v)", err))
+				return NewHTTPStatusCodeError(http.StatusBadRequest)
+			}
+			values, err := uploads.ReadValues()
+			if err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: ImportPartnerArchive (POST) could not read multipart form of incoming request (error: %v)", err))
+				return NewHTTPStatusCodeError(uploadErrorStatus(err))
+			}
+			formData := &request.FormData
+			formData.Uploads = uploads
+			if len(values["note"]) > 0 {
+				if err := fromString(values["note"][0], &formData.Note); err != nil {
+					server.ErrorLogger(fmt.Sprintf("wrap handler: ImportPartnerArchive (POST) could not convert string to specific type (error: %v)", err))
+					return newValidationHTTPError(NewParameterError("note", "formData", CodeInvalidType, err.Error()))
+				}
+			} else {
+				return newValidationHTTPError(NewParameterError("note", "formData", CodeRequired, "formData parameter 'note' is required"))
+			}
+		} else if contentTypeOfResponse == contentTypeApplicationFormUrlencoded {
+		} else {
+			return newNotSupportedContentType(415, contentTypeOfResponse)
+		}
+		if err := fromString(server.PathParam(r, "partnerId"), &request.PartnerId); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ImportPartnerArchive (POST) could not convert string to specific type (error: %v)", err))
+			return newValidationHTTPError(NewParameterError("partnerId", "path", CodeInvalidType, err.Error()))
+		}
+		validationErrors, err := server.Validator.ValidateRequest(request)
+		if err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ImportPartnerArchive (POST) could not validate incoming request (error: %v)", err))
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if validationErrors != nil {
+			return newValidationHTTPError(validationErrors)
+		}
+		response := server.importPartnerArchiveHandler.customHandler(r.Context(), request)
+		if response == nil {
+			server.ErrorLogger("wrap handler: ImportPartnerArchive (POST) received a nil response object")
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ImportPartnerArchive (POST) could not send response (error: %v)", err))
+			return err
+		}
+	}
+	return nil
+}
+
+// Exchanges chat messages with a partner over a WebSocket
+type ChatWithPartnerHandler func(ctx context.Context, request *ChatWithPartnerRequest, conn *ChatWithPartnerServerConn) ChatWithPartnerResponse
+
+type chatWithPartnerHandlerRoute struct {
+	routeDescription RouteDescription
+	customHandler    ChatWithPartnerHandler
+}
+
+func (server *VisAdminServer) SetChatWithPartnerHandler(handler ChatWithPartnerHandler, middleware ...Middleware) {
+	server.chatWithPartnerHandler = &chatWithPartnerHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/partners/{partnerId}/chat", Handler: server.ChatWithPartnerHandler, Middleware: middleware}}
+}
+
+func (server *VisAdminServer) ChatWithPartnerHandler(w http.ResponseWriter, r *http.Request) error {
+	if server.chatWithPartnerHandler.customHandler == nil {
+		server.ErrorLogger("wrap handler: ChatWithPartner (GET) endpoint is not registered")
+		return NewHTTPStatusCodeError(http.StatusNotFound)
+	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ChatWithPartner (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
+		request := new(ChatWithPartnerRequest)
+		if err := fromString(server.PathParam(r, "partnerId"), &request.PartnerId); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ChatWithPartner (GET) could not convert string to specific type (error: %v)", err))
+			return newValidationHTTPError(NewParameterError("partnerId", "path", CodeInvalidType, err.Error()))
+		}
+		validationErrors, err := server.Validator.ValidateRequest(request)
+		if err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ChatWithPartner (GET) could not validate incoming request (error: %v)", err))
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if validationErrors != nil {
+			return newValidationHTTPError(validationErrors)
+		}
+		conn := &ChatWithPartnerServerConn{socket: newWebSocket(w, r, server.WebSockets, server.Validator.ValidateMessage)}
+		response := server.chatWithPartnerHandler.customHandler(r.Context(), request, conn)
+		if conn.socket.Started() {
+			// the response was sent by the upgrade, the connection is closed with the handler
+			if err := conn.Close(); err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: ChatWithPartner (GET) could not close WebSocket (error: %v)", err))
+			}
 			return nil
 		}
-		response := server.getRentalHandler.customHandler(c.Request.Context(), request)
+		if response == nil {
+			server.ErrorLogger("wrap handler: ChatWithPartner (GET) received a nil response object")
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: ChatWithPartner (GET) could not send response (error: %v)", err))
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the contract of a partner, range requests download a part of the contract
+type DownloadPartnerContractHandler func(ctx context.Context, request *DownloadPartnerContractRequest) DownloadPartnerContractResponse
+
+type downloadPartnerContractHandlerRoute struct {
+	routeDescription RouteDescription
+	customHandler    DownloadPartnerContractHandler
+}
+
+func (server *VisAdminServer) SetDownloadPartnerContractHandler(handler DownloadPartnerContractHandler, middleware ...Middleware) {
+	server.downloadPartnerContractHandler = &downloadPartnerContractHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/partners/{partnerId}/contract", Handler: server.DownloadPartnerContractHandler, Middleware: middleware}}
+}
+
+func (server *VisAdminServer) DownloadPartnerContractHandler(w http.ResponseWriter, r *http.Request) error {
+	if server.downloadPartnerContractHandler.customHandler == nil {
+		server.ErrorLogger("wrap handler: DownloadPartnerContract (GET) endpoint is not registered")
+		return NewHTTPStatusCodeError(http.StatusNotFound)
+	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/pdf"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: DownloadPartnerContract (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
+		request := new(DownloadPartnerContractRequest)
+		if err := fromString(server.PathParam(r, "partnerId"), &request.PartnerId); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: DownloadPartnerContract (GET) could not convert string to specific type (error: %v)", err))
+			return newValidationHTTPError(NewParameterError("partnerId", "path", CodeInvalidType, err.Error()))
+		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
+		validationErrors, err := server.Validator.ValidateRequest(request)
+		if err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: DownloadPartnerContract (GET) could not validate incoming request (error: %v)", err))
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if validationErrors != nil {
+			return newValidationHTTPError(validationErrors)
+		}
+		response := server.downloadPartnerContractHandler.customHandler(r.Context(), request)
+		if response == nil {
+			server.ErrorLogger("wrap handler: DownloadPartnerContract (GET) received a nil response object")
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		switch conditional := response.(type) {
+		case *DownloadPartnerContract200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					if conditional.Body != nil {
+						conditional.Body.Close()
+					}
+					response = &DownloadPartnerContract304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					if conditional.Body != nil {
+						conditional.Body.Close()
+					}
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: DownloadPartnerContract (GET) could not send response (error: %v)", err))
+			return err
+		}
+	}
+	return nil
+}
+
+// Uploads several documents of a partner, the documents are limited to 64 bytes
+type UploadPartnerDocumentsHandler func(ctx context.Context, request *UploadPartnerDocumentsRequest) UploadPartnerDocumentsResponse
+
+type uploadPartnerDocumentsHandlerRoute struct {
+	routeDescription RouteDescription
+	customHandler    UploadPartnerDocumentsHandler
+}
+
+func (server *VisAdminServer) SetUploadPartnerDocumentsHandler(handler UploadPartnerDocumentsHandler, middleware ...Middleware) {
+	server.uploadPartnerDocumentsHandler = &uploadPartnerDocumentsHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "POST", Path: "/partners/{partnerId}/documents", Handler: server.UploadPartnerDocumentsHandler, Middleware: middleware}}
+}
+
+func (server *VisAdminServer) UploadPartnerDocumentsHandler(w http.ResponseWriter, r *http.Request) error {
+	if server.uploadPartnerDocumentsHandler.customHandler == nil {
+		server.ErrorLogger("wrap handler: UploadPartnerDocuments (POST) endpoint is not registered")
+		return NewHTTPStatusCodeError(http.StatusNotFound)
+	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: UploadPartnerDocuments (POST) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
+		request := new(UploadPartnerDocumentsRequest)
+		contentTypeOfResponse := extractContentType(r.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeMultipartFormData {
+			uploadLimits := server.UploadLimits
+			uploadLimits.MaxFileSize = 64
+			limitUploads(r, uploadLimits)
+			formData := &request.FormData
+			files0, extractErr0 := extractUploads("documents", r, uploadLimits, []string{"application/pdf", "image/png"})
+			if extractErr0 != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: UploadPartnerDocuments (POST) could not extract upload from incoming request (error: %v)", extractErr0))
+				return NewHTTPStatusCodeError(uploadErrorStatus(extractErr0))
+			}
+			if len(files0) == 0 {
+				return newValidationHTTPError(NewParameterError("documents", "formData", CodeRequired, "formData parameter 'documents' is required"))
+			}
+			formData.Documents = files0
+			files1, extractErr1 := extractUploads("cover", r, uploadLimits, []string{"application/pdf", "image/png"})
+			if extractErr1 != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: UploadPartnerDocuments (POST) could not extract upload from incoming request (error: %v)", extractErr1))
+				return NewHTTPStatusCodeError(uploadErrorStatus(extractErr1))
+			}
+			if len(files1) > 0 {
+				formData.Cover = &files1[0]
+			}
+			if len(r.Form["category"]) > 0 {
+				if err := fromString(r.Form["category"][0], &formData.Category); err != nil {
+					server.ErrorLogger(fmt.Sprintf("wrap handler: UploadPartnerDocuments (POST) could not convert string to specific type (error: %v)", err))
+					return newValidationHTTPError(NewParameterError("category", "formData", CodeInvalidType, err.Error()))
+				}
+			} else {
+				return newValidationHTTPError(NewParameterError("category", "formData", CodeRequired, "formData parameter 'category' is required"))
+			}
+		} else if contentTypeOfResponse == contentTypeApplicationFormUrlencoded {
+		} else {
+			return newNotSupportedContentType(415, contentTypeOfResponse)
+		}
+		if err := fromString(server.PathParam(r, "partnerId"), &request.PartnerId); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: UploadPartnerDocuments (POST) could not convert string to specific type (error: %v)", err))
+			return newValidationHTTPError(NewParameterError("partnerId", "path", CodeInvalidType, err.Error()))
+		}
+		validationErrors, err := server.Validator.ValidateRequest(request)
+		if err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: UploadPartnerDocuments (POST) could not validate incoming request (error: %v)", err))
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if validationErrors != nil {
+			return newValidationHTTPError(validationErrors)
+		}
+		response := server.uploadPartnerDocumentsHandler.customHandler(r.Context(), request)
+		if response == nil {
+			server.ErrorLogger("wrap handler: UploadPartnerDocuments (POST) received a nil response object")
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: UploadPartnerDocuments (POST) could not send response (error: %v)", err))
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the profile of a partner, plain text is produced by the declaration only
+type GetPartnerProfileHandler func(ctx context.Context, request *GetPartnerProfileRequest) GetPartnerProfileResponse
+
+type getPartnerProfileHandlerRoute struct {
+	routeDescription RouteDescription
+	customHandler    GetPartnerProfileHandler
+}
+
+func (server *VisAdminServer) SetGetPartnerProfileHandler(handler GetPartnerProfileHandler, middleware ...Middleware) {
+	server.getPartnerProfileHandler = &getPartnerProfileHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/partners/{partnerId}/profile", Handler: server.GetPartnerProfileHandler, Middleware: middleware}}
+}
+
+func (server *VisAdminServer) GetPartnerProfileHandler(w http.ResponseWriter, r *http.Request) error {
+	if server.getPartnerProfileHandler.customHandler == nil {
+		server.ErrorLogger("wrap handler: GetPartnerProfile (GET) endpoint is not registered")
+		return NewHTTPStatusCodeError(http.StatusNotFound)
+	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetPartnerProfile (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
+		request := new(GetPartnerProfileRequest)
+		if err := fromString(server.PathParam(r, "partnerId"), &request.PartnerId); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetPartnerProfile (GET) could not convert string to specific type (error: %v)", err))
+			return newValidationHTTPError(NewParameterError("partnerId", "path", CodeInvalidType, err.Error()))
+		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
+		validationErrors, err := server.Validator.ValidateRequest(request)
+		if err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetPartnerProfile (GET) could not validate incoming request (error: %v)", err))
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if validationErrors != nil {
+			return newValidationHTTPError(validationErrors)
+		}
+		response := server.getPartnerProfileHandler.customHandler(r.Context(), request)
+		if response == nil {
+			server.ErrorLogger("wrap handler: GetPartnerProfile (GET) received a nil response object")
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		switch conditional := response.(type) {
+		case *GetPartnerProfile200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &GetPartnerProfile304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetPartnerProfile (GET) could not send response (error: %v)", err))
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the statement of a partner, the access token of the OAuth2 security definition is required
+type GetPartnerStatementHandler func(ctx context.Context, request *GetPartnerStatementRequest) GetPartnerStatementResponse
+
+type getPartnerStatementHandlerRoute struct {
+	routeDescription RouteDescription
+	customHandler    GetPartnerStatementHandler
+}
+
+func (server *VisAdminServer) SetGetPartnerStatementHandler(handler GetPartnerStatementHandler, middleware ...Middleware) {
+	server.getPartnerStatementHandler = &getPartnerStatementHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/partners/{partnerId}/statement", Handler: server.GetPartnerStatementHandler, Middleware: middleware}}
+}
+
+func (server *VisAdminServer) GetPartnerStatementHandler(w http.ResponseWriter, r *http.Request) error {
+	if server.getPartnerStatementHandler.customHandler == nil {
+		server.ErrorLogger("wrap handler: GetPartnerStatement (GET) endpoint is not registered")
+		return NewHTTPStatusCodeError(http.StatusNotFound)
+	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetPartnerStatement (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
+		request := new(GetPartnerStatementRequest)
+		request.Authorization = r.Header.Get("Authorization")
+		if err := fromString(server.PathParam(r, "partnerId"), &request.PartnerId); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetPartnerStatement (GET) could not convert string to specific type (error: %v)", err))
+			return newValidationHTTPError(NewParameterError("partnerId", "path", CodeInvalidType, err.Error()))
+		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
+		validationErrors, err := server.Validator.ValidateRequest(request)
+		if err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetPartnerStatement (GET) could not validate incoming request (error: %v)", err))
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if validationErrors != nil {
+			return newValidationHTTPError(validationErrors)
+		}
+		response := server.getPartnerStatementHandler.customHandler(r.Context(), request)
+		if response == nil {
+			server.ErrorLogger("wrap handler: GetPartnerStatement (GET) received a nil response object")
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		switch conditional := response.(type) {
+		case *GetPartnerStatement200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &GetPartnerStatement304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetPartnerStatement (GET) could not send response (error: %v)", err))
+			return err
+		}
+	}
+	return nil
+}
+
+// get rental
+type GetRentalHandler func(ctx context.Context, request *GetRentalRequest) GetRentalResponse
+
+type getRentalHandlerRoute struct {
+	routeDescription RouteDescription
+	customHandler    GetRentalHandler
+}
+
+func (server *VisAdminServer) SetGetRentalHandler(handler GetRentalHandler, middleware ...Middleware) {
+	server.getRentalHandler = &getRentalHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/rental", Handler: server.GetRentalHandler, Middleware: middleware}}
+}
+
+func (server *VisAdminServer) GetRentalHandler(w http.ResponseWriter, r *http.Request) error {
+	if server.getRentalHandler.customHandler == nil {
+		server.ErrorLogger("wrap handler: GetRental (GET) endpoint is not registered")
+		return NewHTTPStatusCodeError(http.StatusNotFound)
+	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetRental (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
+		request := new(GetRentalRequest)
+		contentTypeOfResponse := extractContentType(r.Header.Get(contentTypeHeader))
+		if contentTypeOfResponse == contentTypeApplicationJson {
+			err := JSON(r.Body, &request.Body, true)
+			if err != nil {
+				server.ErrorLogger(fmt.Sprintf("wrap handler: GetRental (GET) could not decode request body of incoming request (%v)", err))
+				return newJsonHTTPError(http.StatusBadRequest, NewBodyError("body", err))
+			}
+		} else {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetRental (GET) content type of incoming request is bad (want: application/json, got: %s)", contentTypeOfResponse))
+			return newNotSupportedContentType(415, contentTypeOfResponse)
+		}
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
+		validationErrors, err := server.Validator.ValidateRequest(request)
+		if err != nil {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetRental (GET) could not validate incoming request (error: %v)", err))
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		if validationErrors != nil {
+			return newJsonHTTPError(http.StatusBadRequest, validationErrors)
+		}
+		response := server.getRentalHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: GetRental (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *GetRental200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &GetRental304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetRental (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -1828,26 +3428,46 @@ func (server *VisAdminServer) SetGetShoesHandler(handler GetShoesHandler, middle
 	server.getShoesHandler = &getShoesHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "GET", Path: "/shop/shoes", Handler: server.GetShoesHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) GetShoesHandler(c *routing.Context) error {
+func (server *VisAdminServer) GetShoesHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.getShoesHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: GetShoes (GET) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/hal+json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: GetShoes (GET) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(GetShoesRequest)
+		request.IfNoneMatch = r.Header.Get(ifNoneMatchHeader)
+		request.IfModifiedSince = parseHTTPTime(r.Header.Get(ifModifiedSinceHeader))
 		validationErrors, err := server.Validator.ValidateRequest(request)
 		if err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetShoes (GET) could not validate incoming request (error: %v)", err))
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.getShoesHandler.customHandler(c.Request.Context(), request)
+		response := server.getShoesHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: GetShoes (GET) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		switch conditional := response.(type) {
+		case *GetShoes200Response:
+			if conditional.ETag != "" || !conditional.LastModified.IsZero() {
+				switch evaluatePreconditions(r, conditional.ETag, conditional.LastModified) {
+				case http.StatusNotModified:
+					response = &GetShoes304Response{ETag: conditional.ETag, LastModified: conditional.LastModified}
+				case http.StatusPreconditionFailed:
+					return NewHTTPStatusCodeError(http.StatusPreconditionFailed)
+				}
+			}
+		}
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: GetShoes (GET) could not send response (error: %v)", err))
 			return err
 		}
@@ -1866,25 +3486,36 @@ func (server *VisAdminServer) SetPostUploadHandler(handler PostUploadHandler, mi
 	server.postUploadHandler = &postUploadHandlerRoute{customHandler: handler, routeDescription: RouteDescription{Method: "POST", Path: "/upload", Handler: server.PostUploadHandler, Middleware: middleware}}
 }
 
-func (server *VisAdminServer) PostUploadHandler(c *routing.Context) error {
+func (server *VisAdminServer) PostUploadHandler(w http.ResponseWriter, r *http.Request) error {
 	if server.postUploadHandler.customHandler == nil {
 		server.ErrorLogger("wrap handler: PostUpload (POST) endpoint is not registered")
 		return NewHTTPStatusCodeError(http.StatusNotFound)
 	} else {
+		negotiatedContentType, acceptable := negotiateContentType(r.Header.Get("Accept"), []string{"application/json"})
+		w.Header().Add("Vary", "Accept")
+		if !acceptable {
+			server.ErrorLogger(fmt.Sprintf("wrap handler: PostUpload (POST) content type of response is not acceptable (accept: %s)", r.Header.Get("Accept")))
+			return NewHTTPStatusCodeError(http.StatusNotAcceptable)
+		}
+		r = r.WithContext(withNegotiatedContentType(r.Context(), negotiatedContentType))
 		request := new(PostUploadRequest)
-		contentTypeOfResponse := extractContentType(c.Request.Header.Get(contentTypeHeader))
+		contentTypeOfResponse := extractContentType(r.Header.Get(contentTypeHeader))
 		if contentTypeOfResponse == contentTypeMultipartFormData {
+			uploadLimits := server.UploadLimits
+			limitUploads(r, uploadLimits)
 			formData := &request.FormData
-			file0, extractErr0 := extractUpload("upfile", c.Request)
+			files0, extractErr0 := extractUploads("upfile", r, uploadLimits, nil)
 			if extractErr0 != nil {
 				server.ErrorLogger(fmt.Sprintf("wrap handler: PostUpload (POST) could not extract upload from incoming request (error: %v)", extractErr0))
-				return NewHTTPStatusCodeError(http.StatusBadRequest)
+				return NewHTTPStatusCodeError(uploadErrorStatus(extractErr0))
 			}
-			formData.Upfile = file0
-			if len(c.Request.Form["note"]) > 0 {
-				if err := fromString(c.Request.Form["note"][0], &formData.Note); err != nil {
+			if len(files0) > 0 {
+				formData.Upfile = &files0[0]
+			}
+			if len(r.Form["note"]) > 0 {
+				if err := fromString(r.Form["note"][0], &formData.Note); err != nil {
 					server.ErrorLogger(fmt.Sprintf("wrap handler: PostUpload (POST) could not convert string to specific type (error: %v)", err))
-					return NewHTTPStatusCodeError(http.StatusBadRequest)
+					return newValidationHTTPError(NewParameterError("note", "formData", CodeInvalidType, err.Error()))
 				}
 			}
 		} else if contentTypeOfResponse == contentTypeApplicationFormUrlencoded {
@@ -1897,14 +3528,14 @@ func (server *VisAdminServer) PostUploadHandler(c *routing.Context) error {
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
 		if validationErrors != nil {
-			return NewHTTPStatusCodeError(http.StatusBadRequest)
+			return newValidationHTTPError(validationErrors)
 		}
-		response := server.postUploadHandler.customHandler(c.Request.Context(), request)
+		response := server.postUploadHandler.customHandler(r.Context(), request)
 		if response == nil {
 			server.ErrorLogger("wrap handler: PostUpload (POST) received a nil response object")
 			return NewHTTPStatusCodeError(http.StatusInternalServerError)
 		}
-		if err := response.write(c.Response); err != nil {
+		if err := response.write(w, r, negotiatedContentType); err != nil {
 			server.ErrorLogger(fmt.Sprintf("wrap handler: PostUpload (POST) could not send response (error: %v)", err))
 			return err
 		}
@@ -1914,28 +3545,20 @@ func (server *VisAdminServer) PostUploadHandler(c *routing.Context) error {
 
 func (server *VisAdminServer) registerValidators() {
 	regex2 := regexp.MustCompile("^([a-f0-9]{8}-[a-f0-9]{4}-[1-5]{1}[a-f0-9]{3}-[89aAbB][a-f0-9]{3}-[a-f0-9]{12})?$")
-	callbackRegex2 := func(fl validator.FieldLevel) bool {
-		return regex2.MatchString(fl.Field().String())
-	}
-	server.Validator.RegisterValidation("regex2", callbackRegex2)
-	server.Validator.RegisterValidation("regex3", callbackRegex2)
+	server.Validator.RegisterPattern("regex2", regex2)
+	server.Validator.RegisterPattern("regex3", regex2)
 	regex7 := regexp.MustCompile("^([a-z]{2})-([A-Z]{2})$")
-	callbackRegex7 := func(fl validator.FieldLevel) bool {
-		return regex7.MatchString(fl.Field().String())
-	}
-	server.Validator.RegisterValidation("regex7", callbackRegex7)
+	server.Validator.RegisterPattern("regex7", regex7)
 	regex5 := regexp.MustCompile("^(http|ftp|https):\\/\\/[\\w\\-_]+(\\.[\\w\\-_]+)+([\\w\\-\\.,@?^=%&amp;:/~\\+#]*[\\w\\-\\@?^=%&amp;/~\\+#])?$")
-	callbackRegex5 := func(fl validator.FieldLevel) bool {
-		return regex5.MatchString(fl.Field().String())
-	}
-	server.Validator.RegisterValidation("regex5", callbackRegex5)
-	server.Validator.RegisterValidation("regex6", callbackRegex5)
+	server.Validator.RegisterPattern("regex5", regex5)
+	server.Validator.RegisterPattern("regex6", regex5)
+	regex9 := regexp.MustCompile("^[0-9a-zA-Z ]*$")
+	server.Validator.RegisterPattern("regex9", regex9)
 	regex1 := regexp.MustCompile("^[a-zA-Z]$")
-	callbackRegex1 := func(fl validator.FieldLevel) bool {
-		return regex1.MatchString(fl.Field().String())
-	}
-	server.Validator.RegisterValidation("regex1", callbackRegex1)
-	server.Validator.RegisterValidation("regex4", callbackRegex1)
+	server.Validator.RegisterPattern("regex1", regex1)
+	server.Validator.RegisterPattern("regex4", regex1)
+	regex8 := regexp.MustCompile("^[a-z]+$")
+	server.Validator.RegisterPattern("regex8", regex8)
 }
 
 func (server *VisAdminServer) Start(port int) error {
@@ -1997,6 +3620,9 @@ func (server *VisAdminServer) Start(port int) error {
 	if server.getBookingHandler != nil {
 		routes = append(routes, server.getBookingHandler.routeDescription)
 	}
+	if server.getBookingYamlHandler != nil {
+		routes = append(routes, server.getBookingYamlHandler.routeDescription)
+	}
 	if server.getBookingsHandler != nil {
 		routes = append(routes, server.getBookingsHandler.routeDescription)
 	}
@@ -2036,6 +3662,42 @@ func (server *VisAdminServer) Start(port int) error {
 	if server.genericFileDownloadHandler != nil {
 		routes = append(routes, server.genericFileDownloadHandler.routeDescription)
 	}
+	if server.validateParametersHandler != nil {
+		routes = append(routes, server.validateParametersHandler.routeDescription)
+	}
+	if server.listPartnersHandler != nil {
+		routes = append(routes, server.listPartnersHandler.routeDescription)
+	}
+	if server.createPartnerHandler != nil {
+		routes = append(routes, server.createPartnerHandler.routeDescription)
+	}
+	if server.watchPartnersHandler != nil {
+		routes = append(routes, server.watchPartnersHandler.routeDescription)
+	}
+	if server.getPartnerHandler != nil {
+		routes = append(routes, server.getPartnerHandler.routeDescription)
+	}
+	if server.updatePartnerHandler != nil {
+		routes = append(routes, server.updatePartnerHandler.routeDescription)
+	}
+	if server.importPartnerArchiveHandler != nil {
+		routes = append(routes, server.importPartnerArchiveHandler.routeDescription)
+	}
+	if server.chatWithPartnerHandler != nil {
+		routes = append(routes, server.chatWithPartnerHandler.routeDescription)
+	}
+	if server.downloadPartnerContractHandler != nil {
+		routes = append(routes, server.downloadPartnerContractHandler.routeDescription)
+	}
+	if server.uploadPartnerDocumentsHandler != nil {
+		routes = append(routes, server.uploadPartnerDocumentsHandler.routeDescription)
+	}
+	if server.getPartnerProfileHandler != nil {
+		routes = append(routes, server.getPartnerProfileHandler.routeDescription)
+	}
+	if server.getPartnerStatementHandler != nil {
+		routes = append(routes, server.getPartnerStatementHandler.routeDescription)
+	}
 	if server.getRentalHandler != nil {
 		routes = append(routes, server.getRentalHandler.routeDescription)
 	}
@@ -2048,4 +3710,4 @@ func (server *VisAdminServer) Start(port int) error {
 	return server.Server.Start(port, routes)
 }
 
-const swagger = "{\"consumes\":[\"application/json\"],\"produces\":[\"application/json\"],\"swagger\":\"2.0\",\"info\":{\"description\":\"Vehicle Information Service Admin API\",\"title\":\"vis-admin\",\"contact\":{\"name\":\"Max Mustermann\",\"email\":\"max.musterman@fake.de\"},\"version\":\"1.0.0\"},\"paths\":{\"/api/client\":{\"get\":{\"summary\":\"List clients\",\"operationId\":\"GetClients\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Status 200\",\"schema\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/Client\"}}},\"204\":{\"description\":\"Status 201\"},\"403\":{\"description\":\"Not authenticated\"}}}},\"/api/client/{clientId}\":{\"get\":{\"summary\":\"Get client\",\"operationId\":\"GetClient\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\",\"schema\":{\"$ref\":\"#/definitions/Client\"}},\"403\":{\"description\":\"Not authenticated\"},\"404\":{\"description\":\"Not found\"}}},\"put\":{\"summary\":\"Create or update client\",\"operationId\":\"CreateOrUpdateClient\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true},{\"name\":\"body\",\"in\":\"body\",\"required\":true,\"schema\":{\"$ref\":\"#/definitions/Client\"}}],\"responses\":{\"200\":{\"description\":\"Updated\"},\"201\":{\"description\":\"Created\"},\"400\":{\"description\":\"Malformed request body\"},\"403\":{\"description\":\"Not authenticated\"},\"405\":{\"description\":\"Not allowed\"}}},\"delete\":{\"summary\":\"Delete client\",\"operationId\":\"DeleteClient\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\"},\"403\":{\"description\":\"Not authenticated\"},\"404\":{\"description\":\"Not found\"}}},\"parameters\":[{\"type\":\"string\",\"name\":\"clientId\",\"in\":\"path\",\"required\":true}]},\"/api/client/{clientId}/views\":{\"get\":{\"summary\":\"List views sets\",\"operationId\":\"GetViewsSets\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\",\"schema\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/views%20set\"}}},\"403\":{\"description\":\"Not authenticated\"}}},\"parameters\":[{\"type\":\"string\",\"name\":\"clientId\",\"in\":\"path\",\"required\":true}]},\"/api/client/{clientId}/views/{viewsId}\":{\"get\":{\"summary\":\"Get views set\",\"operationId\":\"GetViewsSet\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true},{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"page\",\"in\":\"query\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\",\"schema\":{\"$ref\":\"#/definitions/views%20set\"}},\"403\":{\"description\":\"Not authenticated\"},\"404\":{\"description\":\"Not found\"}}},\"put\":{\"summary\":\"Create or update views set\",\"operationId\":\"CreateOrUpdateViewsSet\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true},{\"name\":\"body\",\"in\":\"body\",\"required\":true,\"schema\":{\"$ref\":\"#/definitions/views%20set\"}}],\"responses\":{\"200\":{\"description\":\"Updated\"},\"201\":{\"description\":\"Created\"},\"400\":{\"description\":\"Malformed request body\"},\"403\":{\"description\":\"Not authenticated\"},\"405\":{\"description\":\"Not allowed\"}}},\"post\":{\"description\":\"Make this viewset the active one for the client.\",\"summary\":\"Activate views set\",\"operationId\":\"ActivateViewsSet\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\"},\"403\":{\"description\":\"Not authenticated\"},\"404\":{\"description\":\"Not found\"}}},\"delete\":{\"summary\":\"Delete views set\",\"operationId\":\"DeleteViewsSet\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\"},\"403\":{\"description\":\"Not authenticated\"},\"404\":{\"description\":\"Not found\"}}},\"parameters\":[{\"type\":\"string\",\"name\":\"clientId\",\"in\":\"path\",\"required\":true},{\"type\":\"string\",\"name\":\"viewsId\",\"in\":\"path\",\"required\":true}]},\"/api/client/{clientId}/views/{viewsId}/{view}/{breakpoint}/{spec}\":{\"get\":{\"summary\":\"Show vehicle in view\",\"operationId\":\"ShowVehicleInView\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\"},\"403\":{\"description\":\"Not authenticated\"},\"404\":{\"description\":\"Not found\"}}},\"parameters\":[{\"type\":\"string\",\"name\":\"clientId\",\"in\":\"path\",\"required\":true},{\"type\":\"string\",\"name\":\"viewsId\",\"in\":\"path\",\"required\":true},{\"type\":\"string\",\"name\":\"view\",\"in\":\"path\",\"required\":true},{\"type\":\"string\",\"name\":\"breakpoint\",\"in\":\"path\",\"required\":true},{\"type\":\"string\",\"name\":\"spec\",\"in\":\"path\",\"required\":true}]},\"/api/permission\":{\"get\":{\"description\":\"Get the list of permissions\\na user can grant to other users.\",\"summary\":\"List permissions\",\"operationId\":\"GetPermissions\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Status 200\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}},\"403\":{\"description\":\"Not authenticated\"}}}},\"/api/session\":{\"get\":{\"tags\":[\"SESSION\"],\"summary\":\"Get user info\",\"operationId\":\"GetUserInfo\",\"parameters\":[{\"maxLength\":255,\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true},{\"maximum\":255,\"type\":\"integer\",\"description\":\"session\",\"name\":\"subID\",\"in\":\"header\"}],\"responses\":{\"200\":{\"description\":\"Status 200\",\"schema\":{\"$ref\":\"#/definitions/User\"}},\"400\":{\"description\":\"Malformed request body\",\"schema\":{\"$ref\":\"#/definitions/ValidationErrors\"}},\"403\":{\"description\":\"Not authenticatedq\"}}},\"post\":{\"tags\":[\"SESSION\"],\"summary\":\"Create session\",\"operationId\":\"CreateSession\",\"parameters\":[{\"name\":\"body\",\"in\":\"body\",\"required\":true,\"schema\":{\"type\":\"object\",\"required\":[\"id\",\"password\"],\"properties\":{\"id\":{\"type\":\"string\",\"minLength\":1},\"password\":{\"type\":\"string\",\"minLength\":1}}}}],\"responses\":{\"200\":{\"description\":\"Authentication successful\",\"headers\":{\"X-Auth\":{\"type\":\"string\",\"description\":\"Authentication token\"}}},\"400\":{\"description\":\"Malformed request body\",\"schema\":{\"$ref\":\"#/definitions/ValidationErrors\"}},\"401\":{\"description\":\"Authentication not successful\"}}},\"delete\":{\"summary\":\"Destroy session\",\"operationId\":\"DestroySession\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Session destroyed\"},\"404\":{\"description\":\"Session not found\"}}}},\"/api/user\":{\"get\":{\"summary\":\"List users\",\"operationId\":\"GetUsers\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\",\"schema\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/User\"}}},\"403\":{\"description\":\"Not authenticated\"}}}},\"/api/user/{userId}\":{\"get\":{\"summary\":\"Get user\",\"operationId\":\"GetUser\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\",\"schema\":{\"$ref\":\"#/definitions/User\"}},\"403\":{\"description\":\"Not authenticated\"},\"404\":{\"description\":\"Not found\"}}},\"put\":{\"summary\":\"Create or update user\",\"operationId\":\"CreateOrUpdateUser\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true},{\"name\":\"body\",\"in\":\"body\",\"required\":true,\"schema\":{\"$ref\":\"#/definitions/User\"}}],\"responses\":{\"200\":{\"description\":\"Updated\"},\"201\":{\"description\":\"Created\"},\"400\":{\"description\":\"Malformed request body\"},\"403\":{\"description\":\"Not authenticated\"},\"405\":{\"description\":\"Not allowed\"}}},\"delete\":{\"summary\":\"Delete user\",\"operationId\":\"DeleteUser\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\"},\"403\":{\"description\":\"Not authenticated\"},\"404\":{\"description\":\"Not found\"}}},\"parameters\":[{\"type\":\"string\",\"name\":\"userId\",\"in\":\"path\",\"required\":true},{\"type\":\"boolean\",\"name\":\"allKeys\",\"in\":\"query\"}]},\"/booking\":{\"get\":{\"security\":[{\"X-Session-ID\":[]}],\"description\":\"Get booking of session owner\",\"consumes\":[\"application/xml\"],\"summary\":\"Get booking\",\"operationId\":\"GetBooking\",\"responses\":{\"200\":{\"description\":\"status 200\",\"schema\":{\"type\":\"string\"}},\"400\":{\"description\":\"status 400\"},\"401\":{\"description\":\"Unauthorized Session Token\"},\"404\":{\"description\":\"Resource Not Found\"},\"500\":{\"description\":\"Malfunction (internal requirements not fulfilled)\"}}}},\"/bookings\":{\"get\":{\"security\":[{\"X-Session-ID\":[]}],\"description\":\"Get bookings of session owner\",\"produces\":[\"application/json\"],\"summary\":\"Get bookings\",\"operationId\":\"GetBookings\",\"parameters\":[{\"type\":\"string\",\"name\":\"date\",\"in\":\"header\"},{\"type\":\"array\",\"items\":{\"type\":\"integer\"},\"name\":\"ids\",\"in\":\"query\"}],\"responses\":{\"200\":{\"description\":\"Success List Booking History\",\"schema\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/Booking\"}}},\"400\":{\"description\":\"status 400\"},\"401\":{\"description\":\"Unauthorized Session Token\"},\"404\":{\"description\":\"Resource Not Found\"},\"500\":{\"description\":\"Malfunction (internal requirements not fulfilled)\"}}}},\"/brands/{brandId}/models\":{\"get\":{\"tags\":[\"MODEL\"],\"summary\":\"Get all available models for the given brandId\",\"operationId\":\"ListModels\",\"parameters\":[{\"name\":\"driveConcept\",\"in\":\"query\",\"schema\":{\"$ref\":\"#/definitions/DriveConcept\"}},{\"type\":\"string\",\"x-example\":\"de\",\"name\":\"languageId\",\"in\":\"query\"},{\"type\":\"string\",\"x-example\":\"123\",\"name\":\"classId\",\"in\":\"query\"},{\"type\":\"string\",\"name\":\"lineId\",\"in\":\"query\"},{\"type\":\"array\",\"items\":{\"type\":\"integer\"},\"name\":\"ids\",\"in\":\"query\"}],\"responses\":{\"200\":{\"description\":\"Ok\",\"schema\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/Model\"}},\"examples\":{\"application/json\":{\"drive_concept\":\"drive_concept\",\"price\":38,\"technical_information\":null}}}}},\"parameters\":[{\"type\":\"string\",\"name\":\"brandId\",\"in\":\"path\",\"required\":true}]},\"/classes/{productGroup}\":{\"get\":{\"summary\":\"Get all available classes.\",\"operationId\":\"GetClasses\",\"parameters\":[{\"enum\":[\"WHEELS\",\"PAINTS\",\"UPHOLSTERIES\",\"TRIMS\",\"PACKAGES\",\"LINES\",\"SPECIAL_EDITION\",\"SPECIAL_EQUIPMENT\"],\"type\":\"array\",\"items\":{\"type\":\"string\"},\"description\":\"A list of component types separated by a comma case insensitive. If nothing is defined all component types are returned.\",\"name\":\"componentTypes\",\"in\":\"query\"},{\"enum\":[\"PKW\",\"GELAENDEWAGEN\",\"VAN\",\"SPRINTER\",\"CITAN\",\"SMART\"],\"type\":\"string\",\"default\":\"PKW\",\"description\":\"The productGroup of a vehicle case insensitive.\",\"name\":\"productGroup\",\"in\":\"path\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Successful response\",\"schema\":{\"type\":\"string\"}},\"400\":{\"description\":\"Successful response\",\"schema\":{\"type\":\"string\"}}}}},\"/code\":{\"post\":{\"consumes\":[\"application/x-www-form-urlencoded\"],\"summary\":\"code to token\",\"operationId\":\"Code\",\"parameters\":[{\"type\":\"array\",\"items\":{\"type\":\"integer\"},\"name\":\"state\",\"in\":\"formData\"},{\"type\":\"string\",\"name\":\"response_mode\",\"in\":\"formData\"},{\"type\":\"string\",\"name\":\"code\",\"in\":\"formData\",\"required\":true},{\"type\":\"string\",\"name\":\"session\",\"in\":\"query\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"TBD\",\"schema\":{\"type\":\"string\"}},\"400\":{\"description\":\"status 400\"},\"401\":{\"description\":\"Unauthorized Session code\"},\"404\":{\"description\":\"Resource Not Found\"},\"500\":{\"description\":\"Malfunction (internal requirements not fulfilled)\"}}}},\"/customer/session\":{\"post\":{\"description\":\"Creates a customer session for a given OpenID authentication token.\\n\",\"consumes\":[\"application/x-www-form-urlencoded\"],\"produces\":[\"application/json\"],\"summary\":\"Create session (login)\",\"operationId\":\"CreateCustomerSession\",\"parameters\":[{\"maxLength\":255,\"type\":\"string\",\"description\":\"OpenID authentication token\",\"name\":\"code\",\"in\":\"formData\",\"required\":true},{\"maxLength\":255,\"pattern\":\"^([a-z]{2})-([A-Z]{2})$\",\"type\":\"string\",\"description\":\"default locale\",\"name\":\"locale\",\"in\":\"formData\"},{\"type\":\"string\",\"description\":\"ID of the request in UUIDv4 format\",\"name\":\"X-Request-ID\",\"in\":\"header\"}],\"responses\":{\"201\":{\"description\":\"Session successful created\",\"schema\":{\"$ref\":\"#/definitions/Session\"}},\"401\":{\"description\":\"Invalid OpenID authentication token\"},\"403\":{\"description\":\"Create session with authentication token is forbidden (e.g. Token already used)\\n\"},\"422\":{\"description\":\"Invalid request data\",\"schema\":{\"$ref\":\"#/definitions/ValidationErrors\"}},\"500\":{\"description\":\"Internal server error (e.g. unexpected condition occurred)\"}}},\"delete\":{\"security\":[{\"X-Session-ID\":[]}],\"description\":\"Deletes the user session matching the *X-Auth* header.\\n\",\"summary\":\"Delete session (logout)\",\"operationId\":\"DeleteCustomerSession\",\"parameters\":[{\"type\":\"string\",\"description\":\"ID of the request in UUIDv4 format\",\"name\":\"X-Request-ID\",\"in\":\"header\"}],\"responses\":{\"204\":{\"description\":\"Session successful deleted\"},\"401\":{\"description\":\"Invalid session token\"},\"500\":{\"description\":\"Internal server error (e.g. unexpected condition occurred)\"}}}},\"/download/nested/file\":{\"get\":{\"description\":\"Downloads a file that is a property within a nested structure in the response body\\n\",\"produces\":[\"application/json\"],\"summary\":\"Downloads a nested file\",\"operationId\":\"DownloadNestedFile\",\"responses\":{\"200\":{\"description\":\"Nested file structure\",\"schema\":{\"$ref\":\"#/definitions/NestedFileStructure\"}}}}},\"/download/{image}\":{\"get\":{\"description\":\"Retrieve a image\",\"produces\":[\"image/png\"],\"summary\":\"Retrieve a image\",\"operationId\":\"DownloadImage\",\"parameters\":[{\"type\":\"string\",\"description\":\"The image name of the image\",\"name\":\"image\",\"in\":\"path\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"image to download\",\"schema\":{\"type\":\"file\"},\"headers\":{\"Content-Type\":{\"type\":\"string\"}}},\"500\":{\"description\":\"Malfunction (internal requirements not fulfilled)\"}}}},\"/elements\":{\"get\":{\"summary\":\"ListElements\",\"operationId\":\"ListElements\",\"parameters\":[{\"type\":\"integer\",\"default\":1,\"name\":\"_page\",\"in\":\"query\"},{\"type\":\"integer\",\"default\":10,\"name\":\"_perPage\",\"in\":\"query\"}],\"responses\":{\"200\":{\"description\":\"Status 200\",\"schema\":{\"type\":\"string\"},\"headers\":{\"X-Total-Count\":{\"type\":\"integer\"}}},\"500\":{\"description\":\"Status 500\"}}}},\"/file-upload\":{\"post\":{\"consumes\":[\"multipart/form-data\"],\"summary\":\"File upload\",\"operationId\":\"FileUpload\",\"parameters\":[{\"type\":\"file\",\"description\":\"File to be uploaded in request.\",\"name\":\"file\",\"in\":\"formData\"}],\"responses\":{\"204\":{\"description\":\"File uploaded.\"},\"500\":{\"description\":\"Internal server error\"}}}},\"/filedownload/{file}\":{\"get\":{\"description\":\"Retrieve a file\",\"produces\":[\"text/xml\"],\"summary\":\"Retrieve a file\",\"operationId\":\"DownloadFile\",\"responses\":{\"200\":{\"description\":\"file to download\",\"schema\":{\"type\":\"file\"},\"headers\":{\"Content-Type\":{\"type\":\"string\"}}}}},\"parameters\":[{\"type\":\"string\",\"description\":\"The filename of the file\",\"name\":\"file\",\"in\":\"path\",\"required\":true}]},\"/findByTags\":{\"get\":{\"description\":\"Multiple tags can be provided with comma separated strings. Use tag1, tag2, tag3 for testing.\",\"produces\":[\"application/json\"],\"summary\":\"Finds elements by tags\",\"operationId\":\"FindByTags\",\"parameters\":[{\"maxItems\":5,\"minItems\":2,\"type\":\"array\",\"items\":{\"type\":\"string\"},\"description\":\"Tags to filter by\",\"name\":\"tags\",\"in\":\"query\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"successful operation\",\"schema\":{\"type\":\"string\"}},\"400\":{\"description\":\"Invalid tag value\"}}}},\"/generic/download/{ext}\":{\"get\":{\"description\":\"Retrieve a file\",\"produces\":[\"application/json\"],\"summary\":\"Retrieve a file\",\"operationId\":\"GenericFileDownload\",\"responses\":{\"200\":{\"description\":\"file to download\",\"schema\":{\"type\":\"file\"},\"headers\":{\"Content-Type\":{\"type\":\"string\"},\"Pragma\":{\"type\":\"string\"}}},\"500\":{\"description\":\"Malfunction (internal requirements not fulfilled)\"}}},\"parameters\":[{\"type\":\"string\",\"description\":\"The ext of the file\",\"name\":\"ext\",\"in\":\"path\",\"required\":true}]},\"/rental\":{\"get\":{\"description\":\"get rental\",\"consumes\":[\"application/json\"],\"summary\":\"Get rental\",\"operationId\":\"GetRental\",\"parameters\":[{\"name\":\"body\",\"in\":\"body\",\"required\":true,\"schema\":{\"$ref\":\"#/definitions/Rental\"}}],\"responses\":{\"200\":{\"description\":\"status 200\"},\"400\":{\"description\":\"status 400\",\"schema\":{\"$ref\":\"#/definitions/ValidationErrors\"}}}}},\"/shop/shoes\":{\"get\":{\"produces\":[\"application/hal+json\"],\"summary\":\"Get all shoes\",\"operationId\":\"GetShoes\",\"responses\":{\"200\":{\"description\":\"Successful\",\"schema\":{\"$ref\":\"#/definitions/Shoes\"}}}}},\"/upload\":{\"post\":{\"consumes\":[\"multipart/form-data\"],\"summary\":\"Upload a file with others data\",\"operationId\":\"PostUpload\",\"parameters\":[{\"type\":\"file\",\"description\":\"the file to upload\",\"name\":\"upfile\",\"in\":\"formData\"},{\"maxLength\":4000,\"pattern\":\"^[0-9a-zA-Z ]*$\",\"type\":\"string\",\"description\":\"Description of file\",\"name\":\"note\",\"in\":\"formData\"}],\"responses\":{\"200\":{\"description\":\"Status 200\"},\"500\":{\"description\":\"Status 500\"}}}}},\"definitions\":{\"Address\":{\"type\":\"object\",\"required\":[\"city\",\"country\",\"houseNumber\",\"postalCode\",\"region\",\"street\"],\"properties\":{\"city\":{\"description\":\"City\",\"type\":\"string\"},\"country\":{\"description\":\"Country (ISO 3166)\",\"type\":\"string\"},\"houseNumber\":{\"description\":\"House number\",\"type\":\"string\"},\"postalCode\":{\"description\":\"Postal code\",\"type\":\"string\"},\"region\":{\"description\":\"Region\",\"type\":\"string\"},\"street\":{\"description\":\"Street name\",\"type\":\"string\"}}},\"BasicTypes\":{\"type\":\"object\",\"required\":[\"string\",\"integer\",\"boolean\",\"number\",\"slice\",\"map\"],\"properties\":{\"boolean\":{\"type\":\"boolean\"},\"integer\":{\"type\":\"integer\"},\"map\":{\"type\":\"object\",\"additionalProperties\":{\"type\":\"string\"}},\"number\":{\"type\":\"number\"},\"slice\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}},\"string\":{\"type\":\"string\"}}},\"Booking\":{\"type\":\"object\",\"required\":[\"id\"],\"properties\":{\"bookingID\":{\"type\":\"string\"}}},\"Client\":{\"type\":\"object\",\"required\":[\"id\",\"name\"],\"properties\":{\"activePresets\":{\"type\":\"string\"},\"configuration\":{\"type\":\"object\",\"properties\":{\"bbdCEBaseUrl\":{\"type\":\"string\"},\"bbdCallerIdentifier\":{\"type\":\"string\"},\"bbdDataSupply\":{\"type\":\"string\"},\"bbdImageBackground\":{\"type\":\"string\"},\"bbdImagePerspective\":{\"type\":\"string\"},\"bbdImageType\":{\"type\":\"string\"},\"bbdPassword\":{\"type\":\"string\"},\"bbdProductGroup\":{\"type\":\"string\"},\"bbdSoapMediaProviderUrl\":{\"type\":\"string\"},\"bbdUser\":{\"type\":\"string\"},\"ccoreServiceUrl\":{\"type\":\"string\"},\"cryptKeys\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}},\"healConfigurations\":{\"type\":\"boolean\"}}},\"id\":{\"type\":\"string\"},\"name\":{\"type\":\"string\"}}},\"DriveConcept\":{\"description\":\"The kind of drive concept of a vehicle. Where UNDEFINED is used as the default and/or error case.\",\"type\":\"string\",\"enum\":[\"COMBUSTOR\",\"HYBRID\",\"ELECTRIC\",\"FUELCELL\",\"UNDEFINED\"]},\"EmptySlice\":{\"properties\":{\"EmptySlice\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/Price\"}}}},\"Link\":{\"type\":\"object\",\"required\":[\"href\"],\"properties\":{\"href\":{\"type\":\"string\"}}},\"Links\":{\"type\":\"object\",\"required\":[\"self\"],\"properties\":{\"self\":{\"$ref\":\"#/definitions/Link\"}}},\"Model\":{\"type\":\"object\",\"required\":[\"technicalInformation\",\"price\"],\"properties\":{\"driveConcept\":{\"$ref\":\"#/definitions/DriveConcept\"},\"price\":{\"$ref\":\"#/definitions/Price\"},\"technicalInformation\":{\"$ref\":\"#/definitions/TechnicalInformation\"}}},\"NestedFileStructure\":{\"properties\":{\"data\":{\"type\":\"string\"}}},\"Price\":{\"type\":\"object\",\"required\":[\"currency\",\"value\"],\"properties\":{\"currency\":{\"type\":\"string\",\"example\":\"RMB\"},\"value\":{\"type\":\"number\",\"example\":123456.78}}},\"Rental\":{\"type\":\"object\",\"required\":[\"class\",\"lockStatus\",\"status\",\"stationID\",\"maxDoors\",\"minDoors\",\"website\",\"id\"],\"properties\":{\"class\":{\"type\":\"string\",\"maxLength\":20,\"minLength\":3},\"color\":{\"type\":\"string\",\"maxLength\":20,\"minLength\":3},\"homeID\":{\"type\":\"string\",\"pattern\":\"^[a-zA-Z]$\"},\"id\":{\"type\":\"string\",\"format\":\"uuid\"},\"idOptional\":{\"type\":\"string\",\"format\":\"uuid\"},\"lockStatus\":{\"type\":\"integer\",\"format\":\"int32\",\"maximum\":100,\"minimum\":1,\"exclusiveMinimum\":true},\"maxDoors\":{\"type\":\"integer\",\"maximum\":5},\"minDoors\":{\"type\":\"integer\",\"format\":\"int64\",\"minimum\":5},\"optionalInt\":{\"type\":\"integer\"},\"state\":{\"type\":\"integer\",\"format\":\"int64\"},\"stationID\":{\"type\":\"string\",\"pattern\":\"^[a-zA-Z]$\"},\"status\":{\"type\":\"integer\",\"maximum\":49,\"exclusiveMaximum\":true,\"minimum\":46,\"exclusiveMinimum\":true},\"valid\":{\"type\":\"string\",\"maxLength\":255},\"website\":{\"type\":\"string\",\"format\":\"url\"},\"websiteOptional\":{\"type\":\"string\",\"format\":\"url\",\"maxLength\":255}}},\"Session\":{\"type\":\"object\",\"required\":[\"Token\",\"Registered\"],\"properties\":{\"Registered\":{\"description\":\"Indicates if the user is registered at the rental system\",\"type\":\"boolean\"},\"Token\":{\"description\":\"Token used within the X-Session-ID header\",\"type\":\"string\"}}},\"Shoe\":{\"type\":\"object\",\"required\":[\"name\",\"size\",\"color\",\"_links\"],\"properties\":{\"_links\":{\"$ref\":\"#/definitions/Links\"},\"color\":{\"type\":\"string\"},\"name\":{\"type\":\"string\"},\"size\":{\"type\":\"number\"}}},\"Shoes\":{\"type\":\"object\",\"required\":[\"id\",\"_embedded\",\"_links\"],\"properties\":{\"_embedded\":{\"$ref\":\"#/definitions/ShoesEmbedded\"},\"_links\":{\"$ref\":\"#/definitions/Links\"},\"id\":{\"type\":\"string\"}}},\"ShoesEmbedded\":{\"type\":\"object\",\"required\":[\"shop:shoes\"],\"properties\":{\"shop:shoes\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/Shoe\"}}}},\"TechnicalInformation\":{\"type\":\"object\",\"required\":[\"transmission\"],\"properties\":{\"transmission\":{\"type\":\"string\",\"example\":\"7G-DCT\"}}},\"User\":{\"type\":\"object\",\"required\":[\"id\",\"password\"],\"properties\":{\"Address\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/Address\"}},\"email\":{\"type\":\"string\",\"format\":\"email\",\"maxLength\":255},\"grantedProtocolMappers\":{\"type\":\"object\",\"additionalProperties\":{\"type\":\"string\"}},\"id\":{\"type\":\"string\"},\"password\":{\"type\":\"string\"},\"permissions\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}}},\"ValidationError\":{\"type\":\"object\",\"properties\":{\"Code\":{\"type\":\"string\"},\"Field\":{\"type\":\"string\"},\"Message\":{\"type\":\"string\"}}},\"ValidationErrors\":{\"type\":\"object\",\"properties\":{\"Errors\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/ValidationError\"}},\"Message\":{\"type\":\"string\"}}},\"views set\":{\"type\":\"object\",\"required\":[\"id\"],\"properties\":{\"id\":{\"type\":\"string\"},\"name\":{\"type\":\"string\"},\"views\":{\"description\":\"View definitions in YAML format\",\"type\":\"string\"}}}},\"parameters\":{\"X-Request-ID\":{\"type\":\"string\",\"description\":\"ID of the request in UUIDv4 format\",\"name\":\"X-Request-ID\",\"in\":\"header\"},\"componentType\":{\"enum\":[\"WHEELS\",\"PAINTS\",\"UPHOLSTERIES\",\"TRIMS\",\"PACKAGES\",\"LINES\",\"SPECIAL_EDITION\",\"SPECIAL_EQUIPMENT\"],\"type\":\"array\",\"items\":{\"type\":\"string\"},\"description\":\"A list of component types separated by a comma case insensitive. If nothing is defined all component types are returned.\",\"name\":\"componentTypes\",\"in\":\"query\"},\"fileParam\":{\"type\":\"file\",\"description\":\"File to be uploaded in request.\",\"name\":\"file\",\"in\":\"formData\"},\"productGroup\":{\"enum\":[\"PKW\",\"GELAENDEWAGEN\",\"VAN\",\"SPRINTER\",\"CITAN\",\"SMART\"],\"type\":\"string\",\"default\":\"PKW\",\"description\":\"The productGroup of a vehicle case insensitive.\",\"name\":\"productGroup\",\"in\":\"path\",\"required\":true}},\"securityDefinitions\":{\"X-Session-ID\":{\"type\":\"apiKey\",\"name\":\"X-Session-ID\",\"in\":\"header\"}}}"
+const swagger = "{\"consumes\":[\"application/json\"],\"produces\":[\"application/json\"],\"swagger\":\"2.0\",\"info\":{\"description\":\"Vehicle Information Service Admin API\",\"title\":\"vis-admin\",\"contact\":{\"name\":\"Max Mustermann\",\"email\":\"max.musterman@fake.de\"},\"version\":\"1.0.0\"},\"paths\":{\"/api/client\":{\"get\":{\"summary\":\"List clients\",\"operationId\":\"GetClients\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Status 200\",\"schema\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/Client\"}}},\"204\":{\"description\":\"Status 201\"},\"403\":{\"description\":\"Not authenticated\"}}}},\"/api/client/{clientId}\":{\"get\":{\"summary\":\"Get client\",\"operationId\":\"GetClient\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\",\"schema\":{\"$ref\":\"#/definitions/Client\"}},\"403\":{\"description\":\"Not authenticated\"},\"404\":{\"description\":\"Not found\"}}},\"put\":{\"summary\":\"Create or update client\",\"operationId\":\"CreateOrUpdateClient\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true},{\"name\":\"body\",\"in\":\"body\",\"required\":true,\"schema\":{\"$ref\":\"#/definitions/Client\"}}],\"responses\":{\"200\":{\"description\":\"Updated\"},\"201\":{\"description\":\"Created\"},\"400\":{\"description\":\"Malformed request body\"},\"403\":{\"description\":\"Not authenticated\"},\"405\":{\"description\":\"Not allowed\"}}},\"delete\":{\"summary\":\"Delete client\",\"operationId\":\"DeleteClient\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\"},\"403\":{\"description\":\"Not authenticated\"},\"404\":{\"description\":\"Not found\"}}},\"parameters\":[{\"type\":\"string\",\"name\":\"clientId\",\"in\":\"path\",\"required\":true}]},\"/api/client/{clientId}/views\":{\"get\":{\"summary\":\"List views sets\",\"operationId\":\"GetViewsSets\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\",\"schema\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/views%20set\"}}},\"403\":{\"description\":\"Not authenticated\"}}},\"parameters\":[{\"type\":\"string\",\"name\":\"clientId\",\"in\":\"path\",\"required\":true}]},\"/api/client/{clientId}/views/{viewsId}\":{\"get\":{\"summary\":\"Get views set\",\"operationId\":\"GetViewsSet\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true},{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"page\",\"in\":\"query\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\",\"schema\":{\"$ref\":\"#/definitions/views%20set\"}},\"403\":{\"description\":\"Not authenticated\"},\"404\":{\"description\":\"Not found\"}}},\"put\":{\"summary\":\"Create or update views set\",\"operationId\":\"CreateOrUpdateViewsSet\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true},{\"name\":\"body\",\"in\":\"body\",\"required\":true,\"schema\":{\"$ref\":\"#/definitions/views%20set\"}}],\"responses\":{\"200\":{\"description\":\"Updated\"},\"201\":{\"description\":\"Created\"},\"400\":{\"description\":\"Malformed request body\"},\"403\":{\"description\":\"Not authenticated\"},\"405\":{\"description\":\"Not allowed\"}}},\"post\":{\"description\":\"Make this viewset the active one for the client.\",\"summary\":\"Activate views set\",\"operationId\":\"ActivateViewsSet\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\"},\"403\":{\"description\":\"Not authenticated\"},\"404\":{\"description\":\"Not found\"}}},\"delete\":{\"summary\":\"Delete views set\",\"operationId\":\"DeleteViewsSet\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\"},\"403\":{\"description\":\"Not authenticated\"},\"404\":{\"description\":\"Not found\"}}},\"parameters\":[{\"type\":\"string\",\"name\":\"clientId\",\"in\":\"path\",\"required\":true},{\"type\":\"string\",\"name\":\"viewsId\",\"in\":\"path\",\"required\":true}]},\"/api/client/{clientId}/views/{viewsId}/{view}/{breakpoint}/{spec}\":{\"get\":{\"summary\":\"Show vehicle in view\",\"operationId\":\"ShowVehicleInView\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\"},\"403\":{\"description\":\"Not authenticated\"},\"404\":{\"description\":\"Not found\"}}},\"parameters\":[{\"type\":\"string\",\"name\":\"clientId\",\"in\":\"path\",\"required\":true},{\"type\":\"string\",\"name\":\"viewsId\",\"in\":\"path\",\"required\":true},{\"type\":\"string\",\"name\":\"view\",\"in\":\"path\",\"required\":true},{\"type\":\"string\",\"name\":\"breakpoint\",\"in\":\"path\",\"required\":true},{\"type\":\"string\",\"name\":\"spec\",\"in\":\"path\",\"required\":true}]},\"/api/permission\":{\"get\":{\"description\":\"Get the list of permissions\\na user can grant to other users.\",\"summary\":\"List permissions\",\"operationId\":\"GetPermissions\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Status 200\",\"schema\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}},\"403\":{\"description\":\"Not authenticated\"}}}},\"/api/session\":{\"get\":{\"tags\":[\"SESSION\"],\"summary\":\"Get user info\",\"operationId\":\"GetUserInfo\",\"parameters\":[{\"maxLength\":255,\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true},{\"maximum\":255,\"type\":\"integer\",\"description\":\"session\",\"name\":\"subID\",\"in\":\"header\"}],\"responses\":{\"200\":{\"description\":\"Status 200\",\"schema\":{\"$ref\":\"#/definitions/User\"}},\"400\":{\"description\":\"Malformed request body\",\"schema\":{\"$ref\":\"#/definitions/ValidationErrors\"}},\"403\":{\"description\":\"Not authenticatedq\"}}},\"post\":{\"tags\":[\"SESSION\"],\"summary\":\"Create session\",\"operationId\":\"CreateSession\",\"parameters\":[{\"name\":\"body\",\"in\":\"body\",\"required\":true,\"schema\":{\"type\":\"object\",\"required\":[\"id\",\"password\"],\"properties\":{\"id\":{\"type\":\"string\",\"minLength\":1},\"password\":{\"type\":\"string\",\"minLength\":1}}}}],\"responses\":{\"200\":{\"description\":\"Authentication successful\",\"headers\":{\"X-Auth\":{\"type\":\"string\",\"description\":\"Authentication token\"}}},\"400\":{\"description\":\"Malformed request body\",\"schema\":{\"$ref\":\"#/definitions/ValidationErrors\"}},\"401\":{\"description\":\"Authentication not successful\"}}},\"delete\":{\"summary\":\"Destroy session\",\"operationId\":\"DestroySession\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Session destroyed\"},\"404\":{\"description\":\"Session not found\"}}}},\"/api/user\":{\"get\":{\"summary\":\"List users\",\"operationId\":\"GetUsers\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\",\"schema\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/User\"}}},\"403\":{\"description\":\"Not authenticated\"}}}},\"/api/user/{userId}\":{\"get\":{\"summary\":\"Get user\",\"operationId\":\"GetUser\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\",\"schema\":{\"$ref\":\"#/definitions/User\"}},\"403\":{\"description\":\"Not authenticated\"},\"404\":{\"description\":\"Not found\"}}},\"put\":{\"summary\":\"Create or update user\",\"operationId\":\"CreateOrUpdateUser\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true},{\"name\":\"body\",\"in\":\"body\",\"required\":true,\"schema\":{\"$ref\":\"#/definitions/User\"}}],\"responses\":{\"200\":{\"description\":\"Updated\"},\"201\":{\"description\":\"Created\"},\"400\":{\"description\":\"Malformed request body\"},\"403\":{\"description\":\"Not authenticated\"},\"405\":{\"description\":\"Not allowed\"}}},\"delete\":{\"summary\":\"Delete user\",\"operationId\":\"DeleteUser\",\"parameters\":[{\"type\":\"string\",\"description\":\"Authentication token\",\"name\":\"X-Auth\",\"in\":\"header\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Success\"},\"403\":{\"description\":\"Not authenticated\"},\"404\":{\"description\":\"Not found\"}}},\"parameters\":[{\"type\":\"string\",\"name\":\"userId\",\"in\":\"path\",\"required\":true},{\"type\":\"boolean\",\"name\":\"allKeys\",\"in\":\"query\"}]},\"/booking\":{\"get\":{\"security\":[{\"X-Session-ID\":[]}],\"description\":\"Get booking of session owner\",\"consumes\":[\"application/xml\"],\"summary\":\"Get booking\",\"operationId\":\"GetBooking\",\"responses\":{\"200\":{\"description\":\"status 200\",\"schema\":{\"type\":\"string\"}},\"400\":{\"description\":\"status 400\"},\"401\":{\"description\":\"Unauthorized Session Token\"},\"404\":{\"description\":\"Resource Not Found\"},\"500\":{\"description\":\"Malfunction (internal requirements not fulfilled)\"}}}},\"/booking/yaml\":{\"get\":{\"security\":[{\"X-Session-ID\":[]}],\"description\":\"Get booking of session owner, the consumed content type isn't supported\",\"consumes\":[\"application/x-yaml\"],\"summary\":\"Get booking as YAML\",\"operationId\":\"GetBookingYaml\",\"responses\":{\"200\":{\"description\":\"status 200\",\"schema\":{\"type\":\"string\"}},\"500\":{\"description\":\"Malfunction (internal requirements not fulfilled)\"}}}},\"/bookings\":{\"get\":{\"security\":[{\"X-Session-ID\":[]}],\"description\":\"Get bookings of session owner\",\"produces\":[\"application/json\"],\"summary\":\"Get bookings\",\"operationId\":\"GetBookings\",\"parameters\":[{\"type\":\"string\",\"name\":\"date\",\"in\":\"header\"},{\"type\":\"array\",\"items\":{\"type\":\"integer\"},\"name\":\"ids\",\"in\":\"query\"}],\"responses\":{\"200\":{\"description\":\"Success List Booking History\",\"schema\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/Booking\"}}},\"400\":{\"description\":\"status 400\"},\"401\":{\"description\":\"Unauthorized Session Token\"},\"404\":{\"description\":\"Resource Not Found\"},\"500\":{\"description\":\"Malfunction (internal requirements not fulfilled)\"}}}},\"/brands/{brandId}/models\":{\"get\":{\"tags\":[\"MODEL\"],\"summary\":\"Get all available models for the given brandId\",\"operationId\":\"ListModels\",\"parameters\":[{\"name\":\"driveConcept\",\"in\":\"query\",\"schema\":{\"$ref\":\"#/definitions/DriveConcept\"}},{\"type\":\"string\",\"x-example\":\"de\",\"name\":\"languageId\",\"in\":\"query\"},{\"type\":\"string\",\"x-example\":\"123\",\"name\":\"classId\",\"in\":\"query\"},{\"type\":\"string\",\"name\":\"lineId\",\"in\":\"query\"},{\"type\":\"array\",\"items\":{\"type\":\"integer\"},\"name\":\"ids\",\"in\":\"query\"}],\"responses\":{\"200\":{\"description\":\"Ok\",\"schema\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/Model\"}},\"examples\":{\"application/json\":{\"drive_concept\":\"drive_concept\",\"price\":38,\"technical_information\":null}}}}},\"parameters\":[{\"type\":\"string\",\"name\":\"brandId\",\"in\":\"path\",\"required\":true}]},\"/classes/{productGroup}\":{\"get\":{\"summary\":\"Get all available classes.\",\"operationId\":\"GetClasses\",\"parameters\":[{\"enum\":[\"WHEELS\",\"PAINTS\",\"UPHOLSTERIES\",\"TRIMS\",\"PACKAGES\",\"LINES\",\"SPECIAL_EDITION\",\"SPECIAL_EQUIPMENT\"],\"type\":\"array\",\"items\":{\"type\":\"string\"},\"description\":\"A list of component types separated by a comma case insensitive. If nothing is defined all component types are returned.\",\"name\":\"componentTypes\",\"in\":\"query\"},{\"enum\":[\"PKW\",\"GELAENDEWAGEN\",\"VAN\",\"SPRINTER\",\"CITAN\",\"SMART\"],\"type\":\"string\",\"default\":\"PKW\",\"description\":\"The productGroup of a vehicle case insensitive.\",\"name\":\"productGroup\",\"in\":\"path\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Successful response\",\"schema\":{\"type\":\"string\"}},\"400\":{\"description\":\"Successful response\",\"schema\":{\"type\":\"string\"}}}}},\"/code\":{\"post\":{\"consumes\":[\"application/x-www-form-urlencoded\"],\"summary\":\"code to token\",\"operationId\":\"Code\",\"parameters\":[{\"type\":\"array\",\"items\":{\"type\":\"integer\"},\"name\":\"state\",\"in\":\"formData\"},{\"type\":\"string\",\"name\":\"response_mode\",\"in\":\"formData\"},{\"type\":\"string\",\"name\":\"code\",\"in\":\"formData\",\"required\":true},{\"type\":\"string\",\"name\":\"session\",\"in\":\"query\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"TBD\",\"schema\":{\"type\":\"string\"}},\"400\":{\"description\":\"status 400\"},\"401\":{\"description\":\"Unauthorized Session code\"},\"404\":{\"description\":\"Resource Not Found\"},\"500\":{\"description\":\"Malfunction (internal requirements not fulfilled)\"}}}},\"/customer/session\":{\"post\":{\"description\":\"Creates a customer session for a given OpenID authentication token.\\n\",\"consumes\":[\"application/x-www-form-urlencoded\"],\"produces\":[\"application/json\"],\"summary\":\"Create session (login)\",\"operationId\":\"CreateCustomerSession\",\"parameters\":[{\"maxLength\":255,\"type\":\"string\",\"description\":\"OpenID authentication token\",\"name\":\"code\",\"in\":\"formData\",\"required\":true},{\"maxLength\":255,\"pattern\":\"^([a-z]{2})-([A-Z]{2})$\",\"type\":\"string\",\"description\":\"default locale\",\"name\":\"locale\",\"in\":\"formData\"},{\"type\":\"string\",\"description\":\"ID of the request in UUIDv4 format\",\"name\":\"X-Request-ID\",\"in\":\"header\"}],\"responses\":{\"201\":{\"description\":\"Session successful created\",\"schema\":{\"$ref\":\"#/definitions/Session\"}},\"401\":{\"description\":\"Invalid OpenID authentication token\"},\"403\":{\"description\":\"Create session with authentication token is forbidden (e.g. Token already used)\\n\"},\"422\":{\"description\":\"Invalid request data\",\"schema\":{\"$ref\":\"#/definitions/ValidationErrors\"}},\"500\":{\"description\":\"Internal server error (e.g. unexpected condition occurred)\"}}},\"delete\":{\"security\":[{\"X-Session-ID\":[]}],\"description\":\"Deletes the user session matching the *X-Auth* header.\\n\",\"summary\":\"Delete session (logout)\",\"operationId\":\"DeleteCustomerSession\",\"parameters\":[{\"type\":\"string\",\"description\":\"ID of the request in UUIDv4 format\",\"name\":\"X-Request-ID\",\"in\":\"header\"}],\"responses\":{\"204\":{\"description\":\"Session successful deleted\"},\"401\":{\"description\":\"Invalid session token\"},\"500\":{\"description\":\"Internal server error (e.g. unexpected condition occurred)\"}}}},\"/download/nested/file\":{\"get\":{\"description\":\"Downloads a file that is a property within a nested structure in the response body\\n\",\"produces\":[\"application/json\"],\"summary\":\"Downloads a nested file\",\"operationId\":\"DownloadNestedFile\",\"responses\":{\"200\":{\"description\":\"Nested file structure\",\"schema\":{\"$ref\":\"#/definitions/NestedFileStructure\"}}}}},\"/download/{image}\":{\"get\":{\"description\":\"Retrieve a image\",\"produces\":[\"image/png\"],\"summary\":\"Retrieve a image\",\"operationId\":\"DownloadImage\",\"parameters\":[{\"type\":\"string\",\"description\":\"The image name of the image\",\"name\":\"image\",\"in\":\"path\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"image to download\",\"schema\":{\"type\":\"file\"},\"headers\":{\"Content-Type\":{\"type\":\"string\"}}},\"500\":{\"description\":\"Malfunction (internal requirements not fulfilled)\"}}}},\"/elements\":{\"get\":{\"summary\":\"ListElements\",\"operationId\":\"ListElements\",\"parameters\":[{\"type\":\"integer\",\"default\":1,\"name\":\"_page\",\"in\":\"query\"},{\"type\":\"integer\",\"default\":10,\"name\":\"_perPage\",\"in\":\"query\"}],\"responses\":{\"200\":{\"description\":\"Status 200\",\"schema\":{\"type\":\"string\"},\"headers\":{\"X-Total-Count\":{\"type\":\"integer\"}}},\"500\":{\"description\":\"Status 500\"}}}},\"/file-upload\":{\"post\":{\"consumes\":[\"multipart/form-data\"],\"summary\":\"File upload\",\"operationId\":\"FileUpload\",\"parameters\":[{\"type\":\"file\",\"description\":\"File to be uploaded in request.\",\"name\":\"file\",\"in\":\"formData\"}],\"responses\":{\"204\":{\"description\":\"File uploaded.\"},\"500\":{\"description\":\"Internal server error\"}}}},\"/filedownload/{file}\":{\"get\":{\"description\":\"Retrieve a file\",\"produces\":[\"text/xml\"],\"summary\":\"Retrieve a file\",\"operationId\":\"DownloadFile\",\"responses\":{\"200\":{\"description\":\"file to download\",\"schema\":{\"type\":\"file\"},\"headers\":{\"Content-Type\":{\"type\":\"string\"}}}}},\"parameters\":[{\"type\":\"string\",\"description\":\"The filename of the file\",\"name\":\"file\",\"in\":\"path\",\"required\":true}]},\"/findByTags\":{\"get\":{\"description\":\"Multiple tags can be provided with comma separated strings. Use tag1, tag2, tag3 for testing.\",\"produces\":[\"application/json\"],\"summary\":\"Finds elements by tags\",\"operationId\":\"FindByTags\",\"parameters\":[{\"maxItems\":5,\"minItems\":2,\"type\":\"array\",\"items\":{\"type\":\"string\"},\"description\":\"Tags to filter by\",\"name\":\"tags\",\"in\":\"query\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"successful operation\",\"schema\":{\"type\":\"string\"}},\"400\":{\"description\":\"Invalid tag value\"}}}},\"/generic/download/{ext}\":{\"get\":{\"description\":\"Retrieve a file\",\"produces\":[\"application/json\"],\"summary\":\"Retrieve a file\",\"operationId\":\"GenericFileDownload\",\"responses\":{\"200\":{\"description\":\"file to download\",\"schema\":{\"type\":\"file\"},\"headers\":{\"Content-Type\":{\"type\":\"string\"},\"Pragma\":{\"type\":\"string\"}}},\"500\":{\"description\":\"Malfunction (internal requirements not fulfilled)\"}}},\"parameters\":[{\"type\":\"string\",\"description\":\"The ext of the file\",\"name\":\"ext\",\"in\":\"path\",\"required\":true}]},\"/parameters/{id}\":{\"post\":{\"description\":\"Validates the constraints of path, header and form data parameters\",\"consumes\":[\"multipart/form-data\"],\"summary\":\"Validate parameters\",\"operationId\":\"ValidateParameters\",\"parameters\":[{\"minLength\":3,\"pattern\":\"^[a-z]+$\",\"type\":\"string\",\"name\":\"id\",\"in\":\"path\",\"required\":true},{\"enum\":[\"fast\",\"slow\"],\"type\":\"string\",\"name\":\"X-Mode\",\"in\":\"header\"},{\"maximum\":10,\"type\":\"integer\",\"name\":\"X-Limit\",\"in\":\"header\"},{\"maximum\":1,\"minimum\":0,\"exclusiveMinimum\":true,\"type\":\"number\",\"name\":\"ratio\",\"in\":\"formData\"},{\"minLength\":2,\"type\":\"string\",\"name\":\"label\",\"in\":\"formData\"}],\"responses\":{\"204\":{\"description\":\"Parameters are valid\"},\"400\":{\"description\":\"Invalid parameters\",\"schema\":{\"$ref\":\"#/definitions/ValidationErrors\"}}}}},\"/partners\":{\"get\":{\"description\":\"Lists the partners, the list is streamed as newline delimited JSON if accepted\",\"produces\":[\"application/json\",\"application/x-ndjson\"],\"summary\":\"List partners\",\"operationId\":\"ListPartners\",\"parameters\":[{\"minimum\":1,\"type\":\"integer\",\"name\":\"limit\",\"in\":\"query\"}],\"responses\":{\"200\":{\"description\":\"List of partners\",\"schema\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/Partner\"}}},\"400\":{\"description\":\"Invalid request\",\"schema\":{\"$ref\":\"#/definitions/ValidationErrors\"}}}},\"post\":{\"description\":\"Creates a partner from a XML document\",\"consumes\":[\"application/xml\",\"text/xml\"],\"produces\":[\"application/xml\",\"application/json\"],\"summary\":\"Create partner\",\"operationId\":\"CreatePartner\",\"parameters\":[{\"name\":\"body\",\"in\":\"body\",\"required\":true,\"schema\":{\"$ref\":\"#/definitions/Partner\"}}],\"responses\":{\"201\":{\"description\":\"Partner created\",\"schema\":{\"$ref\":\"#/definitions/Partner\"}},\"400\":{\"description\":\"Invalid partner\",\"schema\":{\"$ref\":\"#/definitions/ValidationErrors\"}}}}},\"/partners/events\":{\"get\":{\"description\":\"Streams the changed partners as server-sent events\",\"produces\":[\"text/event-stream\"],\"summary\":\"Watch partners\",\"operationId\":\"WatchPartners\",\"parameters\":[{\"minimum\":1,\"type\":\"integer\",\"name\":\"limit\",\"in\":\"query\"}],\"responses\":{\"200\":{\"description\":\"Stream of changed partners\",\"schema\":{\"$ref\":\"#/definitions/Partner\"}},\"400\":{\"description\":\"Invalid request\",\"schema\":{\"$ref\":\"#/definitions/ValidationErrors\"}}}}},\"/partners/{partnerId}\":{\"get\":{\"description\":\"Returns a partner, conditional requests are answered with 304 if the partner is unchanged\",\"produces\":[\"application/json\"],\"summary\":\"Get partner\",\"operationId\":\"GetPartner\",\"responses\":{\"200\":{\"description\":\"Partner\",\"schema\":{\"$ref\":\"#/definitions/Partner\"}},\"404\":{\"description\":\"Partner not found\"},\"default\":{\"description\":\"Unexpected error\",\"schema\":{\"$ref\":\"#/definitions/ErrorMessage\"},\"headers\":{\"X-Request-ID\":{\"type\":\"string\"}}}}},\"put\":{\"description\":\"Updates a partner, conditional requests fail with 412 if the partner was changed\",\"consumes\":[\"application/json\"],\"produces\":[\"application/json\"],\"summary\":\"Update partner\",\"operationId\":\"UpdatePartner\",\"parameters\":[{\"name\":\"body\",\"in\":\"body\",\"required\":true,\"schema\":{\"$ref\":\"#/definitions/Partner\"}}],\"responses\":{\"200\":{\"description\":\"Partner updated\",\"schema\":{\"$ref\":\"#/definitions/Partner\"}},\"404\":{\"description\":\"Partner not found\"},\"412\":{\"description\":\"Partner was changed\"}}},\"parameters\":[{\"type\":\"string\",\"name\":\"partnerId\",\"in\":\"path\",\"required\":true}]},\"/partners/{partnerId}/archive\":{\"post\":{\"description\":\"Imports the files of a partner while they are uploaded\",\"consumes\":[\"multipart/form-data\"],\"produces\":[\"application/json\"],\"summary\":\"Import partner archive\",\"operationId\":\"ImportPartnerArchive\",\"parameters\":[{\"type\":\"string\",\"name\":\"partnerId\",\"in\":\"path\",\"required\":true},{\"type\":\"string\",\"name\":\"note\",\"in\":\"formData\",\"required\":true},{\"type\":\"array\",\"items\":{\"type\":\"file\"},\"name\":\"files\",\"in\":\"formData\"}],\"responses\":{\"200\":{\"description\":\"Imported files\",\"schema\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/UploadedFile\"}}},\"413\":{\"description\":\"Archive too large\"}},\"x-upload\":{\"maxTotalSize\":4096,\"stream\":true}}},\"/partners/{partnerId}/chat\":{\"get\":{\"description\":\"Exchanges chat messages with a partner over a WebSocket\",\"schemes\":[\"ws\"],\"summary\":\"Chat with partner\",\"operationId\":\"ChatWithPartner\",\"parameters\":[{\"type\":\"string\",\"name\":\"partnerId\",\"in\":\"path\",\"required\":true}],\"responses\":{\"101\":{\"description\":\"Switching to the WebSocket protocol\"},\"404\":{\"description\":\"Partner not found\"}},\"x-websocket\":{\"inbound\":{\"$ref\":\"#/definitions/ChatMessage\"},\"outbound\":{\"$ref\":\"#/definitions/ChatReply\"}}}},\"/partners/{partnerId}/contract\":{\"get\":{\"description\":\"Returns the contract of a partner, range requests download a part of the contract\",\"produces\":[\"application/pdf\"],\"summary\":\"Download partner contract\",\"operationId\":\"DownloadPartnerContract\",\"parameters\":[{\"type\":\"string\",\"name\":\"partnerId\",\"in\":\"path\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Contract of the partner\",\"schema\":{\"type\":\"file\"},\"headers\":{\"Content-Type\":{\"type\":\"string\"}}},\"404\":{\"description\":\"Partner not found\"}}}},\"/partners/{partnerId}/documents\":{\"post\":{\"description\":\"Uploads several documents of a partner, the documents are limited to 64 bytes\",\"consumes\":[\"multipart/form-data\",\"application/pdf\",\"image/png\"],\"produces\":[\"application/json\"],\"summary\":\"Upload partner documents\",\"operationId\":\"UploadPartnerDocuments\",\"parameters\":[{\"type\":\"string\",\"name\":\"partnerId\",\"in\":\"path\",\"required\":true},{\"type\":\"string\",\"name\":\"category\",\"in\":\"formData\",\"required\":true},{\"type\":\"array\",\"items\":{\"type\":\"file\"},\"name\":\"documents\",\"in\":\"formData\",\"required\":true},{\"type\":\"file\",\"name\":\"cover\",\"in\":\"formData\"}],\"responses\":{\"200\":{\"description\":\"Uploaded documents\",\"schema\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/UploadedFile\"}}},\"413\":{\"description\":\"Document too large\"},\"415\":{\"description\":\"Document type not supported\"}},\"x-upload\":{\"maxFileSize\":64}}},\"/partners/{partnerId}/profile\":{\"get\":{\"description\":\"Returns the profile of a partner, plain text is produced by the declaration only\",\"produces\":[\"text/plain\",\"application/json\"],\"summary\":\"Get partner profile\",\"operationId\":\"GetPartnerProfile\",\"parameters\":[{\"type\":\"string\",\"name\":\"partnerId\",\"in\":\"path\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Partner profile\",\"schema\":{\"$ref\":\"#/definitions/Partner\"}}}}},\"/partners/{partnerId}/statement\":{\"get\":{\"security\":[{\"OAuth2\":[\"partners\"]}],\"description\":\"Returns the statement of a partner, the access token of the OAuth2 security definition is required\",\"summary\":\"Get partner statement\",\"operationId\":\"GetPartnerStatement\",\"parameters\":[{\"type\":\"string\",\"name\":\"partnerId\",\"in\":\"path\",\"required\":true}],\"responses\":{\"200\":{\"description\":\"Partner statement\",\"schema\":{\"$ref\":\"#/definitions/Partner\"}},\"401\":{\"description\":\"Invalid access token\"}}}},\"/rental\":{\"get\":{\"description\":\"get rental\",\"consumes\":[\"application/json\"],\"summary\":\"Get rental\",\"operationId\":\"GetRental\",\"parameters\":[{\"name\":\"body\",\"in\":\"body\",\"required\":true,\"schema\":{\"$ref\":\"#/definitions/Rental\"}}],\"responses\":{\"200\":{\"description\":\"status 200\"},\"400\":{\"description\":\"status 400\",\"schema\":{\"$ref\":\"#/definitions/ValidationErrors\"}}}}},\"/shop/shoes\":{\"get\":{\"produces\":[\"application/hal+json\"],\"summary\":\"Get all shoes\",\"operationId\":\"GetShoes\",\"responses\":{\"200\":{\"description\":\"Successful\",\"schema\":{\"$ref\":\"#/definitions/Shoes\"}}}}},\"/upload\":{\"post\":{\"consumes\":[\"multipart/form-data\"],\"summary\":\"Upload a file with others data\",\"operationId\":\"PostUpload\",\"parameters\":[{\"type\":\"file\",\"description\":\"the file to upload\",\"name\":\"upfile\",\"in\":\"formData\"},{\"maxLength\":4000,\"pattern\":\"^[0-9a-zA-Z ]*$\",\"type\":\"string\",\"description\":\"Description of file\",\"name\":\"note\",\"in\":\"formData\"}],\"responses\":{\"200\":{\"description\":\"Status 200\"},\"500\":{\"description\":\"Status 500\"}}}}},\"definitions\":{\"Address\":{\"type\":\"object\",\"required\":[\"city\",\"country\",\"houseNumber\",\"postalCode\",\"region\",\"street\"],\"properties\":{\"city\":{\"description\":\"City\",\"type\":\"string\"},\"country\":{\"description\":\"Country (ISO 3166)\",\"type\":\"string\"},\"houseNumber\":{\"description\":\"House number\",\"type\":\"string\"},\"postalCode\":{\"description\":\"Postal code\",\"type\":\"string\"},\"region\":{\"description\":\"Region\",\"type\":\"string\"},\"street\":{\"description\":\"Street name\",\"type\":\"string\"}}},\"BasicTypes\":{\"type\":\"object\",\"required\":[\"string\",\"integer\",\"boolean\",\"number\",\"slice\",\"map\"],\"properties\":{\"boolean\":{\"type\":\"boolean\"},\"integer\":{\"type\":\"integer\"},\"map\":{\"type\":\"object\",\"additionalProperties\":{\"type\":\"string\"}},\"number\":{\"type\":\"number\"},\"slice\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}},\"string\":{\"type\":\"string\"}}},\"Booking\":{\"type\":\"object\",\"required\":[\"id\"],\"properties\":{\"bookingID\":{\"type\":\"string\"}}},\"ChatMessage\":{\"type\":\"object\",\"required\":[\"text\"],\"properties\":{\"text\":{\"type\":\"string\",\"minLength\":1}}},\"ChatReply\":{\"type\":\"object\",\"required\":[\"partnerId\",\"text\"],\"properties\":{\"partnerId\":{\"type\":\"string\"},\"text\":{\"type\":\"string\"}}},\"Client\":{\"type\":\"object\",\"required\":[\"id\",\"name\"],\"properties\":{\"activePresets\":{\"type\":\"string\"},\"configuration\":{\"type\":\"object\",\"properties\":{\"bbdCEBaseUrl\":{\"type\":\"string\"},\"bbdCallerIdentifier\":{\"type\":\"string\"},\"bbdDataSupply\":{\"type\":\"string\"},\"bbdImageBackground\":{\"type\":\"string\"},\"bbdImagePerspective\":{\"type\":\"string\"},\"bbdImageType\":{\"type\":\"string\"},\"bbdPassword\":{\"type\":\"string\"},\"bbdProductGroup\":{\"type\":\"string\"},\"bbdSoapMediaProviderUrl\":{\"type\":\"string\"},\"bbdUser\":{\"type\":\"string\"},\"ccoreServiceUrl\":{\"type\":\"string\"},\"cryptKeys\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}},\"healConfigurations\":{\"type\":\"boolean\"}}},\"id\":{\"type\":\"string\"},\"name\":{\"type\":\"string\"}}},\"DriveConcept\":{\"description\":\"The kind of drive concept of a vehicle. Where UNDEFINED is used as the default and/or error case.\",\"type\":\"string\",\"enum\":[\"COMBUSTOR\",\"HYBRID\",\"ELECTRIC\",\"FUELCELL\",\"UNDEFINED\"]},\"EmptySlice\":{\"properties\":{\"EmptySlice\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/Price\"}}}},\"ErrorMessage\":{\"type\":\"object\",\"required\":[\"code\"],\"properties\":{\"code\":{\"type\":\"string\"},\"message\":{\"type\":\"string\"}}},\"Link\":{\"type\":\"object\",\"required\":[\"href\"],\"properties\":{\"href\":{\"type\":\"string\"}}},\"Links\":{\"type\":\"object\",\"required\":[\"self\"],\"properties\":{\"self\":{\"$ref\":\"#/definitions/Link\"}}},\"Model\":{\"type\":\"object\",\"required\":[\"technicalInformation\",\"price\"],\"properties\":{\"driveConcept\":{\"$ref\":\"#/definitions/DriveConcept\"},\"price\":{\"$ref\":\"#/definitions/Price\"},\"technicalInformation\":{\"$ref\":\"#/definitions/TechnicalInformation\"}}},\"NestedFileStructure\":{\"properties\":{\"data\":{\"type\":\"string\"}}},\"Partner\":{\"type\":\"object\",\"required\":[\"id\",\"name\"],\"properties\":{\"credit\":{\"type\":\"integer\"},\"id\":{\"type\":\"string\",\"minLength\":3},\"name\":{\"type\":\"string\"},\"tags\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}}},\"Price\":{\"type\":\"object\",\"required\":[\"currency\",\"value\"],\"properties\":{\"currency\":{\"type\":\"string\",\"example\":\"RMB\"},\"value\":{\"type\":\"number\",\"example\":123456.78}}},\"Rental\":{\"type\":\"object\",\"required\":[\"class\",\"lockStatus\",\"status\",\"stationID\",\"maxDoors\",\"minDoors\",\"website\",\"id\"],\"properties\":{\"class\":{\"type\":\"string\",\"maxLength\":20,\"minLength\":3},\"color\":{\"type\":\"string\",\"maxLength\":20,\"minLength\":3},\"homeID\":{\"type\":\"string\",\"pattern\":\"^[a-zA-Z]$\"},\"id\":{\"type\":\"string\",\"format\":\"uuid\"},\"idOptional\":{\"type\":\"string\",\"format\":\"uuid\"},\"lockStatus\":{\"type\":\"integer\",\"format\":\"int32\",\"maximum\":100,\"minimum\":1,\"exclusiveMinimum\":true},\"maxDoors\":{\"type\":\"integer\",\"maximum\":5},\"minDoors\":{\"type\":\"integer\",\"format\":\"int64\",\"minimum\":5},\"optionalInt\":{\"type\":\"integer\"},\"state\":{\"type\":\"integer\",\"format\":\"int64\"},\"stationID\":{\"type\":\"string\",\"pattern\":\"^[a-zA-Z]$\"},\"status\":{\"type\":\"integer\",\"maximum\":49,\"exclusiveMaximum\":true,\"minimum\":46,\"exclusiveMinimum\":true},\"valid\":{\"type\":\"string\",\"maxLength\":255},\"website\":{\"type\":\"string\",\"format\":\"url\"},\"websiteOptional\":{\"type\":\"string\",\"format\":\"url\",\"maxLength\":255}}},\"Session\":{\"type\":\"object\",\"required\":[\"Token\",\"Registered\"],\"properties\":{\"Registered\":{\"description\":\"Indicates if the user is registered at the rental system\",\"type\":\"boolean\"},\"Token\":{\"description\":\"Token used within the X-Session-ID header\",\"type\":\"string\"}}},\"Shoe\":{\"type\":\"object\",\"required\":[\"name\",\"size\",\"color\",\"_links\"],\"properties\":{\"_links\":{\"$ref\":\"#/definitions/Links\"},\"color\":{\"type\":\"string\"},\"name\":{\"type\":\"string\"},\"size\":{\"type\":\"number\"}}},\"Shoes\":{\"type\":\"object\",\"required\":[\"id\",\"_embedded\",\"_links\"],\"properties\":{\"_embedded\":{\"$ref\":\"#/definitions/ShoesEmbedded\"},\"_links\":{\"$ref\":\"#/definitions/Links\"},\"id\":{\"type\":\"string\"}}},\"ShoesEmbedded\":{\"type\":\"object\",\"required\":[\"shop:shoes\"],\"properties\":{\"shop:shoes\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/Shoe\"}}}},\"TechnicalInformation\":{\"type\":\"object\",\"required\":[\"transmission\"],\"properties\":{\"transmission\":{\"type\":\"string\",\"example\":\"7G-DCT\"}}},\"UploadedFile\":{\"type\":\"object\",\"required\":[\"field\",\"name\",\"size\"],\"properties\":{\"field\":{\"type\":\"string\"},\"name\":{\"type\":\"string\"},\"note\":{\"type\":\"string\"},\"size\":{\"type\":\"integer\",\"format\":\"int64\"}}},\"User\":{\"type\":\"object\",\"required\":[\"id\",\"password\"],\"properties\":{\"Address\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/Address\"}},\"email\":{\"type\":\"string\",\"format\":\"email\",\"maxLength\":255},\"grantedProtocolMappers\":{\"type\":\"object\",\"additionalProperties\":{\"type\":\"string\"}},\"id\":{\"type\":\"string\"},\"password\":{\"type\":\"string\"},\"permissions\":{\"type\":\"array\",\"items\":{\"type\":\"string\"}}}},\"ValidationError\":{\"type\":\"object\",\"properties\":{\"Code\":{\"type\":\"string\"},\"Field\":{\"type\":\"string\"},\"Message\":{\"type\":\"string\"}}},\"ValidationErrors\":{\"type\":\"object\",\"properties\":{\"Errors\":{\"type\":\"array\",\"items\":{\"$ref\":\"#/definitions/ValidationError\"}},\"Message\":{\"type\":\"string\"}},\"xml\":{\"name\":\"validationErrors\"}},\"views set\":{\"type\":\"object\",\"required\":[\"id\"],\"properties\":{\"id\":{\"type\":\"string\"},\"name\":{\"type\":\"string\"},\"views\":{\"description\":\"View definitions in YAML format\",\"type\":\"string\"}}}},\"parameters\":{\"X-Request-ID\":{\"type\":\"string\",\"description\":\"ID of the request in UUIDv4 format\",\"name\":\"X-Request-ID\",\"in\":\"header\"},\"componentType\":{\"enum\":[\"WHEELS\",\"PAINTS\",\"UPHOLSTERIES\",\"TRIMS\",\"PACKAGES\",\"LINES\",\"SPECIAL_EDITION\",\"SPECIAL_EQUIPMENT\"],\"type\":\"array\",\"items\":{\"type\":\"string\"},\"description\":\"A list of component types separated by a comma case insensitive. If nothing is defined all component types are returned.\",\"name\":\"componentTypes\",\"in\":\"query\"},\"fileParam\":{\"type\":\"file\",\"description\":\"File to be uploaded in request.\",\"name\":\"file\",\"in\":\"formData\"},\"productGroup\":{\"enum\":[\"PKW\",\"GELAENDEWAGEN\",\"VAN\",\"SPRINTER\",\"CITAN\",\"SMART\"],\"type\":\"string\",\"default\":\"PKW\",\"description\":\"The productGroup of a vehicle case insensitive.\",\"name\":\"productGroup\",\"in\":\"path\",\"required\":true}},\"securityDefinitions\":{\"OAuth2\":{\"type\":\"oauth2\",\"flow\":\"application\",\"tokenUrl\":\"https://auth.example.com/oauth/token\",\"scopes\":{\"partners\":\"Access partners\"}},\"X-Session-ID\":{\"type\":\"apiKey\",\"name\":\"X-Session-ID\",\"in\":\"header\"}}}"
@@ -11,10 +11,12 @@ import (
 	"image"
 	"image/color"
 	"image/png"
+	"io"
 	"io/ioutil"
 	"log"
-
-	routing "github.com/go-ozzo/ozzo-routing"
+	"net/http"
+	"strconv"
+	"time"
 )
 
 var (
@@ -139,7 +141,7 @@ func GetUsers(ctx context.Context, request *GetUsersRequest) GetUsersResponse {
 }
 
 func GetClient(ctx context.Context, request *GetClientRequest) GetClientResponse {
-	if request.ClientId == fromContext {
+	if ctx.Value("clientId") == fromContext {
 		return &GetClient200Response{}
 	}
 	return nil
@@ -303,53 +305,260 @@ func GetBooking(ctx context.Context, request *GetBookingRequest) GetBookingRespo
 	return &GetBooking500Response{}
 }
 
+func GetBookingYaml(ctx context.Context, request *GetBookingYamlRequest) GetBookingYamlResponse {
+	return &GetBookingYaml500Response{}
+}
+
+func CreatePartner(ctx context.Context, request *CreatePartnerRequest) CreatePartnerResponse {
+
+	if NegotiatedContentType(ctx) == "" {
+		return &CreatePartner400Response{}
+	}
+	return &CreatePartner201Response{Body: request.Body}
+}
+
+// failingPartnersLimit is the limit of partner lists which fail after the response started
+const failingPartnersLimit = 99
+
+func ListPartners(ctx context.Context, request *ListPartnersRequest) ListPartnersResponse {
+
+	limit := int64(3)
+	if request.Limit != nil {
+		limit = *request.Limit
+	}
+
+	i := int64(0)
+	return &ListPartners200Response{Items: NewListPartnersItems(func() (*Partner, error) {
+		if i == limit {
+			return nil, io.EOF
+		}
+		// the partner store fails after the first partner of lists with the magic limit
+		if limit == failingPartnersLimit && i == 1 {
+			return nil, errors.New("partner store unavailable")
+		}
+		i++
+		return &Partner{Id: fmt.Sprintf("p-%d", i), Name: fmt.Sprintf("Partner %d", i)}, nil
+	}, nil)}
+}
+
+// partnerLastModified is the date of the last change of the partner p-1, whose current version is v1
+var partnerLastModified = time.Date(2021, 11, 27, 10, 0, 0, 0, time.UTC)
+
+func GetPartner(ctx context.Context, request *GetPartnerRequest) GetPartnerResponse {
+
+	if request.PartnerId == "p-unavailable" {
+		message := "partner service unavailable"
+		return &GetPartnerDefaultResponse{Status: http.StatusServiceUnavailable, Body: ErrorMessage{Code: "unavailable", Message: &message}, XRequestID: "r-1"}
+	}
+	if request.PartnerId != "p-1" {
+		return &GetPartner404Response{}
+	}
+	return &GetPartner200Response{Body: Partner{Id: "p-1", Name: "Partner 1"}, ETag: "v1", LastModified: partnerLastModified}
+}
+
+func PartnerPrecondition(ctx context.Context, request *UpdatePartnerRequest) (string, time.Time, error) {
+
+	if request.PartnerId != "p-1" {
+		return "", time.Time{}, nil
+	}
+	return "v1", partnerLastModified, nil
+}
+
+func UpdatePartner(ctx context.Context, request *UpdatePartnerRequest) UpdatePartnerResponse {
+
+	if request.PartnerId != "p-1" {
+		return &UpdatePartner404Response{}
+	}
+	return &UpdatePartner200Response{Body: request.Body, ETag: "v2", LastModified: partnerLastModified.Add(time.Hour)}
+}
+
+// partnerContract is the contract of the partner p-1, its version is the version of the partner
+var partnerContract = []byte("%PDF-1.4 contract of partner p-1 %%EOF")
+
+// ReadSeekCloser is a seekable body, which can be downloaded partially
+type ReadSeekCloser struct {
+	*bytes.Reader
+}
+
+func (rc *ReadSeekCloser) Close() error {
+	return nil
+}
+
+func DownloadPartnerContract(ctx context.Context, request *DownloadPartnerContractRequest) DownloadPartnerContractResponse {
+
+	if request.PartnerId != "p-1" {
+		return &DownloadPartnerContract404Response{}
+	}
+	return &DownloadPartnerContract200Response{
+		Body:         &ReadSeekCloser{bytes.NewReader(partnerContract)},
+		ContentType:  "application/pdf",
+		ETag:         "v1",
+		LastModified: partnerLastModified,
+	}
+}
+
+func GetPartnerProfile(ctx context.Context, request *GetPartnerProfileRequest) GetPartnerProfileResponse {
+	return &GetPartnerProfile200Response{Body: Partner{Id: request.PartnerId, Name: "Partner profile"}}
+}
+
+const partnerAccessToken = "partner-token"
+
+func GetPartnerStatement(ctx context.Context, request *GetPartnerStatementRequest) GetPartnerStatementResponse {
+	if request.Authorization != "Bearer "+partnerAccessToken {
+		return &GetPartnerStatement401Response{}
+	}
+	return &GetPartnerStatement200Response{Body: Partner{Id: request.PartnerId, Name: "Partner statement"}}
+}
+
+func UploadPartnerDocuments(ctx context.Context, request *UploadPartnerDocumentsRequest) UploadPartnerDocumentsResponse {
+
+	fields := make([]string, len(request.FormData.Documents))
+	files := request.FormData.Documents
+	for i := range fields {
+		fields[i] = "documents"
+	}
+	if request.FormData.Cover != nil {
+		fields = append(fields, "cover")
+		files = append(files, *request.FormData.Cover)
+	}
+
+	uploaded := make([]UploadedFile, 0, len(files))
+	for i, file := range files {
+		size, err := io.Copy(ioutil.Discard, file.Content)
+		file.Content.Close()
+		if err != nil {
+			return &UploadPartnerDocuments413Response{}
+		}
+		uploaded = append(uploaded, UploadedFile{Field: fields[i], Name: file.Header.Filename, Size: size, Note: &request.FormData.Category})
+	}
+	return &UploadPartnerDocuments200Response{Body: uploaded}
+}
+
+func ImportPartnerArchive(ctx context.Context, request *ImportPartnerArchiveRequest) ImportPartnerArchiveResponse {
+
+	uploaded := make([]UploadedFile, 0)
+	for {
+		part, err := request.FormData.Uploads.Next()
+		if err == io.EOF {
+			return &ImportPartnerArchive200Response{Body: uploaded}
+		}
+		if err != nil {
+			return &ImportPartnerArchive413Response{}
+		}
+
+		size, err := io.Copy(ioutil.Discard, part)
+		if err != nil {
+			return &ImportPartnerArchive413Response{}
+		}
+		uploaded = append(uploaded, UploadedFile{Field: part.FieldName, Name: part.FileName, Size: size, Note: &request.FormData.Note})
+	}
+}
+
+func WatchPartners(ctx context.Context, request *WatchPartnersRequest, events *WatchPartnersEventStream) WatchPartnersResponse {
+
+	limit := int64(3)
+	if request.Limit != nil {
+		limit = *request.Limit
+	}
+
+	next := int64(1)
+	if request.LastEventID != "" {
+		last, err := strconv.ParseInt(request.LastEventID, 10, 64)
+		if err != nil {
+			return &WatchPartners400Response{}
+		}
+		next = last + 1
+	}
+
+	for i := next; i <= limit; i++ {
+		event := &WatchPartnersEvent{
+			Name: "partner",
+			ID:   strconv.FormatInt(i, 10),
+			Data: Partner{Id: fmt.Sprintf("p-%d", i), Name: fmt.Sprintf("Partner %d", i)},
+		}
+		if err := events.Send(event); err != nil {
+			return nil
+		}
+	}
+
+	return &WatchPartners200Response{}
+}
+
+func ChatWithPartner(ctx context.Context, request *ChatWithPartnerRequest, conn *ChatWithPartnerServerConn) ChatWithPartnerResponse {
+
+	if request.PartnerId != "p-1" {
+		return &ChatWithPartner404Response{}
+	}
+
+	for {
+		message, err := conn.Receive()
+		if _, ok := err.(*ValidationErrorsObject); ok {
+			if err := conn.Send(&ChatReply{PartnerId: request.PartnerId, Text: "invalid message"}); err != nil {
+				return nil
+			}
+			continue
+		} else if err != nil {
+			return nil
+		}
+
+		if err := conn.Send(&ChatReply{PartnerId: request.PartnerId, Text: "re: " + message.Text}); err != nil {
+			return nil
+		}
+	}
+}
+
 func GetRental(ctx context.Context, request *GetRentalRequest) GetRentalResponse {
 	return nil
 }
 
 var errTriggerPanic error = errors.New("triggerPanic")
 
-func RouterPanicMiddleware() routing.Handler {
-	return func(c *routing.Context) error {
+func RouterPanicMiddleware() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
-		if "/api/user" == c.Request.URL.Path {
+			if "/api/user" == r.URL.Path {
 
-			defer func() {
-				v := recover()
-				if v != nil {
-					err, ok := v.(error)
+				defer func() {
+					v := recover()
+					if v != nil {
+						err, ok := v.(error)
 
-					if !ok {
-						log.Print("no trigger panic")
-					}
+						if !ok {
+							log.Print("no trigger panic")
+						}
 
-					if err != nil {
-						if !errors.Is(err, errTriggerPanic) {
-							log.Print("error not a panic for /api/user")
+						if err != nil {
+							if !errors.Is(err, errTriggerPanic) {
+								log.Print("error not a panic for /api/user")
+							}
 						}
 					}
-				}
-			}()
+				}()
 
-			panic(errTriggerPanic)
-		}
-		return c.Next()
+				panic(errTriggerPanic)
+			}
+			next.ServeHTTP(w, r)
+		})
 	}
 }
 
-func RouterPopulateContextMiddleware() routing.Handler {
-	return func(c *routing.Context) error {
-		ctx := c.Request.Context()
-		ctx = context.WithValue(ctx, "dummy", "dummy")
-		c.Request = c.Request.WithContext(ctx)
-		return c.Next()
+func RouterPopulateContextMiddleware() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			ctx = context.WithValue(ctx, "dummy", "dummy")
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
 	}
 }
 
-func HandlerMiddleware() routing.Handler {
-	return func(c *routing.Context) error {
-		c.SetParam("clientId", fromContext)
-		return c.Next()
+func HandlerMiddleware() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), "clientId", fromContext)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
 	}
 }
 
@@ -393,3 +602,7 @@ func FileUpload(ctx context.Context, req *FileUploadRequest) FileUploadResponse
 func FindByTags(ctx context.Context, request *FindByTagsRequest) FindByTagsResponse {
 	return &FindByTags200Response{}
 }
+
+func ValidateParameters(ctx context.Context, request *ValidateParametersRequest) ValidateParametersResponse {
+	return new(ValidateParameters204Response)
+}
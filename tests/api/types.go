@@ -1,8 +1,11 @@
 package api
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"io"
 	"net/http"
+	"time"
 )
 
 var contentTypesForFiles = []string{"application/json", "image/png", "image/jpeg", "image/tiff", "image/webp", "image/svg+xml", "image/gif", "image/tiff", "image/x-icon", "application/pdf", "application/octet-stream"}
@@ -19,7 +22,7 @@ type Address struct {
 type BasicTypes struct {
 	Boolean bool              `bson:"boolean,required" json:"boolean,required" xml:"boolean,required"`
 	Integer int64             `bson:"integer,required" json:"integer,required" xml:"integer,required"`
-	Map     map[string]string `bson:"map,required" json:"map,required" xml:"map,required"`
+	Map     map[string]string `bson:"map,required" json:"map,required" xml:"-"`
 	Number  float64           `bson:"number,required" json:"number,required" xml:"number,required"`
 	Slice   []string          `bson:"slice,required" json:"slice,required" xml:"slice,required"`
 	String  string            `bson:"string,required" json:"string,required" xml:"string,required"`
@@ -29,6 +32,15 @@ type Booking struct {
 	BookingID *string `bson:"bookingID,omitempty" json:"bookingID,omitempty" xml:"bookingID,omitempty"`
 }
 
+type ChatMessage struct {
+	Text string `bson:"text,required" json:"text,required" validate:"min=1" xml:"text,required"`
+}
+
+type ChatReply struct {
+	PartnerId string `bson:"partnerId,required" json:"partnerId,required" xml:"partnerId,required"`
+	Text      string `bson:"text,required" json:"text,required" xml:"text,required"`
+}
+
 type Object1 struct {
 	BbdCEBaseUrl            *string  `bson:"bbdCEBaseUrl,omitempty" json:"bbdCEBaseUrl,omitempty" xml:"bbdCEBaseUrl,omitempty"`
 	BbdCallerIdentifier     *string  `bson:"bbdCallerIdentifier,omitempty" json:"bbdCallerIdentifier,omitempty" xml:"bbdCallerIdentifier,omitempty"`
@@ -67,6 +79,11 @@ type EmptySlice struct {
 	EmptySlice []Price `bson:"EmptySlice,omitempty" json:"EmptySlice,omitempty" validate:"omitempty,gt=0,dive" xml:"EmptySlice,omitempty"`
 }
 
+type ErrorMessage struct {
+	Code    string  `bson:"code,required" json:"code,required" xml:"code,required"`
+	Message *string `bson:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
 type Link struct {
 	Href string `bson:"href,required" json:"href,required" xml:"href,required"`
 }
@@ -85,6 +102,13 @@ type NestedFileStructure struct {
 	Data *string `bson:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
 }
 
+type Partner struct {
+	Credit *int64   `bson:"credit,omitempty" json:"credit,omitempty" xml:"credit,omitempty"`
+	Id     string   `bson:"id,required" json:"id,required" validate:"min=3" xml:"id,required"`
+	Name   string   `bson:"name,required" json:"name,required" xml:"name,required"`
+	Tags   []string `bson:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+}
+
 type Price struct {
 	Currency string  `bson:"currency,required" json:"currency,required" xml:"currency,required"`
 	Value    float64 `bson:"value,required" json:"value,required" xml:"value,required"`
@@ -134,10 +158,17 @@ type TechnicalInformation struct {
 	Transmission string `bson:"transmission,required" json:"transmission,required" xml:"transmission,required"`
 }
 
+type UploadedFile struct {
+	Field string  `bson:"field,required" json:"field,required" xml:"field,required"`
+	Name  string  `bson:"name,required" json:"name,required" xml:"name,required"`
+	Note  *string `bson:"note,omitempty" json:"note,omitempty" xml:"note,omitempty"`
+	Size  int64   `bson:"size,required" json:"size,required" xml:"size,required"`
+}
+
 type User struct {
 	Address                []Address         `bson:"Address,omitempty" json:"Address,omitempty" validate:"omitempty,gt=0,dive" xml:"Address,omitempty"`
 	Email                  *string           `bson:"email,omitempty" json:"email,omitempty" validate:"omitempty,email,max=255" xml:"email,omitempty"`
-	GrantedProtocolMappers map[string]string `bson:"grantedProtocolMappers,omitempty" json:"grantedProtocolMappers,omitempty" xml:"grantedProtocolMappers,omitempty"`
+	GrantedProtocolMappers map[string]string `bson:"grantedProtocolMappers,omitempty" json:"grantedProtocolMappers,omitempty" xml:"-"`
 	Id                     string            `bson:"id,required" json:"id,required" xml:"id,required"`
 	Password               string            `bson:"password,required" json:"password,required" xml:"password,required"`
 	Permissions            []string          `bson:"permissions,omitempty" json:"permissions,omitempty" xml:"permissions,omitempty"`
@@ -150,6 +181,7 @@ type ValidationError struct {
 }
 
 type ValidationErrors struct {
+	XMLName xml.Name          `bson:"-" json:"-" xml:"validationErrors"`
 	Errors  []ValidationError `bson:"Errors,omitempty" json:"Errors,omitempty" validate:"omitempty,gt=0,dive" xml:"Errors,omitempty"`
 	Message *string           `bson:"Message,omitempty" json:"Message,omitempty" xml:"Message,omitempty"`
 }
@@ -191,18 +223,22 @@ const (
 )
 
 type GetClientsRequest struct {
-	XAuth string
+	XAuth           string `param:"X-Auth,header"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
 }
 
 type GetClientsResponse interface {
 	isGetClientsResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Status 200
 type GetClients200Response struct {
-	Body []Client `validate:"dive"`
+	Body         []Client `validate:"dive"`
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *GetClients200Response) isGetClientsResponse() {}
@@ -211,7 +247,8 @@ func (r *GetClients200Response) StatusCode() int {
 	return 200
 }
 
-func (r *GetClients200Response) write(response http.ResponseWriter) error {
+func (r *GetClients200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	if err := serveJson(response, 200, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -219,7 +256,10 @@ func (r *GetClients200Response) write(response http.ResponseWriter) error {
 }
 
 // Status 201
-type GetClients204Response struct{}
+type GetClients204Response struct {
+	ETag         string
+	LastModified time.Time
+}
 
 func (r *GetClients204Response) isGetClientsResponse() {}
 
@@ -227,7 +267,8 @@ func (r *GetClients204Response) StatusCode() int {
 	return 204
 }
 
-func (r *GetClients204Response) write(response http.ResponseWriter) error {
+func (r *GetClients204Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(204)
 	return nil
@@ -242,25 +283,58 @@ func (r *GetClients403Response) StatusCode() int {
 	return 403
 }
 
-func (r *GetClients403Response) write(response http.ResponseWriter) error {
+func (r *GetClients403Response) OperationID() string {
+	return "GetClients"
+}
+
+func (r *GetClients403Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetClients403Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(403)
 	return nil
 }
 
+// GetClients304Response is the response of a conditional request if the resource was not modified
+type GetClients304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetClients304Response) isGetClientsResponse() {}
+
+func (r *GetClients304Response) StatusCode() int {
+	return 304
+}
+
+func (r *GetClients304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
 type DeleteClientRequest struct {
-	ClientId string
-	XAuth    string
+	ClientId          string `param:"clientId,path"`
+	XAuth             string `param:"X-Auth,header"`
+	IfMatch           string
+	IfNoneMatch       string
+	IfUnmodifiedSince time.Time
 }
 
 type DeleteClientResponse interface {
 	isDeleteClientResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Success
-type DeleteClient200Response struct{}
+type DeleteClient200Response struct {
+	ETag         string
+	LastModified time.Time
+}
 
 func (r *DeleteClient200Response) isDeleteClientResponse() {}
 
@@ -268,7 +342,8 @@ func (r *DeleteClient200Response) StatusCode() int {
 	return 200
 }
 
-func (r *DeleteClient200Response) write(response http.ResponseWriter) error {
+func (r *DeleteClient200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(200)
 	return nil
@@ -283,7 +358,15 @@ func (r *DeleteClient403Response) StatusCode() int {
 	return 403
 }
 
-func (r *DeleteClient403Response) write(response http.ResponseWriter) error {
+func (r *DeleteClient403Response) OperationID() string {
+	return "DeleteClient"
+}
+
+func (r *DeleteClient403Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *DeleteClient403Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(403)
 	return nil
@@ -298,26 +381,38 @@ func (r *DeleteClient404Response) StatusCode() int {
 	return 404
 }
 
-func (r *DeleteClient404Response) write(response http.ResponseWriter) error {
+func (r *DeleteClient404Response) OperationID() string {
+	return "DeleteClient"
+}
+
+func (r *DeleteClient404Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *DeleteClient404Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(404)
 	return nil
 }
 
 type GetClientRequest struct {
-	ClientId string
-	XAuth    string
+	ClientId        string `param:"clientId,path"`
+	XAuth           string `param:"X-Auth,header"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
 }
 
 type GetClientResponse interface {
 	isGetClientResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Success
 type GetClient200Response struct {
-	Body Client
+	Body         Client
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *GetClient200Response) isGetClientResponse() {}
@@ -326,7 +421,8 @@ func (r *GetClient200Response) StatusCode() int {
 	return 200
 }
 
-func (r *GetClient200Response) write(response http.ResponseWriter) error {
+func (r *GetClient200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	if err := serveJson(response, 200, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -342,7 +438,15 @@ func (r *GetClient403Response) StatusCode() int {
 	return 403
 }
 
-func (r *GetClient403Response) write(response http.ResponseWriter) error {
+func (r *GetClient403Response) OperationID() string {
+	return "GetClient"
+}
+
+func (r *GetClient403Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetClient403Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(403)
 	return nil
@@ -357,26 +461,59 @@ func (r *GetClient404Response) StatusCode() int {
 	return 404
 }
 
-func (r *GetClient404Response) write(response http.ResponseWriter) error {
+func (r *GetClient404Response) OperationID() string {
+	return "GetClient"
+}
+
+func (r *GetClient404Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetClient404Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(404)
 	return nil
 }
 
+// GetClient304Response is the response of a conditional request if the resource was not modified
+type GetClient304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetClient304Response) isGetClientResponse() {}
+
+func (r *GetClient304Response) StatusCode() int {
+	return 304
+}
+
+func (r *GetClient304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
 type CreateOrUpdateClientRequest struct {
-	ClientId string
-	XAuth    string
-	Body     Client
+	ClientId          string `param:"clientId,path"`
+	XAuth             string `param:"X-Auth,header"`
+	Body              Client `param:"body,body"`
+	IfMatch           string
+	IfNoneMatch       string
+	IfUnmodifiedSince time.Time
 }
 
 type CreateOrUpdateClientResponse interface {
 	isCreateOrUpdateClientResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Updated
-type CreateOrUpdateClient200Response struct{}
+type CreateOrUpdateClient200Response struct {
+	ETag         string
+	LastModified time.Time
+}
 
 func (r *CreateOrUpdateClient200Response) isCreateOrUpdateClientResponse() {}
 
@@ -384,14 +521,18 @@ func (r *CreateOrUpdateClient200Response) StatusCode() int {
 	return 200
 }
 
-func (r *CreateOrUpdateClient200Response) write(response http.ResponseWriter) error {
+func (r *CreateOrUpdateClient200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(200)
 	return nil
 }
 
 // Created
-type CreateOrUpdateClient201Response struct{}
+type CreateOrUpdateClient201Response struct {
+	ETag         string
+	LastModified time.Time
+}
 
 func (r *CreateOrUpdateClient201Response) isCreateOrUpdateClientResponse() {}
 
@@ -399,7 +540,8 @@ func (r *CreateOrUpdateClient201Response) StatusCode() int {
 	return 201
 }
 
-func (r *CreateOrUpdateClient201Response) write(response http.ResponseWriter) error {
+func (r *CreateOrUpdateClient201Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(201)
 	return nil
@@ -414,7 +556,15 @@ func (r *CreateOrUpdateClient400Response) StatusCode() int {
 	return 400
 }
 
-func (r *CreateOrUpdateClient400Response) write(response http.ResponseWriter) error {
+func (r *CreateOrUpdateClient400Response) OperationID() string {
+	return "CreateOrUpdateClient"
+}
+
+func (r *CreateOrUpdateClient400Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *CreateOrUpdateClient400Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(400)
 	return nil
@@ -429,7 +579,15 @@ func (r *CreateOrUpdateClient403Response) StatusCode() int {
 	return 403
 }
 
-func (r *CreateOrUpdateClient403Response) write(response http.ResponseWriter) error {
+func (r *CreateOrUpdateClient403Response) OperationID() string {
+	return "CreateOrUpdateClient"
+}
+
+func (r *CreateOrUpdateClient403Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *CreateOrUpdateClient403Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(403)
 	return nil
@@ -444,26 +602,38 @@ func (r *CreateOrUpdateClient405Response) StatusCode() int {
 	return 405
 }
 
-func (r *CreateOrUpdateClient405Response) write(response http.ResponseWriter) error {
+func (r *CreateOrUpdateClient405Response) OperationID() string {
+	return "CreateOrUpdateClient"
+}
+
+func (r *CreateOrUpdateClient405Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *CreateOrUpdateClient405Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(405)
 	return nil
 }
 
 type GetViewsSetsRequest struct {
-	ClientId string
-	XAuth    string
+	ClientId        string `param:"clientId,path"`
+	XAuth           string `param:"X-Auth,header"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
 }
 
 type GetViewsSetsResponse interface {
 	isGetViewsSetsResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Success
 type GetViewsSets200Response struct {
-	Body []ViewsSet `validate:"dive"`
+	Body         []ViewsSet `validate:"dive"`
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *GetViewsSets200Response) isGetViewsSetsResponse() {}
@@ -472,7 +642,8 @@ func (r *GetViewsSets200Response) StatusCode() int {
 	return 200
 }
 
-func (r *GetViewsSets200Response) write(response http.ResponseWriter) error {
+func (r *GetViewsSets200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	if err := serveJson(response, 200, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -488,26 +659,59 @@ func (r *GetViewsSets403Response) StatusCode() int {
 	return 403
 }
 
-func (r *GetViewsSets403Response) write(response http.ResponseWriter) error {
+func (r *GetViewsSets403Response) OperationID() string {
+	return "GetViewsSets"
+}
+
+func (r *GetViewsSets403Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetViewsSets403Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(403)
 	return nil
 }
 
+// GetViewsSets304Response is the response of a conditional request if the resource was not modified
+type GetViewsSets304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetViewsSets304Response) isGetViewsSetsResponse() {}
+
+func (r *GetViewsSets304Response) StatusCode() int {
+	return 304
+}
+
+func (r *GetViewsSets304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
 type DeleteViewsSetRequest struct {
-	ClientId string
-	ViewsId  string
-	XAuth    string
+	ClientId          string `param:"clientId,path"`
+	ViewsId           string `param:"viewsId,path"`
+	XAuth             string `param:"X-Auth,header"`
+	IfMatch           string
+	IfNoneMatch       string
+	IfUnmodifiedSince time.Time
 }
 
 type DeleteViewsSetResponse interface {
 	isDeleteViewsSetResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Success
-type DeleteViewsSet200Response struct{}
+type DeleteViewsSet200Response struct {
+	ETag         string
+	LastModified time.Time
+}
 
 func (r *DeleteViewsSet200Response) isDeleteViewsSetResponse() {}
 
@@ -515,7 +719,8 @@ func (r *DeleteViewsSet200Response) StatusCode() int {
 	return 200
 }
 
-func (r *DeleteViewsSet200Response) write(response http.ResponseWriter) error {
+func (r *DeleteViewsSet200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(200)
 	return nil
@@ -530,7 +735,15 @@ func (r *DeleteViewsSet403Response) StatusCode() int {
 	return 403
 }
 
-func (r *DeleteViewsSet403Response) write(response http.ResponseWriter) error {
+func (r *DeleteViewsSet403Response) OperationID() string {
+	return "DeleteViewsSet"
+}
+
+func (r *DeleteViewsSet403Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *DeleteViewsSet403Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(403)
 	return nil
@@ -545,28 +758,40 @@ func (r *DeleteViewsSet404Response) StatusCode() int {
 	return 404
 }
 
-func (r *DeleteViewsSet404Response) write(response http.ResponseWriter) error {
+func (r *DeleteViewsSet404Response) OperationID() string {
+	return "DeleteViewsSet"
+}
+
+func (r *DeleteViewsSet404Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *DeleteViewsSet404Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(404)
 	return nil
 }
 
 type GetViewsSetRequest struct {
-	ClientId string
-	ViewsId  string
-	Page     string
-	XAuth    string
+	ClientId        string `param:"clientId,path"`
+	ViewsId         string `param:"viewsId,path"`
+	Page            string `param:"page,query"`
+	XAuth           string `param:"X-Auth,header"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
 }
 
 type GetViewsSetResponse interface {
 	isGetViewsSetResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Success
 type GetViewsSet200Response struct {
-	Body ViewsSet
+	Body         ViewsSet
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *GetViewsSet200Response) isGetViewsSetResponse() {}
@@ -575,7 +800,8 @@ func (r *GetViewsSet200Response) StatusCode() int {
 	return 200
 }
 
-func (r *GetViewsSet200Response) write(response http.ResponseWriter) error {
+func (r *GetViewsSet200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	if err := serveJson(response, 200, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -591,7 +817,15 @@ func (r *GetViewsSet403Response) StatusCode() int {
 	return 403
 }
 
-func (r *GetViewsSet403Response) write(response http.ResponseWriter) error {
+func (r *GetViewsSet403Response) OperationID() string {
+	return "GetViewsSet"
+}
+
+func (r *GetViewsSet403Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetViewsSet403Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(403)
 	return nil
@@ -606,22 +840,49 @@ func (r *GetViewsSet404Response) StatusCode() int {
 	return 404
 }
 
-func (r *GetViewsSet404Response) write(response http.ResponseWriter) error {
+func (r *GetViewsSet404Response) OperationID() string {
+	return "GetViewsSet"
+}
+
+func (r *GetViewsSet404Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetViewsSet404Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(404)
 	return nil
 }
 
+// GetViewsSet304Response is the response of a conditional request if the resource was not modified
+type GetViewsSet304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetViewsSet304Response) isGetViewsSetResponse() {}
+
+func (r *GetViewsSet304Response) StatusCode() int {
+	return 304
+}
+
+func (r *GetViewsSet304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
 type ActivateViewsSetRequest struct {
-	ClientId string
-	ViewsId  string
-	XAuth    string
+	ClientId string `param:"clientId,path"`
+	ViewsId  string `param:"viewsId,path"`
+	XAuth    string `param:"X-Auth,header"`
 }
 
 type ActivateViewsSetResponse interface {
 	isActivateViewsSetResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Success
@@ -633,7 +894,7 @@ func (r *ActivateViewsSet200Response) StatusCode() int {
 	return 200
 }
 
-func (r *ActivateViewsSet200Response) write(response http.ResponseWriter) error {
+func (r *ActivateViewsSet200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(200)
 	return nil
@@ -648,7 +909,15 @@ func (r *ActivateViewsSet403Response) StatusCode() int {
 	return 403
 }
 
-func (r *ActivateViewsSet403Response) write(response http.ResponseWriter) error {
+func (r *ActivateViewsSet403Response) OperationID() string {
+	return "ActivateViewsSet"
+}
+
+func (r *ActivateViewsSet403Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *ActivateViewsSet403Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(403)
 	return nil
@@ -663,27 +932,41 @@ func (r *ActivateViewsSet404Response) StatusCode() int {
 	return 404
 }
 
-func (r *ActivateViewsSet404Response) write(response http.ResponseWriter) error {
+func (r *ActivateViewsSet404Response) OperationID() string {
+	return "ActivateViewsSet"
+}
+
+func (r *ActivateViewsSet404Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *ActivateViewsSet404Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(404)
 	return nil
 }
 
 type CreateOrUpdateViewsSetRequest struct {
-	ClientId string
-	ViewsId  string
-	XAuth    string
-	Body     ViewsSet
+	ClientId          string   `param:"clientId,path"`
+	ViewsId           string   `param:"viewsId,path"`
+	XAuth             string   `param:"X-Auth,header"`
+	Body              ViewsSet `param:"body,body"`
+	IfMatch           string
+	IfNoneMatch       string
+	IfUnmodifiedSince time.Time
 }
 
 type CreateOrUpdateViewsSetResponse interface {
 	isCreateOrUpdateViewsSetResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Updated
-type CreateOrUpdateViewsSet200Response struct{}
+type CreateOrUpdateViewsSet200Response struct {
+	ETag         string
+	LastModified time.Time
+}
 
 func (r *CreateOrUpdateViewsSet200Response) isCreateOrUpdateViewsSetResponse() {}
 
@@ -691,14 +974,18 @@ func (r *CreateOrUpdateViewsSet200Response) StatusCode() int {
 	return 200
 }
 
-func (r *CreateOrUpdateViewsSet200Response) write(response http.ResponseWriter) error {
+func (r *CreateOrUpdateViewsSet200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(200)
 	return nil
 }
 
 // Created
-type CreateOrUpdateViewsSet201Response struct{}
+type CreateOrUpdateViewsSet201Response struct {
+	ETag         string
+	LastModified time.Time
+}
 
 func (r *CreateOrUpdateViewsSet201Response) isCreateOrUpdateViewsSetResponse() {}
 
@@ -706,7 +993,8 @@ func (r *CreateOrUpdateViewsSet201Response) StatusCode() int {
 	return 201
 }
 
-func (r *CreateOrUpdateViewsSet201Response) write(response http.ResponseWriter) error {
+func (r *CreateOrUpdateViewsSet201Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(201)
 	return nil
@@ -721,7 +1009,15 @@ func (r *CreateOrUpdateViewsSet400Response) StatusCode() int {
 	return 400
 }
 
-func (r *CreateOrUpdateViewsSet400Response) write(response http.ResponseWriter) error {
+func (r *CreateOrUpdateViewsSet400Response) OperationID() string {
+	return "CreateOrUpdateViewsSet"
+}
+
+func (r *CreateOrUpdateViewsSet400Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *CreateOrUpdateViewsSet400Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(400)
 	return nil
@@ -736,7 +1032,15 @@ func (r *CreateOrUpdateViewsSet403Response) StatusCode() int {
 	return 403
 }
 
-func (r *CreateOrUpdateViewsSet403Response) write(response http.ResponseWriter) error {
+func (r *CreateOrUpdateViewsSet403Response) OperationID() string {
+	return "CreateOrUpdateViewsSet"
+}
+
+func (r *CreateOrUpdateViewsSet403Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *CreateOrUpdateViewsSet403Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(403)
 	return nil
@@ -751,29 +1055,42 @@ func (r *CreateOrUpdateViewsSet405Response) StatusCode() int {
 	return 405
 }
 
-func (r *CreateOrUpdateViewsSet405Response) write(response http.ResponseWriter) error {
+func (r *CreateOrUpdateViewsSet405Response) OperationID() string {
+	return "CreateOrUpdateViewsSet"
+}
+
+func (r *CreateOrUpdateViewsSet405Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *CreateOrUpdateViewsSet405Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(405)
 	return nil
 }
 
 type ShowVehicleInViewRequest struct {
-	ClientId   string
-	ViewsId    string
-	View       string
-	Breakpoint string
-	Spec       string
-	XAuth      string
+	ClientId        string `param:"clientId,path"`
+	ViewsId         string `param:"viewsId,path"`
+	View            string `param:"view,path"`
+	Breakpoint      string `param:"breakpoint,path"`
+	Spec            string `param:"spec,path"`
+	XAuth           string `param:"X-Auth,header"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
 }
 
 type ShowVehicleInViewResponse interface {
 	isShowVehicleInViewResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Success
-type ShowVehicleInView200Response struct{}
+type ShowVehicleInView200Response struct {
+	ETag         string
+	LastModified time.Time
+}
 
 func (r *ShowVehicleInView200Response) isShowVehicleInViewResponse() {}
 
@@ -781,7 +1098,8 @@ func (r *ShowVehicleInView200Response) StatusCode() int {
 	return 200
 }
 
-func (r *ShowVehicleInView200Response) write(response http.ResponseWriter) error {
+func (r *ShowVehicleInView200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(200)
 	return nil
@@ -796,7 +1114,15 @@ func (r *ShowVehicleInView403Response) StatusCode() int {
 	return 403
 }
 
-func (r *ShowVehicleInView403Response) write(response http.ResponseWriter) error {
+func (r *ShowVehicleInView403Response) OperationID() string {
+	return "ShowVehicleInView"
+}
+
+func (r *ShowVehicleInView403Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *ShowVehicleInView403Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(403)
 	return nil
@@ -811,25 +1137,56 @@ func (r *ShowVehicleInView404Response) StatusCode() int {
 	return 404
 }
 
-func (r *ShowVehicleInView404Response) write(response http.ResponseWriter) error {
+func (r *ShowVehicleInView404Response) OperationID() string {
+	return "ShowVehicleInView"
+}
+
+func (r *ShowVehicleInView404Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *ShowVehicleInView404Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(404)
 	return nil
 }
 
+// ShowVehicleInView304Response is the response of a conditional request if the resource was not modified
+type ShowVehicleInView304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *ShowVehicleInView304Response) isShowVehicleInViewResponse() {}
+
+func (r *ShowVehicleInView304Response) StatusCode() int {
+	return 304
+}
+
+func (r *ShowVehicleInView304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
 type GetPermissionsRequest struct {
-	XAuth string
+	XAuth           string `param:"X-Auth,header"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
 }
 
 type GetPermissionsResponse interface {
 	isGetPermissionsResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Status 200
 type GetPermissions200Response struct {
-	Body []string
+	Body         []string
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *GetPermissions200Response) isGetPermissionsResponse() {}
@@ -838,7 +1195,8 @@ func (r *GetPermissions200Response) StatusCode() int {
 	return 200
 }
 
-func (r *GetPermissions200Response) write(response http.ResponseWriter) error {
+func (r *GetPermissions200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	if err := serveJson(response, 200, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -854,24 +1212,57 @@ func (r *GetPermissions403Response) StatusCode() int {
 	return 403
 }
 
-func (r *GetPermissions403Response) write(response http.ResponseWriter) error {
+func (r *GetPermissions403Response) OperationID() string {
+	return "GetPermissions"
+}
+
+func (r *GetPermissions403Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetPermissions403Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(403)
 	return nil
 }
 
+// GetPermissions304Response is the response of a conditional request if the resource was not modified
+type GetPermissions304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetPermissions304Response) isGetPermissionsResponse() {}
+
+func (r *GetPermissions304Response) StatusCode() int {
+	return 304
+}
+
+func (r *GetPermissions304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
 type DestroySessionRequest struct {
-	XAuth string
+	XAuth             string `param:"X-Auth,header"`
+	IfMatch           string
+	IfNoneMatch       string
+	IfUnmodifiedSince time.Time
 }
 
 type DestroySessionResponse interface {
 	isDestroySessionResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Session destroyed
-type DestroySession200Response struct{}
+type DestroySession200Response struct {
+	ETag         string
+	LastModified time.Time
+}
 
 func (r *DestroySession200Response) isDestroySessionResponse() {}
 
@@ -879,7 +1270,8 @@ func (r *DestroySession200Response) StatusCode() int {
 	return 200
 }
 
-func (r *DestroySession200Response) write(response http.ResponseWriter) error {
+func (r *DestroySession200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(200)
 	return nil
@@ -894,26 +1286,38 @@ func (r *DestroySession404Response) StatusCode() int {
 	return 404
 }
 
-func (r *DestroySession404Response) write(response http.ResponseWriter) error {
+func (r *DestroySession404Response) OperationID() string {
+	return "DestroySession"
+}
+
+func (r *DestroySession404Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *DestroySession404Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(404)
 	return nil
 }
 
 type GetUserInfoRequest struct {
-	XAuth string `validate:"max=255"`
-	SubID *int64 `validate:"omitempty,max=255"`
+	XAuth           string `param:"X-Auth,header" validate:"max=255"`
+	SubID           *int64 `param:"subID,header" validate:"omitempty,max=255"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
 }
 
 type GetUserInfoResponse interface {
 	isGetUserInfoResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Status 200
 type GetUserInfo200Response struct {
-	Body User
+	Body         User
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *GetUserInfo200Response) isGetUserInfoResponse() {}
@@ -922,7 +1326,8 @@ func (r *GetUserInfo200Response) StatusCode() int {
 	return 200
 }
 
-func (r *GetUserInfo200Response) write(response http.ResponseWriter) error {
+func (r *GetUserInfo200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	if err := serveJson(response, 200, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -940,7 +1345,15 @@ func (r *GetUserInfo400Response) StatusCode() int {
 	return 400
 }
 
-func (r *GetUserInfo400Response) write(response http.ResponseWriter) error {
+func (r *GetUserInfo400Response) OperationID() string {
+	return "GetUserInfo"
+}
+
+func (r *GetUserInfo400Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), r.Body)
+}
+
+func (r *GetUserInfo400Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	if err := serveJson(response, 400, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -956,25 +1369,52 @@ func (r *GetUserInfo403Response) StatusCode() int {
 	return 403
 }
 
-func (r *GetUserInfo403Response) write(response http.ResponseWriter) error {
+func (r *GetUserInfo403Response) OperationID() string {
+	return "GetUserInfo"
+}
+
+func (r *GetUserInfo403Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetUserInfo403Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(403)
 	return nil
 }
 
+// GetUserInfo304Response is the response of a conditional request if the resource was not modified
+type GetUserInfo304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetUserInfo304Response) isGetUserInfoResponse() {}
+
+func (r *GetUserInfo304Response) StatusCode() int {
+	return 304
+}
+
+func (r *GetUserInfo304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
 type Object2 struct {
 	Id       string `bson:"id,required" json:"id,required" validate:"min=1" xml:"id,required"`
 	Password string `bson:"password,required" json:"password,required" validate:"min=1" xml:"password,required"`
 }
 
 type CreateSessionRequest struct {
-	Body Object2
+	Body Object2 `param:"body,body"`
 }
 
 type CreateSessionResponse interface {
 	isCreateSessionResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Authentication successful
@@ -988,7 +1428,7 @@ func (r *CreateSession200Response) StatusCode() int {
 	return 200
 }
 
-func (r *CreateSession200Response) write(response http.ResponseWriter) error {
+func (r *CreateSession200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()["X-Auth"] = []string{toString(r.XAuth)}
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(200)
@@ -1006,7 +1446,15 @@ func (r *CreateSession400Response) StatusCode() int {
 	return 400
 }
 
-func (r *CreateSession400Response) write(response http.ResponseWriter) error {
+func (r *CreateSession400Response) OperationID() string {
+	return "CreateSession"
+}
+
+func (r *CreateSession400Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), r.Body)
+}
+
+func (r *CreateSession400Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	if err := serveJson(response, 400, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -1022,25 +1470,37 @@ func (r *CreateSession401Response) StatusCode() int {
 	return 401
 }
 
-func (r *CreateSession401Response) write(response http.ResponseWriter) error {
+func (r *CreateSession401Response) OperationID() string {
+	return "CreateSession"
+}
+
+func (r *CreateSession401Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *CreateSession401Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(401)
 	return nil
 }
 
 type GetUsersRequest struct {
-	XAuth string
+	XAuth           string `param:"X-Auth,header"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
 }
 
 type GetUsersResponse interface {
 	isGetUsersResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Success
 type GetUsers200Response struct {
-	Body []User `validate:"dive"`
+	Body         []User `validate:"dive"`
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *GetUsers200Response) isGetUsersResponse() {}
@@ -1049,7 +1509,8 @@ func (r *GetUsers200Response) StatusCode() int {
 	return 200
 }
 
-func (r *GetUsers200Response) write(response http.ResponseWriter) error {
+func (r *GetUsers200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	if err := serveJson(response, 200, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -1065,26 +1526,59 @@ func (r *GetUsers403Response) StatusCode() int {
 	return 403
 }
 
-func (r *GetUsers403Response) write(response http.ResponseWriter) error {
+func (r *GetUsers403Response) OperationID() string {
+	return "GetUsers"
+}
+
+func (r *GetUsers403Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetUsers403Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(403)
 	return nil
 }
 
+// GetUsers304Response is the response of a conditional request if the resource was not modified
+type GetUsers304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetUsers304Response) isGetUsersResponse() {}
+
+func (r *GetUsers304Response) StatusCode() int {
+	return 304
+}
+
+func (r *GetUsers304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
 type DeleteUserRequest struct {
-	UserId  string
-	AllKeys *bool
-	XAuth   string
+	UserId            string `param:"userId,path"`
+	AllKeys           *bool  `param:"allKeys,query"`
+	XAuth             string `param:"X-Auth,header"`
+	IfMatch           string
+	IfNoneMatch       string
+	IfUnmodifiedSince time.Time
 }
 
 type DeleteUserResponse interface {
 	isDeleteUserResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Success
-type DeleteUser200Response struct{}
+type DeleteUser200Response struct {
+	ETag         string
+	LastModified time.Time
+}
 
 func (r *DeleteUser200Response) isDeleteUserResponse() {}
 
@@ -1092,7 +1586,8 @@ func (r *DeleteUser200Response) StatusCode() int {
 	return 200
 }
 
-func (r *DeleteUser200Response) write(response http.ResponseWriter) error {
+func (r *DeleteUser200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(200)
 	return nil
@@ -1107,7 +1602,15 @@ func (r *DeleteUser403Response) StatusCode() int {
 	return 403
 }
 
-func (r *DeleteUser403Response) write(response http.ResponseWriter) error {
+func (r *DeleteUser403Response) OperationID() string {
+	return "DeleteUser"
+}
+
+func (r *DeleteUser403Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *DeleteUser403Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(403)
 	return nil
@@ -1122,27 +1625,39 @@ func (r *DeleteUser404Response) StatusCode() int {
 	return 404
 }
 
-func (r *DeleteUser404Response) write(response http.ResponseWriter) error {
+func (r *DeleteUser404Response) OperationID() string {
+	return "DeleteUser"
+}
+
+func (r *DeleteUser404Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *DeleteUser404Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(404)
 	return nil
 }
 
 type GetUserRequest struct {
-	UserId  string
-	AllKeys *bool
-	XAuth   string
+	UserId          string `param:"userId,path"`
+	AllKeys         *bool  `param:"allKeys,query"`
+	XAuth           string `param:"X-Auth,header"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
 }
 
 type GetUserResponse interface {
 	isGetUserResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Success
 type GetUser200Response struct {
-	Body User
+	Body         User
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *GetUser200Response) isGetUserResponse() {}
@@ -1151,7 +1666,8 @@ func (r *GetUser200Response) StatusCode() int {
 	return 200
 }
 
-func (r *GetUser200Response) write(response http.ResponseWriter) error {
+func (r *GetUser200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	if err := serveJson(response, 200, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -1167,7 +1683,15 @@ func (r *GetUser403Response) StatusCode() int {
 	return 403
 }
 
-func (r *GetUser403Response) write(response http.ResponseWriter) error {
+func (r *GetUser403Response) OperationID() string {
+	return "GetUser"
+}
+
+func (r *GetUser403Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetUser403Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(403)
 	return nil
@@ -1182,27 +1706,60 @@ func (r *GetUser404Response) StatusCode() int {
 	return 404
 }
 
-func (r *GetUser404Response) write(response http.ResponseWriter) error {
+func (r *GetUser404Response) OperationID() string {
+	return "GetUser"
+}
+
+func (r *GetUser404Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetUser404Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(404)
 	return nil
 }
 
+// GetUser304Response is the response of a conditional request if the resource was not modified
+type GetUser304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetUser304Response) isGetUserResponse() {}
+
+func (r *GetUser304Response) StatusCode() int {
+	return 304
+}
+
+func (r *GetUser304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
 type CreateOrUpdateUserRequest struct {
-	UserId  string
-	AllKeys *bool
-	XAuth   string
-	Body    User
+	UserId            string `param:"userId,path"`
+	AllKeys           *bool  `param:"allKeys,query"`
+	XAuth             string `param:"X-Auth,header"`
+	Body              User   `param:"body,body"`
+	IfMatch           string
+	IfNoneMatch       string
+	IfUnmodifiedSince time.Time
 }
 
 type CreateOrUpdateUserResponse interface {
 	isCreateOrUpdateUserResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Updated
-type CreateOrUpdateUser200Response struct{}
+type CreateOrUpdateUser200Response struct {
+	ETag         string
+	LastModified time.Time
+}
 
 func (r *CreateOrUpdateUser200Response) isCreateOrUpdateUserResponse() {}
 
@@ -1210,14 +1767,18 @@ func (r *CreateOrUpdateUser200Response) StatusCode() int {
 	return 200
 }
 
-func (r *CreateOrUpdateUser200Response) write(response http.ResponseWriter) error {
+func (r *CreateOrUpdateUser200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(200)
 	return nil
 }
 
 // Created
-type CreateOrUpdateUser201Response struct{}
+type CreateOrUpdateUser201Response struct {
+	ETag         string
+	LastModified time.Time
+}
 
 func (r *CreateOrUpdateUser201Response) isCreateOrUpdateUserResponse() {}
 
@@ -1225,7 +1786,8 @@ func (r *CreateOrUpdateUser201Response) StatusCode() int {
 	return 201
 }
 
-func (r *CreateOrUpdateUser201Response) write(response http.ResponseWriter) error {
+func (r *CreateOrUpdateUser201Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(201)
 	return nil
@@ -1240,7 +1802,15 @@ func (r *CreateOrUpdateUser400Response) StatusCode() int {
 	return 400
 }
 
-func (r *CreateOrUpdateUser400Response) write(response http.ResponseWriter) error {
+func (r *CreateOrUpdateUser400Response) OperationID() string {
+	return "CreateOrUpdateUser"
+}
+
+func (r *CreateOrUpdateUser400Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *CreateOrUpdateUser400Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(400)
 	return nil
@@ -1255,7 +1825,15 @@ func (r *CreateOrUpdateUser403Response) StatusCode() int {
 	return 403
 }
 
-func (r *CreateOrUpdateUser403Response) write(response http.ResponseWriter) error {
+func (r *CreateOrUpdateUser403Response) OperationID() string {
+	return "CreateOrUpdateUser"
+}
+
+func (r *CreateOrUpdateUser403Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *CreateOrUpdateUser403Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(403)
 	return nil
@@ -1270,25 +1848,37 @@ func (r *CreateOrUpdateUser405Response) StatusCode() int {
 	return 405
 }
 
-func (r *CreateOrUpdateUser405Response) write(response http.ResponseWriter) error {
+func (r *CreateOrUpdateUser405Response) OperationID() string {
+	return "CreateOrUpdateUser"
+}
+
+func (r *CreateOrUpdateUser405Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *CreateOrUpdateUser405Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(405)
 	return nil
 }
 
 type GetBookingRequest struct {
-	XSessionID string
+	XSessionID      string
+	IfNoneMatch     string
+	IfModifiedSince time.Time
 }
 
 type GetBookingResponse interface {
 	isGetBookingResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // status 200
 type GetBooking200Response struct {
-	Body string
+	Body         string
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *GetBooking200Response) isGetBookingResponse() {}
@@ -1297,7 +1887,8 @@ func (r *GetBooking200Response) StatusCode() int {
 	return 200
 }
 
-func (r *GetBooking200Response) write(response http.ResponseWriter) error {
+func (r *GetBooking200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	if err := serveJson(response, 200, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -1313,7 +1904,15 @@ func (r *GetBooking400Response) StatusCode() int {
 	return 400
 }
 
-func (r *GetBooking400Response) write(response http.ResponseWriter) error {
+func (r *GetBooking400Response) OperationID() string {
+	return "GetBooking"
+}
+
+func (r *GetBooking400Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetBooking400Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(400)
 	return nil
@@ -1328,7 +1927,15 @@ func (r *GetBooking401Response) StatusCode() int {
 	return 401
 }
 
-func (r *GetBooking401Response) write(response http.ResponseWriter) error {
+func (r *GetBooking401Response) OperationID() string {
+	return "GetBooking"
+}
+
+func (r *GetBooking401Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetBooking401Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(401)
 	return nil
@@ -1343,10 +1950,18 @@ func (r *GetBooking404Response) StatusCode() int {
 	return 404
 }
 
-func (r *GetBooking404Response) write(response http.ResponseWriter) error {
-	response.Header()[contentTypeHeader] = []string{}
-	response.WriteHeader(404)
-	return nil
+func (r *GetBooking404Response) OperationID() string {
+	return "GetBooking"
+}
+
+func (r *GetBooking404Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetBooking404Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(404)
+	return nil
 }
 
 // Malfunction (internal requirements not fulfilled)
@@ -1358,27 +1973,133 @@ func (r *GetBooking500Response) StatusCode() int {
 	return 500
 }
 
-func (r *GetBooking500Response) write(response http.ResponseWriter) error {
+func (r *GetBooking500Response) OperationID() string {
+	return "GetBooking"
+}
+
+func (r *GetBooking500Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetBooking500Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(500)
+	return nil
+}
+
+// GetBooking304Response is the response of a conditional request if the resource was not modified
+type GetBooking304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetBooking304Response) isGetBookingResponse() {}
+
+func (r *GetBooking304Response) StatusCode() int {
+	return 304
+}
+
+func (r *GetBooking304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
+type GetBookingYamlRequest struct {
+	XSessionID      string
+	IfNoneMatch     string
+	IfModifiedSince time.Time
+}
+
+type GetBookingYamlResponse interface {
+	isGetBookingYamlResponse()
+	StatusCode() int
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
+}
+
+// status 200
+type GetBookingYaml200Response struct {
+	Body         string
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetBookingYaml200Response) isGetBookingYamlResponse() {}
+
+func (r *GetBookingYaml200Response) StatusCode() int {
+	return 200
+}
+
+func (r *GetBookingYaml200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	if err := serveJson(response, 200, r.Body); err != nil {
+		return NewHTTPStatusCodeError(http.StatusInternalServerError)
+	}
+	return nil
+}
+
+// Malfunction (internal requirements not fulfilled)
+type GetBookingYaml500Response struct{}
+
+func (r *GetBookingYaml500Response) isGetBookingYamlResponse() {}
+
+func (r *GetBookingYaml500Response) StatusCode() int {
+	return 500
+}
+
+func (r *GetBookingYaml500Response) OperationID() string {
+	return "GetBookingYaml"
+}
+
+func (r *GetBookingYaml500Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetBookingYaml500Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(500)
 	return nil
 }
 
+// GetBookingYaml304Response is the response of a conditional request if the resource was not modified
+type GetBookingYaml304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetBookingYaml304Response) isGetBookingYamlResponse() {}
+
+func (r *GetBookingYaml304Response) StatusCode() int {
+	return 304
+}
+
+func (r *GetBookingYaml304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
 type GetBookingsRequest struct {
-	Ids        []int64
-	Date       *string
-	XSessionID string
+	Ids             []int64 `param:"ids,query"`
+	Date            *string `param:"date,header"`
+	XSessionID      string
+	IfNoneMatch     string
+	IfModifiedSince time.Time
 }
 
 type GetBookingsResponse interface {
 	isGetBookingsResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Success List Booking History
 type GetBookings200Response struct {
-	Body []Booking `validate:"dive"`
+	Body         []Booking `validate:"dive"`
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *GetBookings200Response) isGetBookingsResponse() {}
@@ -1387,7 +2108,8 @@ func (r *GetBookings200Response) StatusCode() int {
 	return 200
 }
 
-func (r *GetBookings200Response) write(response http.ResponseWriter) error {
+func (r *GetBookings200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	if err := serveJson(response, 200, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -1403,7 +2125,15 @@ func (r *GetBookings400Response) StatusCode() int {
 	return 400
 }
 
-func (r *GetBookings400Response) write(response http.ResponseWriter) error {
+func (r *GetBookings400Response) OperationID() string {
+	return "GetBookings"
+}
+
+func (r *GetBookings400Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetBookings400Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(400)
 	return nil
@@ -1418,7 +2148,15 @@ func (r *GetBookings401Response) StatusCode() int {
 	return 401
 }
 
-func (r *GetBookings401Response) write(response http.ResponseWriter) error {
+func (r *GetBookings401Response) OperationID() string {
+	return "GetBookings"
+}
+
+func (r *GetBookings401Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetBookings401Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(401)
 	return nil
@@ -1433,7 +2171,15 @@ func (r *GetBookings404Response) StatusCode() int {
 	return 404
 }
 
-func (r *GetBookings404Response) write(response http.ResponseWriter) error {
+func (r *GetBookings404Response) OperationID() string {
+	return "GetBookings"
+}
+
+func (r *GetBookings404Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetBookings404Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(404)
 	return nil
@@ -1448,30 +2194,61 @@ func (r *GetBookings500Response) StatusCode() int {
 	return 500
 }
 
-func (r *GetBookings500Response) write(response http.ResponseWriter) error {
+func (r *GetBookings500Response) OperationID() string {
+	return "GetBookings"
+}
+
+func (r *GetBookings500Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetBookings500Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(500)
 	return nil
 }
 
+// GetBookings304Response is the response of a conditional request if the resource was not modified
+type GetBookings304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetBookings304Response) isGetBookingsResponse() {}
+
+func (r *GetBookings304Response) StatusCode() int {
+	return 304
+}
+
+func (r *GetBookings304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
 type ListModelsRequest struct {
-	BrandId      string
-	DriveConcept *DriveConcept
-	LanguageId   *string
-	ClassId      *string
-	LineId       *string
-	Ids          []int64
+	BrandId         string        `param:"brandId,path"`
+	DriveConcept    *DriveConcept `param:"driveConcept,query"`
+	LanguageId      *string       `param:"languageId,query"`
+	ClassId         *string       `param:"classId,query"`
+	LineId          *string       `param:"lineId,query"`
+	Ids             []int64       `param:"ids,query"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
 }
 
 type ListModelsResponse interface {
 	isListModelsResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Ok
 type ListModels200Response struct {
-	Body []Model `validate:"dive"`
+	Body         []Model `validate:"dive"`
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *ListModels200Response) isListModelsResponse() {}
@@ -1480,27 +2257,51 @@ func (r *ListModels200Response) StatusCode() int {
 	return 200
 }
 
-func (r *ListModels200Response) write(response http.ResponseWriter) error {
+func (r *ListModels200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	if err := serveJson(response, 200, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
 	return nil
 }
 
+// ListModels304Response is the response of a conditional request if the resource was not modified
+type ListModels304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *ListModels304Response) isListModelsResponse() {}
+
+func (r *ListModels304Response) StatusCode() int {
+	return 304
+}
+
+func (r *ListModels304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
 type GetClassesRequest struct {
-	ProductGroup   ProductGroup
-	ComponentTypes []ComponentTypes
+	ProductGroup    ProductGroup     `param:"productGroup,path" validate:"oneof=PKW GELAENDEWAGEN VAN SPRINTER CITAN SMART"`
+	ComponentTypes  []ComponentTypes `param:"componentTypes,query" validate:"omitempty,gt=0,dive,oneof=WHEELS PAINTS UPHOLSTERIES TRIMS PACKAGES LINES SPECIAL_EDITION SPECIAL_EQUIPMENT"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
 }
 
 type GetClassesResponse interface {
 	isGetClassesResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Successful response
 type GetClasses200Response struct {
-	Body string
+	Body         string
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *GetClasses200Response) isGetClassesResponse() {}
@@ -1509,7 +2310,8 @@ func (r *GetClasses200Response) StatusCode() int {
 	return 200
 }
 
-func (r *GetClasses200Response) write(response http.ResponseWriter) error {
+func (r *GetClasses200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	if err := serveJson(response, 200, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -1527,24 +2329,51 @@ func (r *GetClasses400Response) StatusCode() int {
 	return 400
 }
 
-func (r *GetClasses400Response) write(response http.ResponseWriter) error {
+func (r *GetClasses400Response) OperationID() string {
+	return "GetClasses"
+}
+
+func (r *GetClasses400Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), r.Body)
+}
+
+func (r *GetClasses400Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	if err := serveJson(response, 400, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
 	return nil
 }
 
+// GetClasses304Response is the response of a conditional request if the resource was not modified
+type GetClasses304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetClasses304Response) isGetClassesResponse() {}
+
+func (r *GetClasses304Response) StatusCode() int {
+	return 304
+}
+
+func (r *GetClasses304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
 type CodeRequest struct {
-	Session      string
-	State        []int64
-	ResponseMode *string
-	Code         string
+	Session      string  `param:"session,query"`
+	State        []int64 `param:"state,formData"`
+	ResponseMode *string `param:"response_mode,formData"`
+	Code         string  `param:"code,formData"`
 }
 
 type CodeResponse interface {
 	isCodeResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // TBD
@@ -1558,7 +2387,7 @@ func (r *Code200Response) StatusCode() int {
 	return 200
 }
 
-func (r *Code200Response) write(response http.ResponseWriter) error {
+func (r *Code200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	if err := serveJson(response, 200, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -1574,7 +2403,15 @@ func (r *Code400Response) StatusCode() int {
 	return 400
 }
 
-func (r *Code400Response) write(response http.ResponseWriter) error {
+func (r *Code400Response) OperationID() string {
+	return "Code"
+}
+
+func (r *Code400Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *Code400Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(400)
 	return nil
@@ -1589,7 +2426,15 @@ func (r *Code401Response) StatusCode() int {
 	return 401
 }
 
-func (r *Code401Response) write(response http.ResponseWriter) error {
+func (r *Code401Response) OperationID() string {
+	return "Code"
+}
+
+func (r *Code401Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *Code401Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(401)
 	return nil
@@ -1604,7 +2449,15 @@ func (r *Code404Response) StatusCode() int {
 	return 404
 }
 
-func (r *Code404Response) write(response http.ResponseWriter) error {
+func (r *Code404Response) OperationID() string {
+	return "Code"
+}
+
+func (r *Code404Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *Code404Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(404)
 	return nil
@@ -1619,25 +2472,39 @@ func (r *Code500Response) StatusCode() int {
 	return 500
 }
 
-func (r *Code500Response) write(response http.ResponseWriter) error {
+func (r *Code500Response) OperationID() string {
+	return "Code"
+}
+
+func (r *Code500Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *Code500Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(500)
 	return nil
 }
 
 type DeleteCustomerSessionRequest struct {
-	XRequestID *string
-	XSessionID string
+	XRequestID        *string `param:"X-Request-ID,header"`
+	XSessionID        string
+	IfMatch           string
+	IfNoneMatch       string
+	IfUnmodifiedSince time.Time
 }
 
 type DeleteCustomerSessionResponse interface {
 	isDeleteCustomerSessionResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Session successful deleted
-type DeleteCustomerSession204Response struct{}
+type DeleteCustomerSession204Response struct {
+	ETag         string
+	LastModified time.Time
+}
 
 func (r *DeleteCustomerSession204Response) isDeleteCustomerSessionResponse() {}
 
@@ -1645,7 +2512,8 @@ func (r *DeleteCustomerSession204Response) StatusCode() int {
 	return 204
 }
 
-func (r *DeleteCustomerSession204Response) write(response http.ResponseWriter) error {
+func (r *DeleteCustomerSession204Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(204)
 	return nil
@@ -1660,7 +2528,15 @@ func (r *DeleteCustomerSession401Response) StatusCode() int {
 	return 401
 }
 
-func (r *DeleteCustomerSession401Response) write(response http.ResponseWriter) error {
+func (r *DeleteCustomerSession401Response) OperationID() string {
+	return "DeleteCustomerSession"
+}
+
+func (r *DeleteCustomerSession401Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *DeleteCustomerSession401Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(401)
 	return nil
@@ -1675,22 +2551,30 @@ func (r *DeleteCustomerSession500Response) StatusCode() int {
 	return 500
 }
 
-func (r *DeleteCustomerSession500Response) write(response http.ResponseWriter) error {
+func (r *DeleteCustomerSession500Response) OperationID() string {
+	return "DeleteCustomerSession"
+}
+
+func (r *DeleteCustomerSession500Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *DeleteCustomerSession500Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(500)
 	return nil
 }
 
 type CreateCustomerSessionRequest struct {
-	XRequestID *string
-	Code       string  `validate:"max=255"`
-	Locale     *string `validate:"omitempty,regex7,max=255"`
+	XRequestID *string `param:"X-Request-ID,header"`
+	Code       string  `param:"code,formData" validate:"max=255"`
+	Locale     *string `param:"locale,formData" validate:"omitempty,regex7,max=255"`
 }
 
 type CreateCustomerSessionResponse interface {
 	isCreateCustomerSessionResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Session successful created
@@ -1704,7 +2588,7 @@ func (r *CreateCustomerSession201Response) StatusCode() int {
 	return 201
 }
 
-func (r *CreateCustomerSession201Response) write(response http.ResponseWriter) error {
+func (r *CreateCustomerSession201Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	if err := serveJson(response, 201, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -1720,7 +2604,15 @@ func (r *CreateCustomerSession401Response) StatusCode() int {
 	return 401
 }
 
-func (r *CreateCustomerSession401Response) write(response http.ResponseWriter) error {
+func (r *CreateCustomerSession401Response) OperationID() string {
+	return "CreateCustomerSession"
+}
+
+func (r *CreateCustomerSession401Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *CreateCustomerSession401Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(401)
 	return nil
@@ -1737,7 +2629,15 @@ func (r *CreateCustomerSession403Response) StatusCode() int {
 	return 403
 }
 
-func (r *CreateCustomerSession403Response) write(response http.ResponseWriter) error {
+func (r *CreateCustomerSession403Response) OperationID() string {
+	return "CreateCustomerSession"
+}
+
+func (r *CreateCustomerSession403Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *CreateCustomerSession403Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(403)
 	return nil
@@ -1754,7 +2654,15 @@ func (r *CreateCustomerSession422Response) StatusCode() int {
 	return 422
 }
 
-func (r *CreateCustomerSession422Response) write(response http.ResponseWriter) error {
+func (r *CreateCustomerSession422Response) OperationID() string {
+	return "CreateCustomerSession"
+}
+
+func (r *CreateCustomerSession422Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), r.Body)
+}
+
+func (r *CreateCustomerSession422Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	if err := serveJson(response, 422, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -1770,23 +2678,36 @@ func (r *CreateCustomerSession500Response) StatusCode() int {
 	return 500
 }
 
-func (r *CreateCustomerSession500Response) write(response http.ResponseWriter) error {
+func (r *CreateCustomerSession500Response) OperationID() string {
+	return "CreateCustomerSession"
+}
+
+func (r *CreateCustomerSession500Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *CreateCustomerSession500Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(500)
 	return nil
 }
 
-type DownloadNestedFileRequest struct{}
+type DownloadNestedFileRequest struct {
+	IfNoneMatch     string
+	IfModifiedSince time.Time
+}
 
 type DownloadNestedFileResponse interface {
 	isDownloadNestedFileResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Nested file structure
 type DownloadNestedFile200Response struct {
-	Body NestedFileStructure
+	Body         NestedFileStructure
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *DownloadNestedFile200Response) isDownloadNestedFileResponse() {}
@@ -1795,27 +2716,53 @@ func (r *DownloadNestedFile200Response) StatusCode() int {
 	return 200
 }
 
-func (r *DownloadNestedFile200Response) write(response http.ResponseWriter) error {
+func (r *DownloadNestedFile200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	if err := serveJson(response, 200, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
 	return nil
 }
 
+// DownloadNestedFile304Response is the response of a conditional request if the resource was not modified
+type DownloadNestedFile304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *DownloadNestedFile304Response) isDownloadNestedFileResponse() {}
+
+func (r *DownloadNestedFile304Response) StatusCode() int {
+	return 304
+}
+
+func (r *DownloadNestedFile304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
 type DownloadImageRequest struct {
-	Image string
+	Image           string `param:"image,path"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
+	Range           string
+	IfRange         string
 }
 
 type DownloadImageResponse interface {
 	isDownloadImageResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // image to download
 type DownloadImage200Response struct {
-	Body        io.ReadCloser
-	ContentType string
+	Body         io.ReadCloser
+	ContentType  string
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *DownloadImage200Response) isDownloadImageResponse() {}
@@ -1824,8 +2771,16 @@ func (r *DownloadImage200Response) StatusCode() int {
 	return 200
 }
 
-func (r *DownloadImage200Response) write(response http.ResponseWriter) error {
+func (r *DownloadImage200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()["Content-Type"] = []string{toString(r.ContentType)}
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	if content, ok := r.Body.(io.ReadSeeker); ok {
+		serveRanges(response, request, content, r.LastModified)
+		if err := r.Body.Close(); err != nil {
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		return nil
+	}
 	if _, err := io.Copy(response, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -1844,27 +2799,88 @@ func (r *DownloadImage500Response) StatusCode() int {
 	return 500
 }
 
-func (r *DownloadImage500Response) write(response http.ResponseWriter) error {
+func (r *DownloadImage500Response) OperationID() string {
+	return "DownloadImage"
+}
+
+func (r *DownloadImage500Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *DownloadImage500Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(500)
 	return nil
 }
 
+// DownloadImage304Response is the response of a conditional request if the resource was not modified
+type DownloadImage304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *DownloadImage304Response) isDownloadImageResponse() {}
+
+func (r *DownloadImage304Response) StatusCode() int {
+	return 304
+}
+
+func (r *DownloadImage304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
+// DownloadImage206Response is the response of a range request, a multipart/byteranges body contains several ranges
+type DownloadImage206Response struct {
+	Body         io.ReadCloser
+	ContentType  string
+	ContentRange string
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *DownloadImage206Response) isDownloadImageResponse() {}
+
+func (r *DownloadImage206Response) StatusCode() int {
+	return 206
+}
+
+func (r *DownloadImage206Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()[contentTypeHeader] = []string{r.ContentType}
+	response.Header()[contentRangeHeader] = []string{r.ContentRange}
+	response.Header()[acceptRangesHeader] = []string{"bytes"}
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.WriteHeader(206)
+	if _, err := io.Copy(response, r.Body); err != nil {
+		return NewHTTPStatusCodeError(http.StatusInternalServerError)
+	}
+	if err := r.Body.Close(); err != nil {
+		return NewHTTPStatusCodeError(http.StatusInternalServerError)
+	}
+	return nil
+}
+
 type ListElementsRequest struct {
-	Page    *int64
-	PerPage *int64
+	Page            *int64 `param:"_page,query"`
+	PerPage         *int64 `param:"_perPage,query"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
 }
 
 type ListElementsResponse interface {
 	isListElementsResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Status 200
 type ListElements200Response struct {
-	Body        string
-	XTotalCount int64
+	Body         string
+	XTotalCount  int64
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *ListElements200Response) isListElementsResponse() {}
@@ -1873,8 +2889,9 @@ func (r *ListElements200Response) StatusCode() int {
 	return 200
 }
 
-func (r *ListElements200Response) write(response http.ResponseWriter) error {
+func (r *ListElements200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()["X-Total-Count"] = []string{toString(r.XTotalCount)}
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	if err := serveJson(response, 200, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -1890,14 +2907,41 @@ func (r *ListElements500Response) StatusCode() int {
 	return 500
 }
 
-func (r *ListElements500Response) write(response http.ResponseWriter) error {
+func (r *ListElements500Response) OperationID() string {
+	return "ListElements"
+}
+
+func (r *ListElements500Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *ListElements500Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(500)
 	return nil
 }
 
+// ListElements304Response is the response of a conditional request if the resource was not modified
+type ListElements304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *ListElements304Response) isListElementsResponse() {}
+
+func (r *ListElements304Response) StatusCode() int {
+	return 304
+}
+
+func (r *ListElements304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
 type FileUploadRequestFormData struct {
-	File *MimeFile
+	File *MimeFile `param:"file,formData"`
 }
 
 type FileUploadRequest struct {
@@ -1907,7 +2951,7 @@ type FileUploadRequest struct {
 type FileUploadResponse interface {
 	isFileUploadResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // File uploaded.
@@ -1919,7 +2963,7 @@ func (r *FileUpload204Response) StatusCode() int {
 	return 204
 }
 
-func (r *FileUpload204Response) write(response http.ResponseWriter) error {
+func (r *FileUpload204Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(204)
 	return nil
@@ -1934,26 +2978,38 @@ func (r *FileUpload500Response) StatusCode() int {
 	return 500
 }
 
-func (r *FileUpload500Response) write(response http.ResponseWriter) error {
+func (r *FileUpload500Response) OperationID() string {
+	return "FileUpload"
+}
+
+func (r *FileUpload500Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *FileUpload500Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(500)
 	return nil
 }
 
 type DownloadFileRequest struct {
-	File string
+	File            string `param:"file,path"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
 }
 
 type DownloadFileResponse interface {
 	isDownloadFileResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // file to download
 type DownloadFile200Response struct {
-	Body        io.ReadCloser
-	ContentType string
+	Body         io.ReadCloser
+	ContentType  string
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *DownloadFile200Response) isDownloadFileResponse() {}
@@ -1962,26 +3018,50 @@ func (r *DownloadFile200Response) StatusCode() int {
 	return 200
 }
 
-func (r *DownloadFile200Response) write(response http.ResponseWriter) error {
+func (r *DownloadFile200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()["Content-Type"] = []string{toString(r.ContentType)}
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(200)
 	return nil
 }
 
+// DownloadFile304Response is the response of a conditional request if the resource was not modified
+type DownloadFile304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *DownloadFile304Response) isDownloadFileResponse() {}
+
+func (r *DownloadFile304Response) StatusCode() int {
+	return 304
+}
+
+func (r *DownloadFile304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
 type FindByTagsRequest struct {
-	Tags []string
+	Tags            []string `param:"tags,query"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
 }
 
 type FindByTagsResponse interface {
 	isFindByTagsResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // successful operation
 type FindByTags200Response struct {
-	Body string
+	Body         string
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *FindByTags200Response) isFindByTagsResponse() {}
@@ -1990,7 +3070,8 @@ func (r *FindByTags200Response) StatusCode() int {
 	return 200
 }
 
-func (r *FindByTags200Response) write(response http.ResponseWriter) error {
+func (r *FindByTags200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	if err := serveJson(response, 200, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -2006,27 +3087,60 @@ func (r *FindByTags400Response) StatusCode() int {
 	return 400
 }
 
-func (r *FindByTags400Response) write(response http.ResponseWriter) error {
+func (r *FindByTags400Response) OperationID() string {
+	return "FindByTags"
+}
+
+func (r *FindByTags400Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *FindByTags400Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(400)
 	return nil
 }
 
+// FindByTags304Response is the response of a conditional request if the resource was not modified
+type FindByTags304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *FindByTags304Response) isFindByTagsResponse() {}
+
+func (r *FindByTags304Response) StatusCode() int {
+	return 304
+}
+
+func (r *FindByTags304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
 type GenericFileDownloadRequest struct {
-	Ext string
+	Ext             string `param:"ext,path"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
+	Range           string
+	IfRange         string
 }
 
 type GenericFileDownloadResponse interface {
 	isGenericFileDownloadResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // file to download
 type GenericFileDownload200Response struct {
-	Body        io.ReadCloser
-	ContentType string
-	Pragma      string
+	Body         io.ReadCloser
+	ContentType  string
+	Pragma       string
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *GenericFileDownload200Response) isGenericFileDownloadResponse() {}
@@ -2035,9 +3149,17 @@ func (r *GenericFileDownload200Response) StatusCode() int {
 	return 200
 }
 
-func (r *GenericFileDownload200Response) write(response http.ResponseWriter) error {
+func (r *GenericFileDownload200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()["Content-Type"] = []string{toString(r.ContentType)}
 	response.Header()["Pragma"] = []string{toString(r.Pragma)}
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	if content, ok := r.Body.(io.ReadSeeker); ok {
+		serveRanges(response, request, content, r.LastModified)
+		if err := r.Body.Close(); err != nil {
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		return nil
+	}
 	if _, err := io.Copy(response, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
@@ -2056,66 +3178,1197 @@ func (r *GenericFileDownload500Response) StatusCode() int {
 	return 500
 }
 
-func (r *GenericFileDownload500Response) write(response http.ResponseWriter) error {
-	response.Header()[contentTypeHeader] = []string{}
-	response.WriteHeader(500)
-	return nil
+func (r *GenericFileDownload500Response) OperationID() string {
+	return "GenericFileDownload"
 }
 
-type GetRentalRequest struct {
-	Body Rental
+func (r *GenericFileDownload500Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
 }
 
-type GetRentalResponse interface {
-	isGetRentalResponse()
-	StatusCode() int
-	write(response http.ResponseWriter) error
+func (r *GenericFileDownload500Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(500)
+	return nil
 }
 
-// status 200
-type GetRental200Response struct{}
+// GenericFileDownload304Response is the response of a conditional request if the resource was not modified
+type GenericFileDownload304Response struct {
+	ETag         string
+	LastModified time.Time
+}
 
-func (r *GetRental200Response) isGetRentalResponse() {}
+func (r *GenericFileDownload304Response) isGenericFileDownloadResponse() {}
 
-func (r *GetRental200Response) StatusCode() int {
-	return 200
+func (r *GenericFileDownload304Response) StatusCode() int {
+	return 304
 }
 
-func (r *GetRental200Response) write(response http.ResponseWriter) error {
+func (r *GenericFileDownload304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	response.Header()[contentTypeHeader] = []string{}
-	response.WriteHeader(200)
+	response.WriteHeader(304)
 	return nil
 }
 
-// status 400
-type GetRental400Response struct {
-	Body ValidationErrors
+// GenericFileDownload206Response is the response of a range request, a multipart/byteranges body contains several ranges
+type GenericFileDownload206Response struct {
+	Body         io.ReadCloser
+	ContentType  string
+	ContentRange string
+	ETag         string
+	LastModified time.Time
 }
 
-func (r *GetRental400Response) isGetRentalResponse() {}
+func (r *GenericFileDownload206Response) isGenericFileDownloadResponse() {}
 
-func (r *GetRental400Response) StatusCode() int {
-	return 400
+func (r *GenericFileDownload206Response) StatusCode() int {
+	return 206
 }
 
-func (r *GetRental400Response) write(response http.ResponseWriter) error {
-	if err := serveJson(response, 400, r.Body); err != nil {
+func (r *GenericFileDownload206Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()[contentTypeHeader] = []string{r.ContentType}
+	response.Header()[contentRangeHeader] = []string{r.ContentRange}
+	response.Header()[acceptRangesHeader] = []string{"bytes"}
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.WriteHeader(206)
+	if _, err := io.Copy(response, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
-	return nil
-}
+	if err := r.Body.Close(); err != nil {
+		return NewHTTPStatusCodeError(http.StatusInternalServerError)
+	}
+	return nil
+}
+
+type ValidateParametersRequestFormData struct {
+	Ratio *float64 `param:"ratio,formData" validate:"omitempty,gt=0,max=1"`
+	Label *string  `param:"label,formData" validate:"omitempty,min=2"`
+}
+
+type XMode string
+
+const (
+	XModeFast XMode = "fast"
+	XModeSlow XMode = "slow"
+)
+
+type ValidateParametersRequest struct {
+	FormData ValidateParametersRequestFormData
+	Id       string `param:"id,path" validate:"min=3,regex8"`
+	XMode    *XMode `param:"X-Mode,header" validate:"omitempty,oneof=fast slow"`
+	XLimit   *int64 `param:"X-Limit,header" validate:"omitempty,max=10"`
+}
+
+type ValidateParametersResponse interface {
+	isValidateParametersResponse()
+	StatusCode() int
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
+}
+
+// Parameters are valid
+type ValidateParameters204Response struct{}
+
+func (r *ValidateParameters204Response) isValidateParametersResponse() {}
+
+func (r *ValidateParameters204Response) StatusCode() int {
+	return 204
+}
+
+func (r *ValidateParameters204Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(204)
+	return nil
+}
+
+// Invalid parameters
+type ValidateParameters400Response struct {
+	Body ValidationErrors
+}
+
+func (r *ValidateParameters400Response) isValidateParametersResponse() {}
+
+func (r *ValidateParameters400Response) StatusCode() int {
+	return 400
+}
+
+func (r *ValidateParameters400Response) OperationID() string {
+	return "ValidateParameters"
+}
+
+func (r *ValidateParameters400Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), r.Body)
+}
+
+func (r *ValidateParameters400Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	if err := serveJson(response, 400, r.Body); err != nil {
+		return NewHTTPStatusCodeError(http.StatusInternalServerError)
+	}
+	return nil
+}
+
+type ListPartnersRequest struct {
+	Limit           *int64 `param:"limit,query" validate:"omitempty,min=1"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
+}
+
+type ListPartnersResponse interface {
+	isListPartnersResponse()
+	StatusCode() int
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
+}
+
+// ListPartnersItems are the items of a streamed ListPartners response, Next returns io.EOF after the last item
+type ListPartnersItems struct {
+	next   func() (*Partner, error)
+	closer func() error
+}
+
+// NewListPartnersItems creates the items of a ListPartners response which are produced by next, close is called with the end of the response and may be nil
+func NewListPartnersItems(next func() (*Partner, error), close func() error) *ListPartnersItems {
+	return &ListPartnersItems{next: next, closer: close}
+}
+
+func (i *ListPartnersItems) Next() (*Partner, error) {
+	return i.next()
+}
+
+func (i *ListPartnersItems) Close() error {
+	if i.closer == nil {
+		return nil
+	}
+	return i.closer()
+}
+
+func readListPartnersItems(body io.ReadCloser) *ListPartnersItems {
+	reader := newItemReader(body)
+	return NewListPartnersItems(func() (*Partner, error) {
+		item := new(Partner)
+		if err := reader.Next(item); err != nil {
+			return nil, err
+		}
+		return item, nil
+	}, reader.Close)
+}
+
+// List of partners
+type ListPartners200Response struct {
+	Body         []Partner `validate:"dive"`
+	Items        *ListPartnersItems
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *ListPartners200Response) isListPartnersResponse() {}
+
+func (r *ListPartners200Response) StatusCode() int {
+	return 200
+}
+
+func (r *ListPartners200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	if r.Items != nil {
+		defer r.Items.Close()
+		next := func() (interface{}, error) {
+			return r.Items.Next()
+		}
+		if contentType != contentTypeApplicationNdjson {
+			return serveJsonArray(response, 200, next)
+		}
+		return serveNdjson(response, 200, next)
+	}
+	switch contentType {
+	case "application/x-ndjson":
+		if err := serveNdjson(response, 200, sliceItems(r.Body)); err != nil {
+			return err
+		}
+	default:
+		if err := serveJson(response, 200, r.Body); err != nil {
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+	}
+	return nil
+}
+
+// Invalid request
+type ListPartners400Response struct {
+	Body ValidationErrors
+}
+
+func (r *ListPartners400Response) isListPartnersResponse() {}
+
+func (r *ListPartners400Response) StatusCode() int {
+	return 400
+}
+
+func (r *ListPartners400Response) OperationID() string {
+	return "ListPartners"
+}
+
+func (r *ListPartners400Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), r.Body)
+}
+
+func (r *ListPartners400Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	if err := serveJson(response, 400, r.Body); err != nil {
+		return NewHTTPStatusCodeError(http.StatusInternalServerError)
+	}
+	return nil
+}
+
+// ListPartners304Response is the response of a conditional request if the resource was not modified
+type ListPartners304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *ListPartners304Response) isListPartnersResponse() {}
+
+func (r *ListPartners304Response) StatusCode() int {
+	return 304
+}
+
+func (r *ListPartners304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
+type CreatePartnerRequest struct {
+	Body Partner `param:"body,body"`
+}
+
+type CreatePartnerResponse interface {
+	isCreatePartnerResponse()
+	StatusCode() int
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
+}
+
+// Partner created
+type CreatePartner201Response struct {
+	Body Partner
+}
+
+func (r *CreatePartner201Response) isCreatePartnerResponse() {}
+
+func (r *CreatePartner201Response) StatusCode() int {
+	return 201
+}
+
+func (r *CreatePartner201Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	switch contentType {
+	case "application/xml":
+		if err := serveXml(response, "application/xml", 201, r.Body); err != nil {
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+	default:
+		if err := serveJson(response, 201, r.Body); err != nil {
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+	}
+	return nil
+}
+
+// Invalid partner
+type CreatePartner400Response struct {
+	Body ValidationErrors
+}
+
+func (r *CreatePartner400Response) isCreatePartnerResponse() {}
+
+func (r *CreatePartner400Response) StatusCode() int {
+	return 400
+}
+
+func (r *CreatePartner400Response) OperationID() string {
+	return "CreatePartner"
+}
+
+func (r *CreatePartner400Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), r.Body)
+}
+
+func (r *CreatePartner400Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	switch contentType {
+	case "application/xml":
+		if err := serveXml(response, "application/xml", 400, r.Body); err != nil {
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+	default:
+		if err := serveJson(response, 400, r.Body); err != nil {
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+	}
+	return nil
+}
+
+type WatchPartnersRequest struct {
+	Limit       *int64 `param:"limit,query" validate:"omitempty,min=1"`
+	LastEventID string
+}
+
+type WatchPartnersResponse interface {
+	isWatchPartnersResponse()
+	StatusCode() int
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
+}
+
+// WatchPartnersEvent is a server-sent event of the WatchPartners operation
+type WatchPartnersEvent struct {
+	Name  string
+	ID    string
+	Data  Partner
+	Retry time.Duration
+}
+
+// WatchPartnersEventStream sends the events of the WatchPartners operation, sending stops if the request is canceled
+type WatchPartnersEventStream struct {
+	stream *eventStream
+}
+
+func (s *WatchPartnersEventStream) Send(event *WatchPartnersEvent) error {
+	return s.stream.Send(event.Name, event.ID, event.Data, event.Retry)
+}
+
+// WatchPartnersEventReader reads the events of the WatchPartners operation, io.EOF is returned at the end of the stream
+type WatchPartnersEventReader struct {
+	reader *eventReader
+}
+
+func (r *WatchPartnersEventReader) Next() (*WatchPartnersEvent, error) {
+	event, err := r.reader.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := &WatchPartnersEvent{
+		ID:    event.ID,
+		Name:  event.Name,
+		Retry: event.Retry,
+	}
+	if err := json.Unmarshal([]byte(event.Data), &decoded.Data); err != nil {
+		return nil, err
+	}
+
+	return decoded, nil
+}
+
+// LastEventID returns the ID of the last received event to resume the stream with the LastEventID of the request
+func (r *WatchPartnersEventReader) LastEventID() string {
+	return r.reader.LastEventID()
+}
+
+func (r *WatchPartnersEventReader) Close() error {
+	return r.reader.Close()
+}
+
+// Stream of changed partners
+type WatchPartners200Response struct {
+	Events *WatchPartnersEventReader
+}
+
+func (r *WatchPartners200Response) isWatchPartnersResponse() {}
+
+func (r *WatchPartners200Response) StatusCode() int {
+	return 200
+}
+
+func (r *WatchPartners200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()[contentTypeHeader] = []string{contentTypeTextEventStream}
+	response.WriteHeader(200)
+	return nil
+}
+
+// Invalid request
+type WatchPartners400Response struct {
+	Body ValidationErrors
+}
+
+func (r *WatchPartners400Response) isWatchPartnersResponse() {}
+
+func (r *WatchPartners400Response) StatusCode() int {
+	return 400
+}
+
+func (r *WatchPartners400Response) OperationID() string {
+	return "WatchPartners"
+}
+
+func (r *WatchPartners400Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), r.Body)
+}
+
+func (r *WatchPartners400Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(400)
+	return nil
+}
+
+type GetPartnerRequest struct {
+	PartnerId       string `param:"partnerId,path"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
+}
+
+type GetPartnerResponse interface {
+	isGetPartnerResponse()
+	StatusCode() int
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
+}
+
+// Partner
+type GetPartner200Response struct {
+	Body         Partner
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetPartner200Response) isGetPartnerResponse() {}
+
+func (r *GetPartner200Response) StatusCode() int {
+	return 200
+}
+
+func (r *GetPartner200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	if err := serveJson(response, 200, r.Body); err != nil {
+		return NewHTTPStatusCodeError(http.StatusInternalServerError)
+	}
+	return nil
+}
+
+// Partner not found
+type GetPartner404Response struct{}
+
+func (r *GetPartner404Response) isGetPartnerResponse() {}
+
+func (r *GetPartner404Response) StatusCode() int {
+	return 404
+}
+
+func (r *GetPartner404Response) OperationID() string {
+	return "GetPartner"
+}
+
+func (r *GetPartner404Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetPartner404Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(404)
+	return nil
+}
+
+// GetPartner304Response is the response of a conditional request if the resource was not modified
+type GetPartner304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetPartner304Response) isGetPartnerResponse() {}
+
+func (r *GetPartner304Response) StatusCode() int {
+	return 304
+}
+
+func (r *GetPartner304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
+// Unexpected error
+type GetPartnerDefaultResponse struct {
+	Body       ErrorMessage
+	XRequestID string
+	Status     int
+}
+
+func (r *GetPartnerDefaultResponse) isGetPartnerResponse() {}
+
+// StatusCode returns the status code of the response, it defaults to 500 if the status isn't set
+func (r *GetPartnerDefaultResponse) StatusCode() int {
+	if r.Status == 0 {
+		return http.StatusInternalServerError
+	}
+	return r.Status
+}
+
+func (r *GetPartnerDefaultResponse) OperationID() string {
+	return "GetPartner"
+}
+
+func (r *GetPartnerDefaultResponse) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), r.Body)
+}
+
+func (r *GetPartnerDefaultResponse) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()["X-Request-ID"] = []string{toString(r.XRequestID)}
+	if err := serveJson(response, r.StatusCode(), r.Body); err != nil {
+		return NewHTTPStatusCodeError(http.StatusInternalServerError)
+	}
+	return nil
+}
+
+type UpdatePartnerRequest struct {
+	PartnerId         string  `param:"partnerId,path"`
+	Body              Partner `param:"body,body"`
+	IfMatch           string
+	IfNoneMatch       string
+	IfUnmodifiedSince time.Time
+}
+
+type UpdatePartnerResponse interface {
+	isUpdatePartnerResponse()
+	StatusCode() int
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
+}
+
+// Partner updated
+type UpdatePartner200Response struct {
+	Body         Partner
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *UpdatePartner200Response) isUpdatePartnerResponse() {}
+
+func (r *UpdatePartner200Response) StatusCode() int {
+	return 200
+}
+
+func (r *UpdatePartner200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	if err := serveJson(response, 200, r.Body); err != nil {
+		return NewHTTPStatusCodeError(http.StatusInternalServerError)
+	}
+	return nil
+}
+
+// Partner not found
+type UpdatePartner404Response struct{}
+
+func (r *UpdatePartner404Response) isUpdatePartnerResponse() {}
+
+func (r *UpdatePartner404Response) StatusCode() int {
+	return 404
+}
+
+func (r *UpdatePartner404Response) OperationID() string {
+	return "UpdatePartner"
+}
+
+func (r *UpdatePartner404Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *UpdatePartner404Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(404)
+	return nil
+}
+
+// Partner was changed
+type UpdatePartner412Response struct{}
+
+func (r *UpdatePartner412Response) isUpdatePartnerResponse() {}
+
+func (r *UpdatePartner412Response) StatusCode() int {
+	return 412
+}
+
+func (r *UpdatePartner412Response) OperationID() string {
+	return "UpdatePartner"
+}
+
+func (r *UpdatePartner412Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *UpdatePartner412Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(412)
+	return nil
+}
+
+type ImportPartnerArchiveRequestFormData struct {
+	Files   []MimeFile `param:"files,formData"`
+	Uploads *UploadReader
+	Note    string `param:"note,formData"`
+}
+
+type ImportPartnerArchiveRequest struct {
+	FormData  ImportPartnerArchiveRequestFormData
+	PartnerId string `param:"partnerId,path"`
+}
+
+type ImportPartnerArchiveResponse interface {
+	isImportPartnerArchiveResponse()
+	StatusCode() int
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
+}
+
+// Imported files
+type ImportPartnerArchive200Response struct {
+	Body []UploadedFile `validate:"dive"`
+}
+
+func (r *ImportPartnerArchive200Response) isImportPartnerArchiveResponse() {}
+
+func (r *ImportPartnerArchive200Response) StatusCode() int {
+	return 200
+}
+
+func (r *ImportPartnerArchive200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	if err := serveJson(response, 200, r.Body); err != nil {
+		return NewHTTPStatusCodeError(http.StatusInternalServerError)
+	}
+	return nil
+}
+
+// Archive too large
+type ImportPartnerArchive413Response struct{}
+
+func (r *ImportPartnerArchive413Response) isImportPartnerArchiveResponse() {}
+
+func (r *ImportPartnerArchive413Response) StatusCode() int {
+	return 413
+}
+
+func (r *ImportPartnerArchive413Response) OperationID() string {
+	return "ImportPartnerArchive"
+}
+
+func (r *ImportPartnerArchive413Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *ImportPartnerArchive413Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(413)
+	return nil
+}
+
+type ChatWithPartnerRequest struct {
+	PartnerId string `param:"partnerId,path"`
+}
+
+type ChatWithPartnerResponse interface {
+	isChatWithPartnerResponse()
+	StatusCode() int
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
+}
+
+// Switching to the WebSocket protocol
+type ChatWithPartner101Response struct {
+	Conn *ChatWithPartnerClientConn
+}
+
+func (r *ChatWithPartner101Response) isChatWithPartnerResponse() {}
+
+func (r *ChatWithPartner101Response) StatusCode() int {
+	return 101
+}
+
+func (r *ChatWithPartner101Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(101)
+	return nil
+}
+
+// Partner not found
+type ChatWithPartner404Response struct{}
+
+func (r *ChatWithPartner404Response) isChatWithPartnerResponse() {}
+
+func (r *ChatWithPartner404Response) StatusCode() int {
+	return 404
+}
+
+func (r *ChatWithPartner404Response) OperationID() string {
+	return "ChatWithPartner"
+}
+
+func (r *ChatWithPartner404Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *ChatWithPartner404Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(404)
+	return nil
+}
+
+// ChatWithPartnerServerConn is the WebSocket of the ChatWithPartner operation on the server, it's upgraded with the first message
+type ChatWithPartnerServerConn struct {
+	socket *webSocket
+}
+
+// Receive reads and validates the next message, io.EOF is returned if the peer closed the connection
+func (c *ChatWithPartnerServerConn) Receive() (*ChatMessage, error) {
+	message := new(ChatMessage)
+	if err := c.socket.Read(message); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// Send validates and writes a message
+func (c *ChatWithPartnerServerConn) Send(message *ChatReply) error {
+	return c.socket.Write(message)
+}
+
+func (c *ChatWithPartnerServerConn) Close() error {
+	return c.socket.Close()
+}
+
+// ChatWithPartnerClientConn is the WebSocket of the ChatWithPartner operation on the client
+type ChatWithPartnerClientConn struct {
+	socket *webSocket
+}
+
+// Receive reads and validates the next message, io.EOF is returned if the peer closed the connection
+func (c *ChatWithPartnerClientConn) Receive() (*ChatReply, error) {
+	message := new(ChatReply)
+	if err := c.socket.Read(message); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// Send validates and writes a message
+func (c *ChatWithPartnerClientConn) Send(message *ChatMessage) error {
+	return c.socket.Write(message)
+}
+
+func (c *ChatWithPartnerClientConn) Close() error {
+	return c.socket.Close()
+}
+
+type DownloadPartnerContractRequest struct {
+	PartnerId       string `param:"partnerId,path"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
+	Range           string
+	IfRange         string
+}
+
+type DownloadPartnerContractResponse interface {
+	isDownloadPartnerContractResponse()
+	StatusCode() int
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
+}
+
+// Contract of the partner
+type DownloadPartnerContract200Response struct {
+	Body         io.ReadCloser
+	ContentType  string
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *DownloadPartnerContract200Response) isDownloadPartnerContractResponse() {}
+
+func (r *DownloadPartnerContract200Response) StatusCode() int {
+	return 200
+}
+
+func (r *DownloadPartnerContract200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()["Content-Type"] = []string{toString(r.ContentType)}
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	if content, ok := r.Body.(io.ReadSeeker); ok {
+		serveRanges(response, request, content, r.LastModified)
+		if err := r.Body.Close(); err != nil {
+			return NewHTTPStatusCodeError(http.StatusInternalServerError)
+		}
+		return nil
+	}
+	if _, err := io.Copy(response, r.Body); err != nil {
+		return NewHTTPStatusCodeError(http.StatusInternalServerError)
+	}
+	if err := r.Body.Close(); err != nil {
+		return NewHTTPStatusCodeError(http.StatusInternalServerError)
+	}
+	return nil
+}
+
+// Partner not found
+type DownloadPartnerContract404Response struct{}
+
+func (r *DownloadPartnerContract404Response) isDownloadPartnerContractResponse() {}
+
+func (r *DownloadPartnerContract404Response) StatusCode() int {
+	return 404
+}
+
+func (r *DownloadPartnerContract404Response) OperationID() string {
+	return "DownloadPartnerContract"
+}
+
+func (r *DownloadPartnerContract404Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *DownloadPartnerContract404Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(404)
+	return nil
+}
+
+// DownloadPartnerContract304Response is the response of a conditional request if the resource was not modified
+type DownloadPartnerContract304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *DownloadPartnerContract304Response) isDownloadPartnerContractResponse() {}
+
+func (r *DownloadPartnerContract304Response) StatusCode() int {
+	return 304
+}
+
+func (r *DownloadPartnerContract304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
+// DownloadPartnerContract206Response is the response of a range request, a multipart/byteranges body contains several ranges
+type DownloadPartnerContract206Response struct {
+	Body         io.ReadCloser
+	ContentType  string
+	ContentRange string
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *DownloadPartnerContract206Response) isDownloadPartnerContractResponse() {}
+
+func (r *DownloadPartnerContract206Response) StatusCode() int {
+	return 206
+}
+
+func (r *DownloadPartnerContract206Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()[contentTypeHeader] = []string{r.ContentType}
+	response.Header()[contentRangeHeader] = []string{r.ContentRange}
+	response.Header()[acceptRangesHeader] = []string{"bytes"}
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.WriteHeader(206)
+	if _, err := io.Copy(response, r.Body); err != nil {
+		return NewHTTPStatusCodeError(http.StatusInternalServerError)
+	}
+	if err := r.Body.Close(); err != nil {
+		return NewHTTPStatusCodeError(http.StatusInternalServerError)
+	}
+	return nil
+}
+
+type UploadPartnerDocumentsRequestFormData struct {
+	Documents []MimeFile `param:"documents,formData"`
+	Cover     *MimeFile  `param:"cover,formData"`
+	Category  string     `param:"category,formData"`
+}
+
+type UploadPartnerDocumentsRequest struct {
+	FormData  UploadPartnerDocumentsRequestFormData
+	PartnerId string `param:"partnerId,path"`
+}
+
+type UploadPartnerDocumentsResponse interface {
+	isUploadPartnerDocumentsResponse()
+	StatusCode() int
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
+}
+
+// Uploaded documents
+type UploadPartnerDocuments200Response struct {
+	Body []UploadedFile `validate:"dive"`
+}
+
+func (r *UploadPartnerDocuments200Response) isUploadPartnerDocumentsResponse() {}
+
+func (r *UploadPartnerDocuments200Response) StatusCode() int {
+	return 200
+}
+
+func (r *UploadPartnerDocuments200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	if err := serveJson(response, 200, r.Body); err != nil {
+		return NewHTTPStatusCodeError(http.StatusInternalServerError)
+	}
+	return nil
+}
+
+// Document too large
+type UploadPartnerDocuments413Response struct{}
+
+func (r *UploadPartnerDocuments413Response) isUploadPartnerDocumentsResponse() {}
+
+func (r *UploadPartnerDocuments413Response) StatusCode() int {
+	return 413
+}
+
+func (r *UploadPartnerDocuments413Response) OperationID() string {
+	return "UploadPartnerDocuments"
+}
+
+func (r *UploadPartnerDocuments413Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *UploadPartnerDocuments413Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(413)
+	return nil
+}
+
+// Document type not supported
+type UploadPartnerDocuments415Response struct{}
+
+func (r *UploadPartnerDocuments415Response) isUploadPartnerDocumentsResponse() {}
+
+func (r *UploadPartnerDocuments415Response) StatusCode() int {
+	return 415
+}
+
+func (r *UploadPartnerDocuments415Response) OperationID() string {
+	return "UploadPartnerDocuments"
+}
+
+func (r *UploadPartnerDocuments415Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *UploadPartnerDocuments415Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(415)
+	return nil
+}
+
+type GetPartnerProfileRequest struct {
+	PartnerId       string `param:"partnerId,path"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
+}
+
+type GetPartnerProfileResponse interface {
+	isGetPartnerProfileResponse()
+	StatusCode() int
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
+}
+
+// Partner profile
+type GetPartnerProfile200Response struct {
+	Body         Partner
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetPartnerProfile200Response) isGetPartnerProfileResponse() {}
+
+func (r *GetPartnerProfile200Response) StatusCode() int {
+	return 200
+}
+
+func (r *GetPartnerProfile200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	if err := serveJson(response, 200, r.Body); err != nil {
+		return NewHTTPStatusCodeError(http.StatusInternalServerError)
+	}
+	return nil
+}
+
+// GetPartnerProfile304Response is the response of a conditional request if the resource was not modified
+type GetPartnerProfile304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetPartnerProfile304Response) isGetPartnerProfileResponse() {}
+
+func (r *GetPartnerProfile304Response) StatusCode() int {
+	return 304
+}
+
+func (r *GetPartnerProfile304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
+type GetPartnerStatementRequest struct {
+	PartnerId       string `param:"partnerId,path"`
+	Authorization   string
+	IfNoneMatch     string
+	IfModifiedSince time.Time
+}
+
+type GetPartnerStatementResponse interface {
+	isGetPartnerStatementResponse()
+	StatusCode() int
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
+}
+
+// Partner statement
+type GetPartnerStatement200Response struct {
+	Body         Partner
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetPartnerStatement200Response) isGetPartnerStatementResponse() {}
+
+func (r *GetPartnerStatement200Response) StatusCode() int {
+	return 200
+}
+
+func (r *GetPartnerStatement200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	if err := serveJson(response, 200, r.Body); err != nil {
+		return NewHTTPStatusCodeError(http.StatusInternalServerError)
+	}
+	return nil
+}
+
+// Invalid access token
+type GetPartnerStatement401Response struct{}
+
+func (r *GetPartnerStatement401Response) isGetPartnerStatementResponse() {}
+
+func (r *GetPartnerStatement401Response) StatusCode() int {
+	return 401
+}
+
+func (r *GetPartnerStatement401Response) OperationID() string {
+	return "GetPartnerStatement"
+}
+
+func (r *GetPartnerStatement401Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *GetPartnerStatement401Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(401)
+	return nil
+}
+
+// GetPartnerStatement304Response is the response of a conditional request if the resource was not modified
+type GetPartnerStatement304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetPartnerStatement304Response) isGetPartnerStatementResponse() {}
+
+func (r *GetPartnerStatement304Response) StatusCode() int {
+	return 304
+}
+
+func (r *GetPartnerStatement304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
+type GetRentalRequest struct {
+	Body            Rental `param:"body,body"`
+	IfNoneMatch     string
+	IfModifiedSince time.Time
+}
+
+type GetRentalResponse interface {
+	isGetRentalResponse()
+	StatusCode() int
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
+}
+
+// status 200
+type GetRental200Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetRental200Response) isGetRentalResponse() {}
+
+func (r *GetRental200Response) StatusCode() int {
+	return 200
+}
+
+func (r *GetRental200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(200)
+	return nil
+}
+
+// status 400
+type GetRental400Response struct {
+	Body ValidationErrors
+}
+
+func (r *GetRental400Response) isGetRentalResponse() {}
+
+func (r *GetRental400Response) StatusCode() int {
+	return 400
+}
+
+func (r *GetRental400Response) OperationID() string {
+	return "GetRental"
+}
+
+func (r *GetRental400Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), r.Body)
+}
+
+func (r *GetRental400Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	if err := serveJson(response, 400, r.Body); err != nil {
+		return NewHTTPStatusCodeError(http.StatusInternalServerError)
+	}
+	return nil
+}
+
+// GetRental304Response is the response of a conditional request if the resource was not modified
+type GetRental304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetRental304Response) isGetRentalResponse() {}
+
+func (r *GetRental304Response) StatusCode() int {
+	return 304
+}
+
+func (r *GetRental304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
 
-type GetShoesRequest struct{}
+type GetShoesRequest struct {
+	IfNoneMatch     string
+	IfModifiedSince time.Time
+}
 
 type GetShoesResponse interface {
 	isGetShoesResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Successful
 type GetShoes200Response struct {
-	Body Shoes
+	Body         Shoes
+	ETag         string
+	LastModified time.Time
 }
 
 func (r *GetShoes200Response) isGetShoesResponse() {}
@@ -2124,16 +4377,36 @@ func (r *GetShoes200Response) StatusCode() int {
 	return 200
 }
 
-func (r *GetShoes200Response) write(response http.ResponseWriter) error {
+func (r *GetShoes200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
 	if err := serveHalJson(response, 200, r.Body); err != nil {
 		return NewHTTPStatusCodeError(http.StatusInternalServerError)
 	}
 	return nil
 }
 
+// GetShoes304Response is the response of a conditional request if the resource was not modified
+type GetShoes304Response struct {
+	ETag         string
+	LastModified time.Time
+}
+
+func (r *GetShoes304Response) isGetShoesResponse() {}
+
+func (r *GetShoes304Response) StatusCode() int {
+	return 304
+}
+
+func (r *GetShoes304Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
+	setValidators(response.Header(), r.ETag, r.LastModified)
+	response.Header()[contentTypeHeader] = []string{}
+	response.WriteHeader(304)
+	return nil
+}
+
 type PostUploadRequestFormData struct {
-	Upfile *MimeFile
-	Note   *string
+	Upfile *MimeFile `param:"upfile,formData"`
+	Note   *string   `param:"note,formData" validate:"omitempty,regex9,max=4000"`
 }
 
 type PostUploadRequest struct {
@@ -2143,7 +4416,7 @@ type PostUploadRequest struct {
 type PostUploadResponse interface {
 	isPostUploadResponse()
 	StatusCode() int
-	write(response http.ResponseWriter) error
+	write(response http.ResponseWriter, request *http.Request, contentType string) error
 }
 
 // Status 200
@@ -2155,7 +4428,7 @@ func (r *PostUpload200Response) StatusCode() int {
 	return 200
 }
 
-func (r *PostUpload200Response) write(response http.ResponseWriter) error {
+func (r *PostUpload200Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(200)
 	return nil
@@ -2170,7 +4443,15 @@ func (r *PostUpload500Response) StatusCode() int {
 	return 500
 }
 
-func (r *PostUpload500Response) write(response http.ResponseWriter) error {
+func (r *PostUpload500Response) OperationID() string {
+	return "PostUpload"
+}
+
+func (r *PostUpload500Response) Error() string {
+	return responseErrorMessage(r.OperationID(), r.StatusCode(), nil)
+}
+
+func (r *PostUpload500Response) write(response http.ResponseWriter, request *http.Request, contentType string) error {
 	response.Header()[contentTypeHeader] = []string{}
 	response.WriteHeader(500)
 	return nil
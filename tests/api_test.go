@@ -2,22 +2,28 @@ package tests
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
 	"fmt"
 	"github.com/ExperienceOne/apikit/middleware"
 	"github.com/ExperienceOne/apikit/roundtripper"
+	"io"
 	"io/ioutil"
 	"log"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"net/textproto"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/ExperienceOne/apikit/generator/stringutil"
 	"github.com/ExperienceOne/apikit/tests/api"
-
-	routing "github.com/go-ozzo/ozzo-routing"
 )
 
 var (
@@ -42,11 +48,10 @@ func TestMain(m *testing.M) {
 	testServerWrapper = api.NewVisAdminServer(&api.ServerOpts{
 		Middleware:   middlewares,
 		ErrorHandler: log.Println,
-		OnStart: func(router *routing.Router) {
-			router.Get("/no-spec-route", func(context *routing.Context) error {
-				context.Write(1)
-				return nil
-			})
+		OnStart: func(router api.Router) {
+			router.Handle(http.MethodGet, "/no-spec-route", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("1"))
+			}))
 		},
 	})
 
@@ -61,6 +66,7 @@ func TestMain(m *testing.M) {
 	testServerWrapper.SetDownloadFileHandler(api.DownloadFile)
 	testServerWrapper.SetGetBookingsHandler(api.GetBookings)
 	testServerWrapper.SetGetBookingHandler(api.GetBooking)
+	testServerWrapper.SetGetBookingYamlHandler(api.GetBookingYaml)
 	testServerWrapper.SetGetClassesHandler(api.GetClasses)
 	testServerWrapper.SetListModelsHandler(api.GetBrands)
 	testServerWrapper.SetGenericFileDownloadHandler(api.GenericFileDownload)
@@ -72,6 +78,24 @@ func TestMain(m *testing.M) {
 	testServerWrapper.SetGetShoesHandler(api.GetShoes)
 	testServerWrapper.SetFileUploadHandler(api.FileUpload)
 	testServerWrapper.SetFindByTagsHandler(api.FindByTags)
+	testServerWrapper.SetValidateParametersHandler(api.ValidateParameters)
+	testServerWrapper.SetCreatePartnerHandler(api.CreatePartner)
+	testServerWrapper.SetListPartnersHandler(api.ListPartners)
+	testServerWrapper.SetGetPartnerHandler(api.GetPartner)
+	testServerWrapper.SetUpdatePartnerHandler(api.UpdatePartner)
+	testServerWrapper.SetUpdatePartnerPrecondition(api.PartnerPrecondition)
+	testServerWrapper.SetDownloadPartnerContractHandler(api.DownloadPartnerContract)
+	testServerWrapper.SetUploadPartnerDocumentsHandler(api.UploadPartnerDocuments)
+	testServerWrapper.SetImportPartnerArchiveHandler(api.ImportPartnerArchive)
+	testServerWrapper.SetWatchPartnersHandler(api.WatchPartners)
+	testServerWrapper.SetChatWithPartnerHandler(api.ChatWithPartner)
+	testServerWrapper.SetGetPartnerProfileHandler(api.GetPartnerProfile, api.Middleware{Handler: func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("Vary", "Origin")
+			next.ServeHTTP(w, r)
+		})
+	}})
+	testServerWrapper.SetGetPartnerStatementHandler(api.GetPartnerStatement)
 
 	go testServerWrapper.Start(4567)
 	time.Sleep(1 * time.Second)
@@ -147,7 +171,7 @@ func TestGetUsers(t *testing.T) {
 		XAuth: "sessionID",
 	}
 
-	response, err := VisAdminClient.GetUsers(request)
+	response, err := VisAdminClient.GetUsers(context.Background(), request)
 	if err != nil {
 		t.Fatalf("error sending GetUsers GET request: %v", err)
 	}
@@ -157,6 +181,33 @@ func TestGetUsers(t *testing.T) {
 	}
 }
 
+func TestGetUsersCanceledContext(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := VisAdminClient.GetUsers(ctx, &api.GetUsersRequest{XAuth: "sessionID"})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected canceled request, got: %v", err)
+	}
+}
+
+func TestGetUsersWithoutContext(t *testing.T) {
+	t.Parallel()
+
+	client := api.NewVisAdminClientWithoutContext(new(http.Client), "http://localhost:4567", api.Opts{})
+
+	response, err := client.GetUsers(&api.GetUsersRequest{XAuth: "sessionID"})
+	if err != nil {
+		t.Fatalf("error sending GetUsers GET request: %v", err)
+	}
+
+	if _, ok := response.(*api.GetUsers200Response); !ok {
+		t.Fatalf("error GetUsers response is bad: %#v", response)
+	}
+}
+
 func TestGetClient(t *testing.T) {
 	t.Parallel()
 
@@ -165,7 +216,7 @@ func TestGetClient(t *testing.T) {
 		ClientId: "test",
 	}
 
-	response, err := VisAdminClient.GetClient(request)
+	response, err := VisAdminClient.GetClient(context.Background(), request)
 	if err != nil {
 		t.Fatalf("error sending GetClient GET request: %v", err)
 	}
@@ -178,7 +229,7 @@ func TestGetClient(t *testing.T) {
 func TestGetBookings200(t *testing.T) {
 	t.Parallel()
 
-	response, err := VisAdminClient.GetBookings(&api.GetBookingsRequest{XSessionID: "xsession"})
+	response, err := VisAdminClient.GetBookings(context.Background(), &api.GetBookingsRequest{XSessionID: "xsession"})
 	if err != nil {
 		t.Fatalf("error sending GetBookings200 GET request: %v", err)
 		return
@@ -198,10 +249,70 @@ func TestGetBookings200(t *testing.T) {
 	}
 }
 
+func TestGetBookingsCredentials(t *testing.T) {
+	t.Parallel()
+
+	client := api.NewVisAdminClient(new(http.Client), "http://localhost:4567", api.Opts{
+		Credentials: map[string]api.CredentialProvider{
+			"X-Session-ID": api.APIKeyCredential("xsession"),
+		},
+	})
+
+	response, err := client.GetBookings(context.Background(), &api.GetBookingsRequest{})
+	if err != nil {
+		t.Fatalf("error sending GetBookings GET request: %v", err)
+	}
+	if _, ok := response.(*api.GetBookings200Response); !ok {
+		t.Fatalf("error GetBookings response is bad: %#v", response)
+	}
+
+	// the value of the request overrides the credential
+	response, err = client.GetBookings(context.Background(), &api.GetBookingsRequest{XSessionID: "xsession1"})
+	if err != nil {
+		t.Fatalf("error sending GetBookings GET request: %v", err)
+	}
+	if _, ok := response.(*api.GetBookings401Response); !ok {
+		t.Fatalf("error GetBookings response is bad: %#v", response)
+	}
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token(ctx context.Context) (*api.OAuth2Token, error) {
+	return &api.OAuth2Token{AccessToken: string(s), Expiry: time.Now().Add(time.Hour)}, nil
+}
+
+func TestGetPartnerStatementCredentials(t *testing.T) {
+	t.Parallel()
+
+	client := api.NewVisAdminClient(new(http.Client), "http://localhost:4567", api.Opts{
+		Credentials: map[string]api.CredentialProvider{
+			"OAuth2": api.BearerTokenCredential(staticTokenSource("partner-token")),
+		},
+	})
+
+	response, err := client.GetPartnerStatement(context.Background(), &api.GetPartnerStatementRequest{PartnerId: "p-1"})
+	if err != nil {
+		t.Fatalf("error sending GetPartnerStatement GET request: %v", err)
+	}
+	if statement, ok := response.(*api.GetPartnerStatement200Response); !ok || statement.Body.Id != "p-1" {
+		t.Fatalf("error GetPartnerStatement response is bad: %#v", response)
+	}
+
+	// the value of the request overrides the credential
+	response, err = client.GetPartnerStatement(context.Background(), &api.GetPartnerStatementRequest{PartnerId: "p-1", Authorization: "Bearer invalid"})
+	if err != nil {
+		t.Fatalf("error sending GetPartnerStatement GET request: %v", err)
+	}
+	if _, ok := response.(*api.GetPartnerStatement401Response); !ok {
+		t.Fatalf("error GetPartnerStatement response is bad: %#v", response)
+	}
+}
+
 func TestGetBookings401(t *testing.T) {
 	t.Parallel()
 
-	response, err := VisAdminClient.GetBookings(&api.GetBookingsRequest{XSessionID: "xsession1"})
+	response, err := VisAdminClient.GetBookings(context.Background(), &api.GetBookingsRequest{XSessionID: "xsession1"})
 	if err != nil {
 		t.Fatalf("error sending GetBookings401 GET request: %v", err)
 		return
@@ -212,24 +323,38 @@ func TestGetBookings401(t *testing.T) {
 	}
 }
 
-func TestGetBookingErrNotSupportedContentType(t *testing.T) {
+func TestGetBooking(t *testing.T) {
+	t.Parallel()
+
+	// operations consuming XML are supported
+	response, err := VisAdminClient.GetBooking(context.Background(), &api.GetBookingRequest{})
+	if err != nil {
+		t.Fatalf("error sending GetBooking GET request: %v", err)
+	}
+
+	if _, ok := response.(*api.GetBooking500Response); !ok {
+		t.Fatalf("error GetBooking response is bad: %#v", response)
+	}
+}
+
+func TestGetBookingYamlErrNotSupportedContentType(t *testing.T) {
 	t.Parallel()
 
-	response, err := VisAdminClient.GetBooking(&api.GetBookingRequest{})
+	response, err := VisAdminClient.GetBookingYaml(context.Background(), &api.GetBookingYamlRequest{})
 	if err == nil {
-		t.Fatalf("error sending GetBooking GET request: %#v", response)
+		t.Fatalf("error sending GetBookingYaml GET request: %#v", response)
 		return
 	}
 
 	if !strings.Contains(err.Error(), "error unsupported media type") {
-		t.Fatalf("error sending GetBooking GET request: %v", err)
+		t.Fatalf("error sending GetBookingYaml GET request: %v", err)
 	}
 }
 
 func TestGetUserInfo400(t *testing.T) {
 	t.Parallel()
 
-	response, err := BadRequestVisAdminClient.GetUserInfo(&api.GetUserInfoRequest{XAuth: "trigger400"})
+	response, err := BadRequestVisAdminClient.GetUserInfo(context.Background(), &api.GetUserInfoRequest{XAuth: "trigger400"})
 	if err != nil {
 		t.Fatalf("error sending GetUserInfo GET request: %#v", err)
 		return
@@ -240,6 +365,101 @@ func TestGetUserInfo400(t *testing.T) {
 	}
 }
 
+func TestResponseErrors(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"Message": "invalid auth"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	client := api.NewVisAdminClient(new(http.Client), ts.URL, api.Opts{ResponseErrors: true})
+
+	response, err := client.GetClient(context.Background(), &api.GetClientRequest{ClientId: "unknown", XAuth: "test"})
+	if response != nil || !api.IsNotFound(err) {
+		t.Fatalf("404 response wasn't returned as error: %#v, %v", response, err)
+	}
+	if err.Error() != "GetClient responded with status code 404 (Not Found)" {
+		t.Fatalf("unexpected error message: %v", err)
+	}
+
+	_, err = client.GetUserInfo(context.Background(), &api.GetUserInfoRequest{XAuth: "test"})
+	var badRequest *api.GetUserInfo400Response
+	if !errors.As(err, &badRequest) || badRequest.Body.Message == nil || *badRequest.Body.Message != "invalid auth" {
+		t.Fatalf("400 response wasn't returned as error: %v", err)
+	}
+	if !api.IsBadRequest(err) || api.IsServerError(err) {
+		t.Fatalf("unexpected status code of error: %v", err)
+	}
+
+	// without the option the responses are returned as before, but they can be used as errors
+	client = api.NewVisAdminClient(new(http.Client), ts.URL, api.Opts{})
+	response, err = client.GetClient(context.Background(), &api.GetClientRequest{ClientId: "unknown", XAuth: "test"})
+	if err != nil {
+		t.Fatalf("error sending GetClient request: %v", err)
+	}
+	responseErr, ok := response.(api.ResponseError)
+	if !ok || responseErr.StatusCode() != http.StatusNotFound || responseErr.OperationID() != "GetClient" {
+		t.Fatalf("404 response isn't a response error: %#v", response)
+	}
+}
+
+func TestUnexpectedResponse(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusTeapot)
+		w.Write([]byte("short and stout"))
+	}))
+	defer ts.Close()
+
+	client := api.NewVisAdminClient(new(http.Client), ts.URL, api.Opts{})
+
+	_, err := client.GetClient(context.Background(), &api.GetClientRequest{ClientId: "c-1", XAuth: "test"})
+	var unexpected *api.UnexpectedResponseError
+	if !errors.As(err, &unexpected) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if unexpected.StatusCode != http.StatusTeapot || unexpected.Header.Get("Content-Type") != "text/plain" || string(unexpected.Body) != "short and stout" {
+		t.Fatalf("unexpected response: %#v", unexpected)
+	}
+	if unexpected.Method != http.MethodGet || unexpected.URL != ts.URL+"/api/client/c-1" {
+		t.Fatalf("unexpected request: %s %s", unexpected.Method, unexpected.URL)
+	}
+}
+
+func TestDefaultResponse(t *testing.T) {
+	t.Parallel()
+
+	response, err := VisAdminClient.GetPartner(context.Background(), &api.GetPartnerRequest{PartnerId: "p-unavailable"})
+	if err != nil {
+		t.Fatalf("error sending GetPartner GET request: %v", err)
+	}
+	defaultResponse, ok := response.(*api.GetPartnerDefaultResponse)
+	if !ok {
+		t.Fatalf("unexpected response: %#v", response)
+	}
+	if defaultResponse.StatusCode() != http.StatusServiceUnavailable || defaultResponse.XRequestID != "r-1" {
+		t.Fatalf("unexpected status or headers: %#v", defaultResponse)
+	}
+	if defaultResponse.Body.Code != "unavailable" || defaultResponse.Body.Message == nil || *defaultResponse.Body.Message != "partner service unavailable" {
+		t.Fatalf("unexpected body: %#v", defaultResponse.Body)
+	}
+
+	client := api.NewVisAdminClient(new(http.Client), "http://localhost:4567", api.Opts{ResponseErrors: true})
+	_, err = client.GetPartner(context.Background(), &api.GetPartnerRequest{PartnerId: "p-unavailable"})
+	if !api.IsServerError(err) {
+		t.Fatalf("default response wasn't returned as error: %v", err)
+	}
+}
+
 // Test case for post upload
 func TestPostUpload(t *testing.T) {
 	t.Parallel()
@@ -255,7 +475,7 @@ func TestPostUpload(t *testing.T) {
 		},
 	}
 
-	resp, err := VisAdminClient.PostUpload(request)
+	resp, err := VisAdminClient.PostUpload(context.Background(), request)
 	if err != nil {
 		t.Fatalf("error sending upload error: %v", err)
 	}
@@ -274,7 +494,7 @@ func TestDownloadImage(t *testing.T) {
 		Image: "dummy.png",
 	}
 
-	resp, err := VisAdminClient.DownloadImage(request)
+	resp, err := VisAdminClient.DownloadImage(context.Background(), request)
 	if err != nil {
 		t.Fatalf("image download error: %v", err)
 	}
@@ -305,7 +525,7 @@ func TestGetShoes(t *testing.T) {
 
 	request := &api.GetShoesRequest{}
 
-	resp, err := VisAdminClient.GetShoes(request)
+	resp, err := VisAdminClient.GetShoes(context.Background(), request)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -324,7 +544,7 @@ func TestDownloadFileNotSupportedContentType(t *testing.T) {
 		File: "dummy.png",
 	}
 
-	_, err := VisAdminClient.DownloadFile(request)
+	_, err := VisAdminClient.DownloadFile(context.Background(), request)
 	if err == nil {
 		t.Fatal("error is nil")
 	}
@@ -338,7 +558,7 @@ func TestDownloadFileNotSupportedContentType(t *testing.T) {
 func TestCreateSessionFail(t *testing.T) {
 	t.Parallel()
 
-	_, err := VisAdminClient.CreateSession(nil)
+	_, err := VisAdminClient.CreateSession(context.Background(), nil)
 	if err == nil {
 		t.Fatal("sending nil request did not cause error")
 	}
@@ -348,7 +568,7 @@ func TestCreateSessionFail(t *testing.T) {
 func TestCreateSessionBadRequest(t *testing.T) {
 	t.Parallel()
 
-	response, err := VisAdminClient.CreateSession(&api.CreateSessionRequest{})
+	response, err := VisAdminClient.CreateSession(context.Background(), &api.CreateSessionRequest{})
 	if err != nil {
 		t.Fatalf("error sending CreateSession empty POST body caused error: %v", err)
 		return
@@ -363,7 +583,7 @@ func TestCreateSessionBadRequest(t *testing.T) {
 func TestUserWorkflow(t *testing.T) {
 	t.Parallel()
 
-	response, err := VisAdminClient.CreateSession(&api.CreateSessionRequest{Body: api.Object2{Id: id, Password: password}})
+	response, err := VisAdminClient.CreateSession(context.Background(), &api.CreateSessionRequest{Body: api.Object2{Id: id, Password: password}})
 	if err != nil {
 		t.Fatalf("error sending CreateSession POST request: %v", err)
 		return
@@ -377,7 +597,7 @@ func TestUserWorkflow(t *testing.T) {
 		} else {
 			auth := response200.XAuth
 
-			response, err := VisAdminClient.GetUserInfo(&api.GetUserInfoRequest{XAuth: "skfsdfj"})
+			response, err := VisAdminClient.GetUserInfo(context.Background(), &api.GetUserInfoRequest{XAuth: "skfsdfj"})
 			if err != nil {
 				t.Fatalf("error sending GetUserInfo GET request: %v", err)
 				return
@@ -387,7 +607,7 @@ func TestUserWorkflow(t *testing.T) {
 				t.Fatalf("response to get user info request without auth header is not 403: %#v", response)
 			}
 
-			response, err = VisAdminClient.GetUserInfo(&api.GetUserInfoRequest{XAuth: auth})
+			response, err = VisAdminClient.GetUserInfo(context.Background(), &api.GetUserInfoRequest{XAuth: auth})
 			if err != nil {
 				t.Fatalf("error sending GetUserInfo GET request: %v", err)
 				return
@@ -420,7 +640,7 @@ func TestGetClasses(t *testing.T) {
 	request.ComponentTypes = append(request.ComponentTypes, api.ComponentTypesWHEELS)
 	request.ProductGroup = api.ProductGroupPKW
 
-	response, err := VisAdminClient.GetClasses(request)
+	response, err := VisAdminClient.GetClasses(context.Background(), request)
 	if err != nil {
 		t.Fatalf("error sending GetClasses GET request: %v", err)
 		return
@@ -438,7 +658,7 @@ func TestCheckEmail(t *testing.T) {
 
 	// check optional validation
 	invalidEmail := "sdifisifjs"
-	invalidEmailResponse, _ := BadRequestVisAdminClient.CreateOrUpdateUser(&api.CreateOrUpdateUserRequest{
+	invalidEmailResponse, _ := BadRequestVisAdminClient.CreateOrUpdateUser(context.Background(), &api.CreateOrUpdateUserRequest{
 		XAuth:  "999",
 		UserId: "9",
 		Body: api.User{
@@ -452,10 +672,46 @@ func TestCheckEmail(t *testing.T) {
 	}
 }
 
+func TestClientSideValidation(t *testing.T) {
+	t.Parallel()
+
+	client := api.NewVisAdminClient(new(http.Client), "http://localhost:4567", api.Opts{Validate: true})
+
+	invalidEmail := "sdifisifjs"
+	response, err := client.CreateOrUpdateUser(context.Background(), &api.CreateOrUpdateUserRequest{
+		XAuth:  "999",
+		UserId: "9",
+		Body: api.User{
+			Id:       "9",
+			Password: "aosjdfosjdf",
+			Email:    &invalidEmail,
+		},
+	})
+	if response != nil {
+		t.Fatalf("invalid request was sent: %#v", response)
+	}
+
+	var validationErr *api.RequestValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(validationErr.Errors) != 1 || validationErr.Errors[0].Field != "email" || validationErr.Errors[0].Location != "body" {
+		t.Fatalf("unexpected validation errors: %#v", validationErr.Errors)
+	}
+
+	usersResponse, err := client.GetUsers(context.Background(), &api.GetUsersRequest{XAuth: "sessionID"})
+	if err != nil {
+		t.Fatalf("error sending valid GetUsers GET request: %v", err)
+	}
+	if _, ok := usersResponse.(*api.GetUsers200Response); !ok {
+		t.Fatalf("error GetUsers response is bad: %#v", usersResponse)
+	}
+}
+
 func TestCheckEmailOmitIfEmpty(t *testing.T) {
 	t.Parallel()
 
-	emailResponse, _ := VisAdminClient.CreateOrUpdateUser(&api.CreateOrUpdateUserRequest{
+	emailResponse, _ := VisAdminClient.CreateOrUpdateUser(context.Background(), &api.CreateOrUpdateUserRequest{
 		XAuth:  "999",
 		UserId: "9",
 		Body: api.User{
@@ -473,7 +729,7 @@ func TestCheckEmailOmitIfEmpty(t *testing.T) {
 func TestHandleSafePanic(t *testing.T) {
 	t.Parallel()
 
-	_, err := VisAdminClient.GetPermissions(&api.GetPermissionsRequest{XAuth: "99999999"})
+	_, err := VisAdminClient.GetPermissions(context.Background(), &api.GetPermissionsRequest{XAuth: "99999999"})
 	if err == nil || strings.Contains(err.Error(), "unknown response status code '500'") {
 		t.Fatalf("unexpected error: %#v", err.Error())
 	}
@@ -489,7 +745,10 @@ func TestSetHeaderFromContex(t *testing.T) {
 		XAuth: "test",
 	}
 
-	resp, err := fakeClient.GetClients(req)
+	headers := make(http.Header)
+	headers.Set("x-context-api-key", "test")
+
+	resp, err := fakeClient.GetClients(api.CreateHttpContext(headers), req)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -499,6 +758,60 @@ func TestSetHeaderFromContex(t *testing.T) {
 	}
 }
 
+func TestClientHooks(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Audit") != "GetClients" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id": "broken`))
+	}))
+	defer ts.Close()
+
+	var responses []string
+	var decodeErr error
+	hooks := api.HooksClient{
+		OnRequest: func(operation api.ClientOperation, request *http.Request) {
+			request.Header.Set("X-Audit", operation.ID)
+		},
+		OnResponse: func(operation api.ClientOperation, request *http.Request, response *http.Response, duration time.Duration) {
+			responses = append(responses, fmt.Sprintf("%s %s %d", operation.Method, operation.Route, response.StatusCode))
+		},
+		OnError: func(operation api.ClientOperation, request *http.Request, response *http.Response, err error) {
+			if response != nil {
+				decodeErr = err
+			}
+		},
+	}
+
+	client := api.NewVisAdminClient(new(http.Client), ts.URL, api.Opts{Hooks: hooks})
+
+	_, err := client.GetClients(context.Background(), &api.GetClientsRequest{XAuth: "test"})
+	if err == nil || err != decodeErr {
+		t.Fatalf("decode error wasn't passed to the hook: %v, %v", err, decodeErr)
+	}
+	if len(responses) != 1 || responses[0] != "GET /api/client 200" {
+		t.Fatalf("unexpected responses: %v", responses)
+	}
+
+	var transportErr error
+	hooks.OnError = func(operation api.ClientOperation, request *http.Request, response *http.Response, err error) {
+		if response == nil {
+			transportErr = err
+		}
+	}
+	ts.Close()
+
+	client = api.NewVisAdminClient(new(http.Client), ts.URL, api.Opts{Hooks: hooks})
+	_, err = client.GetClients(context.Background(), &api.GetClientsRequest{XAuth: "test"})
+	if err == nil || err != transportErr {
+		t.Fatalf("transport error wasn't passed to the hook: %v, %v", err, transportErr)
+	}
+}
+
 func TestGetListModelsQueryChaining(t *testing.T) {
 	t.Parallel()
 
@@ -512,7 +825,7 @@ func TestGetListModelsQueryChaining(t *testing.T) {
 		Ids:        []int64{1, 2, 3},
 	}
 
-	resp, err := VisAdminClient.ListModels(req)
+	resp, err := VisAdminClient.ListModels(context.Background(), req)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -526,7 +839,7 @@ func TestGetListModelsQueryChaining(t *testing.T) {
 		LanguageId: &testString,
 	}
 
-	resp, err = VisAdminClient.ListModels(req)
+	resp, err = VisAdminClient.ListModels(context.Background(), req)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -543,7 +856,7 @@ func TestGetJSONFile(t *testing.T) {
 		Ext: ".json",
 	}
 
-	resp, err := VisAdminClient.GenericFileDownload(request)
+	resp, err := VisAdminClient.GenericFileDownload(context.Background(), request)
 	if err != nil {
 		t.Fatalf("json download error: %v", err)
 	}
@@ -561,9 +874,9 @@ func TestGetJSONFile(t *testing.T) {
 func TestGetRental(t *testing.T) {
 	t.Parallel()
 
-	fields := []string{"Class", "LockStatus", "MaxDoors", "MinDoors", "Status", "Color", "Valid", "StationID", "HomeID", "Id", "Website"}
+	fields := []string{"class", "lockStatus", "maxDoors", "minDoors", "status", "color", "valid", "stationID", "homeID", "id", "website"}
 
-	resp, err := VisAdminClient.GetRental(&api.GetRentalRequest{
+	resp, err := VisAdminClient.GetRental(context.Background(), &api.GetRentalRequest{
 		Body: api.Rental{},
 	})
 	if err != nil {
@@ -588,9 +901,9 @@ func TestGetRental(t *testing.T) {
 	website := "http:example.de"
 	website2 := ""
 
-	fields = append(fields, "WebsiteOptional")
+	fields = append(fields, "websiteOptional")
 
-	resp, err = VisAdminClient.GetRental(&api.GetRentalRequest{Body: api.Rental{
+	resp, err = VisAdminClient.GetRental(context.Background(), &api.GetRentalRequest{Body: api.Rental{
 		Color:           &color,
 		Id:              uuid,
 		Website:         website,
@@ -615,7 +928,7 @@ func TestGetRental(t *testing.T) {
 
 	homeID := "r088888"
 
-	resp, err = VisAdminClient.GetRental(&api.GetRentalRequest{Body: api.Rental{HomeID: &homeID}})
+	resp, err = VisAdminClient.GetRental(context.Background(), &api.GetRentalRequest{Body: api.Rental{HomeID: &homeID}})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -637,7 +950,7 @@ func TestGetRental(t *testing.T) {
 func TestListElements(t *testing.T) {
 	t.Parallel()
 
-	resp, err := VisAdminClient.ListElements(&api.ListElementsRequest{})
+	resp, err := VisAdminClient.ListElements(context.Background(), &api.ListElementsRequest{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -654,7 +967,7 @@ func TestListElements(t *testing.T) {
 func TestCode(t *testing.T) {
 	t.Parallel()
 
-	resp, err := VisAdminClient.Code(&api.CodeRequest{
+	resp, err := VisAdminClient.Code(context.Background(), &api.CodeRequest{
 		State:   []int64{1, 2, 3},
 		Session: "test",
 		Code:    "rueeerjfid-ifjsfjfs-osdjfj",
@@ -672,7 +985,7 @@ func TestCode(t *testing.T) {
 func TestCreateCustomerSession_InvalidToken(t *testing.T) {
 
 	request := &api.CreateCustomerSessionRequest{Code: "invalid_token"}
-	resp, err := VisAdminClient.CreateCustomerSession(request)
+	resp, err := VisAdminClient.CreateCustomerSession(context.Background(), request)
 	if err != nil {
 		t.Fatalf("unexpected error = %+v", err)
 	}
@@ -686,7 +999,7 @@ func TestCreateCustomerSession_InvalidToken(t *testing.T) {
 func TestCreateCustomerSession_Success(t *testing.T) {
 
 	request := &api.CreateCustomerSessionRequest{Code: "abc"}
-	resp, err := VisAdminClient.CreateCustomerSession(request)
+	resp, err := VisAdminClient.CreateCustomerSession(context.Background(), request)
 	if err != nil {
 		t.Fatalf("unexpected error = %+v", err)
 	}
@@ -731,7 +1044,7 @@ func isValidVersion(t *testing.T, version *api.VersionInfo) {
 func TestDownloadNestedFile(t *testing.T) {
 	t.Parallel()
 
-	resp, err := VisAdminClient.DownloadNestedFile(&api.DownloadNestedFileRequest{})
+	resp, err := VisAdminClient.DownloadNestedFile(context.Background(), &api.DownloadNestedFileRequest{})
 	if err != nil {
 		t.Fatalf("nested file download failed: %v", err)
 	}
@@ -758,7 +1071,7 @@ func TestFileUpload(t *testing.T) {
 		},
 	}
 
-	resp, err := VisAdminClient.FileUpload(&req)
+	resp, err := VisAdminClient.FileUpload(context.Background(), &req)
 	if err != nil {
 		t.Fatalf("error during file upload: %v", err)
 	}
@@ -810,7 +1123,7 @@ func TestMinMaxItemsValidator(t *testing.T) {
 				Tags: test.tags,
 			}
 
-			resp, err := VisAdminClient.FindByTags(&req)
+			resp, err := VisAdminClient.FindByTags(context.Background(), &req)
 			if err != nil {
 				t.Fatalf("error find by tags failed: %v", err)
 			}
@@ -821,3 +1134,908 @@ func TestMinMaxItemsValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateParameters(t *testing.T) {
+	t.Parallel()
+
+	ratio := 0.5
+	zeroRatio := float64(0)
+	label := "a"
+	limit := int64(11)
+	mode := api.XModeFast
+	invalidMode := api.XMode("medium")
+
+	tests := []struct {
+		name    string
+		request api.ValidateParametersRequest
+		field   string
+		code    string
+	}{
+		{
+			name: "valid",
+			request: api.ValidateParametersRequest{
+				Id:       "abc",
+				XMode:    &mode,
+				FormData: api.ValidateParametersRequestFormData{Ratio: &ratio},
+			},
+		},
+		{
+			name:    "path_min_length",
+			request: api.ValidateParametersRequest{Id: "ab"},
+			field:   "id",
+			code:    "invalid-min",
+		},
+		{
+			name:    "path_pattern",
+			request: api.ValidateParametersRequest{Id: "ABC"},
+			field:   "id",
+			code:    "invalid-pattern",
+		},
+		{
+			name:    "header_enum",
+			request: api.ValidateParametersRequest{Id: "abc", XMode: &invalidMode},
+			field:   "X-Mode",
+			code:    "invalid-oneof",
+		},
+		{
+			name:    "header_maximum",
+			request: api.ValidateParametersRequest{Id: "abc", XLimit: &limit},
+			field:   "X-Limit",
+			code:    "invalid-max",
+		},
+		{
+			name: "form_exclusive_minimum",
+			request: api.ValidateParametersRequest{
+				Id:       "abc",
+				FormData: api.ValidateParametersRequestFormData{Ratio: &zeroRatio},
+			},
+			field: "ratio",
+			code:  "invalid-gt",
+		},
+		{
+			name: "form_min_length",
+			request: api.ValidateParametersRequest{
+				Id:       "abc",
+				FormData: api.ValidateParametersRequestFormData{Label: &label},
+			},
+			field: "label",
+			code:  "invalid-min",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			resp, err := VisAdminClient.ValidateParameters(context.Background(), &test.request)
+			if err != nil {
+				t.Fatalf("error sending ValidateParameters request: %v", err)
+			}
+
+			if test.code == "" {
+				if _, ok := resp.(*api.ValidateParameters204Response); !ok {
+					t.Fatalf("unexpected ValidateParameters response: %#v", resp)
+				}
+				return
+			}
+
+			badRequest, ok := resp.(*api.ValidateParameters400Response)
+			if !ok {
+				t.Fatalf("unexpected ValidateParameters response: %#v", resp)
+			}
+
+			if len(badRequest.Body.Errors) != 1 {
+				t.Fatalf("unexpected validation errors: %#v", badRequest.Body.Errors)
+			}
+
+			validationError := badRequest.Body.Errors[0]
+			if validationError.Field == nil || *validationError.Field != test.field || validationError.Code == nil || *validationError.Code != test.code {
+				t.Fatalf("validation error is bad, want: '%s' (%s), got: %#v", test.field, test.code, validationError)
+			}
+		})
+	}
+}
+
+func TestCreatePartner(t *testing.T) {
+	t.Parallel()
+
+	credit := int64(100)
+	partner := api.Partner{Id: "p-42", Name: "ACME & Sons", Credit: &credit, Tags: []string{"b2b", "legacy"}}
+
+	response, err := VisAdminClient.CreatePartner(context.Background(), &api.CreatePartnerRequest{Body: partner})
+	if err != nil {
+		t.Fatalf("error sending CreatePartner POST request: %v", err)
+	}
+
+	created, ok := response.(*api.CreatePartner201Response)
+	if !ok {
+		t.Fatalf("unexpected response: %#v", response)
+	}
+	if !reflect.DeepEqual(created.Body, partner) {
+		t.Fatalf("unexpected partner (expected: %#v, actual: %#v)", partner, created.Body)
+	}
+
+	response, err = VisAdminClient.CreatePartner(context.Background(), &api.CreatePartnerRequest{Body: api.Partner{Id: "p", Name: "ACME"}})
+	if err != nil {
+		t.Fatalf("error sending CreatePartner POST request: %v", err)
+	}
+
+	invalid, ok := response.(*api.CreatePartner400Response)
+	if !ok {
+		t.Fatalf("unexpected response: %#v", response)
+	}
+	if len(invalid.Body.Errors) != 1 || *invalid.Body.Errors[0].Field != "id" || *invalid.Body.Errors[0].Code != "invalid-min" {
+		t.Fatalf("unexpected validation errors: %#v", invalid.Body)
+	}
+}
+
+func TestListPartners(t *testing.T) {
+	t.Parallel()
+
+	want := []api.Partner{{Id: "p-1", Name: "Partner 1"}, {Id: "p-2", Name: "Partner 2"}}
+
+	// the client accepts JSON by default
+	limit := int64(2)
+	response, err := VisAdminClient.ListPartners(context.Background(), &api.ListPartnersRequest{Limit: &limit})
+	if err != nil {
+		t.Fatalf("error sending ListPartners GET request: %v", err)
+	}
+	list, ok := response.(*api.ListPartners200Response)
+	if !ok {
+		t.Fatalf("unexpected response: %#v", response)
+	}
+	if list.Items != nil {
+		t.Fatal("items are streamed without the StreamItems option")
+	}
+	if !reflect.DeepEqual(list.Body, want) {
+		t.Fatalf("unexpected partners (expected: %#v, actual: %#v)", want, list.Body)
+	}
+
+	// the StreamItems option prefers newline delimited JSON
+	client := api.NewVisAdminClient(new(http.Client), "http://localhost:4567", api.Opts{StreamItems: true})
+	response, err = client.ListPartners(context.Background(), &api.ListPartnersRequest{Limit: &limit})
+	if err != nil {
+		t.Fatalf("error sending ListPartners GET request: %v", err)
+	}
+	list, ok = response.(*api.ListPartners200Response)
+	if !ok {
+		t.Fatalf("unexpected response: %#v", response)
+	}
+	if list.Items == nil {
+		t.Fatal("items are not streamed")
+	}
+	defer list.Items.Close()
+
+	var partners []api.Partner
+	for {
+		partner, err := list.Items.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("error reading partner: %v", err)
+		}
+		partners = append(partners, *partner)
+	}
+	if !reflect.DeepEqual(partners, want) {
+		t.Fatalf("unexpected partners (expected: %#v, actual: %#v)", want, partners)
+	}
+
+	// clients which don't accept newline delimited JSON receive a JSON array
+	req, err := http.NewRequest(http.MethodGet, "http://localhost:4567/partners?limit=2", nil)
+	if err != nil {
+		t.Fatalf("error creating request: %v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("error sending request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if contentType := resp.Header.Get("Content-Type"); contentType != "application/json" {
+		t.Fatalf("content type is bad, want:'application/json', got:'%s'", contentType)
+	}
+	partners = nil
+	if err := json.NewDecoder(resp.Body).Decode(&partners); err != nil {
+		t.Fatalf("error decoding partners: %v", err)
+	}
+	if !reflect.DeepEqual(partners, want) {
+		t.Fatalf("unexpected partners (expected: %#v, actual: %#v)", want, partners)
+	}
+}
+
+func TestListPartnersProducerError(t *testing.T) {
+	t.Parallel()
+
+	for _, accept := range []string{"application/x-ndjson", "application/json"} {
+		t.Run(accept, func(t *testing.T) {
+
+			req, err := http.NewRequest(http.MethodGet, "http://localhost:4567/partners?limit=99", nil)
+			if err != nil {
+				t.Fatalf("error creating request: %v", err)
+			}
+			req.Header.Set("Accept", accept)
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("error sending request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("unexpected status code: %d", resp.StatusCode)
+			}
+			// the response is aborted instead of appending an error response to the stream
+			body, err := ioutil.ReadAll(resp.Body)
+			if err == nil {
+				t.Fatalf("response is not aborted: %q", body)
+			}
+			if strings.Contains(string(body), "Internal Server Error") || !strings.Contains(string(body), "p-1") {
+				t.Fatalf("unexpected body: %q", body)
+			}
+		})
+	}
+}
+
+func TestGetPartnerConditional(t *testing.T) {
+	t.Parallel()
+
+	response, err := VisAdminClient.GetPartner(context.Background(), &api.GetPartnerRequest{PartnerId: "p-1"})
+	if err != nil {
+		t.Fatalf("error sending GetPartner GET request: %v", err)
+	}
+	partner, ok := response.(*api.GetPartner200Response)
+	if !ok {
+		t.Fatalf("unexpected response: %#v", response)
+	}
+	if partner.ETag != "\"v1\"" {
+		t.Fatalf("unexpected entity tag: %s", partner.ETag)
+	}
+	if !partner.LastModified.Equal(time.Date(2021, 11, 27, 10, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected last modified date: %v", partner.LastModified)
+	}
+
+	tests := []struct {
+		name    string
+		request *api.GetPartnerRequest
+		status  int
+	}{
+		{name: "matching entity tag", request: &api.GetPartnerRequest{PartnerId: "p-1", IfNoneMatch: partner.ETag}, status: http.StatusNotModified},
+		{name: "other entity tag", request: &api.GetPartnerRequest{PartnerId: "p-1", IfNoneMatch: "\"v0\""}, status: http.StatusOK},
+		{name: "not modified since", request: &api.GetPartnerRequest{PartnerId: "p-1", IfModifiedSince: partner.LastModified}, status: http.StatusNotModified},
+		{name: "modified since", request: &api.GetPartnerRequest{PartnerId: "p-1", IfModifiedSince: partner.LastModified.Add(-time.Second)}, status: http.StatusOK},
+		{name: "missing partner", request: &api.GetPartnerRequest{PartnerId: "p-2", IfNoneMatch: partner.ETag}, status: http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, err := VisAdminClient.GetPartner(context.Background(), test.request)
+			if err != nil {
+				t.Fatalf("error sending GetPartner GET request: %v", err)
+			}
+			if response.StatusCode() != test.status {
+				t.Fatalf("unexpected status code (expected: %d, actual: %d)", test.status, response.StatusCode())
+			}
+			if notModified, ok := response.(*api.GetPartner304Response); ok && notModified.ETag != partner.ETag {
+				t.Fatalf("unexpected entity tag of not modified response: %s", notModified.ETag)
+			}
+		})
+	}
+}
+
+func TestUpdatePartnerPrecondition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		request *api.UpdatePartnerRequest
+		status  int
+	}{
+		{name: "matching entity tag", request: &api.UpdatePartnerRequest{PartnerId: "p-1", IfMatch: "\"v1\""}, status: http.StatusOK},
+		{name: "changed entity tag", request: &api.UpdatePartnerRequest{PartnerId: "p-1", IfMatch: "\"v0\""}, status: http.StatusPreconditionFailed},
+		{name: "changed since", request: &api.UpdatePartnerRequest{PartnerId: "p-1", IfUnmodifiedSince: time.Date(2021, 11, 27, 9, 0, 0, 0, time.UTC)}, status: http.StatusPreconditionFailed},
+		{name: "create only", request: &api.UpdatePartnerRequest{PartnerId: "p-1", IfNoneMatch: "*"}, status: http.StatusPreconditionFailed},
+		{name: "any of missing partner", request: &api.UpdatePartnerRequest{PartnerId: "p-2", IfMatch: "*"}, status: http.StatusPreconditionFailed},
+		{name: "unconditional", request: &api.UpdatePartnerRequest{PartnerId: "p-1"}, status: http.StatusOK},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.request.Body = api.Partner{Id: test.request.PartnerId, Name: "Updated partner"}
+			response, err := VisAdminClient.UpdatePartner(context.Background(), test.request)
+			if err != nil {
+				t.Fatalf("error sending UpdatePartner PUT request: %v", err)
+			}
+			if response.StatusCode() != test.status {
+				t.Fatalf("unexpected status code (expected: %d, actual: %d)", test.status, response.StatusCode())
+			}
+			if updated, ok := response.(*api.UpdatePartner200Response); ok && updated.ETag != "\"v2\"" {
+				t.Fatalf("unexpected entity tag of updated partner: %s", updated.ETag)
+			}
+		})
+	}
+}
+
+func TestDownloadPartnerContractRange(t *testing.T) {
+	t.Parallel()
+
+	contract := "%PDF-1.4 contract of partner p-1 %%EOF"
+
+	tests := []struct {
+		name         string
+		request      *api.DownloadPartnerContractRequest
+		status       int
+		contentRange string
+		body         string
+	}{
+		{name: "whole contract", request: &api.DownloadPartnerContractRequest{PartnerId: "p-1"}, status: http.StatusOK, body: contract},
+		{name: "first bytes", request: &api.DownloadPartnerContractRequest{PartnerId: "p-1", Range: "bytes=0-7"}, status: http.StatusPartialContent, contentRange: "bytes 0-7/38", body: "%PDF-1.4"},
+		{name: "resume", request: &api.DownloadPartnerContractRequest{PartnerId: "p-1", Range: "bytes=9-", IfRange: "\"v1\""}, status: http.StatusPartialContent, contentRange: "bytes 9-37/38", body: contract[9:]},
+		{name: "resume changed contract", request: &api.DownloadPartnerContractRequest{PartnerId: "p-1", Range: "bytes=9-", IfRange: "\"v0\""}, status: http.StatusOK, body: contract},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, err := VisAdminClient.DownloadPartnerContract(context.Background(), test.request)
+			if err != nil {
+				t.Fatalf("error sending DownloadPartnerContract GET request: %v", err)
+			}
+			if response.StatusCode() != test.status {
+				t.Fatalf("unexpected status code (expected: %d, actual: %d)", test.status, response.StatusCode())
+			}
+
+			var body io.ReadCloser
+			switch response := response.(type) {
+			case *api.DownloadPartnerContract200Response:
+				body = response.Body
+			case *api.DownloadPartnerContract206Response:
+				if response.ContentRange != test.contentRange {
+					t.Errorf("unexpected content range (expected: %s, actual: %s)", test.contentRange, response.ContentRange)
+				}
+				if response.ETag != "\"v1\"" {
+					t.Errorf("unexpected entity tag: %s", response.ETag)
+				}
+				body = response.Body
+			}
+			defer body.Close()
+
+			data, err := ioutil.ReadAll(body)
+			if err != nil {
+				t.Fatalf("error reading contract: %v", err)
+			}
+			if string(data) != test.body {
+				t.Fatalf("unexpected contract (expected: %s, actual: %s)", test.body, data)
+			}
+		})
+	}
+
+	_, err := VisAdminClient.DownloadPartnerContract(context.Background(), &api.DownloadPartnerContractRequest{PartnerId: "p-1", Range: "bytes=100-"})
+	if statusCode, ok := api.ResponseStatusCode(err); !ok || statusCode != http.StatusRequestedRangeNotSatisfiable {
+		t.Fatalf("unsatisfiable range is not rejected: %v", err)
+	}
+}
+
+func TestUploadPartnerDocuments(t *testing.T) {
+	t.Parallel()
+
+	file := func(name, contentType, content string) api.MimeFile {
+		header := &multipart.FileHeader{Filename: name, Header: textproto.MIMEHeader{"Content-Type": []string{contentType}}}
+		return api.MimeFile{Header: header, Content: ioutil.NopCloser(strings.NewReader(content))}
+	}
+
+	tests := []struct {
+		name      string
+		documents []api.MimeFile
+		status    int
+		uploaded  []api.UploadedFile
+	}{
+		{
+			name:      "several documents",
+			documents: []api.MimeFile{file("contract.pdf", "application/pdf", "%PDF-1.4"), file("logo.png", "image/png", "PNG")},
+			status:    http.StatusOK,
+			uploaded: []api.UploadedFile{
+				{Field: "documents", Name: "contract.pdf", Size: 8},
+				{Field: "documents", Name: "logo.png", Size: 3},
+				{Field: "cover", Name: "cover.png", Size: 5},
+			},
+		},
+		{name: "document too large", documents: []api.MimeFile{file("contract.pdf", "application/pdf", strings.Repeat("x", 65))}, status: http.StatusRequestEntityTooLarge},
+		{name: "unsupported document", documents: []api.MimeFile{file("notes.txt", "text/plain", "notes")}, status: http.StatusUnsupportedMediaType},
+		{name: "missing documents", status: http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cover := file("cover.png", "image/png", "cover")
+			request := &api.UploadPartnerDocumentsRequest{
+				PartnerId: "p-1",
+				FormData:  api.UploadPartnerDocumentsRequestFormData{Category: "contracts", Documents: test.documents, Cover: &cover},
+			}
+			response, err := VisAdminClient.UploadPartnerDocuments(context.Background(), request)
+			// the operation doesn't declare its 400 response
+			if test.status == http.StatusBadRequest {
+				if !api.IsBadRequest(err) {
+					t.Fatalf("missing documents are not rejected: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error sending UploadPartnerDocuments POST request: %v", err)
+			}
+			if response.StatusCode() != test.status {
+				t.Fatalf("unexpected status code (expected: %d, actual: %d)", test.status, response.StatusCode())
+			}
+			if uploaded, ok := response.(*api.UploadPartnerDocuments200Response); ok {
+				category := "contracts"
+				for i := range test.uploaded {
+					test.uploaded[i].Note = &category
+				}
+				if !reflect.DeepEqual(uploaded.Body, test.uploaded) {
+					t.Fatalf("unexpected documents (expected: %#v, actual: %#v)", test.uploaded, uploaded.Body)
+				}
+			}
+		})
+	}
+}
+
+func TestImportPartnerArchive(t *testing.T) {
+	t.Parallel()
+
+	file := func(name, content string) api.MimeFile {
+		return api.MimeFile{Header: &multipart.FileHeader{Filename: name}, Content: ioutil.NopCloser(strings.NewReader(content))}
+	}
+
+	request := &api.ImportPartnerArchiveRequest{
+		PartnerId: "p-1",
+		FormData:  api.ImportPartnerArchiveRequestFormData{Note: "import", Files: []api.MimeFile{file("a.csv", "a;b"), file("b.csv", "c;d;e")}},
+	}
+	response, err := VisAdminClient.ImportPartnerArchive(context.Background(), request)
+	if err != nil {
+		t.Fatalf("error sending ImportPartnerArchive POST request: %v", err)
+	}
+	imported, ok := response.(*api.ImportPartnerArchive200Response)
+	if !ok {
+		t.Fatalf("unexpected response: %#v", response)
+	}
+	note := "import"
+	want := []api.UploadedFile{{Field: "files", Name: "a.csv", Size: 3, Note: &note}, {Field: "files", Name: "b.csv", Size: 5, Note: &note}}
+	if !reflect.DeepEqual(imported.Body, want) {
+		t.Fatalf("unexpected files (expected: %#v, actual: %#v)", want, imported.Body)
+	}
+
+	request.FormData.Files = []api.MimeFile{file("large.csv", strings.Repeat("x", 5000))}
+	response, err = VisAdminClient.ImportPartnerArchive(context.Background(), request)
+	if err != nil {
+		t.Fatalf("error sending ImportPartnerArchive POST request: %v", err)
+	}
+	if response.StatusCode() != http.StatusRequestEntityTooLarge {
+		t.Fatalf("unexpected status code (expected: %d, actual: %d)", http.StatusRequestEntityTooLarge, response.StatusCode())
+	}
+}
+
+func TestWatchPartners(t *testing.T) {
+	t.Parallel()
+
+	watch := func(lastEventID string) *api.WatchPartnersEventReader {
+		response, err := VisAdminClient.WatchPartners(context.Background(), &api.WatchPartnersRequest{LastEventID: lastEventID})
+		if err != nil {
+			t.Fatalf("error sending WatchPartners GET request: %v", err)
+		}
+		stream, ok := response.(*api.WatchPartners200Response)
+		if !ok {
+			t.Fatalf("unexpected response: %#v", response)
+		}
+		return stream.Events
+	}
+
+	events := watch("")
+	event, err := events.Next()
+	if err != nil {
+		t.Fatalf("error reading event: %v", err)
+	}
+	want := &api.WatchPartnersEvent{Name: "partner", ID: "1", Data: api.Partner{Id: "p-1", Name: "Partner 1"}}
+	if !reflect.DeepEqual(event, want) {
+		t.Fatalf("unexpected event (expected: %#v, actual: %#v)", want, event)
+	}
+	if err := events.Close(); err != nil {
+		t.Fatalf("error closing event stream: %v", err)
+	}
+
+	// resume the stream after the last received event
+	events = watch(events.LastEventID())
+	defer events.Close()
+
+	var ids []string
+	for {
+		event, err := events.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("error reading event: %v", err)
+		}
+		ids = append(ids, event.ID)
+	}
+	if !reflect.DeepEqual(ids, []string{"2", "3"}) {
+		t.Fatalf("unexpected events of resumed stream: %v", ids)
+	}
+
+	response, err := VisAdminClient.WatchPartners(context.Background(), &api.WatchPartnersRequest{LastEventID: "last"})
+	if err != nil {
+		t.Fatalf("error sending WatchPartners GET request: %v", err)
+	}
+	if _, ok := response.(*api.WatchPartners400Response); !ok {
+		t.Fatalf("unexpected response: %#v", response)
+	}
+}
+
+func TestChatWithPartner(t *testing.T) {
+	t.Parallel()
+
+	response, err := VisAdminClient.ChatWithPartner(context.Background(), &api.ChatWithPartnerRequest{PartnerId: "p-1"})
+	if err != nil {
+		t.Fatalf("error dialing ChatWithPartner WebSocket: %v", err)
+	}
+	chat, ok := response.(*api.ChatWithPartner101Response)
+	if !ok {
+		t.Fatalf("unexpected response: %#v", response)
+	}
+	defer chat.Conn.Close()
+
+	if err := chat.Conn.Send(&api.ChatMessage{Text: "hello"}); err != nil {
+		t.Fatalf("error sending message: %v", err)
+	}
+	reply, err := chat.Conn.Receive()
+	if err != nil {
+		t.Fatalf("error receiving reply: %v", err)
+	}
+	want := &api.ChatReply{PartnerId: "p-1", Text: "re: hello"}
+	if !reflect.DeepEqual(reply, want) {
+		t.Fatalf("unexpected reply (expected: %#v, actual: %#v)", want, reply)
+	}
+
+	// the server validates the inbound messages and replies to the invalid one
+	if err := chat.Conn.Send(&api.ChatMessage{}); err != nil {
+		t.Fatalf("error sending message: %v", err)
+	}
+	reply, err = chat.Conn.Receive()
+	if err != nil {
+		t.Fatalf("error receiving reply: %v", err)
+	}
+	if reply.Text != "invalid message" {
+		t.Fatalf("unexpected reply to invalid message: %#v", reply)
+	}
+
+	response, err = VisAdminClient.ChatWithPartner(context.Background(), &api.ChatWithPartnerRequest{PartnerId: "p-2"})
+	if err != nil {
+		t.Fatalf("error dialing ChatWithPartner WebSocket: %v", err)
+	}
+	if _, ok := response.(*api.ChatWithPartner404Response); !ok {
+		t.Fatalf("unexpected response: %#v", response)
+	}
+}
+
+func TestChatWithPartnerHooks(t *testing.T) {
+	t.Parallel()
+
+	audits := make(chan string, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		audits <- r.Header.Get("X-Audit")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	// the handshake isn't sent by the transport of the client, but the hooks can change it like other requests
+	hooks := api.HooksClient{
+		OnRequest: func(operation api.ClientOperation, request *http.Request) {
+			request.Header.Set("X-Audit", operation.ID)
+		},
+	}
+	client := api.NewVisAdminClient(new(http.Client), ts.URL, api.Opts{Hooks: hooks})
+
+	response, err := client.ChatWithPartner(context.Background(), &api.ChatWithPartnerRequest{PartnerId: "p-1"})
+	if err != nil {
+		t.Fatalf("error dialing ChatWithPartner WebSocket: %v", err)
+	}
+	if _, ok := response.(*api.ChatWithPartner404Response); !ok {
+		t.Fatalf("unexpected response: %#v", response)
+	}
+	if audit := <-audits; audit != "ChatWithPartner" {
+		t.Fatalf("hook didn't change the handshake (X-Audit: %q)", audit)
+	}
+}
+
+func TestContentNegotiationSerializable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		accept      string
+		status      int
+		contentType string
+	}{
+		{name: "no accept header", status: http.StatusOK, contentType: "application/json"},
+		{name: "declared plain text", accept: "text/plain", status: http.StatusNotAcceptable},
+		{name: "plain text preferred", accept: "text/plain, application/json;q=0.1", status: http.StatusOK, contentType: "application/json"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			req, err := http.NewRequest(http.MethodGet, "http://localhost:4567/partners/p-1/profile", nil)
+			if err != nil {
+				t.Fatalf("error creating request: %v", err)
+			}
+			if test.accept != "" {
+				req.Header.Set("Accept", test.accept)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("error sending request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != test.status {
+				t.Fatalf("response status is bad, want:'%d', got:'%d'", test.status, resp.StatusCode)
+			}
+			// the Vary header of the middleware is kept
+			if vary := resp.Header["Vary"]; !reflect.DeepEqual(vary, []string{"Origin", "Accept"}) {
+				t.Fatalf("vary header is bad, want:'[Origin Accept]', got:'%v'", vary)
+			}
+			if contentType := resp.Header.Get("Content-Type"); test.contentType != "" && contentType != test.contentType {
+				t.Fatalf("content type is bad, want:'%s', got:'%s'", test.contentType, contentType)
+			}
+		})
+	}
+}
+
+func TestContentNegotiation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		accept      string
+		status      int
+		contentType string
+	}{
+		{
+			name:        "no accept header",
+			status:      http.StatusCreated,
+			contentType: "application/xml",
+		},
+		{
+			name:        "preferred json",
+			accept:      "application/xml;q=0.5, application/json",
+			status:      http.StatusCreated,
+			contentType: "application/json",
+		},
+		{
+			name:        "wildcard",
+			accept:      "text/html, */*;q=0.1",
+			status:      http.StatusCreated,
+			contentType: "application/xml",
+		},
+		{
+			name:   "not acceptable",
+			accept: "text/html",
+			status: http.StatusNotAcceptable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			req, err := http.NewRequest(http.MethodPost, "http://localhost:4567/partners", strings.NewReader("<Partner><id>p-42</id><name>ACME</name></Partner>"))
+			if err != nil {
+				t.Fatalf("error creating request: %v", err)
+			}
+			req.Header.Set("Content-Type", "application/xml")
+			if test.accept != "" {
+				req.Header.Set("Accept", test.accept)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("error sending request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != test.status {
+				t.Fatalf("response status is bad, want:'%d', got:'%d'", test.status, resp.StatusCode)
+			}
+			if vary := resp.Header.Get("Vary"); vary != "Accept" {
+				t.Fatalf("vary header is bad, want:'Accept', got:'%s'", vary)
+			}
+			if contentType := resp.Header.Get("Content-Type"); test.contentType != "" && contentType != test.contentType {
+				t.Fatalf("content type is bad, want:'%s', got:'%s'", test.contentType, contentType)
+			}
+		})
+	}
+}
+
+func TestBadRequestXML(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		body  string
+		field string
+		code  string
+	}{
+		{
+			name:  "syntax",
+			body:  `<Partner><id>abc</id>`,
+			field: "body",
+			code:  "invalid-xml",
+		},
+		{
+			name:  "validation",
+			body:  `<Partner><id>p</id><name>ACME</name></Partner>`,
+			field: "id",
+			code:  "invalid-min",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			req, err := http.NewRequest(http.MethodPost, "http://localhost:4567/partners", strings.NewReader(test.body))
+			if err != nil {
+				t.Fatalf("error creating request: %v", err)
+			}
+			req.Header.Set("Content-Type", "text/xml")
+			req.Header.Set("Accept", "application/xml")
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("error sending request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Fatalf("response status is bad, want:'%d', got:'%d'", http.StatusBadRequest, resp.StatusCode)
+			}
+			if contentType := resp.Header.Get("Content-Type"); contentType != "application/xml" {
+				t.Fatalf("content type is bad, want:'application/xml', got:'%s'", contentType)
+			}
+
+			// the root element is named by the schema of the validation errors
+			body, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("error reading validation errors: %v", err)
+			}
+			if !strings.Contains(string(body), "<validationErrors>") {
+				t.Fatalf("root element of validation errors is bad: %s", body)
+			}
+
+			var validationErrors api.ValidationErrors
+			if err := xml.Unmarshal(body, &validationErrors); err != nil {
+				t.Fatalf("error decoding validation errors: %v", err)
+			}
+			if len(validationErrors.Errors) != 1 || *validationErrors.Errors[0].Field != test.field || *validationErrors.Errors[0].Code != test.code {
+				t.Fatalf("unexpected validation errors: %s", body)
+			}
+		})
+	}
+}
+
+func TestBadRequestConversionErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		header   http.Header
+		body     string
+		field    string
+		location string
+		pointer  string
+		code     string
+	}{
+		{
+			name:     "body_type_mismatch",
+			method:   http.MethodPost,
+			path:     "/api/session",
+			header:   http.Header{"Content-Type": []string{"application/json"}},
+			body:     `{"id": 1, "password": "password"}`,
+			field:    "id",
+			location: "body",
+			pointer:  "/id",
+			code:     "invalid-type",
+		},
+		{
+			name:     "body_missing_required",
+			method:   http.MethodPost,
+			path:     "/api/session",
+			header:   http.Header{"Content-Type": []string{"application/json"}},
+			body:     `{"password": "password"}`,
+			field:    "id",
+			location: "body",
+			pointer:  "/id",
+			code:     "required",
+		},
+		{
+			name:     "body_syntax",
+			method:   http.MethodPost,
+			path:     "/api/session",
+			header:   http.Header{"Content-Type": []string{"application/json"}},
+			body:     `{"id": `,
+			field:    "body",
+			location: "body",
+			code:     "invalid-json",
+		},
+		{
+			name:     "xml_body_syntax",
+			method:   http.MethodPost,
+			path:     "/partners",
+			header:   http.Header{"Content-Type": []string{"text/xml"}, "Accept": []string{"application/json"}},
+			body:     `<Partner><id>abc</id>`,
+			field:    "body",
+			location: "body",
+			code:     "invalid-xml",
+		},
+		{
+			name:     "header_type_mismatch",
+			method:   http.MethodPost,
+			path:     "/parameters/abc",
+			header:   http.Header{"Content-Type": []string{"multipart/form-data; boundary=b"}, "X-Limit": []string{"abc"}},
+			body:     "--b--\r\n",
+			field:    "X-Limit",
+			location: "header",
+			code:     "invalid-type",
+		},
+		{
+			name:     "header_missing_required",
+			method:   http.MethodGet,
+			path:     "/api/session",
+			field:    "X-Auth",
+			location: "header",
+			code:     "required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			req, err := http.NewRequest(test.method, "http://localhost:4567"+test.path, strings.NewReader(test.body))
+			if err != nil {
+				t.Fatalf("error creating request: %v", err)
+			}
+			for key, values := range test.header {
+				req.Header[key] = values
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("error sending request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Fatalf("response status is bad, want:'%d', got:'%d'", http.StatusBadRequest, resp.StatusCode)
+			}
+
+			var validationErrors struct {
+				Errors []struct {
+					Field    string `json:"field"`
+					Code     string `json:"code"`
+					Location string `json:"location"`
+					Pointer  string `json:"pointer"`
+				} `json:"errors"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&validationErrors); err != nil {
+				t.Fatalf("error decoding validation errors: %v", err)
+			}
+
+			if len(validationErrors.Errors) != 1 {
+				t.Fatalf("unexpected validation errors: %#v", validationErrors.Errors)
+			}
+
+			got := validationErrors.Errors[0]
+			if got.Field != test.field || got.Location != test.location || got.Pointer != test.pointer || got.Code != test.code {
+				t.Fatalf("validation error is bad, want: '%s' in %s at '%s' (%s), got: %#v", test.field, test.location, test.pointer, test.code, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package tests
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ExperienceOne/apikit/tests/api"
+)
+
+// closeCounter counts the closed files of the responses
+type closeCounter struct {
+	io.Reader
+	closed *int32
+}
+
+func (c *closeCounter) Close() error {
+	atomic.AddInt32(c.closed, 1)
+	return nil
+}
+
+// Tests closing the file of a download which is replaced by the evaluation of the preconditions.
+func TestConditionalDownloadClosesFile(t *testing.T) {
+
+	var closed int32
+
+	server := api.NewVisAdminServer(&api.ServerOpts{ErrorHandler: log.Println})
+	server.SetDownloadPartnerContractHandler(func(ctx context.Context, request *api.DownloadPartnerContractRequest) api.DownloadPartnerContractResponse {
+		return &api.DownloadPartnerContract200Response{
+			Body:        &closeCounter{Reader: strings.NewReader("%PDF-1.4"), closed: &closed},
+			ContentType: "application/pdf",
+			ETag:        "v1",
+		}
+	})
+
+	go server.Start(4573)
+
+	defer server.Server.Stop()
+
+	time.Sleep(1 * time.Second)
+
+	tests := []struct {
+		name   string
+		header string
+		value  string
+		status int
+	}{
+		{name: "not modified", header: "If-None-Match", value: "\"v1\"", status: http.StatusNotModified},
+		{name: "precondition failed", header: "If-Match", value: "\"v0\"", status: http.StatusPreconditionFailed},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			atomic.StoreInt32(&closed, 0)
+
+			req, err := http.NewRequest(http.MethodGet, "http://localhost:4573/partners/p-1/contract", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set(test.header, test.value)
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != test.status {
+				t.Fatalf("unexpected status code (expected: %d, actual: %d)", test.status, resp.StatusCode)
+			}
+			if atomic.LoadInt32(&closed) != 1 {
+				t.Fatalf("file of the replaced response is not closed")
+			}
+		})
+	}
+}
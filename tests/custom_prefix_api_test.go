@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"context"
 	"log"
 	"net/http"
 	"testing"
@@ -37,7 +38,7 @@ func TestCustomPrefix(t *testing.T) {
 
 	client := api.NewVisAdminClient(new(http.Client), "http://localhost:4568/my-custom-prefix", opts)
 
-	response, err := client.GetUsers(&api.GetUsersRequest{
+	response, err := client.GetUsers(context.Background(), &api.GetUsersRequest{
 		XAuth: "sessionID",
 	})
 
@@ -0,0 +1,151 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ExperienceOne/apikit/middleware"
+	"github.com/ExperienceOne/apikit/tests/api"
+)
+
+// Tests the errors of the generated server written as problem details (RFC 7807).
+func TestProblemDetails(t *testing.T) {
+
+	server := api.NewVisAdminServer(&api.ServerOpts{
+		ErrorHandler:   log.Println,
+		Middleware:     []api.Middleware{{Handler: middleware.RequestID().Handler}},
+		ProblemDetails: true,
+		ProblemExtension: func(r *http.Request, err error, problem *api.Problem) {
+			problem.Extensions["service"] = "vis-admin"
+		},
+	})
+
+	server.SetCreateSessionHandler(api.CreateSession)
+	server.SetValidateParametersHandler(api.ValidateParameters)
+	server.SetGetUsersHandler(nil)
+	// the request is rejected before the handler is called, the operation declares no validation errors
+	server.SetGetClientsHandler(func(ctx context.Context, request *api.GetClientsRequest) api.GetClientsResponse {
+		return &api.GetClients200Response{}
+	})
+
+	go server.Start(4571)
+
+	defer server.Server.Stop()
+
+	time.Sleep(1 * time.Second)
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		contentType string
+		body        string
+		status      int
+		detail      string
+		errors      int
+	}{
+		{
+			name:        "unsupported content type",
+			method:      http.MethodPost,
+			path:        "/api/session",
+			contentType: "text/plain",
+			body:        "user",
+			status:      http.StatusUnsupportedMediaType,
+			detail:      "error unsupported media type (text/plain)",
+		},
+		{
+			name:        "decode failure",
+			method:      http.MethodPost,
+			path:        "/api/session",
+			contentType: "application/json",
+			body:        `{"user":`,
+			status:      http.StatusBadRequest,
+			detail:      "validation failed",
+			errors:      1,
+		},
+		{
+			name:        "validation failure",
+			method:      http.MethodPost,
+			path:        "/parameters/a",
+			contentType: "multipart/form-data; boundary=b",
+			body:        "--b--\r\n",
+			status:      http.StatusBadRequest,
+			detail:      "validation failed",
+			errors:      1,
+		},
+		{
+			name:   "undeclared validation errors",
+			method: http.MethodGet,
+			path:   "/api/client",
+			status: http.StatusBadRequest,
+			detail: "validation failed",
+			errors: 1,
+		},
+		{
+			name:   "missing handler",
+			method: http.MethodGet,
+			path:   "/api/user",
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			req, err := http.NewRequest(test.method, "http://localhost:4571"+test.path, strings.NewReader(test.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.contentType != "" {
+				req.Header.Set("Content-Type", test.contentType)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != test.status {
+				t.Fatalf("unexpected status code (expected: %d, actual: %d)", test.status, resp.StatusCode)
+			}
+			if contentType := resp.Header.Get("Content-Type"); contentType != "application/problem+json" {
+				t.Fatalf("unexpected content type (expected: application/problem+json, actual: %s)", contentType)
+			}
+
+			var problem struct {
+				Type     string            `json:"type"`
+				Title    string            `json:"title"`
+				Status   int               `json:"status"`
+				Detail   string            `json:"detail"`
+				Instance string            `json:"instance"`
+				Service  string            `json:"service"`
+				Errors   []json.RawMessage `json:"errors"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&problem); err != nil {
+				t.Fatal(err)
+			}
+
+			if problem.Type != "about:blank" || problem.Title != http.StatusText(test.status) || problem.Status != test.status {
+				t.Errorf("unexpected problem: %+v", problem)
+			}
+			if problem.Detail != test.detail {
+				t.Errorf("unexpected detail (expected: %q, actual: %q)", test.detail, problem.Detail)
+			}
+			if problem.Instance == "" {
+				t.Error("request ID of problem is empty")
+			}
+			if problem.Service != "vis-admin" {
+				t.Errorf("extension of problem is missing: %+v", problem)
+			}
+			if len(problem.Errors) != test.errors {
+				t.Errorf("unexpected count of errors (expected: %d, actual: %d)", test.errors, len(problem.Errors))
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ExperienceOne/apikit/internal/framework/xserver"
+	"github.com/ExperienceOne/apikit/router"
+	"github.com/ExperienceOne/apikit/tests/api"
+)
+
+// Tests running the generated server on a chi router.
+func TestChiRouter(t *testing.T) {
+	testRouter(t, router.Chi(nil), 4569)
+}
+
+// Tests running the generated server on an explicit ozzo-routing router.
+func TestOzzoRouter(t *testing.T) {
+	testRouter(t, router.Ozzo(), 4572)
+}
+
+func testRouter(t *testing.T, r xserver.Router, port int) {
+
+	server := api.NewVisAdminServer(&api.ServerOpts{
+		ErrorHandler:   log.Println,
+		Router:         r,
+		ProblemDetails: true,
+	})
+
+	server.SetGetClassesHandler(func(ctx context.Context, request *api.GetClassesRequest) api.GetClassesResponse {
+		if request.ProductGroup != api.ProductGroupPKW {
+			return &api.GetClasses400Response{}
+		}
+		return &api.GetClasses200Response{}
+	})
+
+	go server.Start(port)
+
+	defer server.Server.Stop()
+
+	time.Sleep(1 * time.Second)
+
+	client := api.NewVisAdminClient(new(http.Client), "http://localhost:"+strconv.Itoa(port), api.Opts{})
+
+	request := new(api.GetClassesRequest)
+	request.ComponentTypes = append(request.ComponentTypes, api.ComponentTypesWHEELS)
+	request.ProductGroup = api.ProductGroupPKW
+
+	response, err := client.GetClasses(context.Background(), request)
+	if err != nil {
+		t.Fatalf("error sending GetClasses GET request: %v", err)
+	}
+
+	if _, ok := response.(*api.GetClasses200Response); !ok {
+		t.Fatalf("error GetClasses response is bad: %#v", response)
+	}
+
+	// routing errors are rendered by the server like the errors of the handlers
+	routingErrors := []struct {
+		method string
+		path   string
+		status int
+	}{
+		{method: http.MethodGet, path: "/unknown", status: http.StatusNotFound},
+		{method: http.MethodDelete, path: "/classes/pkw", status: http.StatusMethodNotAllowed},
+	}
+
+	for _, routingError := range routingErrors {
+
+		req, err := http.NewRequest(routingError.method, "http://localhost:"+strconv.Itoa(port)+routingError.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != routingError.status {
+			t.Fatalf("unexpected status code of %s %s (expected: %d, actual: %d)", routingError.method, routingError.path, routingError.status, resp.StatusCode)
+		}
+		if contentType := resp.Header.Get("Content-Type"); contentType != "application/problem+json" {
+			t.Fatalf("unexpected content type of %s %s (expected: application/problem+json, actual: %s)", routingError.method, routingError.path, contentType)
+		}
+		if routingError.status == http.StatusMethodNotAllowed && !strings.Contains(resp.Header.Get("Allow"), http.MethodGet) {
+			t.Fatalf("unexpected allowed methods: %s", resp.Header.Get("Allow"))
+		}
+	}
+}
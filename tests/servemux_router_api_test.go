@@ -0,0 +1,17 @@
+//go:build go1.22
+// +build go1.22
+
+//go:debug httpmuxgo121=0
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/ExperienceOne/apikit/router"
+)
+
+// Tests running the generated server on a http.ServeMux.
+func TestServeMuxRouter(t *testing.T) {
+	testRouter(t, router.ServeMux(nil), 4570)
+}
@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"log"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ExperienceOne/apikit/tests/api"
+)
+
+// Tests the upgrade of WebSockets configured by the server options.
+func TestWebSocketOrigin(t *testing.T) {
+
+	server := api.NewVisAdminServer(&api.ServerOpts{
+		ErrorHandler: log.Println,
+		WebSockets: api.WebSocketOpts{
+			CheckOrigin: func(r *http.Request) bool {
+				return r.Header.Get("Origin") == "https://partners.example.com"
+			},
+		},
+	})
+	server.SetChatWithPartnerHandler(api.ChatWithPartner)
+
+	go server.Start(4574)
+
+	defer server.Server.Stop()
+
+	time.Sleep(1 * time.Second)
+
+	client := api.NewVisAdminClient(new(http.Client), "http://localhost:4574", api.Opts{})
+
+	tests := []struct {
+		name     string
+		origin   string
+		accepted bool
+	}{
+		{
+			name:     "accepted origin",
+			origin:   "https://partners.example.com",
+			accepted: true,
+		},
+		{
+			name:   "rejected origin",
+			origin: "https://attacker.example.com",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+
+			ctx := api.CreateHttpContext(http.Header{"Origin": []string{test.origin}})
+			response, err := client.ChatWithPartner(ctx, &api.ChatWithPartnerRequest{PartnerId: "p-1"})
+
+			if !test.accepted {
+				if !api.IsForbidden(err) {
+					t.Fatalf("handshake of origin is not rejected: %v, %#v", err, response)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("error dialing ChatWithPartner WebSocket: %v", err)
+			}
+			chat, ok := response.(*api.ChatWithPartner101Response)
+			if !ok {
+				t.Fatalf("unexpected response: %#v", response)
+			}
+			defer chat.Conn.Close()
+
+			if err := chat.Conn.Send(&api.ChatMessage{Text: "hello"}); err != nil {
+				t.Fatalf("error sending message: %v", err)
+			}
+			if reply, err := chat.Conn.Receive(); err != nil || reply.Text != "re: hello" {
+				t.Fatalf("unexpected reply: %#v, %v", reply, err)
+			}
+		})
+	}
+}